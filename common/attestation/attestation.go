@@ -0,0 +1,192 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+// Package attestation verifies device attestations used by attestation posture checks.
+//
+// An attestation is a device certificate chain plus a signature, made with the device certificate's private key, over
+// a payload bound to the API session the attestation is submitted for. The private key will usually be held in a TPM
+// or other secure element, so that a valid attestation can't be produced by software which only has access to the
+// SDK. The signature proves possession of the key; whether the device certificate is trusted is decided separately by
+// each posture check, which verifies the chain against its own CA bundle.
+package attestation
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// MaxClockSkew is how far the timestamp in an attestation may differ from the current time
+const MaxClockSkew = 5 * time.Minute
+
+const payloadPrefix = "hanzozt-posture-attestation"
+
+// Payload returns the data a device signs to attest for the given API session. The timestamp is signed as provided,
+// and must be an RFC 3339 time.
+func Payload(apiSessionId, timestamp string) []byte {
+	return []byte(payloadPrefix + "\n" + apiSessionId + "\n" + timestamp)
+}
+
+// Evidence is an attestation submitted by a client
+type Evidence struct {
+	// Certificates is the device certificate chain, leaf first
+	Certificates []*x509.Certificate
+	// Timestamp is the RFC 3339 time the attestation was made
+	Timestamp string
+	// Signature is the signature over Payload made with the device certificate's key
+	Signature []byte
+}
+
+// Verify checks that the evidence is fresh, that the device certificate is currently valid and that the signature
+// was made by the device certificate's key for the given API session. It does not check that the device certificate
+// is trusted, see VerifyChain.
+func (self *Evidence) Verify(apiSessionId string, now time.Time) error {
+	if len(self.Certificates) == 0 {
+		return errors.New("no device certificate provided")
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, self.Timestamp)
+	if err != nil {
+		return errors.Wrap(err, "invalid attestation timestamp")
+	}
+
+	if skew := now.Sub(timestamp); skew > MaxClockSkew || skew < -MaxClockSkew {
+		return fmt.Errorf("attestation timestamp %s is not within %s of the current time", self.Timestamp, MaxClockSkew)
+	}
+
+	leaf := self.Certificates[0]
+	if now.Before(leaf.NotBefore) || now.After(leaf.NotAfter) {
+		return fmt.Errorf("device certificate is not valid at %s", now.UTC().Format(time.RFC3339))
+	}
+
+	return VerifySignature(leaf, Payload(apiSessionId, self.Timestamp), self.Signature)
+}
+
+// VerifySignature checks a signature over payload made with the key of the given certificate. ECDSA signatures are
+// expected in ASN.1 form and RSA signatures as PKCS #1 v1.5 or PSS, both over a SHA-256 digest. Ed25519 signatures are
+// over the payload itself.
+func VerifySignature(cert *x509.Certificate, payload, signature []byte) error {
+	digest := sha256.Sum256(payload)
+
+	switch pub := cert.PublicKey.(type) {
+	case *ecdsa.PublicKey:
+		if ecdsa.VerifyASN1(pub, digest[:], signature) {
+			return nil
+		}
+	case *rsa.PublicKey:
+		if rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], signature) == nil {
+			return nil
+		}
+		if rsa.VerifyPSS(pub, crypto.SHA256, digest[:], signature, nil) == nil {
+			return nil
+		}
+	case ed25519.PublicKey:
+		if ed25519.Verify(pub, payload, signature) {
+			return nil
+		}
+	default:
+		return fmt.Errorf("unsupported device certificate key type %T", cert.PublicKey)
+	}
+
+	return errors.New("attestation signature is invalid")
+}
+
+// VerifyChain checks that the device certificate chains to one of the given roots. Any certificates after the leaf
+// are used as intermediates.
+func VerifyChain(roots *x509.CertPool, chain []*x509.Certificate, now time.Time) error {
+	if len(chain) == 0 {
+		return errors.New("no device certificate provided")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	_, err := chain[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+
+	return err
+}
+
+// ParseCertificates parses one or more PEM encoded certificates
+func ParseCertificates(pemData string) ([]*x509.Certificate, error) {
+	var result []*x509.Certificate
+
+	rest := []byte(pemData)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, cert)
+	}
+
+	if len(result) == 0 {
+		return nil, errors.New("no certificates found")
+	}
+
+	return result, nil
+}
+
+// ParseDerCertificates parses DER encoded certificates, as carried in attestation tokens
+func ParseDerCertificates(ders [][]byte) ([]*x509.Certificate, error) {
+	var result []*x509.Certificate
+	for _, der := range ders {
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, cert)
+	}
+	return result, nil
+}
+
+// NewCertPool returns a pool containing the PEM encoded certificates
+func NewCertPool(pemData string) (*x509.CertPool, error) {
+	certs, err := ParseCertificates(pemData)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	for _, cert := range certs {
+		pool.AddCert(cert)
+	}
+	return pool, nil
+}
@@ -0,0 +1,150 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package attestation
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  crypto.Signer
+}
+
+func newTestCert(t *testing.T, name string, key crypto.Signer, parent *testCert, isCa bool) *testCert {
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  isCa,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+
+	parentCert, parentKey := template, key
+	if parent != nil {
+		parentCert, parentKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCert, key.Public(), parentKey)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCert{cert: cert, key: key}
+}
+
+func newEcKey(t *testing.T) crypto.Signer {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+func toPem(certs ...*x509.Certificate) string {
+	var result []byte
+	for _, cert := range certs {
+		result = append(result, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})...)
+	}
+	return string(result)
+}
+
+func TestEvidenceVerify(t *testing.T) {
+	req := require.New(t)
+
+	ca := newTestCert(t, "ca", newEcKey(t), nil, true)
+	device := newTestCert(t, "device", newEcKey(t), ca, false)
+
+	now := time.Now()
+	timestamp := now.UTC().Format(time.RFC3339)
+	digest := sha256.Sum256(Payload("session-1", timestamp))
+	signature, err := device.key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	req.NoError(err)
+
+	evidence := &Evidence{
+		Certificates: []*x509.Certificate{device.cert},
+		Timestamp:    timestamp,
+		Signature:    signature,
+	}
+
+	req.NoError(evidence.Verify("session-1", now))
+	req.ErrorContains(evidence.Verify("session-2", now), "signature is invalid")
+	req.ErrorContains(evidence.Verify("session-1", now.Add(10*time.Minute)), "not within")
+
+	evidence.Timestamp = "yesterday"
+	req.ErrorContains(evidence.Verify("session-1", now), "invalid attestation timestamp")
+
+	req.ErrorContains((&Evidence{Timestamp: timestamp}).Verify("session-1", now), "no device certificate")
+}
+
+func TestVerifySignatureEd25519(t *testing.T) {
+	req := require.New(t)
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	req.NoError(err)
+
+	device := newTestCert(t, "device", key, nil, false)
+	payload := Payload("session-1", "2024-01-01T00:00:00Z")
+
+	req.NoError(VerifySignature(device.cert, payload, ed25519.Sign(key, payload)))
+	req.Error(VerifySignature(device.cert, payload, ed25519.Sign(key, []byte("other"))))
+}
+
+func TestVerifyChain(t *testing.T) {
+	req := require.New(t)
+
+	ca := newTestCert(t, "ca", newEcKey(t), nil, true)
+	intermediate := newTestCert(t, "intermediate", newEcKey(t), ca, true)
+	device := newTestCert(t, "device", newEcKey(t), intermediate, false)
+	otherCa := newTestCert(t, "other-ca", newEcKey(t), nil, true)
+
+	roots, err := NewCertPool(toPem(ca.cert))
+	req.NoError(err)
+
+	otherRoots, err := NewCertPool(toPem(otherCa.cert))
+	req.NoError(err)
+
+	chain, err := ParseCertificates(toPem(device.cert, intermediate.cert))
+	req.NoError(err)
+	req.Len(chain, 2)
+
+	req.NoError(VerifyChain(roots, chain, time.Now()))
+	req.Error(VerifyChain(otherRoots, chain, time.Now()))
+	req.Error(VerifyChain(roots, chain[:1], time.Now()))
+	req.Error(VerifyChain(roots, chain, time.Now().Add(2*time.Hour)))
+
+	chain, err = ParseDerCertificates([][]byte{device.cert.Raw, intermediate.cert.Raw})
+	req.NoError(err)
+	req.NoError(VerifyChain(roots, chain, time.Now()))
+
+	_, err = ParseCertificates("not a certificate")
+	req.Error(err)
+}
@@ -0,0 +1,78 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package ctrl_msg
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// DialerIdentity is the identity which dialed a circuit, as passed to the hosting router in the circuit peer data.
+// The controller sets it from the identity it created the circuit for, so the hosting side can rely on it.
+type DialerIdentity struct {
+	Id             string
+	Name           string
+	RoleAttributes []string
+	Token          string
+}
+
+// AddToPeerData replaces any dialer identity in the given peer data with this one. The role attributes and token are
+// only added if the token is set, as they're only needed when the hosted application is told who the caller is.
+func (self *DialerIdentity) AddToPeerData(peerData map[uint32][]byte) map[uint32][]byte {
+	if peerData == nil {
+		peerData = map[uint32][]byte{}
+	}
+
+	peerData[DialerIdentityIdHeader] = []byte(self.Id)
+	peerData[DialerIdentityNameHeader] = []byte(self.Name)
+
+	if self.Token == "" {
+		delete(peerData, DialerIdentityRoleAttributesHeader)
+		delete(peerData, DialerIdentityTokenHeader)
+		return peerData
+	}
+
+	roleAttributes := self.RoleAttributes
+	if roleAttributes == nil {
+		roleAttributes = []string{}
+	}
+	peerData[DialerIdentityRoleAttributesHeader], _ = json.Marshal(roleAttributes)
+	peerData[DialerIdentityTokenHeader] = []byte(self.Token)
+	return peerData
+}
+
+// GetDialerIdentity returns the dialer identity from the given circuit peer data, or nil if it doesn't contain one
+func GetDialerIdentity(peerData map[uint32][]byte) (*DialerIdentity, error) {
+	dialerId, ok := peerData[DialerIdentityIdHeader]
+	if !ok || len(dialerId) == 0 {
+		return nil, nil
+	}
+
+	result := &DialerIdentity{
+		Id:    string(dialerId),
+		Name:  string(peerData[DialerIdentityNameHeader]),
+		Token: string(peerData[DialerIdentityTokenHeader]),
+	}
+
+	if roleAttributes, ok := peerData[DialerIdentityRoleAttributesHeader]; ok {
+		if err := json.Unmarshal(roleAttributes, &result.RoleAttributes); err != nil {
+			return result, fmt.Errorf("unable to unmarshal role attributes of dialer identity (%w)", err)
+		}
+	}
+
+	return result, nil
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/hanzozt/channel/v4"
 	"github.com/hanzozt/zt/v2/common/pb/edge_ctrl_pb"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -34,6 +35,7 @@ const (
 	RouteResultSuccessHeader    = 1102
 	RouteResultErrorHeader      = 1103
 	RouteResultErrorCodeHeader  = 1104
+	RouteResultDialTimeHeader   = 1105
 
 	TerminatorLocalAddressHeader  = 1110
 	TerminatorRemoteAddressHeader = 1111
@@ -46,6 +48,9 @@ const (
 	DialerIdentityIdHeader   = 1115
 	DialerIdentityNameHeader = 1116
 
+	DialerIdentityRoleAttributesHeader = 1117
+	DialerIdentityTokenHeader          = 1118
+
 	ErrorTypeGeneric                 = 0
 	ErrorTypeInvalidTerminator       = 1
 	ErrorTypeMisconfiguredTerminator = 2
@@ -59,9 +64,10 @@ const (
 	CreateCircuitReqTerminatorInstanceIdHeader = 13
 	CreateCircuitReqApiSessionTokenHeader      = 14
 
-	CreateCircuitRespCircuitId  = 11
-	CreateCircuitRespAddress    = 12
-	CreateCircuitRespTagsHeader = 13
+	CreateCircuitRespCircuitId       = 11
+	CreateCircuitRespAddress         = 12
+	CreateCircuitRespTagsHeader      = 13
+	CreateCircuitRespRateLimitHeader = 14
 
 	HeaderResultErrorCode = 10
 
@@ -164,15 +170,23 @@ type CreateCircuitResponse struct {
 	Address   string
 	PeerData  map[uint32][]byte
 	Tags      map[string]string
+	RateLimit *edge_ctrl_pb.RateLimit
 }
 
-func (self *CreateCircuitResponse) ToMessage() *channel.Message {
+func (self *CreateCircuitResponse) ToMessage() (*channel.Message, error) {
 	msg := channel.NewMessage(int32(edge_ctrl_pb.ContentType_CreateCircuitV2ResponseType), nil)
 	msg.PutStringHeader(CreateCircuitRespCircuitId, self.CircuitId)
 	msg.PutStringHeader(CreateCircuitRespAddress, self.Address)
 	msg.PutU32ToBytesMapHeader(CreateCircuitPeerDataHeader, self.PeerData)
 	msg.PutStringToStringMapHeader(CreateCircuitRespTagsHeader, self.Tags)
-	return msg
+	if self.RateLimit != nil {
+		rateLimit, err := proto.Marshal(self.RateLimit)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal create circuit response rate limit (%w)", err)
+		}
+		msg.Headers[CreateCircuitRespRateLimitHeader] = rateLimit
+	}
+	return msg, nil
 }
 
 func DecodeCreateCircuitResponse(m *channel.Message) (*CreateCircuitResponse, error) {
@@ -188,10 +202,19 @@ func DecodeCreateCircuitResponse(m *channel.Message) (*CreateCircuitResponse, er
 		return nil, fmt.Errorf("unable to get create circuit response tags (%w)", err)
 	}
 
+	var rateLimit *edge_ctrl_pb.RateLimit
+	if val, found := m.Headers[CreateCircuitRespRateLimitHeader]; found {
+		rateLimit = &edge_ctrl_pb.RateLimit{}
+		if err = proto.Unmarshal(val, rateLimit); err != nil {
+			return nil, fmt.Errorf("unable to get create circuit response rate limit (%w)", err)
+		}
+	}
+
 	return &CreateCircuitResponse{
 		CircuitId: circuitId,
 		Address:   address,
 		PeerData:  peerData,
 		Tags:      tags,
+		RateLimit: rateLimit,
 	}, nil
 }
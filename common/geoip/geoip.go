@@ -0,0 +1,216 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+// Package geoip provides source address evaluation for geo/IP posture checks.
+//
+// Country lookups use an offline database in CSV form. Each line maps either an address range or a CIDR to an ISO
+// 3166-1 alpha-2 country code:
+//
+//	1.0.0.0,1.0.0.255,AU
+//	2001:200::/32,JP
+//
+// Blank lines and lines starting with # are ignored, as is a header line, if present. This is the layout used by the
+// freely available IP to country lite databases.
+package geoip
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/gaissmai/extnetip"
+	"github.com/pkg/errors"
+)
+
+type ipRange struct {
+	start   netip.Addr
+	end     netip.Addr
+	country string
+}
+
+// Database maps source addresses to country codes
+type Database struct {
+	ranges []ipRange
+}
+
+// Load reads a country database from the given CSV file
+func Load(path string) (*Database, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to open geoip database '%s'", path)
+	}
+	defer func() { _ = f.Close() }()
+
+	db, err := Parse(f)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to load geoip database '%s'", path)
+	}
+	return db, nil
+}
+
+// Parse reads a country database in CSV form
+func Parse(r io.Reader) (*Database, error) {
+	reader := csv.NewReader(bufio.NewReader(r))
+	reader.FieldsPerRecord = -1
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+	reader.ReuseRecord = true
+
+	db := &Database{}
+	line := 0
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line++
+
+		entry, err := parseRecord(record)
+		if err != nil {
+			if line == 1 {
+				// allow for a header line
+				continue
+			}
+			return nil, errors.Wrapf(err, "invalid entry on line %d", line)
+		}
+		db.ranges = append(db.ranges, *entry)
+	}
+
+	sort.Slice(db.ranges, func(i, j int) bool {
+		return db.ranges[i].start.Less(db.ranges[j].start)
+	})
+
+	return db, nil
+}
+
+func parseRecord(record []string) (*ipRange, error) {
+	switch len(record) {
+	case 2:
+		prefix, err := ParsePrefix(record[0])
+		if err != nil {
+			return nil, err
+		}
+		first, last := extnetip.Range(prefix)
+		return newRange(first, last, record[1])
+	case 3:
+		start, err := netip.ParseAddr(strings.TrimSpace(record[0]))
+		if err != nil {
+			return nil, err
+		}
+		end, err := netip.ParseAddr(strings.TrimSpace(record[1]))
+		if err != nil {
+			return nil, err
+		}
+		start, end = start.Unmap(), end.Unmap()
+		if start.Is4() != end.Is4() || end.Less(start) {
+			return nil, fmt.Errorf("invalid address range %s - %s", start, end)
+		}
+		return newRange(start, end, record[2])
+	default:
+		return nil, fmt.Errorf("expected 2 or 3 fields, found %d", len(record))
+	}
+}
+
+func newRange(start, end netip.Addr, country string) (*ipRange, error) {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	if country == "" {
+		return nil, errors.New("country code is empty")
+	}
+	return &ipRange{
+		start:   start,
+		end:     end,
+		country: country,
+	}, nil
+}
+
+// Len returns the number of address ranges in the database
+func (self *Database) Len() int {
+	if self == nil {
+		return 0
+	}
+	return len(self.ranges)
+}
+
+// Lookup returns the country code for the given address, or an empty string if the address isn't in the database.
+// A nil database returns an empty string for all addresses.
+func (self *Database) Lookup(addr netip.Addr) string {
+	if self == nil || !addr.IsValid() {
+		return ""
+	}
+	addr = addr.Unmap()
+
+	// find the first range starting after the address, the range before it is the only candidate
+	idx := sort.Search(len(self.ranges), func(i int) bool {
+		return addr.Less(self.ranges[i].start)
+	})
+
+	if idx == 0 {
+		return ""
+	}
+
+	candidate := self.ranges[idx-1]
+	if candidate.end.Less(addr) || candidate.start.Is4() != addr.Is4() {
+		return ""
+	}
+	return candidate.country
+}
+
+// ParsePrefix parses a CIDR. A single address is accepted and treated as a host prefix.
+func ParsePrefix(val string) (netip.Prefix, error) {
+	val = strings.TrimSpace(val)
+	if strings.Contains(val, "/") {
+		prefix, err := netip.ParsePrefix(val)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		if prefix.Addr().Is4In6() {
+			if prefix.Bits() < 96 {
+				return netip.Prefix{}, fmt.Errorf("invalid IPv4-mapped prefix %s", val)
+			}
+			return netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()-96).Masked(), nil
+		}
+		return prefix.Masked(), nil
+	}
+
+	addr, err := netip.ParseAddr(val)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// ParseAddr parses a source address, which may include a port, as found in http.Request.RemoteAddr or a
+// net.Addr string
+func ParseAddr(val string) (netip.Addr, error) {
+	if addrPort, err := netip.ParseAddrPort(val); err == nil {
+		return addrPort.Addr().Unmap(), nil
+	}
+
+	addr, err := netip.ParseAddr(strings.Trim(val, "[]"))
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	return addr.Unmap(), nil
+}
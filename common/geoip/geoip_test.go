@@ -0,0 +1,122 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package geoip
+
+import (
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testDb = `ip_range_start,ip_range_end,country_code
+# comment
+1.0.0.0,1.0.0.255,au
+2.16.0.0,2.16.255.255,DE
+10.20.0.0/16,US
+2001:200::/32,JP
+`
+
+func TestDatabaseLookup(t *testing.T) {
+	req := require.New(t)
+
+	db, err := Parse(strings.NewReader(testDb))
+	req.NoError(err)
+	req.Equal(4, db.Len())
+
+	req.Equal("AU", db.Lookup(netip.MustParseAddr("1.0.0.0")))
+	req.Equal("AU", db.Lookup(netip.MustParseAddr("1.0.0.255")))
+	req.Equal("", db.Lookup(netip.MustParseAddr("1.0.1.0")))
+	req.Equal("DE", db.Lookup(netip.MustParseAddr("2.16.4.5")))
+	req.Equal("US", db.Lookup(netip.MustParseAddr("10.20.30.40")))
+	req.Equal("US", db.Lookup(netip.MustParseAddr("::ffff:10.20.30.40")))
+	req.Equal("", db.Lookup(netip.MustParseAddr("10.21.0.1")))
+	req.Equal("JP", db.Lookup(netip.MustParseAddr("2001:200::1")))
+	req.Equal("", db.Lookup(netip.MustParseAddr("2001:201::1")))
+	req.Equal("", db.Lookup(netip.MustParseAddr("0.0.0.1")))
+
+	var nilDb *Database
+	req.Equal("", nilDb.Lookup(netip.MustParseAddr("1.0.0.1")))
+}
+
+func TestDatabaseParseErrors(t *testing.T) {
+	req := require.New(t)
+
+	_, err := Parse(strings.NewReader("1.0.0.0,1.0.0.255,AU\nbad,entry\n"))
+	req.ErrorContains(err, "line 2")
+
+	_, err = Parse(strings.NewReader("1.0.0.0,1.0.0.255,AU\n1.0.1.255,1.0.1.0,AU\n"))
+	req.ErrorContains(err, "invalid address range")
+
+	_, err = Parse(strings.NewReader("1.0.0.0,1.0.0.255,AU\n1.0.1.0/24,\n"))
+	req.ErrorContains(err, "country code is empty")
+}
+
+func TestParseAddr(t *testing.T) {
+	req := require.New(t)
+
+	addr, err := ParseAddr("192.168.1.1:1234")
+	req.NoError(err)
+	req.Equal("192.168.1.1", addr.String())
+
+	addr, err = ParseAddr("[2001:db8::1]:443")
+	req.NoError(err)
+	req.Equal("2001:db8::1", addr.String())
+
+	addr, err = ParseAddr("::ffff:10.0.0.1")
+	req.NoError(err)
+	req.Equal("10.0.0.1", addr.String())
+
+	_, err = ParseAddr("not-an-address")
+	req.Error(err)
+}
+
+func TestRules(t *testing.T) {
+	req := require.New(t)
+
+	rules := &Rules{}
+	req.NoError(rules.Validate())
+	req.NoError(rules.Evaluate(netip.MustParseAddr("8.8.8.8"), ""))
+	req.Error(rules.Evaluate(netip.Addr{}, ""))
+
+	rules = &Rules{
+		AllowedCidrs:     []string{"10.0.0.0/8", "192.168.1.10"},
+		DeniedCidrs:      []string{"10.66.0.0/16"},
+		AllowedCountries: []string{"us", "CA"},
+		DeniedCountries:  []string{"KP"},
+	}
+	req.NoError(rules.Validate())
+
+	req.NoError(rules.Evaluate(netip.MustParseAddr("10.1.2.3"), ""))
+	req.NoError(rules.Evaluate(netip.MustParseAddr("192.168.1.10"), ""))
+	req.NoError(rules.Evaluate(netip.MustParseAddr("::ffff:10.1.2.3"), ""))
+	req.NoError(rules.Evaluate(netip.MustParseAddr("8.8.8.8"), "US"))
+	req.ErrorContains(rules.Evaluate(netip.MustParseAddr("10.66.1.1"), "US"), "denied CIDR")
+	req.ErrorContains(rules.Evaluate(netip.MustParseAddr("8.8.8.8"), "KP"), "denied country")
+	req.ErrorContains(rules.Evaluate(netip.MustParseAddr("8.8.8.8"), "FR"), "not in an allowed")
+	req.ErrorContains(rules.Evaluate(netip.MustParseAddr("192.168.1.11"), ""), "not in an allowed")
+
+	rules = &Rules{DeniedCountries: []string{"KP"}}
+	req.NoError(rules.Evaluate(netip.MustParseAddr("8.8.8.8"), ""))
+	req.NoError(rules.Evaluate(netip.MustParseAddr("8.8.8.8"), "US"))
+
+	req.Error((&Rules{AllowedCidrs: []string{"10.0.0.0/33"}}).Validate())
+	req.Error((&Rules{DeniedCidrs: []string{"nope"}}).Validate())
+	req.Error((&Rules{AllowedCountries: []string{"USA"}}).Validate())
+	req.Error((&Rules{DeniedCountries: []string{"1A"}}).Validate())
+}
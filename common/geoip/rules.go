@@ -0,0 +1,131 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package geoip
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Rules are the allow and deny lists of a geo/IP posture check.
+//
+// A source address is rejected if it is in a denied CIDR or resolves to a denied country. If any allow entries are
+// present, the address must also be in an allowed CIDR or resolve to an allowed country. Addresses which can't be
+// resolved to a country never match country entries.
+type Rules struct {
+	AllowedCidrs     []string
+	DeniedCidrs      []string
+	AllowedCountries []string
+	DeniedCountries  []string
+}
+
+// Validate checks that all CIDRs and country codes are well-formed
+func (self *Rules) Validate() error {
+	for _, cidr := range self.AllowedCidrs {
+		if _, err := ParsePrefix(cidr); err != nil {
+			return errors.Wrapf(err, "invalid allowed CIDR '%s'", cidr)
+		}
+	}
+
+	for _, cidr := range self.DeniedCidrs {
+		if _, err := ParsePrefix(cidr); err != nil {
+			return errors.Wrapf(err, "invalid denied CIDR '%s'", cidr)
+		}
+	}
+
+	for _, country := range self.AllowedCountries {
+		if !isCountryCode(country) {
+			return fmt.Errorf("invalid allowed country '%s', must be an ISO 3166-1 alpha-2 code", country)
+		}
+	}
+
+	for _, country := range self.DeniedCountries {
+		if !isCountryCode(country) {
+			return fmt.Errorf("invalid denied country '%s', must be an ISO 3166-1 alpha-2 code", country)
+		}
+	}
+
+	return nil
+}
+
+// Evaluate returns nil if the given source address and country satisfy the rules, otherwise an error describing
+// why the address was rejected
+func (self *Rules) Evaluate(addr netip.Addr, country string) error {
+	if !addr.IsValid() {
+		return errors.New("source address unknown")
+	}
+	addr = addr.Unmap()
+
+	if cidr, found := matchPrefix(addr, self.DeniedCidrs); found {
+		return fmt.Errorf("source address %s is in denied CIDR %s", addr, cidr)
+	}
+
+	if country != "" && containsCountry(self.DeniedCountries, country) {
+		return fmt.Errorf("source address %s is in denied country %s", addr, country)
+	}
+
+	if len(self.AllowedCidrs) == 0 && len(self.AllowedCountries) == 0 {
+		return nil
+	}
+
+	if _, found := matchPrefix(addr, self.AllowedCidrs); found {
+		return nil
+	}
+
+	if country != "" && containsCountry(self.AllowedCountries, country) {
+		return nil
+	}
+
+	if country == "" {
+		return fmt.Errorf("source address %s is not in an allowed CIDR or country", addr)
+	}
+	return fmt.Errorf("source address %s (%s) is not in an allowed CIDR or country", addr, country)
+}
+
+func matchPrefix(addr netip.Addr, cidrs []string) (string, bool) {
+	for _, cidr := range cidrs {
+		if prefix, err := ParsePrefix(cidr); err == nil && prefix.Contains(addr) {
+			return cidr, true
+		}
+	}
+	return "", false
+}
+
+func containsCountry(countries []string, country string) bool {
+	for _, c := range countries {
+		if strings.EqualFold(strings.TrimSpace(c), country) {
+			return true
+		}
+	}
+	return false
+}
+
+func isCountryCode(val string) bool {
+	val = strings.TrimSpace(val)
+	if len(val) != 2 {
+		return false
+	}
+	for _, r := range val {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
@@ -17,6 +17,9 @@ type CircuitDetail struct {
 	TimeSinceActivity string            `json:"timeSinceActivity"`
 	CtrlId            string            `json:"ctrlId"`
 	Routes            map[string]string `json:"routes"`
+	AlternateRoutes   map[string]string `json:"alternateRoutes,omitempty"`
+	Multipath         string            `json:"multipath,omitempty"`
+	Priority          string            `json:"priority,omitempty"`
 	Destinations      map[string]string `json:"destinations"`
 }
 
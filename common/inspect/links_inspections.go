@@ -33,6 +33,7 @@ type LinkInspectDetail struct {
 	Underlays          map[string]int    `json:"underlays"`
 	Connections        []*LinkConnection `json:"connections"`
 	ConnStateIteration uint32            `json:"connStateIteration"`
+	Compression        string            `json:"compression,omitempty"`
 }
 
 type LinksInspectResult struct {
@@ -0,0 +1,104 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package metrics
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/hanzozt/metrics"
+	"github.com/hanzozt/metrics/metrics_pb"
+)
+
+// MaxReportTick is the longest interval at which a registry should report to an IntervalReporter. The report interval
+// can be changed in steps of the tick, so keeping it short lets the interval be changed without a restart.
+const MaxReportTick = 5 * time.Second
+
+// IntervalReporter is a metrics handler which lets the report interval of a metrics registry be changed while it's
+// running. The registry reports to it every tick, and it merges the messages it receives, passing them on once per
+// report interval. Usage and interval counters are accumulated, while the latest values of all other metrics are
+// sent.
+type IntervalReporter struct {
+	handler  metrics.Handler
+	tick     time.Duration
+	interval atomic.Int64
+	pending  *metrics_pb.MetricsMessage
+	lastSent time.Time
+}
+
+// NewIntervalReporter creates an IntervalReporter which passes messages on to the given handler at the given report
+// interval. The registry should be started with a report interval of ReportTick()
+func NewIntervalReporter(handler metrics.Handler, reportInterval time.Duration) *IntervalReporter {
+	result := &IntervalReporter{
+		handler:  handler,
+		tick:     min(reportInterval, MaxReportTick),
+		lastSent: time.Now(),
+	}
+	result.interval.Store(int64(reportInterval))
+	return result
+}
+
+// ReportTick returns the interval at which the registry should report
+func (self *IntervalReporter) ReportTick() time.Duration {
+	return self.tick
+}
+
+// SetReportInterval changes the interval at which metrics are passed on. Intervals shorter than the report tick are
+// treated as the tick, and other intervals are rounded to the nearest whole number of ticks
+func (self *IntervalReporter) SetReportInterval(interval time.Duration) {
+	self.interval.Store(int64(interval))
+}
+
+// AcceptMetrics is called by the registry from a single goroutine, so the pending message needs no locking
+func (self *IntervalReporter) AcceptMetrics(message *metrics_pb.MetricsMessage) {
+	if message == nil {
+		return
+	}
+
+	if self.pending != nil {
+		mergeMetrics(self.pending, message)
+	}
+	self.pending = message
+
+	// reports arrive every tick, give or take, so send once we're within half a tick of the interval
+	interval := time.Duration(self.interval.Load())
+	if time.Since(self.lastSent)+self.tick/2 < interval {
+		return
+	}
+
+	self.pending = nil
+	self.lastSent = time.Now()
+	self.handler.AcceptMetrics(message)
+}
+
+// mergeMetrics adds the usage and interval counters of an earlier message to a later one
+func mergeMetrics(earlier, later *metrics_pb.MetricsMessage) {
+	if len(earlier.UsageCounters) > 0 {
+		later.UsageCounters = append(earlier.UsageCounters, later.UsageCounters...)
+	}
+
+	for name, counter := range earlier.IntervalCounters {
+		if current, found := later.IntervalCounters[name]; found {
+			current.Buckets = append(counter.Buckets, current.Buckets...)
+		} else {
+			if later.IntervalCounters == nil {
+				later.IntervalCounters = map[string]*metrics_pb.MetricsMessage_IntervalCounter{}
+			}
+			later.IntervalCounters[name] = counter
+		}
+	}
+}
@@ -0,0 +1,75 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hanzozt/metrics/metrics_pb"
+	"github.com/stretchr/testify/require"
+)
+
+type testMetricsHandler struct {
+	msgs []*metrics_pb.MetricsMessage
+}
+
+func (self *testMetricsHandler) AcceptMetrics(message *metrics_pb.MetricsMessage) {
+	self.msgs = append(self.msgs, message)
+}
+
+func TestIntervalReporterMergesUntilInterval(t *testing.T) {
+	req := require.New(t)
+
+	handler := &testMetricsHandler{}
+	reporter := NewIntervalReporter(handler, time.Minute)
+	req.Equal(MaxReportTick, reporter.ReportTick())
+
+	newMsg := func(usage int64, gauge int64) *metrics_pb.MetricsMessage {
+		return &metrics_pb.MetricsMessage{
+			IntValues: map[string]int64{"gauge": gauge},
+			IntervalCounters: map[string]*metrics_pb.MetricsMessage_IntervalCounter{
+				"usage": {
+					Buckets: []*metrics_pb.MetricsMessage_IntervalBucket{{IntervalStartUTC: usage}},
+				},
+			},
+			UsageCounters: []*metrics_pb.MetricsMessage_UsageCounter{{IntervalStartUTC: usage}},
+		}
+	}
+
+	reporter.AcceptMetrics(newMsg(1, 1))
+	reporter.AcceptMetrics(newMsg(2, 2))
+	req.Empty(handler.msgs)
+
+	// once the interval has passed, the merged message is sent
+	reporter.lastSent = time.Now().Add(-time.Minute)
+	reporter.AcceptMetrics(newMsg(3, 3))
+	req.Len(handler.msgs, 1)
+
+	msg := handler.msgs[0]
+	req.Equal(int64(3), msg.IntValues["gauge"])
+	req.Len(msg.UsageCounters, 3)
+	req.Equal(int64(1), msg.UsageCounters[0].IntervalStartUTC)
+	req.Len(msg.IntervalCounters["usage"].Buckets, 3)
+
+	// shortening the interval takes effect on the next report
+	reporter.SetReportInterval(MaxReportTick)
+	reporter.lastSent = time.Now().Add(-MaxReportTick)
+	reporter.AcceptMetrics(newMsg(4, 4))
+	req.Len(handler.msgs, 2)
+	req.Len(handler.msgs[1].UsageCounters, 1)
+}
@@ -49,15 +49,20 @@ const (
 	CustomClaimIsCertExtendable = "z_ice"
 	CustomClaimImproperCert     = "z_iccc"
 	CustomClaimIsLegacy         = "z_leg"
+	CustomClaimDeviceCerts      = "z_dc"
+	CustomClaimRoleAttributes   = "z_roles"
 
 	DefaultAccessTokenDuration  = 30 * time.Minute
 	DefaultIdTokenDuration      = 30 * time.Minute
 	DefaultRefreshTokenDuration = 24 * time.Hour
+	DefaultCallerTokenDuration  = time.Hour
 
 	TokenTypeAccess        = "a"
 	TokenTypeRefresh       = "r"
 	TokenTypeServiceAccess = "s"
 	TokenTypeTotp          = "t"
+	TokenTypeAttestation   = "d"
+	TokenTypeCaller        = "c"
 
 	ServiceSessionTypeBind = "Bind"
 	ServiceSessionTypeDial = "Dial"
@@ -192,6 +197,21 @@ func (c *ServiceAccessClaims) HasAudience(targetAud string) bool {
 	return false
 }
 
+// CallerClaims identify the caller of a service to the application hosting it. The subject is the id of the calling
+// identity and the audience is the id of the service.
+type CallerClaims struct {
+	jwt.RegisteredClaims
+
+	// IdentityName is the name of the calling identity
+	IdentityName string `json:"name"`
+
+	// RoleAttributes are the role attributes of the calling identity
+	RoleAttributes []string `json:"z_roles"`
+
+	// TokenType denotes the overall token type, which is a token that identifies the caller of a service
+	TokenType string `json:"z_t"`
+}
+
 type AccessClaims struct {
 	oidc.AccessTokenClaims
 	CustomClaims
@@ -332,3 +352,31 @@ func (t *TotpClaims) HasAudience(targetAud string) bool {
 	}
 	return false
 }
+
+// AttestationClaims is a set of claims used to define attestation JWT tokens. They are issued by a controller after
+// it has verified a device attestation submitted for an API session, and carry the attested device certificate chain
+// so that routers can evaluate attestation posture checks against their own CA bundles. Like TOTP tokens, they have
+// no expiration date and are scoped to the API Session they were issued for.
+//
+// Claims:
+//   - z_asid: the id of the api session that the token is scoped to
+//   - z_t: the type of token, always "d"
+//   - z_dc: the DER encoded device certificate chain, leaf first
+//   - sub: the identity id of the identity that the token is scoped to
+//   - iss: the controller that issued the token
+//   - issued_at: the time the token was issued, also the time the attestation was verified
+type AttestationClaims struct {
+	jwt.RegisteredClaims
+	ApiSessionId       string   `json:"z_asid,omitempty"`
+	Type               string   `json:"z_t"`
+	DeviceCertificates [][]byte `json:"z_dc"`
+}
+
+func (t *AttestationClaims) HasAudience(targetAud string) bool {
+	for _, aud := range t.Audience {
+		if aud == targetAud {
+			return true
+		}
+	}
+	return false
+}
@@ -117,3 +117,19 @@ func (request *LinkStateUpdate) GetContentType() int32 {
 func (request *Alerts) GetContentType() int32 {
 	return int32(ContentType_AlertsType)
 }
+
+func (request *RouterDrainStatus) GetContentType() int32 {
+	return int32(ContentType_RouterDrainStatusType)
+}
+
+func (request *UpdateManagedConfig) GetContentType() int32 {
+	return int32(ContentType_UpdateManagedConfigType)
+}
+
+func (request *ManagedConfigStatus) GetContentType() int32 {
+	return int32(ContentType_ManagedConfigStatusType)
+}
+
+func (request *CertStatus) GetContentType() int32 {
+	return int32(ContentType_CertStatusType)
+}
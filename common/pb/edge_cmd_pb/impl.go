@@ -1,5 +1,10 @@
 package edge_cmd_pb
 
+import (
+	"github.com/hanzozt/zt/v2/common/schedule"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
 func (x *CreateEdgeTerminatorCommand) GetCommandType() int32 {
 	return int32(CommandType_CreateEdgeTerminatorType)
 }
@@ -92,3 +97,47 @@ func DecodeTags(tags map[string]*TagValue) map[string]interface{} {
 
 	return result
 }
+
+func EncodePolicySchedule(spec *schedule.Spec) *PolicySchedule {
+	if spec.IsEmpty() {
+		return nil
+	}
+
+	result := &PolicySchedule{
+		TimeZone: spec.TimeZone,
+		Windows:  spec.Windows,
+	}
+
+	if spec.NotBefore != nil {
+		result.NotBefore = timestamppb.New(*spec.NotBefore)
+	}
+
+	if spec.NotAfter != nil {
+		result.NotAfter = timestamppb.New(*spec.NotAfter)
+	}
+
+	return result
+}
+
+func DecodePolicySchedule(msg *PolicySchedule) *schedule.Spec {
+	if msg == nil {
+		return nil
+	}
+
+	result := &schedule.Spec{
+		TimeZone: msg.TimeZone,
+		Windows:  msg.Windows,
+	}
+
+	if msg.NotBefore != nil {
+		notBefore := msg.NotBefore.AsTime()
+		result.NotBefore = &notBefore
+	}
+
+	if msg.NotAfter != nil {
+		notAfter := msg.NotAfter.AsTime()
+		result.NotAfter = &notAfter
+	}
+
+	return result
+}
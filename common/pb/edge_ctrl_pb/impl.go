@@ -18,6 +18,9 @@ package edge_ctrl_pb
 
 import (
 	"fmt"
+
+	"github.com/hanzozt/zt/v2/common/schedule"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (x *DataState_Identity) GetServiceConfigsAsMap() map[string]map[string]string {
@@ -37,6 +40,50 @@ func (x *DataState_Identity) GetServiceConfigsAsMap() map[string]map[string]stri
 	return result
 }
 
+func NewPolicySchedule(spec *schedule.Spec) *DataState_PolicySchedule {
+	if spec.IsEmpty() {
+		return nil
+	}
+
+	result := &DataState_PolicySchedule{
+		TimeZone: spec.TimeZone,
+		Windows:  spec.Windows,
+	}
+
+	if spec.NotBefore != nil {
+		result.NotBefore = timestamppb.New(*spec.NotBefore)
+	}
+
+	if spec.NotAfter != nil {
+		result.NotAfter = timestamppb.New(*spec.NotAfter)
+	}
+
+	return result
+}
+
+func (x *DataState_PolicySchedule) ToSpec() *schedule.Spec {
+	if x == nil {
+		return nil
+	}
+
+	result := &schedule.Spec{
+		TimeZone: x.TimeZone,
+		Windows:  x.Windows,
+	}
+
+	if x.NotBefore != nil {
+		notBefore := x.NotBefore.AsTime()
+		result.NotBefore = &notBefore
+	}
+
+	if x.NotAfter != nil {
+		notAfter := x.NotAfter.AsTime()
+		result.NotAfter = &notAfter
+	}
+
+	return result
+}
+
 func (request *RouterDataModelValidateRequest) GetContentType() int32 {
 	return int32(ContentType_ValidateDataStateRequestType)
 }
@@ -45,6 +92,10 @@ func (request *RouterDataModelValidateResponse) GetContentType() int32 {
 	return int32(ContentType_ValidateDataStateResponseType)
 }
 
+func (request *EdgeRouterPolicySchedules) GetContentType() int32 {
+	return int32(ContentType_EdgeRouterPolicySchedulesType)
+}
+
 func (diff *RouterDataModelDiff) ToDetail() string {
 	return fmt.Sprintf("%s id: %s %s: %s", diff.EntityType, diff.EntityId, diff.DiffType, diff.Detail)
 }
@@ -0,0 +1,5 @@
+//go:generate protoc -I ./ ./event.proto --go_out=paths=source_relative:./
+
+package event_pb
+
+// Here to provide the go:generate line above
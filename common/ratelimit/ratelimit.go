@@ -0,0 +1,207 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+// Package ratelimit implements bandwidth shaping for services. Limits are given by a rate-limit.v1 config, attached
+// to a service or assigned to an identity as a service config override. The controller resolves the config which
+// applies when a circuit is created and passes it to the initiating router in the create circuit response, where it's
+// enforced on the ingress connection using token buckets.
+//
+// Up limits apply to data read from the client, down limits to data written to the client. The scope controls which
+// circuits on a router share buckets: each circuit has its own, all circuits for the same identity and service share
+// them, or all circuits for the service share them.
+package ratelimit
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hanzozt/zt/v2/common/pb/edge_ctrl_pb"
+)
+
+const (
+	ConfigTypeV1 = "rate-limit.v1"
+
+	ScopeCircuit  = "circuit"
+	ScopeIdentity = "identity"
+	ScopeService  = "service"
+)
+
+// Limit is a token bucket rate limit
+type Limit struct {
+	// BytesPerSecond is the sustained rate
+	BytesPerSecond int64 `json:"bytesPerSecond"`
+	// BurstBytes is how many bytes may be sent at once after an idle period. Defaults to one second at the sustained
+	// rate
+	BurstBytes int64 `json:"burstBytes,omitempty"`
+}
+
+func (self *Limit) GetBurst() int64 {
+	if self.BurstBytes > 0 {
+		return self.BurstBytes
+	}
+	return self.BytesPerSecond
+}
+
+func (self *Limit) validate(name string) error {
+	if self == nil {
+		return nil
+	}
+	if self.BytesPerSecond < 1 {
+		return fmt.Errorf("%s.bytesPerSecond must be at least 1", name)
+	}
+	if self.BurstBytes < 0 {
+		return fmt.Errorf("%s.burstBytes must not be negative", name)
+	}
+	return nil
+}
+
+// Config is the contents of a rate-limit.v1 config
+type Config struct {
+	Scope string `json:"scope,omitempty"`
+	Up    *Limit `json:"up,omitempty"`
+	Down  *Limit `json:"down,omitempty"`
+}
+
+// GetScope returns the scope of the config, which defaults to identity
+func (self *Config) GetScope() string {
+	if self.Scope == "" {
+		return ScopeIdentity
+	}
+	return self.Scope
+}
+
+func (self *Config) IsEmpty() bool {
+	return self == nil || (self.Up == nil && self.Down == nil)
+}
+
+func (self *Config) Validate() error {
+	if scope := self.GetScope(); scope != ScopeCircuit && scope != ScopeIdentity && scope != ScopeService {
+		return fmt.Errorf("invalid scope '%s', must be one of '%s', '%s' or '%s'", scope, ScopeCircuit, ScopeIdentity, ScopeService)
+	}
+	if err := self.Up.validate("up"); err != nil {
+		return err
+	}
+	return self.Down.validate("down")
+}
+
+// Key returns a string which is the same for equal configs, so circuits sharing buckets can be matched by config
+func (self *Config) Key() string {
+	result, _ := json.Marshal(self)
+	return string(result)
+}
+
+// ToProto returns the config in the form sent to routers, or nil if the config is empty
+func (self *Config) ToProto() *edge_ctrl_pb.RateLimit {
+	if self.IsEmpty() {
+		return nil
+	}
+	return &edge_ctrl_pb.RateLimit{
+		Scope: self.GetScope(),
+		Up:    self.Up.toProto(),
+		Down:  self.Down.toProto(),
+	}
+}
+
+func (self *Limit) toProto() *edge_ctrl_pb.RateLimit_Limit {
+	if self == nil {
+		return nil
+	}
+	return &edge_ctrl_pb.RateLimit_Limit{
+		BytesPerSecond: self.BytesPerSecond,
+		BurstBytes:     self.BurstBytes,
+	}
+}
+
+// FromProto returns the config sent to a router, or nil if there isn't one
+func FromProto(msg *edge_ctrl_pb.RateLimit) (*Config, error) {
+	if msg == nil {
+		return nil, nil
+	}
+	result := &Config{
+		Scope: msg.Scope,
+		Up:    limitFromProto(msg.Up),
+		Down:  limitFromProto(msg.Down),
+	}
+	if err := result.Validate(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func limitFromProto(msg *edge_ctrl_pb.RateLimit_Limit) *Limit {
+	if msg == nil {
+		return nil
+	}
+	return &Limit{
+		BytesPerSecond: msg.BytesPerSecond,
+		BurstBytes:     msg.BurstBytes,
+	}
+}
+
+// ParseConfig parses the data of a rate-limit.v1 config
+func ParseConfig(data map[string]interface{}) (*Config, error) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Config{}
+	if err = json.Unmarshal(encoded, result); err != nil {
+		return nil, fmt.Errorf("invalid rate limit config (%w)", err)
+	}
+	if err = result.Validate(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Bucket is a token bucket. Callers reserve capacity before sending and wait for the returned delay. A reservation
+// larger than the available tokens puts the bucket into debt, so payloads larger than the burst size are still sent,
+// just later, and concurrent users of a shared bucket are queued behind one another.
+type Bucket struct {
+	lock   sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func NewBucket(limit *Limit) *Bucket {
+	return &Bucket{
+		rate:   float64(limit.BytesPerSecond),
+		burst:  float64(limit.GetBurst()),
+		tokens: float64(limit.GetBurst()),
+	}
+}
+
+// Reserve takes size bytes from the bucket, returning how long the caller should wait before sending them
+func (self *Bucket) Reserve(size int, now time.Time) time.Duration {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if !self.last.IsZero() && now.After(self.last) {
+		self.tokens = min(self.burst, self.tokens+now.Sub(self.last).Seconds()*self.rate)
+	}
+	self.last = now
+
+	self.tokens -= float64(size)
+	if self.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-self.tokens / self.rate * float64(time.Second))
+}
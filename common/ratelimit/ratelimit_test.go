@@ -0,0 +1,97 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConfig(t *testing.T) {
+	req := require.New(t)
+
+	config, err := ParseConfig(map[string]interface{}{
+		"up": map[string]interface{}{
+			"bytesPerSecond": 1000,
+			"burstBytes":     4000,
+		},
+	})
+	req.NoError(err)
+	req.Equal(ScopeIdentity, config.GetScope())
+	req.Equal(int64(1000), config.Up.BytesPerSecond)
+	req.Equal(int64(4000), config.Up.GetBurst())
+	req.Nil(config.Down)
+
+	_, err = ParseConfig(map[string]interface{}{"scope": "router"})
+	req.ErrorContains(err, "invalid scope")
+
+	_, err = ParseConfig(map[string]interface{}{"down": map[string]interface{}{"bytesPerSecond": 0}})
+	req.ErrorContains(err, "down.bytesPerSecond")
+}
+
+func TestConfigProto(t *testing.T) {
+	req := require.New(t)
+
+	config := &Config{Scope: ScopeService, Down: &Limit{BytesPerSecond: 10}}
+	msg := config.ToProto()
+	req.Equal(ScopeService, msg.Scope)
+	req.Nil(msg.Up)
+
+	decoded, err := FromProto(msg)
+	req.NoError(err)
+	req.Equal(config, decoded)
+	req.Equal(int64(10), decoded.Down.GetBurst())
+	req.Equal(config.Key(), decoded.Key())
+	req.NotEqual(config.Key(), (&Config{Scope: ScopeService, Down: &Limit{BytesPerSecond: 20}}).Key())
+
+	// the default scope is sent explicitly
+	req.Equal(ScopeIdentity, (&Config{Up: &Limit{BytesPerSecond: 10}}).ToProto().Scope)
+
+	req.Nil((&Config{}).ToProto())
+	decoded, err = FromProto(nil)
+	req.NoError(err)
+	req.Nil(decoded)
+
+	msg.Down.BytesPerSecond = 0
+	_, err = FromProto(msg)
+	req.ErrorContains(err, "down.bytesPerSecond")
+}
+
+func TestBucket(t *testing.T) {
+	req := require.New(t)
+
+	now := time.Now()
+	bucket := NewBucket(&Limit{BytesPerSecond: 1000, BurstBytes: 2000})
+
+	// the burst is available immediately
+	req.Equal(time.Duration(0), bucket.Reserve(2000, now))
+
+	// then the bucket goes into debt
+	req.Equal(500*time.Millisecond, bucket.Reserve(500, now))
+	req.Equal(time.Second, bucket.Reserve(500, now))
+
+	// and refills at the sustained rate
+	now = now.Add(2 * time.Second)
+	req.Equal(time.Duration(0), bucket.Reserve(1000, now))
+
+	// never beyond the burst size
+	now = now.Add(time.Hour)
+	req.Equal(time.Duration(0), bucket.Reserve(2000, now))
+	req.Equal(time.Second, bucket.Reserve(1000, now))
+}
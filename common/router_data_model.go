@@ -35,6 +35,7 @@ import (
 	"github.com/michaelquigley/pfxlog"
 	"github.com/hanzozt/foundation/v2/concurrenz"
 	"github.com/hanzozt/zt/v2/common/pb/edge_ctrl_pb"
+	"github.com/hanzozt/zt/v2/common/schedule"
 	cmap "github.com/orcaman/concurrent-map/v2"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/proto"
@@ -531,12 +532,14 @@ type DataStateServicePolicy = edge_ctrl_pb.DataState_ServicePolicy
 // ServicePolicy defines the authorization rules that control which identities can access which services.
 // A policy can be either a dial policy (allowing identities to connect to services) or a bind policy
 // (allowing identities to host services). Policies can include posture checks that must be satisfied
-// for access to be granted. The struct tracks the sets of identities, services, and posture checks
-// associated with the policy, along with indices for tracking incremental updates.
+// for access to be granted, and may be limited to a schedule, outside of which the policy grants no access.
+// The struct tracks the sets of identities, services, and posture checks associated with the policy, along
+// with indices for tracking incremental updates.
 type ServicePolicy struct {
 	Id                 string
 	Name               string
 	PolicyType         edge_ctrl_pb.PolicyType
+	Schedule           *schedule.Schedule
 	Services           cmap.ConcurrentMap[string, struct{}]
 	PostureChecks      cmap.ConcurrentMap[string, struct{}]
 	Identities         cmap.ConcurrentMap[string, struct{}]
@@ -553,9 +556,26 @@ func (self *ServicePolicy) ToProtobuf() *edge_ctrl_pb.DataState_ServicePolicy {
 		Id:         self.Id,
 		Name:       self.Name,
 		PolicyType: self.PolicyType,
+		Schedule:   edge_ctrl_pb.NewPolicySchedule(self.Schedule.GetSpec()),
 	}
 }
 
+// IsActive returns true if the policy's schedule, if it has one, permits access at the given time
+func (self *ServicePolicy) IsActive(t time.Time) bool {
+	return self.Schedule.IsActive(t)
+}
+
+// newServicePolicySchedule parses the schedule sent by the controller. Schedules are validated by the controller,
+// but if one can't be parsed here, the policy is treated as never active, rather than always active.
+func newServicePolicySchedule(servicePolicy *DataStateServicePolicy) *schedule.Schedule {
+	result, err := schedule.Parse(servicePolicy.Schedule.ToSpec())
+	if err != nil {
+		pfxlog.Logger().WithError(err).WithField("policyId", servicePolicy.Id).
+			Error("invalid service policy schedule, policy will not grant access")
+	}
+	return result
+}
+
 func (self *ServicePolicy) IsDial() bool {
 	return self.PolicyType == edge_ctrl_pb.PolicyType_DialPolicy
 }
@@ -1246,6 +1266,7 @@ func (rdm *RouterDataModel) applyUpdateServicePolicyEvent(index uint64, model *e
 				Id:                 servicePolicy.Id,
 				Name:               servicePolicy.Name,
 				PolicyType:         servicePolicy.PolicyType,
+				Schedule:           newServicePolicySchedule(servicePolicy),
 				Services:           cmap.New[struct{}](),
 				PostureChecks:      cmap.New[struct{}](),
 				Identities:         cmap.New[struct{}](),
@@ -1257,6 +1278,7 @@ func (rdm *RouterDataModel) applyUpdateServicePolicyEvent(index uint64, model *e
 				Id:            servicePolicy.Id,
 				Name:          servicePolicy.Name,
 				PolicyType:    servicePolicy.PolicyType,
+				Schedule:      newServicePolicySchedule(servicePolicy),
 				Services:      valueInMap.Services,
 				PostureChecks: valueInMap.PostureChecks,
 				Identities:    valueInMap.Identities,
@@ -1507,6 +1529,7 @@ func (rdm *RouterDataModel) HandleServicePolicyChange(index uint64, model *edge_
 		Id:                 servicePolicy.Id,
 		Name:               servicePolicy.Name,
 		PolicyType:         servicePolicy.PolicyType,
+		Schedule:           servicePolicy.Schedule,
 		Services:           servicePolicy.Services,
 		PostureChecks:      servicePolicy.PostureChecks,
 		Identities:         servicePolicy.Identities,
@@ -1806,6 +1829,16 @@ func (rdm *RouterDataModel) GetServiceAccessPolicies(identityId string, serviceI
 	}, nil
 }
 
+// HasScheduledServicePolicies returns true if any service policy is limited to a schedule
+func (rdm *RouterDataModel) HasScheduledServicePolicies() bool {
+	for _, servicePolicy := range rdm.ServicePolicies.Items() {
+		if servicePolicy.Schedule != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func CloneMap[V any](m cmap.ConcurrentMap[string, V]) cmap.ConcurrentMap[string, V] {
 	result := cmap.New[V]()
 	m.IterCb(func(key string, v V) {
@@ -2259,6 +2292,7 @@ func (rdm *RouterDataModel) NotifyServicePolicyServiceChange(servicePolicyId str
 				Id:                 servicePolicy.Id,
 				Name:               servicePolicy.Name,
 				PolicyType:         servicePolicy.PolicyType,
+				Schedule:           servicePolicy.Schedule,
 				Services:           servicePolicy.Services,
 				PostureChecks:      servicePolicy.PostureChecks,
 				Identities:         servicePolicy.Identities,
@@ -2287,6 +2321,7 @@ func (rdm *RouterDataModel) NotifyServicePolicyPostureChecksChange(servicePolicy
 				Id:                 servicePolicy.Id,
 				Name:               servicePolicy.Name,
 				PolicyType:         servicePolicy.PolicyType,
+				Schedule:           servicePolicy.Schedule,
 				Services:           servicePolicy.Services,
 				PostureChecks:      servicePolicy.PostureChecks,
 				Identities:         servicePolicy.Identities,
@@ -2370,7 +2405,9 @@ func (rdm *RouterDataModel) Diff(o *RouterDataModel, sink DiffSink) {
 		edge_ctrl_pb.DataState_PostureCheck_Mfa_{}, edge_ctrl_pb.DataState_PostureCheck_Mfa{},
 		edge_ctrl_pb.DataState_PostureCheck_OsList_{}, edge_ctrl_pb.DataState_PostureCheck_OsList{}, edge_ctrl_pb.DataState_PostureCheck_Os{},
 		edge_ctrl_pb.DataState_PostureCheck_Process_{}, edge_ctrl_pb.DataState_PostureCheck_Process{},
-		edge_ctrl_pb.DataState_PostureCheck_ProcessMulti_{}, edge_ctrl_pb.DataState_PostureCheck_ProcessMulti{})
+		edge_ctrl_pb.DataState_PostureCheck_ProcessMulti_{}, edge_ctrl_pb.DataState_PostureCheck_ProcessMulti{},
+		edge_ctrl_pb.DataState_PostureCheck_GeoIp_{}, edge_ctrl_pb.DataState_PostureCheck_GeoIp{},
+		edge_ctrl_pb.DataState_PostureCheck_Attestation_{}, edge_ctrl_pb.DataState_PostureCheck_Attestation{})
 	diffType("public-keys", rdm.PublicKeys, o.PublicKeys, sink, edge_ctrl_pb.DataState_PublicKey{})
 	diffType("revocations", rdm.Revocations, o.Revocations, sink, edge_ctrl_pb.DataState_Revocation{})
 	diffMaps("cached-public-keys", rdm.getPublicKeysAsCmap(), o.getPublicKeysAsCmap(), sink, func(a, b crypto.PublicKey) []string {
@@ -0,0 +1,298 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+// Package routerconfig implements centrally managed router configuration. A router.v1 config holds a subset of the
+// router settings: log level, forwarder tuning, metrics intervals, listener options and link groups. It's assigned to
+// routers on the controller, which pushes it to them over the control channel. Routers overlay it on their local
+// configuration file, apply what can be changed at runtime immediately and the rest on their next restart, and
+// report back which version they applied.
+package routerconfig
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	ConfigTypeV1 = "router.v1"
+
+	SectionForwarder  = "forwarder"
+	SectionMetrics    = "metrics"
+	SectionListeners  = "listeners"
+	SectionLinkGroups = "linkGroups"
+)
+
+// ForwarderKeys are the forwarder options which may be managed. They have the same names and units (milliseconds for
+// intervals) as the keys of the forwarder section of the router configuration file
+var ForwarderKeys = []string{
+	"faultTxInterval",
+	"idleCircuitTimeout",
+	"idleTxInterval",
+	"linkDialQueueLength",
+	"linkDialWorkerCount",
+	"rateLimitedQueueLength",
+	"rateLimitedWorkerCount",
+	"unresponsiveLinkTimeout",
+	"xgressCloseCheckInterval",
+	"xgressDialDwellTime",
+	"xgressDialQueueLength",
+	"xgressDialWorkerCount",
+}
+
+// MetricsKeys are the metrics options which may be managed
+var MetricsKeys = []string{
+	"intervalAgeThreshold",
+	"reportInterval",
+}
+
+type LogConfig struct {
+	Level string `json:"level,omitempty"`
+}
+
+type MetricsConfig struct {
+	ReportInterval       string `json:"reportInterval,omitempty"`
+	IntervalAgeThreshold string `json:"intervalAgeThreshold,omitempty"`
+}
+
+// Config is the contents of a router.v1 config
+type Config struct {
+	Log       *LogConfig     `json:"log,omitempty"`
+	Forwarder map[string]int `json:"forwarder,omitempty"`
+	Metrics   *MetricsConfig `json:"metrics,omitempty"`
+	// Listeners holds xgress listener options, keyed by listener binding
+	Listeners map[string]map[string]interface{} `json:"listeners,omitempty"`
+	// LinkGroups replaces the groups of all the router's link listeners and dialers
+	LinkGroups []string `json:"linkGroups,omitempty"`
+}
+
+func (self *Config) Validate() error {
+	if self.Log != nil && self.Log.Level != "" {
+		if _, err := logrus.ParseLevel(self.Log.Level); err != nil {
+			return fmt.Errorf("invalid log.level '%s'", self.Log.Level)
+		}
+	}
+
+	for key, val := range self.Forwarder {
+		if !slices.Contains(ForwarderKeys, key) {
+			return fmt.Errorf("unsupported forwarder option '%s'", key)
+		}
+		if val < 0 {
+			return fmt.Errorf("forwarder.%s must not be negative", key)
+		}
+	}
+
+	if self.Metrics != nil {
+		if err := validateDuration("metrics.reportInterval", self.Metrics.ReportInterval); err != nil {
+			return err
+		}
+		if err := validateDuration("metrics.intervalAgeThreshold", self.Metrics.IntervalAgeThreshold); err != nil {
+			return err
+		}
+	}
+
+	for binding, options := range self.Listeners {
+		if binding == "" {
+			return fmt.Errorf("listener options must be keyed by listener binding")
+		}
+		if _, found := options["binding"]; found {
+			return fmt.Errorf("listeners.%s may not change the listener binding", binding)
+		}
+	}
+
+	for _, group := range self.LinkGroups {
+		if group == "" {
+			return fmt.Errorf("linkGroups may not contain empty group names")
+		}
+	}
+
+	return nil
+}
+
+func validateDuration(name, val string) error {
+	if val == "" {
+		return nil
+	}
+	if d, err := time.ParseDuration(val); err != nil || d <= 0 {
+		return fmt.Errorf("invalid value '%s' for %s, must be a positive duration", val, name)
+	}
+	return nil
+}
+
+// Encode returns the config in the form sent to routers
+func (self *Config) Encode() []byte {
+	result, _ := json.Marshal(self)
+	return result
+}
+
+// ApplyToMap overlays the config on a router configuration map, as loaded from the router configuration file. The
+// given map isn't modified: the result shares the sections of the original which the config doesn't touch. Listener
+// options for a binding with no matching listener are reported as an error.
+func (self *Config) ApplyToMap(src map[interface{}]interface{}) (map[interface{}]interface{}, error) {
+	result := map[interface{}]interface{}{}
+	for k, v := range src {
+		result[k] = v
+	}
+
+	if len(self.Forwarder) > 0 {
+		forwarder := copyMap(src[SectionForwarder])
+		for key, val := range self.Forwarder {
+			forwarder[key] = val
+		}
+		result[SectionForwarder] = forwarder
+	}
+
+	if self.Metrics != nil && (self.Metrics.ReportInterval != "" || self.Metrics.IntervalAgeThreshold != "") {
+		metrics := copyMap(src[SectionMetrics])
+		if self.Metrics.ReportInterval != "" {
+			metrics["reportInterval"] = self.Metrics.ReportInterval
+		}
+		if self.Metrics.IntervalAgeThreshold != "" {
+			metrics["intervalAgeThreshold"] = self.Metrics.IntervalAgeThreshold
+		}
+		result[SectionMetrics] = metrics
+	}
+
+	if len(self.Listeners) > 0 {
+		srcListeners, _ := src[SectionListeners].([]interface{})
+		var listeners []interface{}
+		matched := map[string]bool{}
+		for _, val := range srcListeners {
+			listener, ok := val.(map[interface{}]interface{})
+			if !ok {
+				listeners = append(listeners, val)
+				continue
+			}
+			binding, _ := listener["binding"].(string)
+			if options, found := self.Listeners[binding]; found {
+				matched[binding] = true
+				listener = copyMap(listener)
+				listenerOptions := copyMap(listener["options"])
+				for key, optionVal := range options {
+					listenerOptions[key] = toYamlValue(optionVal)
+				}
+				listener["options"] = listenerOptions
+			}
+			listeners = append(listeners, listener)
+		}
+
+		for binding := range self.Listeners {
+			if !matched[binding] {
+				return nil, fmt.Errorf("no listener with binding '%s' is configured", binding)
+			}
+		}
+		result[SectionListeners] = listeners
+	}
+
+	if len(self.LinkGroups) > 0 {
+		var groups []interface{}
+		for _, group := range self.LinkGroups {
+			groups = append(groups, group)
+		}
+
+		link := copyMap(src["link"])
+		for _, key := range []string{"listeners", "dialers"} {
+			if srcList, ok := link[key].([]interface{}); ok {
+				var list []interface{}
+				for _, val := range srcList {
+					if m, ok := val.(map[interface{}]interface{}); ok {
+						m = copyMap(m)
+						m["groups"] = groups
+						val = m
+					}
+					list = append(list, val)
+				}
+				link[key] = list
+			}
+		}
+		result["link"] = link
+	}
+
+	return result, nil
+}
+
+func copyMap(val interface{}) map[interface{}]interface{} {
+	result := map[interface{}]interface{}{}
+	if m, ok := val.(map[interface{}]interface{}); ok {
+		for k, v := range m {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// toYamlValue converts a value decoded from JSON to the form used in maps decoded from YAML
+func toYamlValue(val interface{}) interface{} {
+	switch v := val.(type) {
+	case map[string]interface{}:
+		result := map[interface{}]interface{}{}
+		for key, child := range v {
+			result[key] = toYamlValue(child)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, 0, len(v))
+		for _, child := range v {
+			result = append(result, toYamlValue(child))
+		}
+		return result
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < math.MaxInt32 {
+			return int(v)
+		}
+		return v
+	default:
+		return val
+	}
+}
+
+// ParseConfig parses the data of a router.v1 config
+func ParseConfig(data map[string]interface{}) (*Config, error) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return Decode(encoded)
+}
+
+// Decode parses a config in the form sent to routers
+func Decode(val []byte) (*Config, error) {
+	result := &Config{}
+	decoder := json.NewDecoder(bytes.NewReader(val))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(result); err != nil {
+		return nil, fmt.Errorf("invalid router config (%w)", err)
+	}
+	if err := result.Validate(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Version returns a version for the given config data, which changes whenever the data does. As it's derived from the
+// data, all controllers calculate the same version for a config
+func Version(data map[string]interface{}) string {
+	encoded, _ := json.Marshal(data)
+	hash := sha256.Sum256(encoded)
+	return hex.EncodeToString(hash[:8])
+}
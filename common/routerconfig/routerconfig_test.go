@@ -0,0 +1,126 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routerconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConfig(t *testing.T) {
+	req := require.New(t)
+
+	data := map[string]interface{}{
+		"log": map[string]interface{}{"level": "debug"},
+		"forwarder": map[string]interface{}{
+			"idleCircuitTimeout": 30000,
+		},
+		"metrics": map[string]interface{}{"reportInterval": "30s"},
+	}
+
+	config, err := ParseConfig(data)
+	req.NoError(err)
+	req.Equal("debug", config.Log.Level)
+	req.Equal(30000, config.Forwarder["idleCircuitTimeout"])
+	req.Equal("30s", config.Metrics.ReportInterval)
+
+	decoded, err := Decode(config.Encode())
+	req.NoError(err)
+	req.Equal(config, decoded)
+
+	version := Version(data)
+	req.Equal(version, Version(data))
+	data["log"] = map[string]interface{}{"level": "info"}
+	req.NotEqual(version, Version(data))
+
+	_, err = ParseConfig(map[string]interface{}{"forwarder": map[string]interface{}{"bogus": 1}})
+	req.ErrorContains(err, "unsupported forwarder option")
+
+	_, err = ParseConfig(map[string]interface{}{"metrics": map[string]interface{}{"reportInterval": "soon"}})
+	req.ErrorContains(err, "metrics.reportInterval")
+
+	_, err = ParseConfig(map[string]interface{}{"log": map[string]interface{}{"level": "loud"}})
+	req.ErrorContains(err, "log.level")
+
+	_, err = ParseConfig(map[string]interface{}{"unknown": true})
+	req.Error(err)
+}
+
+func TestApplyToMap(t *testing.T) {
+	req := require.New(t)
+
+	edgeListener := map[interface{}]interface{}{
+		"binding": "edge",
+		"address": "tls:0.0.0.0:3022",
+		"options": map[interface{}]interface{}{
+			"advertise": "router.example.com:3022",
+		},
+	}
+	linkListener := map[interface{}]interface{}{
+		"binding": "transport",
+		"bind":    "tls:0.0.0.0:6000",
+	}
+	src := map[interface{}]interface{}{
+		"v": 3,
+		"forwarder": map[interface{}]interface{}{
+			"faultTxInterval": 1000,
+		},
+		"listeners": []interface{}{edgeListener},
+		"link": map[interface{}]interface{}{
+			"listeners": []interface{}{linkListener},
+		},
+	}
+
+	config := &Config{
+		Forwarder: map[string]int{"idleTxInterval": 5000},
+		Metrics:   &MetricsConfig{ReportInterval: "15s"},
+		Listeners: map[string]map[string]interface{}{
+			"edge": {
+				"getSessionTimeout": float64(30),
+				"nested":            map[string]interface{}{"enabled": true},
+			},
+		},
+		LinkGroups: []string{"east"},
+	}
+
+	result, err := config.ApplyToMap(src)
+	req.NoError(err)
+
+	req.Equal(map[interface{}]interface{}{"faultTxInterval": 1000, "idleTxInterval": 5000}, result["forwarder"])
+	req.Equal(map[interface{}]interface{}{"reportInterval": "15s"}, result["metrics"])
+
+	listener := result["listeners"].([]interface{})[0].(map[interface{}]interface{})
+	options := listener["options"].(map[interface{}]interface{})
+	req.Equal("router.example.com:3022", options["advertise"])
+	req.Equal(30, options["getSessionTimeout"])
+	req.Equal(map[interface{}]interface{}{"enabled": true}, options["nested"])
+
+	link := result["link"].(map[interface{}]interface{})
+	linkListenerResult := link["listeners"].([]interface{})[0].(map[interface{}]interface{})
+	req.Equal([]interface{}{"east"}, linkListenerResult["groups"])
+
+	// the source map is left untouched
+	req.Equal(map[interface{}]interface{}{"faultTxInterval": 1000}, src["forwarder"])
+	req.NotContains(edgeListener["options"], "getSessionTimeout")
+	req.NotContains(linkListener, "groups")
+	req.NotContains(src, "metrics")
+
+	config = &Config{Listeners: map[string]map[string]interface{}{"tunnel": {"mode": "tproxy"}}}
+	_, err = config.ApplyToMap(src)
+	req.ErrorContains(err, "no listener with binding 'tunnel'")
+}
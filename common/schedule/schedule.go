@@ -0,0 +1,280 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+// Package schedule implements the time windows which may be attached to policies. A schedule is made up of optional
+// validity dates and an optional set of cron-like windows, evaluated in a given time zone.
+//
+// Each window is a five field cron expression: minute, hour, day of month, month and day of week. Rather than
+// describing when something should run, a window describes the minutes during which a policy is in force. For
+// example, "* 9-16 * * MON-FRI" is active from 09:00 until 16:59 on weekdays, and "0-29 12 * * *" is active for the
+// first half hour after noon every day. Fields support '*', single values, ranges ("1-5"), steps ("*/15", "0-30/10")
+// and comma separated lists. Months and days of the week may be given by number or by three letter name. Day of week
+// 0 and 7 are both Sunday. As with cron, if both day of month and day of week are restricted, a day matching either
+// is accepted.
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Spec is the stored form of a schedule
+type Spec struct {
+	// TimeZone is an IANA time zone name, such as America/New_York. Windows are evaluated in UTC if not set
+	TimeZone string `json:"timeZone,omitempty"`
+	// NotBefore, if set, is the time from which the schedule may be active
+	NotBefore *time.Time `json:"notBefore,omitempty"`
+	// NotAfter, if set, is the time after which the schedule is never active
+	NotAfter *time.Time `json:"notAfter,omitempty"`
+	// Windows are cron-like expressions describing the minutes during which the schedule is active. If no windows
+	// are given, the schedule is active at all times between NotBefore and NotAfter
+	Windows []string `json:"windows,omitempty"`
+}
+
+// IsEmpty returns true if the spec places no restrictions on when it's active
+func (self *Spec) IsEmpty() bool {
+	return self == nil || (self.TimeZone == "" && self.NotBefore == nil && self.NotAfter == nil && len(self.Windows) == 0)
+}
+
+func (self *Spec) Validate() error {
+	_, err := Parse(self)
+	return err
+}
+
+// Schedule is a parsed Spec, which can be evaluated. A nil Schedule is always active
+type Schedule struct {
+	spec     Spec
+	location *time.Location
+	windows  []*window
+	invalid  bool
+}
+
+// Parse validates the given spec and returns the corresponding schedule. If the spec is empty, nil is returned. If
+// the spec is invalid, an error is returned along with a schedule which is never active, so that callers which
+// choose to ignore the error fail closed.
+func Parse(spec *Spec) (*Schedule, error) {
+	if spec.IsEmpty() {
+		return nil, nil
+	}
+
+	result := &Schedule{
+		spec:     *spec,
+		location: time.UTC,
+	}
+
+	if spec.TimeZone != "" {
+		location, err := time.LoadLocation(spec.TimeZone)
+		if err != nil {
+			result.invalid = true
+			return result, fmt.Errorf("invalid time zone '%s' (%w)", spec.TimeZone, err)
+		}
+		result.location = location
+	}
+
+	if spec.NotBefore != nil && spec.NotAfter != nil && !spec.NotAfter.After(*spec.NotBefore) {
+		result.invalid = true
+		return result, fmt.Errorf("notAfter (%s) must be after notBefore (%s)",
+			spec.NotAfter.Format(time.RFC3339), spec.NotBefore.Format(time.RFC3339))
+	}
+
+	for _, expr := range spec.Windows {
+		w, err := parseWindow(expr)
+		if err != nil {
+			result.invalid = true
+			return result, fmt.Errorf("invalid window '%s' (%w)", expr, err)
+		}
+		result.windows = append(result.windows, w)
+	}
+
+	return result, nil
+}
+
+// GetSpec returns the spec the schedule was parsed from
+func (self *Schedule) GetSpec() *Spec {
+	if self == nil {
+		return nil
+	}
+	spec := self.spec
+	return &spec
+}
+
+// IsActive returns true if the schedule is in force at the given time
+func (self *Schedule) IsActive(t time.Time) bool {
+	if self == nil {
+		return true
+	}
+
+	if self.invalid {
+		return false
+	}
+
+	if self.spec.NotBefore != nil && t.Before(*self.spec.NotBefore) {
+		return false
+	}
+
+	if self.spec.NotAfter != nil && t.After(*self.spec.NotAfter) {
+		return false
+	}
+
+	if len(self.windows) == 0 {
+		return true
+	}
+
+	local := t.In(self.location)
+	for _, w := range self.windows {
+		if w.matches(local) {
+			return true
+		}
+	}
+	return false
+}
+
+type window struct {
+	minutes     uint64
+	hours       uint64
+	daysOfMonth uint64
+	months      uint64
+	daysOfWeek  uint64
+	anyDom      bool
+	anyDow      bool
+}
+
+func (self *window) matches(t time.Time) bool {
+	if self.minutes&(1<<uint(t.Minute())) == 0 ||
+		self.hours&(1<<uint(t.Hour())) == 0 ||
+		self.months&(1<<uint(t.Month())) == 0 {
+		return false
+	}
+
+	domMatch := self.daysOfMonth&(1<<uint(t.Day())) != 0
+	dowMatch := self.daysOfWeek&(1<<uint(t.Weekday())) != 0
+
+	if self.anyDom || self.anyDow {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+var monthNames = map[string]int{
+	"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+	"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+}
+
+var dayNames = map[string]int{
+	"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+}
+
+func parseWindow(expr string) (*window, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields (minute hour day-of-month month day-of-week), found %d", len(fields))
+	}
+
+	result := &window{}
+	var err error
+
+	if result.minutes, _, err = parseField(fields[0], 0, 59, nil); err != nil {
+		return nil, fmt.Errorf("minute: %w", err)
+	}
+	if result.hours, _, err = parseField(fields[1], 0, 23, nil); err != nil {
+		return nil, fmt.Errorf("hour: %w", err)
+	}
+	if result.daysOfMonth, result.anyDom, err = parseField(fields[2], 1, 31, nil); err != nil {
+		return nil, fmt.Errorf("day of month: %w", err)
+	}
+	if result.months, _, err = parseField(fields[3], 1, 12, monthNames); err != nil {
+		return nil, fmt.Errorf("month: %w", err)
+	}
+	if result.daysOfWeek, result.anyDow, err = parseField(fields[4], 0, 7, dayNames); err != nil {
+		return nil, fmt.Errorf("day of week: %w", err)
+	}
+
+	// 7 is an alias for Sunday
+	if result.daysOfWeek&(1<<7) != 0 {
+		result.daysOfWeek = (result.daysOfWeek | 1) &^ (1 << 7)
+	}
+
+	return result, nil
+}
+
+// parseField returns the bits set for the given field, and whether the field was unrestricted ('*')
+func parseField(field string, min, max int, names map[string]int) (uint64, bool, error) {
+	var result uint64
+	unrestricted := false
+
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepPart); err != nil || step < 1 {
+				return 0, false, fmt.Errorf("invalid step '%s'", stepPart)
+			}
+		}
+
+		var low, high int
+		if rangePart == "*" {
+			low, high = min, max
+			unrestricted = unrestricted || !hasStep
+		} else if lowPart, highPart, isRange := strings.Cut(rangePart, "-"); isRange {
+			var err error
+			if low, err = parseValue(lowPart, min, max, names); err != nil {
+				return 0, false, err
+			}
+			if high, err = parseValue(highPart, min, max, names); err != nil {
+				return 0, false, err
+			}
+			if high < low {
+				return 0, false, fmt.Errorf("invalid range '%s'", rangePart)
+			}
+		} else {
+			var err error
+			if low, err = parseValue(rangePart, min, max, names); err != nil {
+				return 0, false, err
+			}
+			high = low
+			if hasStep {
+				high = max
+			}
+		}
+
+		for i := low; i <= high; i += step {
+			result |= 1 << uint(i)
+		}
+	}
+
+	return result, unrestricted, nil
+}
+
+func parseValue(value string, min, max int, names map[string]int) (int, error) {
+	if v, found := names[strings.ToUpper(value)]; found {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value '%s'", value)
+	}
+
+	if v < min || v > max {
+		return 0, fmt.Errorf("value %d out of range [%d-%d]", v, min, max)
+	}
+
+	return v, nil
+}
@@ -0,0 +1,136 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mustParse(t *testing.T, spec *Spec) *Schedule {
+	s, err := Parse(spec)
+	require.NoError(t, err)
+	return s
+}
+
+func TestEmptyScheduleIsAlwaysActive(t *testing.T) {
+	req := require.New(t)
+
+	s := mustParse(t, &Spec{})
+	req.Nil(s)
+	req.True(s.IsActive(time.Now()))
+	req.Nil(s.GetSpec())
+}
+
+func TestBusinessHours(t *testing.T) {
+	req := require.New(t)
+
+	s := mustParse(t, &Spec{
+		TimeZone: "America/New_York",
+		Windows:  []string{"* 9-16 * * MON-FRI"},
+	})
+
+	ny, err := time.LoadLocation("America/New_York")
+	req.NoError(err)
+
+	// Wednesday
+	req.False(s.IsActive(time.Date(2024, 3, 13, 8, 59, 0, 0, ny)))
+	req.True(s.IsActive(time.Date(2024, 3, 13, 9, 0, 0, 0, ny)))
+	req.True(s.IsActive(time.Date(2024, 3, 13, 16, 59, 59, 0, ny)))
+	req.False(s.IsActive(time.Date(2024, 3, 13, 17, 0, 0, 0, ny)))
+
+	// the same instant, expressed in UTC
+	req.True(s.IsActive(time.Date(2024, 3, 13, 13, 0, 0, 0, time.UTC)))
+	req.False(s.IsActive(time.Date(2024, 3, 13, 9, 0, 0, 0, time.UTC)))
+
+	// Saturday
+	req.False(s.IsActive(time.Date(2024, 3, 16, 12, 0, 0, 0, ny)))
+}
+
+func TestValidityDates(t *testing.T) {
+	req := require.New(t)
+
+	notBefore := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	notAfter := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	s := mustParse(t, &Spec{NotBefore: &notBefore, NotAfter: &notAfter})
+	req.False(s.IsActive(notBefore.Add(-time.Second)))
+	req.True(s.IsActive(notBefore))
+	req.True(s.IsActive(notAfter))
+	req.False(s.IsActive(notAfter.Add(time.Second)))
+
+	s = mustParse(t, &Spec{NotBefore: &notBefore, Windows: []string{"0-29 12 * * *"}})
+	req.False(s.IsActive(time.Date(2023, 12, 31, 12, 0, 0, 0, time.UTC)))
+	req.True(s.IsActive(time.Date(2024, 6, 1, 12, 29, 0, 0, time.UTC)))
+	req.False(s.IsActive(time.Date(2024, 6, 1, 12, 30, 0, 0, time.UTC)))
+}
+
+func TestWindowFields(t *testing.T) {
+	req := require.New(t)
+
+	// steps and lists
+	s := mustParse(t, &Spec{Windows: []string{"*/15,7 * * * *"}})
+	for _, minute := range []int{0, 7, 15, 30, 45} {
+		req.True(s.IsActive(time.Date(2024, 1, 1, 10, minute, 0, 0, time.UTC)), "minute %d", minute)
+	}
+	req.False(s.IsActive(time.Date(2024, 1, 1, 10, 8, 0, 0, time.UTC)))
+
+	// 7 is Sunday, and month names are accepted
+	s = mustParse(t, &Spec{Windows: []string{"* * * jan 7"}})
+	req.True(s.IsActive(time.Date(2024, 1, 7, 10, 0, 0, 0, time.UTC)))
+	req.False(s.IsActive(time.Date(2024, 1, 8, 10, 0, 0, 0, time.UTC)))
+	req.False(s.IsActive(time.Date(2024, 2, 4, 10, 0, 0, 0, time.UTC)))
+
+	// when both day of month and day of week are restricted, either may match
+	s = mustParse(t, &Spec{Windows: []string{"* * 1 * MON"}})
+	req.True(s.IsActive(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)))  // Friday the 1st
+	req.True(s.IsActive(time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC)))  // Monday
+	req.False(s.IsActive(time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC))) // Tuesday
+
+	// multiple windows
+	s = mustParse(t, &Spec{Windows: []string{"* 9 * * *", "* 14 * * *"}})
+	req.True(s.IsActive(time.Date(2024, 3, 5, 9, 30, 0, 0, time.UTC)))
+	req.True(s.IsActive(time.Date(2024, 3, 5, 14, 30, 0, 0, time.UTC)))
+	req.False(s.IsActive(time.Date(2024, 3, 5, 12, 30, 0, 0, time.UTC)))
+}
+
+func TestInvalidSpecs(t *testing.T) {
+	req := require.New(t)
+
+	notBefore := time.Now()
+	notAfter := notBefore.Add(-time.Hour)
+
+	for _, spec := range []*Spec{
+		{TimeZone: "Not/AZone"},
+		{NotBefore: &notBefore, NotAfter: &notAfter},
+		{Windows: []string{"* * * *"}},
+		{Windows: []string{"60 * * * *"}},
+		{Windows: []string{"* 5-2 * * *"}},
+		{Windows: []string{"*/0 * * * *"}},
+		{Windows: []string{"* * * FOO *"}},
+	} {
+		s, err := Parse(spec)
+		req.Error(err, "spec %+v", spec)
+		req.Error(spec.Validate())
+
+		// invalid schedules fail closed
+		req.NotNil(s)
+		req.False(s.IsActive(time.Now()))
+	}
+}
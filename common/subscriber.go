@@ -202,6 +202,8 @@ func (self *IdentitySubscription) DiffWith(other *IdentitySubscription, sink Dif
 		edge_ctrl_pb.DataState_PostureCheck_OsList_{}, edge_ctrl_pb.DataState_PostureCheck_OsList{}, edge_ctrl_pb.DataState_PostureCheck_Os{},
 		edge_ctrl_pb.DataState_PostureCheck_Process_{}, edge_ctrl_pb.DataState_PostureCheck_Process{},
 		edge_ctrl_pb.DataState_PostureCheck_ProcessMulti_{}, edge_ctrl_pb.DataState_PostureCheck_ProcessMulti{},
+		edge_ctrl_pb.DataState_PostureCheck_GeoIp_{}, edge_ctrl_pb.DataState_PostureCheck_GeoIp{},
+		edge_ctrl_pb.DataState_PostureCheck_Attestation_{}, edge_ctrl_pb.DataState_PostureCheck_Attestation{},
 	), adapter)
 }
 
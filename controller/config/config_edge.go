@@ -79,6 +79,21 @@ type Enrollment struct {
 	SigningCertCaPem  []byte
 	EdgeIdentity      EnrollmentOption
 	EdgeRouter        EnrollmentOption
+
+	// NextSigningCert is the signer which will replace SigningCert. While it's configured, it's trusted alongside
+	// SigningCert, router certificates are signed by it and routers are told to renew certificates it didn't sign.
+	NextSigningCert       identity.Identity
+	NextSigningCertConfig identity.Config
+	NextSigningCertCaPem  []byte
+}
+
+// GetRouterSigningCert returns the signer used for router certificates, which is the next signing cert while a
+// rollover is in progress
+func (self *Enrollment) GetRouterSigningCert() identity.Identity {
+	if self.NextSigningCert != nil {
+		return self.NextSigningCert
+	}
+	return self.SigningCert
 }
 
 type EnrollmentOption struct {
@@ -119,6 +134,7 @@ type EdgeConfig struct {
 	caCerts              []*x509.Certificate
 	caCertPool           *x509.CertPool
 	DisablePostureChecks bool
+	GeoIpDatabase        string
 }
 
 type HttpTimeouts struct {
@@ -410,6 +426,72 @@ func validateHostPortString(address string) error {
 	return nil
 }
 
+// loadNextSigningCert loads the signer which will replace the current signing cert. Its certificate and CA are added
+// to the trusted CAs, so that certificates signed by either are accepted during the rollover
+func (c *EdgeConfig) loadNextSigningCert(value interface{}) error {
+	nextSigningCertSubMap, ok := value.(map[interface{}]interface{})
+	if !ok {
+		return errors.New("[edge.enrollment.nextSigningCert] must be a map")
+	}
+
+	var err error
+	c.Enrollment.NextSigningCertConfig = identity.Config{}
+
+	if value, found := nextSigningCertSubMap["cert"]; found {
+		c.Enrollment.NextSigningCertConfig.Cert = value.(string)
+		certPem, err := os.ReadFile(c.Enrollment.NextSigningCertConfig.Cert)
+		if err != nil {
+			return fmt.Errorf("unable to read [edge.enrollment.nextSigningCert.cert]: %w", err)
+		}
+		_, _ = c.caPems.WriteString("\n")
+		_, _ = c.caPems.Write(certPem)
+	} else {
+		return errors.New("required configuration value [edge.enrollment.nextSigningCert.cert] is missing")
+	}
+
+	if value, found := nextSigningCertSubMap["key"]; found {
+		c.Enrollment.NextSigningCertConfig.Key = value.(string)
+	} else {
+		return errors.New("required configuration value [edge.enrollment.nextSigningCert.key] is missing")
+	}
+
+	if value, found := nextSigningCertSubMap["ca"]; found {
+		c.Enrollment.NextSigningCertConfig.CA = value.(string)
+
+		if c.Enrollment.NextSigningCertCaPem, err = os.ReadFile(c.Enrollment.NextSigningCertConfig.CA); err != nil {
+			return errors.New("could not read file CA file from [edge.enrollment.nextSigningCert.ca]")
+		}
+
+		_, _ = c.caPems.WriteString("\n")
+		_, _ = c.caPems.Write(c.Enrollment.NextSigningCertCaPem)
+	}
+
+	if c.Enrollment.NextSigningCert, err = identity.LoadIdentity(c.Enrollment.NextSigningCertConfig); err != nil {
+		return fmt.Errorf("error loading [edge.enrollment.nextSigningCert]: %s", err)
+	}
+
+	if err = c.Enrollment.NextSigningCert.WatchFiles(); err != nil {
+		pfxlog.Logger().WithError(err).Warn("could not enable file watching on next enrollment signing cert")
+	}
+
+	return nil
+}
+
+// NextSigningCertChainPem returns the PEM encoded chain of the next signing cert, which routers add to their trusted
+// CAs, or nil if no rollover is in progress
+func (c *EdgeConfig) NextSigningCertChainPem() []byte {
+	if c.Enrollment.NextSigningCert == nil {
+		return nil
+	}
+
+	var result []byte
+	for _, cert := range c.Enrollment.NextSigningCert.Cert().Certificate {
+		result = append(result, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert})...)
+	}
+	result = append(result, c.Enrollment.NextSigningCertCaPem...)
+	return result
+}
+
 func (c *EdgeConfig) loadEnrollmentSection(edgeConfigMap map[interface{}]interface{}) error {
 	c.Enrollment = Enrollment{}
 	var err error
@@ -464,6 +546,12 @@ func (c *EdgeConfig) loadEnrollmentSection(edgeConfigMap map[interface{}]interfa
 			return errors.New("required configuration section [edge.enrollment.signingCert] missing")
 		}
 
+		if value, found := enrollmentSubMap["nextSigningCert"]; found {
+			if err = c.loadNextSigningCert(value); err != nil {
+				return err
+			}
+		}
+
 		if value, found := enrollmentSubMap["edgeIdentity"]; found {
 			edgeIdentitySubMap := value.(map[interface{}]interface{})
 
@@ -662,6 +750,14 @@ func LoadEdgeConfigFromMap(configMap map[interface{}]interface{}) (*EdgeConfig,
 		}
 	}
 
+	if v, ok := edgeConfigMap["geoIpDatabase"]; ok {
+		if strVal, ok := v.(string); ok {
+			edgeConfig.GeoIpDatabase = strings.TrimSpace(strVal)
+		} else {
+			return nil, fmt.Errorf("invalid type for 'geoIpDatabase' config %T", v)
+		}
+	}
+
 	return edgeConfig, nil
 }
 
@@ -8,7 +8,10 @@ import (
 	"crypto/rand"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/pem"
 	"math/big"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -362,6 +365,44 @@ func Test_CalculateCaPems(t *testing.T) {
 
 }
 
+func Test_loadNextSigningCert(t *testing.T) {
+	req := require.New(t)
+
+	signer, key := newSelfSignedCert("next signer", true)
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	req.NoError(err)
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "next.cert")
+	keyFile := filepath.Join(dir, "next.key")
+	req.NoError(os.WriteFile(certFile, nfpem.EncodeToBytes(signer), 0600))
+	req.NoError(os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer}), 0600))
+
+	c := &EdgeConfig{caPems: bytes.NewBuffer(nil)}
+	req.Nil(c.NextSigningCertChainPem())
+
+	err = c.loadNextSigningCert(map[interface{}]interface{}{"cert": certFile})
+	req.ErrorContains(err, "nextSigningCert.key")
+
+	err = c.loadNextSigningCert(map[interface{}]interface{}{
+		"cert": certFile,
+		"key":  keyFile,
+	})
+	req.NoError(err)
+	defer c.Enrollment.NextSigningCert.StopWatchingFiles()
+
+	req.Equal(c.Enrollment.NextSigningCert, c.Enrollment.GetRouterSigningCert())
+
+	chain := nfpem.PemBytesToCertificates(c.NextSigningCertChainPem())
+	req.Len(chain, 1)
+	req.True(chain[0].Equal(signer))
+
+	// the next signer is trusted alongside the current one
+	caCerts := c.CaCerts()
+	req.Len(caCerts, 1)
+	req.True(caCerts[0].Equal(signer))
+}
+
 func newSelfSignedCert(commonName string, isCas bool) (*x509.Certificate, crypto.PrivateKey) {
 	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 	if err != nil {
@@ -20,6 +20,7 @@ import (
 	"math"
 	"time"
 
+	"github.com/hanzozt/zt/v2/controller/xt"
 	"github.com/pkg/errors"
 
 	"github.com/sirupsen/logrus"
@@ -50,8 +51,10 @@ type NetworkConfig struct {
 	CycleSeconds            uint32
 	InitialLinkLatency      time.Duration
 	IntervalAgeThreshold    time.Duration
+	LinkCost                LinkCostConfig
 	MetricsReportInterval   time.Duration
 	MinRouterCost           uint16
+	OutlierDetection        xt.OutlierDetectionConfig
 	PendingLinkTimeout      time.Duration
 	RouteTimeout            time.Duration
 	RouterConnectChurnLimit time.Duration
@@ -73,6 +76,7 @@ func DefaultNetworkConfig() *NetworkConfig {
 		InitialLinkLatency:    DefaultOptionsInitialLinkLatency,
 		MetricsReportInterval: DefaultOptionsMetricsReportInterval,
 		MinRouterCost:         DefaultOptionsMinRouterCost,
+		OutlierDetection:      xt.DefaultOutlierDetectionConfig(),
 		PendingLinkTimeout:    DefaultOptionsPendingLinkTimeout,
 		RouterComm: struct {
 			QueueSize  uint32
@@ -258,5 +262,167 @@ func LoadNetworkConfig(src map[interface{}]interface{}) (*NetworkConfig, error)
 		}
 	}
 
+	if value, found := src["linkCost"]; found {
+		if submap, ok := value.(map[interface{}]interface{}); ok {
+			if err := loadLinkCostConfig(&options.LinkCost, submap); err != nil {
+				return nil, err
+			}
+		} else {
+			return nil, errors.New("invalid value for 'linkCost', must be a map")
+		}
+	}
+
+	if value, found := src["outlierDetection"]; found {
+		if submap, ok := value.(map[interface{}]interface{}); ok {
+			if err := loadOutlierDetectionConfig(&options.OutlierDetection, submap); err != nil {
+				return nil, err
+			}
+		} else {
+			return nil, errors.New("invalid value for 'outlierDetection', must be a map")
+		}
+	}
+
 	return options, nil
 }
+
+func loadOutlierDetectionConfig(config *xt.OutlierDetectionConfig, src map[interface{}]interface{}) error {
+	config.Enabled = true
+
+	if value, found := src["enabled"]; found {
+		if enabled, ok := value.(bool); ok {
+			config.Enabled = enabled
+		} else {
+			return errors.New("invalid value for 'outlierDetection.enabled', must be a boolean")
+		}
+	}
+
+	if value, found := src["consecutiveFailures"]; found {
+		if consecutiveFailures, ok := value.(int); ok && consecutiveFailures >= 0 {
+			config.ConsecutiveFailures = uint32(consecutiveFailures)
+		} else {
+			return errors.New("invalid value for 'outlierDetection.consecutiveFailures', must be an integer greater than or equal to 0")
+		}
+	}
+
+	if value, found := src["failureRateThreshold"]; found {
+		var threshold float64
+		if intVal, ok := value.(int); ok {
+			threshold = float64(intVal)
+		} else if floatVal, ok := value.(float64); ok {
+			threshold = floatVal
+		} else {
+			return errors.New("invalid value for 'outlierDetection.failureRateThreshold', must be a number")
+		}
+		if threshold < 0 || threshold > 1 {
+			return errors.New("invalid value for 'outlierDetection.failureRateThreshold', must be between 0 and 1")
+		}
+		config.FailureRateThreshold = threshold
+	}
+
+	if value, found := src["failureRateMinDials"]; found {
+		if minDials, ok := value.(int); ok && minDials >= 0 {
+			config.FailureRateMinDials = uint32(minDials)
+		} else {
+			return errors.New("invalid value for 'outlierDetection.failureRateMinDials', must be an integer greater than or equal to 0")
+		}
+	}
+
+	durations := []struct {
+		name  string
+		field *time.Duration
+	}{
+		{name: "failureRateWindow", field: &config.FailureRateWindow},
+		{name: "baseEjectionDuration", field: &config.BaseEjectionDuration},
+		{name: "maxEjectionDuration", field: &config.MaxEjectionDuration},
+	}
+
+	for _, duration := range durations {
+		if value, found := src[duration.name]; found {
+			strVal, ok := value.(string)
+			if !ok {
+				return errors.Errorf("invalid value for 'outlierDetection.%s', must be a duration", duration.name)
+			}
+			val, err := time.ParseDuration(strVal)
+			if err != nil {
+				return errors.Wrapf(err, "invalid value for 'outlierDetection.%s'", duration.name)
+			}
+			if val <= 0 {
+				return errors.Errorf("invalid value for 'outlierDetection.%s', must be greater than 0", duration.name)
+			}
+			*duration.field = val
+		}
+	}
+
+	if config.MaxEjectionDuration < config.BaseEjectionDuration {
+		return errors.New("invalid value for 'outlierDetection.maxEjectionDuration', must be greater than or equal to baseEjectionDuration")
+	}
+
+	if value, found := src["maxEjectedPercent"]; found {
+		if maxEjectedPercent, ok := value.(int); ok && maxEjectedPercent >= 0 && maxEjectedPercent <= 100 {
+			config.MaxEjectedPercent = uint32(maxEjectedPercent)
+		} else {
+			return errors.New("invalid value for 'outlierDetection.maxEjectedPercent', must be an integer between 0 and 100")
+		}
+	}
+
+	return nil
+}
+
+// LinkCostConfig controls how link quality figures reported by routers contribute to link cost, in addition to link
+// latency and static cost. All contributions are disabled by default.
+type LinkCostConfig struct {
+	// LossCost is added to the link cost for each percent of link probes lost, using the higher of the figures
+	// reported by the two routers
+	LossCost uint32
+	// JitterFactor is multiplied by the jitter reported by each router, in milliseconds, and added to the link cost
+	JitterFactor float64
+	// MinAvailableBandwidth is the available bandwidth, in bits per second, below which LowBandwidthCost is added to
+	// the link cost
+	MinAvailableBandwidth int64
+	// LowBandwidthCost is added to the link cost if either router estimates the available bandwidth to be below
+	// MinAvailableBandwidth
+	LowBandwidthCost uint32
+}
+
+func loadLinkCostConfig(config *LinkCostConfig, src map[interface{}]interface{}) error {
+	if value, found := src["lossCost"]; found {
+		if lossCost, ok := value.(int); ok && lossCost >= 0 {
+			config.LossCost = uint32(lossCost)
+		} else {
+			return errors.New("invalid value for 'linkCost.lossCost', must be an integer greater than or equal to 0")
+		}
+	}
+
+	if value, found := src["jitterFactor"]; found {
+		var jitterFactor float64
+		if intVal, ok := value.(int); ok {
+			jitterFactor = float64(intVal)
+		} else if floatVal, ok := value.(float64); ok {
+			jitterFactor = floatVal
+		} else {
+			return errors.New("invalid value for 'linkCost.jitterFactor', must be a number")
+		}
+		if jitterFactor < 0 {
+			return errors.New("invalid value for 'linkCost.jitterFactor', must be greater than or equal to 0")
+		}
+		config.JitterFactor = jitterFactor
+	}
+
+	if value, found := src["minAvailableBandwidth"]; found {
+		if minBandwidth, ok := value.(int); ok && minBandwidth >= 0 {
+			config.MinAvailableBandwidth = int64(minBandwidth)
+		} else {
+			return errors.New("invalid value for 'linkCost.minAvailableBandwidth', must be an integer greater than or equal to 0")
+		}
+	}
+
+	if value, found := src["lowBandwidthCost"]; found {
+		if lowBandwidthCost, ok := value.(int); ok && lowBandwidthCost >= 0 {
+			config.LowBandwidthCost = uint32(lowBandwidthCost)
+		} else {
+			return errors.New("invalid value for 'linkCost.lowBandwidthCost', must be an integer greater than or equal to 0")
+		}
+	}
+
+	return nil
+}
@@ -61,6 +61,8 @@ import (
 	"github.com/hanzozt/zt/v2/controller/xctrl"
 	"github.com/hanzozt/zt/v2/controller/xmgmt"
 	"github.com/hanzozt/zt/v2/controller/xt"
+	"github.com/hanzozt/zt/v2/controller/xt_consistenthash"
+	"github.com/hanzozt/zt/v2/controller/xt_leastloaded"
 	"github.com/hanzozt/zt/v2/controller/xt_random"
 	"github.com/hanzozt/zt/v2/controller/xt_smartrouting"
 	"github.com/hanzozt/zt/v2/controller/xt_sticky"
@@ -325,6 +327,16 @@ func NewController(cfg *config.Config, versionProvider versions.VersionProvider)
 		})
 	}
 
+	if caPem := cfg.Edge.NextSigningCertChainPem(); len(caPem) > 0 {
+		logrus.Info("signing CA rollover in progress, routers will be sent the next signing CA")
+		c.network.AddRouterPresenceHandler(&OnConnectSettingsHandler{
+			config: cfg,
+			settings: map[int32][]byte{
+				int32(ctrl_pb.SettingTypes_SigningCaRollover): caPem,
+			},
+		})
+	}
+
 	if c.raftController != nil {
 		logrus.Info("Adding router presence handler to send out ctrl addresses")
 		c.network.AddRouterPresenceHandler(
@@ -332,6 +344,14 @@ func NewController(cfg *config.Config, versionProvider versions.VersionProvider)
 		)
 	}
 
+	routerDrainSettingsHandler := &RouterDrainSettingsHandler{}
+	c.network.AddRouterPresenceHandler(routerDrainSettingsHandler)
+	c.network.Router.AddDrainListener(routerDrainSettingsHandler)
+
+	routerManagedConfigHandler := NewRouterManagedConfigHandler(c.network)
+	c.network.AddRouterPresenceHandler(routerManagedConfigHandler)
+	c.network.Router.AddConfigListener(routerManagedConfigHandler)
+
 	if err := c.showOptions(); err != nil {
 		return nil, err
 	}
@@ -537,6 +557,9 @@ func (c *Controller) Shutdown() {
 		if c.config.Edge.Enrollment.SigningCert != nil {
 			c.config.Edge.Enrollment.SigningCert.StopWatchingFiles()
 		}
+		if c.config.Edge.Enrollment.NextSigningCert != nil {
+			c.config.Edge.Enrollment.NextSigningCert.StopWatchingFiles()
+		}
 
 		if c.healthChecker != nil {
 			c.healthChecker.DeregisterAll()
@@ -571,6 +594,8 @@ func (c *Controller) registerXts() {
 	xt.GlobalRegistry().RegisterFactory(xt_random.NewFactory())
 	xt.GlobalRegistry().RegisterFactory(xt_weighted.NewFactory())
 	xt.GlobalRegistry().RegisterFactory(xt_sticky.NewFactory())
+	xt.GlobalRegistry().RegisterFactory(xt_leastloaded.NewFactory())
+	xt.GlobalRegistry().RegisterFactory(xt_consistenthash.NewFactory())
 }
 
 func (c *Controller) registerComponents() error {
@@ -9,6 +9,7 @@ import (
 	"github.com/hanzozt/storage/ast"
 	"github.com/hanzozt/storage/boltz"
 	"github.com/hanzozt/zt/v2/common/eid"
+	"github.com/hanzozt/zt/v2/common/schedule"
 )
 
 func newEdgeRouterPolicy(name string) *EdgeRouterPolicy {
@@ -25,6 +26,8 @@ type EdgeRouterPolicy struct {
 	Semantic        string   `json:"semantic"`
 	IdentityRoles   []string `json:"identityRoles"`
 	EdgeRouterRoles []string `json:"edgeRouterRoles"`
+	// Schedule optionally restricts the times at which the policy is in force
+	Schedule *schedule.Spec `json:"schedule,omitempty"`
 }
 
 func (entity *EdgeRouterPolicy) GetName() string {
@@ -99,6 +102,7 @@ func (store *edgeRouterPolicyStoreImpl) FillEntity(entity *EdgeRouterPolicy, buc
 	entity.Semantic = bucket.GetStringWithDefault(FieldSemantic, SemanticAllOf)
 	entity.IdentityRoles = bucket.GetStringList(FieldIdentityRoles)
 	entity.EdgeRouterRoles = bucket.GetStringList(FieldEdgeRouterRoles)
+	entity.Schedule = loadPolicySchedule(bucket)
 }
 
 func (store *edgeRouterPolicyStoreImpl) PersistEntity(entity *EdgeRouterPolicy, ctx *boltz.PersistContext) {
@@ -119,6 +123,7 @@ func (store *edgeRouterPolicyStoreImpl) PersistEntity(entity *EdgeRouterPolicy,
 		}
 		ctx.SetRequiredString(FieldSemantic, entity.Semantic)
 	}
+	persistPolicySchedule(ctx, entity.Schedule)
 
 	sort.Strings(entity.EdgeRouterRoles)
 	sort.Strings(entity.IdentityRoles)
@@ -23,6 +23,8 @@ import (
 
 	"github.com/michaelquigley/pfxlog"
 	"github.com/hanzozt/storage/boltz"
+	"github.com/hanzozt/zt/v2/common/ratelimit"
+	"github.com/hanzozt/zt/v2/common/routerconfig"
 )
 
 func (m *Migrations) initialize(step *boltz.MigrationStep) int {
@@ -41,10 +43,15 @@ func (m *Migrations) initialize(step *boltz.MigrationStep) int {
 	m.createInterceptV1ConfigType(step)
 	m.createHostV1ConfigType(step)
 	m.addProcessMultiPostureCheck(step)
+	m.addGeoIpPostureCheckType(step)
+	m.addAttestationPostureCheckType(step)
 	m.createConfigType(step, hostV2ConfigType)
 	m.addSystemAuthPolicies(step)
 	m.createConfigType(step, interfacesConfigTypeV1)
 	m.createConfigType(step, proxyConfigTypeV1)
+	m.createConfigType(step, rateLimitConfigTypeV1)
+	m.createConfigType(step, routerConfigTypeV1)
+	m.createConfigType(step, routeConstraintsConfigTypeV1)
 
 	return CurrentDbVersion
 }
@@ -295,6 +302,62 @@ var tunnelDefinitions = map[string]interface{}{
 			},
 		},
 	},
+	"httpHeaderName": map[string]interface{}{
+		"type":    "string",
+		"pattern": "^[A-Za-z0-9-]+$",
+	},
+	"httpProxyRule": map[string]interface{}{
+		"type":                 "object",
+		"additionalProperties": false,
+		"required":             []interface{}{"pathPrefix", "allow"},
+		"properties": map[string]interface{}{
+			"pathPrefix": map[string]interface{}{
+				"type":        "string",
+				"pattern":     "^/",
+				"description": "The rule applies to requests for this path and the paths below it",
+			},
+			"methods": map[string]interface{}{
+				"type":        "array",
+				"items":       map[string]interface{}{"type": "string"},
+				"description": "If defined, the rule only applies to requests using one of these methods",
+			},
+			"allow": map[string]interface{}{
+				"type": "array",
+				"items": map[string]interface{}{
+					"type":    "string",
+					"pattern": "^[#@].+",
+				},
+				"description": "Callers allowed by the rule. '#all' allows every caller, '#attr' allows callers with the role attribute 'attr', which is only supported when hosting from a router, and '@identity' allows the caller with the given id or name",
+			},
+		},
+	},
+	"httpProxyConfiguration": map[string]interface{}{
+		"type":                 "object",
+		"additionalProperties": false,
+		"properties": map[string]interface{}{
+			"identityIdHeader": map[string]interface{}{
+				"$ref":        "#/definitions/httpHeaderName",
+				"description": "The header carrying the id of the caller. Defaults to 'X-ZT-Identity-Id'",
+			},
+			"identityNameHeader": map[string]interface{}{
+				"$ref":        "#/definitions/httpHeaderName",
+				"description": "The header carrying the name of the caller. Defaults to 'X-ZT-Identity-Name'",
+			},
+			"roleAttributesHeader": map[string]interface{}{
+				"$ref":        "#/definitions/httpHeaderName",
+				"description": "The header carrying the comma separated role attributes of the caller. Defaults to 'X-ZT-Role-Attributes'",
+			},
+			"identityTokenHeader": map[string]interface{}{
+				"$ref":        "#/definitions/httpHeaderName",
+				"description": "The header carrying a JWT signed by the controller which identifies the caller. Defaults to 'X-ZT-Identity-Token'",
+			},
+			"rules": map[string]interface{}{
+				"type":        "array",
+				"items":       map[string]interface{}{"$ref": "#/definitions/httpProxyRule"},
+				"description": "If defined, each request is allowed or denied by the first rule matching it. Requests not matching any rule are denied",
+			},
+		},
+	},
 	"ipv4AddressTranslation": map[string]interface{}{
 		"type":                 "object",
 		"additionalProperties": false,
@@ -431,6 +494,15 @@ var hostV1SchemaSansDefs = map[string]interface{}{
 				"$ref":        "#/definitions/proxyConfiguration",
 				"description": "If defined, outgoing connections will be send through this proxy server",
 			},
+			"proxyProtocol": map[string]interface{}{
+				"type":        "string",
+				"enum":        []interface{}{"v1", "v2"},
+				"description": "If defined, a PROXY protocol header of the given version is sent to the dialed tcp address before any data. The source address is taken from the app data of the dial. Version 2 headers carry the id and name of the caller and the name of the service in the custom TLV types 0xE0, 0xE2 and 0xE1.",
+			},
+			"httpProxy": map[string]interface{}{
+				"$ref":        "#/definitions/httpProxyConfiguration",
+				"description": "If defined, connections are served as HTTP/1.1 or HTTP/2 and requests are forwarded to the dialed tcp address with headers identifying the caller. Role attributes and the identity token are only available to router embedded tunnelers.",
+			},
 		},
 	),
 	"additionalProperties": false,
@@ -480,9 +552,9 @@ var hostV1SchemaSansDefs = map[string]interface{}{
 	},
 }
 
-var hostV1ConfigTypeId = "NH5p4FpGR"
+var HostV1ConfigTypeId = "NH5p4FpGR"
 var hostV1ConfigType = &ConfigType{
-	BaseExtEntity: boltz.BaseExtEntity{Id: hostV1ConfigTypeId},
+	BaseExtEntity: boltz.BaseExtEntity{Id: HostV1ConfigTypeId},
 	Name:          "host.v1",
 	Schema: combine(
 		map[string]interface{}{
@@ -492,9 +564,9 @@ var hostV1ConfigType = &ConfigType{
 		hostV1SchemaSansDefs),
 }
 
-var hostV2ConfigTypeId = "host.v2"
+var HostV2ConfigTypeId = "host.v2"
 var hostV2ConfigType = &ConfigType{
-	BaseExtEntity: boltz.BaseExtEntity{Id: hostV2ConfigTypeId},
+	BaseExtEntity: boltz.BaseExtEntity{Id: HostV2ConfigTypeId},
 	Name:          "host.v2",
 	Schema: map[string]interface{}{
 		"$id": "http://zt-edge.netfoundry.io/schemas/host.v2.schema.json",
@@ -687,6 +759,175 @@ var proxyConfigTypeV1 = &ConfigType{
 	},
 }
 
+var rateLimitConfigTypeV1 = &ConfigType{
+	BaseExtEntity: boltz.BaseExtEntity{
+		Id: ratelimit.ConfigTypeV1,
+	},
+	Name: ratelimit.ConfigTypeV1,
+	Schema: map[string]interface{}{
+		"$id":                  "http://edge.hanzozt.org/schemas/rate-limit.v1.config.json",
+		"type":                 "object",
+		"additionalProperties": false,
+		"definitions": map[string]interface{}{
+			"limit": map[string]interface{}{
+				"type":                 "object",
+				"additionalProperties": false,
+				"required": []interface{}{
+					"bytesPerSecond",
+				},
+				"properties": map[string]interface{}{
+					"bytesPerSecond": map[string]interface{}{
+						"type":        "integer",
+						"minimum":     1,
+						"description": "The sustained rate, in bytes per second",
+					},
+					"burstBytes": map[string]interface{}{
+						"type":        "integer",
+						"minimum":     1,
+						"description": "The number of bytes which may be sent at once after an idle period. Defaults to one second at the sustained rate",
+					},
+				},
+			},
+		},
+		"properties": map[string]interface{}{
+			"scope": map[string]interface{}{
+				"type": "string",
+				"enum": []interface{}{
+					ratelimit.ScopeCircuit,
+					ratelimit.ScopeIdentity,
+					ratelimit.ScopeService,
+				},
+				"description": "Which circuits on a router share the limit. Defaults to identity, where the circuits of an identity for the service share the limit",
+			},
+			"up": map[string]interface{}{
+				"$ref":        "#/definitions/limit",
+				"description": "The limit on data sent by the client",
+			},
+			"down": map[string]interface{}{
+				"$ref":        "#/definitions/limit",
+				"description": "The limit on data sent to the client",
+			},
+		},
+	},
+}
+
+var RouteConstraintsV1TypeId = "route-constraints.v1"
+
+var routeConstraintsConfigTypeV1 = &ConfigType{
+	BaseExtEntity: boltz.BaseExtEntity{
+		Id: RouteConstraintsV1TypeId,
+	},
+	Name: RouteConstraintsV1TypeId,
+	Schema: map[string]interface{}{
+		"$id":                  "http://edge.hanzozt.org/schemas/route-constraints.v1.config.json",
+		"type":                 "object",
+		"additionalProperties": false,
+		"definitions": map[string]interface{}{
+			"routerRoles": map[string]interface{}{
+				"type":     "array",
+				"minItems": 1,
+				"items": map[string]interface{}{
+					"type":    "string",
+					"pattern": "^[#@].+$",
+				},
+			},
+		},
+		"properties": map[string]interface{}{
+			"allowedRouterRoles": map[string]interface{}{
+				"$ref":        "#/definitions/routerRoles",
+				"description": "If set, paths may only use edge routers matching one of these roles. Roles are role attributes prefixed with # or router ids prefixed with @",
+			},
+			"deniedRouterRoles": map[string]interface{}{
+				"$ref":        "#/definitions/routerRoles",
+				"description": "Paths may not use edge routers matching any of these roles",
+			},
+			"requiredRouterRoles": map[string]interface{}{
+				"$ref":        "#/definitions/routerRoles",
+				"description": "If set, paths must pass through at least one edge router matching one of these roles",
+			},
+		},
+	},
+}
+
+var routerConfigTypeV1 = &ConfigType{
+	BaseExtEntity: boltz.BaseExtEntity{
+		Id: routerconfig.ConfigTypeV1,
+	},
+	Name: routerconfig.ConfigTypeV1,
+	Schema: map[string]interface{}{
+		"$id":                  "http://edge.hanzozt.org/schemas/router.v1.config.json",
+		"type":                 "object",
+		"additionalProperties": false,
+		"definitions": map[string]interface{}{
+			"duration": map[string]interface{}{
+				"type":    "string",
+				"pattern": "^([0-9]+(h|m|s|ms))+$",
+			},
+			"milliseconds": map[string]interface{}{
+				"type":    "integer",
+				"minimum": 0,
+			},
+		},
+		"properties": map[string]interface{}{
+			"log": map[string]interface{}{
+				"type":                 "object",
+				"additionalProperties": false,
+				"properties": map[string]interface{}{
+					"level": map[string]interface{}{
+						"type":        "string",
+						"enum":        []interface{}{"panic", "fatal", "error", "warn", "warning", "info", "debug", "trace"},
+						"description": "The router log level. Applied immediately",
+					},
+				},
+			},
+			"forwarder": map[string]interface{}{
+				"type":                 "object",
+				"additionalProperties": false,
+				"description":          "Forwarder options, with the same names and units as the router configuration file. idleCircuitTimeout and idleTxInterval are applied immediately, the others on restart",
+				"properties":           routerConfigForwarderProperties(),
+			},
+			"metrics": map[string]interface{}{
+				"type":                 "object",
+				"additionalProperties": false,
+				"description":          "Metrics reporting options. Applied on restart",
+				"properties": map[string]interface{}{
+					"reportInterval": map[string]interface{}{
+						"$ref": "#/definitions/duration",
+					},
+					"intervalAgeThreshold": map[string]interface{}{
+						"$ref": "#/definitions/duration",
+					},
+				},
+			},
+			"listeners": map[string]interface{}{
+				"type":        "object",
+				"description": "Xgress listener options, keyed by listener binding. Merged with the options in the router configuration file and applied on restart",
+				"additionalProperties": map[string]interface{}{
+					"type": "object",
+				},
+			},
+			"linkGroups": map[string]interface{}{
+				"type":        "array",
+				"description": "Replaces the groups of the router's link listeners and dialers. Applied on restart",
+				"items": map[string]interface{}{
+					"type":      "string",
+					"minLength": 1,
+				},
+			},
+		},
+	},
+}
+
+func routerConfigForwarderProperties() map[string]interface{} {
+	result := map[string]interface{}{}
+	for _, key := range routerconfig.ForwarderKeys {
+		result[key] = map[string]interface{}{
+			"$ref": "#/definitions/milliseconds",
+		}
+	}
+	return result
+}
+
 func (m *Migrations) createInitialTunnelerConfigTypes(step *boltz.MigrationStep) {
 	clientConfigTypeV1 := &ConfigType{
 		BaseExtEntity: boltz.BaseExtEntity{Id: clientConfigV1TypeId},
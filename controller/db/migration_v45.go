@@ -0,0 +1,38 @@
+package db
+
+import (
+	"time"
+
+	"github.com/hanzozt/storage/boltz"
+)
+
+func (m *Migrations) addGeoIpPostureCheckType(step *boltz.MigrationStep) {
+	if m.stores.PostureCheckType.IsEntityPresent(step.Ctx.Tx(), PostureCheckTypeGeoIp) {
+		return
+	}
+
+	var operatingSystems []OperatingSystem
+	for _, osType := range []string{"Windows", "Linux", "Android", "macOS", "iOS"} {
+		operatingSystems = append(operatingSystems, OperatingSystem{
+			OsType:     osType,
+			OsVersions: []string{},
+		})
+	}
+
+	geoIpCheckType := &PostureCheckType{
+		BaseExtEntity: boltz.BaseExtEntity{
+			Id:        PostureCheckTypeGeoIp,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+			Tags:      map[string]interface{}{},
+			Migrate:   false,
+		},
+		Name:             "Geo/IP Check",
+		OperatingSystems: operatingSystems,
+	}
+
+	if err := m.stores.PostureCheckType.Create(step.Ctx, geoIpCheckType); err != nil {
+		step.SetError(err)
+		return
+	}
+}
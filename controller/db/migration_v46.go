@@ -0,0 +1,38 @@
+package db
+
+import (
+	"time"
+
+	"github.com/hanzozt/storage/boltz"
+)
+
+func (m *Migrations) addAttestationPostureCheckType(step *boltz.MigrationStep) {
+	if m.stores.PostureCheckType.IsEntityPresent(step.Ctx.Tx(), PostureCheckTypeAttestation) {
+		return
+	}
+
+	var operatingSystems []OperatingSystem
+	for _, osType := range []string{"Windows", "Linux", "Android", "macOS", "iOS"} {
+		operatingSystems = append(operatingSystems, OperatingSystem{
+			OsType:     osType,
+			OsVersions: []string{},
+		})
+	}
+
+	attestationCheckType := &PostureCheckType{
+		BaseExtEntity: boltz.BaseExtEntity{
+			Id:        PostureCheckTypeAttestation,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+			Tags:      map[string]interface{}{},
+			Migrate:   false,
+		},
+		Name:             "Device Attestation Check",
+		OperatingSystems: operatingSystems,
+	}
+
+	if err := m.stores.PostureCheckType.Create(step.Ctx, attestationCheckType); err != nil {
+		step.SetError(err)
+		return
+	}
+}
@@ -25,7 +25,7 @@ import (
 )
 
 const (
-	CurrentDbVersion = 44
+	CurrentDbVersion = 51
 	FieldVersion     = "version"
 )
 
@@ -209,6 +209,36 @@ func (m *Migrations) migrate(step *boltz.MigrationStep) int {
 		m.dropEntity(step, EntityTypeSessions)
 	}
 
+	if step.CurrentVersion < 45 {
+		m.addGeoIpPostureCheckType(step)
+	}
+
+	if step.CurrentVersion < 46 {
+		m.addAttestationPostureCheckType(step)
+	}
+
+	if step.CurrentVersion < 47 {
+		m.createOrUpdateConfigType(step, rateLimitConfigTypeV1)
+	}
+
+	if step.CurrentVersion < 48 {
+		m.createOrUpdateConfigType(step, routerConfigTypeV1)
+	}
+
+	if step.CurrentVersion < 49 {
+		m.createOrUpdateConfigType(step, routeConstraintsConfigTypeV1)
+	}
+
+	if step.CurrentVersion < 50 {
+		step.SetError(m.stores.ConfigType.Update(step.Ctx, hostV1ConfigType, nil))
+		step.SetError(m.stores.ConfigType.Update(step.Ctx, hostV2ConfigType, nil))
+	}
+
+	if step.CurrentVersion < 51 {
+		step.SetError(m.stores.ConfigType.Update(step.Ctx, hostV1ConfigType, nil))
+		step.SetError(m.stores.ConfigType.Update(step.Ctx, hostV2ConfigType, nil))
+	}
+
 	// current version
 	if step.CurrentVersion <= CurrentDbVersion {
 		return CurrentDbVersion
@@ -10,13 +10,64 @@ import (
 	"github.com/hanzozt/storage/ast"
 	"github.com/hanzozt/storage/boltz"
 	"github.com/hanzozt/zt/v2/common/pb/edge_ctrl_pb"
+	"github.com/hanzozt/zt/v2/common/schedule"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"go.etcd.io/bbolt"
 )
 
+const (
+	FieldPolicySchedule          = "schedule"
+	FieldPolicyScheduleTimeZone  = "scheduleTimeZone"
+	FieldPolicyScheduleNotBefore = "scheduleNotBefore"
+	FieldPolicyScheduleNotAfter  = "scheduleNotAfter"
+	FieldPolicyScheduleWindows   = "scheduleWindows"
+)
+
 type ServicePolicyEventsKeyType string
 
+// loadPolicySchedule reads the optional schedule of a service or edge router policy
+func loadPolicySchedule(bucket *boltz.TypedBucket) *schedule.Spec {
+	spec := &schedule.Spec{
+		TimeZone:  bucket.GetStringWithDefault(FieldPolicyScheduleTimeZone, ""),
+		NotBefore: bucket.GetTime(FieldPolicyScheduleNotBefore),
+		NotAfter:  bucket.GetTime(FieldPolicyScheduleNotAfter),
+		Windows:   bucket.GetStringList(FieldPolicyScheduleWindows),
+	}
+
+	if spec.IsEmpty() {
+		return nil
+	}
+	return spec
+}
+
+// persistPolicySchedule stores the schedule of a service or edge router policy. The schedule is updated as a unit,
+// so all the schedule fields are written if the schedule field has changed.
+func persistPolicySchedule(ctx *boltz.PersistContext, spec *schedule.Spec) {
+	if !ctx.ProceedWithSet(FieldPolicySchedule) {
+		return
+	}
+
+	if err := spec.Validate(); err != nil {
+		ctx.Bucket.SetError(errorz.NewFieldError(err.Error(), FieldPolicySchedule, spec))
+		return
+	}
+
+	if spec == nil {
+		spec = &schedule.Spec{}
+	}
+
+	var timeZone *string
+	if spec.TimeZone != "" {
+		timeZone = &spec.TimeZone
+	}
+
+	ctx.Bucket.SetStringP(FieldPolicyScheduleTimeZone, timeZone, nil)
+	ctx.Bucket.SetTimeP(FieldPolicyScheduleNotBefore, spec.NotBefore, nil)
+	ctx.Bucket.SetTimeP(FieldPolicyScheduleNotAfter, spec.NotAfter, nil)
+	ctx.Bucket.SetStringList(FieldPolicyScheduleWindows, spec.Windows, nil)
+}
+
 type serviceEventHandler struct {
 	events []*ServiceEvent
 }
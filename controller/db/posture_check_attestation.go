@@ -0,0 +1,68 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"github.com/hanzozt/foundation/v2/errorz"
+	"github.com/hanzozt/storage/boltz"
+	"github.com/hanzozt/zt/v2/common/attestation"
+)
+
+const (
+	FieldPostureCheckAttestationTrustedCaPem   = "trustedCaPem"
+	FieldPostureCheckAttestationTimeoutSeconds = "timeoutSeconds"
+)
+
+func newPostureCheckAttestation() PostureCheckSubType {
+	return &PostureCheckAttestation{
+		TimeoutSeconds: -1,
+	}
+}
+
+type PostureCheckAttestation struct {
+	TrustedCaPem   string `json:"trustedCaPem"`
+	TimeoutSeconds int64  `json:"timeoutSeconds"`
+}
+
+func (entity *PostureCheckAttestation) GetTypeId() string {
+	return PostureCheckTypeAttestation
+}
+
+func (entity *PostureCheckAttestation) LoadValues(bucket *boltz.TypedBucket) {
+	entity.TrustedCaPem = bucket.GetStringWithDefault(FieldPostureCheckAttestationTrustedCaPem, "")
+	entity.TimeoutSeconds = bucket.GetInt64WithDefault(FieldPostureCheckAttestationTimeoutSeconds, -1)
+
+	if entity.TimeoutSeconds <= 0 {
+		entity.TimeoutSeconds = -1
+	}
+}
+
+func (entity *PostureCheckAttestation) SetValues(ctx *boltz.PersistContext, bucket *boltz.TypedBucket) {
+	if ctx.ProceedWithSet(FieldPostureCheckAttestationTrustedCaPem) {
+		if _, err := attestation.ParseCertificates(entity.TrustedCaPem); err != nil {
+			bucket.SetError(errorz.NewFieldError("trustedCaPem must contain at least one PEM encoded certificate", FieldPostureCheckAttestationTrustedCaPem, entity.TrustedCaPem))
+			return
+		}
+	}
+
+	if entity.TimeoutSeconds <= 0 {
+		entity.TimeoutSeconds = -1
+	}
+
+	bucket.SetString(FieldPostureCheckAttestationTrustedCaPem, entity.TrustedCaPem, ctx.FieldChecker)
+	bucket.SetInt64(FieldPostureCheckAttestationTimeoutSeconds, entity.TimeoutSeconds, ctx.FieldChecker)
+}
@@ -0,0 +1,84 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"strings"
+
+	"github.com/hanzozt/foundation/v2/errorz"
+	"github.com/hanzozt/storage/boltz"
+	"github.com/hanzozt/zt/v2/common/geoip"
+)
+
+const (
+	FieldPostureCheckGeoIpAllowedCidrs     = "allowedCidrs"
+	FieldPostureCheckGeoIpDeniedCidrs      = "deniedCidrs"
+	FieldPostureCheckGeoIpAllowedCountries = "allowedCountries"
+	FieldPostureCheckGeoIpDeniedCountries  = "deniedCountries"
+)
+
+func newPostureCheckGeoIp() PostureCheckSubType {
+	return &PostureCheckGeoIp{}
+}
+
+type PostureCheckGeoIp struct {
+	AllowedCidrs     []string `json:"allowedCidrs"`
+	DeniedCidrs      []string `json:"deniedCidrs"`
+	AllowedCountries []string `json:"allowedCountries"`
+	DeniedCountries  []string `json:"deniedCountries"`
+}
+
+func (entity *PostureCheckGeoIp) GetTypeId() string {
+	return PostureCheckTypeGeoIp
+}
+
+func (entity *PostureCheckGeoIp) LoadValues(bucket *boltz.TypedBucket) {
+	entity.AllowedCidrs = bucket.GetStringList(FieldPostureCheckGeoIpAllowedCidrs)
+	entity.DeniedCidrs = bucket.GetStringList(FieldPostureCheckGeoIpDeniedCidrs)
+	entity.AllowedCountries = bucket.GetStringList(FieldPostureCheckGeoIpAllowedCountries)
+	entity.DeniedCountries = bucket.GetStringList(FieldPostureCheckGeoIpDeniedCountries)
+}
+
+func (entity *PostureCheckGeoIp) SetValues(ctx *boltz.PersistContext, bucket *boltz.TypedBucket) {
+	entity.AllowedCountries = normalizeCountries(entity.AllowedCountries)
+	entity.DeniedCountries = normalizeCountries(entity.DeniedCountries)
+
+	rules := &geoip.Rules{
+		AllowedCidrs:     entity.AllowedCidrs,
+		DeniedCidrs:      entity.DeniedCidrs,
+		AllowedCountries: entity.AllowedCountries,
+		DeniedCountries:  entity.DeniedCountries,
+	}
+
+	if err := rules.Validate(); err != nil {
+		bucket.SetError(errorz.NewFieldError(err.Error(), "geoIp", entity))
+		return
+	}
+
+	bucket.SetStringList(FieldPostureCheckGeoIpAllowedCidrs, entity.AllowedCidrs, ctx.FieldChecker)
+	bucket.SetStringList(FieldPostureCheckGeoIpDeniedCidrs, entity.DeniedCidrs, ctx.FieldChecker)
+	bucket.SetStringList(FieldPostureCheckGeoIpAllowedCountries, entity.AllowedCountries, ctx.FieldChecker)
+	bucket.SetStringList(FieldPostureCheckGeoIpDeniedCountries, entity.DeniedCountries, ctx.FieldChecker)
+}
+
+func normalizeCountries(countries []string) []string {
+	var result []string
+	for _, country := range countries {
+		result = append(result, strings.ToUpper(strings.TrimSpace(country)))
+	}
+	return result
+}
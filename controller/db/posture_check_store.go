@@ -41,6 +41,8 @@ const (
 	PostureCheckTypeProcessMulti = "PROCESS_MULTI"
 	PostureCheckTypeMAC          = "MAC"
 	PostureCheckTypeMFA          = "MFA"
+	PostureCheckTypeGeoIp        = "GEO_IP"
+	PostureCheckTypeAttestation  = "ATTESTATION"
 )
 
 var postureCheckSubTypeMap = map[string]newPostureCheckSubType{
@@ -50,6 +52,8 @@ var postureCheckSubTypeMap = map[string]newPostureCheckSubType{
 	PostureCheckTypeProcessMulti: newPostureCheckProcessMulti,
 	PostureCheckTypeMAC:          newPostureCheckMacAddresses,
 	PostureCheckTypeMFA:          newPostureCheckMfa,
+	PostureCheckTypeGeoIp:        newPostureCheckGeoIp,
+	PostureCheckTypeAttestation:  newPostureCheckAttestation,
 }
 
 type newPostureCheckSubType func() PostureCheckSubType
@@ -30,6 +30,8 @@ const (
 	FieldRouterCost        = "cost"
 	FieldRouterNoTraversal = "noTraversal"
 	FieldRouterDisabled    = "disabled"
+	FieldRouterDraining    = "draining"
+	FieldRouterConfigId    = "configId"
 )
 
 type Router struct {
@@ -39,6 +41,8 @@ type Router struct {
 	Cost        uint16       `json:"cost"`
 	NoTraversal bool         `json:"noTraversal"`
 	Disabled    bool         `json:"disabled"`
+	Draining    bool         `json:"draining"`
+	ConfigId    *string      `json:"configId"`
 	Interfaces  []*Interface `json:"interfaces"`
 }
 
@@ -67,6 +71,7 @@ type routerStoreImpl struct {
 	baseStore[*Router]
 	indexName         boltz.ReadIndex
 	terminatorsSymbol boltz.EntitySetSymbol
+	configIdSymbol    boltz.EntitySymbol
 }
 
 func (store *routerStoreImpl) initializeLocal() {
@@ -80,6 +85,9 @@ func (store *routerStoreImpl) initializeLocal() {
 	store.AddSymbol(FieldRouterCost, ast.NodeTypeInt64)
 	store.AddSymbol(FieldRouterNoTraversal, ast.NodeTypeBool)
 	store.AddSymbol(FieldRouterDisabled, ast.NodeTypeBool)
+	store.AddSymbol(FieldRouterDraining, ast.NodeTypeBool)
+	store.configIdSymbol = store.AddFkSymbol(FieldRouterConfigId, store.stores.config)
+	store.AddFkConstraint(store.configIdSymbol, true, boltz.CascadeNone)
 }
 
 func (store *routerStoreImpl) initializeLinked() {
@@ -96,6 +104,8 @@ func (self *routerStoreImpl) FillEntity(entity *Router, bucket *boltz.TypedBucke
 	entity.Cost = uint16(bucket.GetInt32WithDefault(FieldRouterCost, 0))
 	entity.NoTraversal = bucket.GetBoolWithDefault(FieldRouterNoTraversal, false)
 	entity.Disabled = bucket.GetBoolWithDefault(FieldRouterDisabled, false)
+	entity.Draining = bucket.GetBoolWithDefault(FieldRouterDraining, false)
+	entity.ConfigId = bucket.GetString(FieldRouterConfigId)
 	entity.Interfaces = loadInterfaces(bucket)
 }
 
@@ -106,6 +116,8 @@ func (self *routerStoreImpl) PersistEntity(entity *Router, ctx *boltz.PersistCon
 	ctx.SetInt32(FieldRouterCost, int32(entity.Cost))
 	ctx.SetBool(FieldRouterNoTraversal, entity.NoTraversal)
 	ctx.SetBool(FieldRouterDisabled, entity.Disabled)
+	ctx.SetBool(FieldRouterDraining, entity.Draining)
+	ctx.SetStringP(FieldRouterConfigId, entity.ConfigId)
 	storeInterfaces(entity.Interfaces, ctx)
 }
 
@@ -9,6 +9,7 @@ import (
 	"github.com/hanzozt/storage/ast"
 	"github.com/hanzozt/storage/boltz"
 	"github.com/hanzozt/zt/v2/common/pb/edge_ctrl_pb"
+	"github.com/hanzozt/zt/v2/common/schedule"
 )
 
 type PolicyType string
@@ -65,6 +66,8 @@ type ServicePolicy struct {
 	IdentityRoles     []string   `json:"identityRoles"`
 	ServiceRoles      []string   `json:"serviceRoles"`
 	PostureCheckRoles []string   `json:"postureCheckRoles"`
+	// Schedule optionally restricts the times at which the policy is in force
+	Schedule *schedule.Spec `json:"schedule,omitempty"`
 }
 
 func (entity *ServicePolicy) GetName() string {
@@ -156,6 +159,7 @@ func (store *servicePolicyStoreImpl) FillEntity(entity *ServicePolicy, bucket *b
 	entity.IdentityRoles = bucket.GetStringList(FieldIdentityRoles)
 	entity.ServiceRoles = bucket.GetStringList(FieldServiceRoles)
 	entity.PostureCheckRoles = bucket.GetStringList(FieldPostureCheckRoles)
+	entity.Schedule = loadPolicySchedule(bucket)
 }
 
 func (store *servicePolicyStoreImpl) PersistEntity(entity *ServicePolicy, ctx *boltz.PersistContext) {
@@ -195,6 +199,7 @@ func (store *servicePolicyStoreImpl) PersistEntity(entity *ServicePolicy, ctx *b
 	ctx.SetRequiredString(FieldName, entity.Name)
 	ctx.SetInt32(FieldServicePolicyType, entity.PolicyType.Id())
 	ctx.SetRequiredString(FieldSemantic, entity.Semantic)
+	persistPolicySchedule(ctx, entity.Schedule)
 	servicePolicyStore := ctx.Store.(*servicePolicyStoreImpl)
 
 	sort.Strings(entity.ServiceRoles)
@@ -5,12 +5,14 @@ import (
 	"math/rand"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/hanzozt/foundation/v2/errorz"
 	"github.com/hanzozt/foundation/v2/stringz"
 	"github.com/hanzozt/storage/boltz"
 	"github.com/hanzozt/storage/boltztest"
 	"github.com/hanzozt/zt/v2/common/eid"
+	"github.com/hanzozt/zt/v2/common/schedule"
 	"go.etcd.io/bbolt"
 )
 
@@ -23,6 +25,7 @@ func Test_ServicePolicyStore(t *testing.T) {
 	t.Run("test create/update service policies with invalid entity refs", ctx.testServicePolicyInvalidValues)
 	t.Run("test service policy evaluation", ctx.testServicePolicyRoleEvaluation)
 	t.Run("test update/delete referenced entities", ctx.testServicePolicyUpdateDeleteRefs)
+	t.Run("test service policy schedules", ctx.testServicePolicySchedule)
 }
 
 func newServicePolicy(name string) *ServicePolicy {
@@ -480,3 +483,47 @@ func (ctx *TestContext) validateServicePolicyDenormalization() {
 	}))
 	ctx.NoError(errorHolder.GetError())
 }
+
+func (ctx *TestContext) testServicePolicySchedule(_ *testing.T) {
+	ctx.CleanupAll()
+
+	notBefore := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	policy := newServicePolicy(eid.New())
+	policy.Schedule = &schedule.Spec{
+		TimeZone:  "America/New_York",
+		NotBefore: &notBefore,
+		Windows:   []string{"* 9-16 * * MON-FRI"},
+	}
+	boltztest.RequireCreate(ctx, policy)
+
+	err := ctx.GetDb().View(func(tx *bbolt.Tx) error {
+		testPolicy, err := ctx.stores.ServicePolicy.LoadById(tx, policy.Id)
+		ctx.NoError(err)
+		ctx.NotNil(testPolicy.Schedule)
+		ctx.Equal("America/New_York", testPolicy.Schedule.TimeZone)
+		ctx.NotNil(testPolicy.Schedule.NotBefore)
+		ctx.True(notBefore.Equal(*testPolicy.Schedule.NotBefore))
+		ctx.Nil(testPolicy.Schedule.NotAfter)
+		ctx.Equal([]string{"* 9-16 * * MON-FRI"}, testPolicy.Schedule.Windows)
+		return nil
+	})
+	ctx.NoError(err)
+
+	policy.Schedule = nil
+	boltztest.RequireUpdate(ctx, policy)
+
+	err = ctx.GetDb().View(func(tx *bbolt.Tx) error {
+		testPolicy, err := ctx.stores.ServicePolicy.LoadById(tx, policy.Id)
+		ctx.NoError(err)
+		ctx.Nil(testPolicy.Schedule)
+		return nil
+	})
+	ctx.NoError(err)
+
+	policy.Schedule = &schedule.Spec{Windows: []string{"* 25 * * *"}}
+	err = boltztest.Update(ctx, policy)
+	ctx.Error(err)
+	var fieldErr *errorz.FieldError
+	ctx.ErrorAs(err, &fieldErr)
+	ctx.Equal(FieldPolicySchedule, fieldErr.FieldName)
+}
@@ -19,6 +19,7 @@ package db
 import (
 	"time"
 
+	"github.com/hanzozt/foundation/v2/errorz"
 	"github.com/hanzozt/storage/ast"
 	"github.com/hanzozt/storage/boltz"
 	"github.com/hanzozt/zt/v2/controller/xt"
@@ -30,13 +31,37 @@ const (
 	EntityTypeServices             = "services"
 	FieldServiceTerminatorStrategy = "terminatorStrategy"
 	FieldServiceMaxIdleTime        = "maxIdleTime"
+	FieldServiceMultipath          = "multipath"
+	FieldServicePriority           = "priority"
+
+	// ServiceMultipathDuplicate services have every payload sent over two disjoint paths, for reliability
+	ServiceMultipathDuplicate = "duplicate"
+	// ServiceMultipathStripe services have payloads alternated between two disjoint paths, for throughput
+	ServiceMultipathStripe = "stripe"
+
+	// ServicePriorityInteractive services have their payloads sent ahead of other traffic on links, for low latency
+	ServicePriorityInteractive = "interactive"
+	// ServicePriorityBulk is the default priority class
+	ServicePriorityBulk = "bulk"
+	// ServicePriorityBackground services have their payloads sent after other traffic on links
+	ServicePriorityBackground = "background"
 )
 
+func IsValidServiceMultipath(multipath string) bool {
+	return multipath == "" || multipath == ServiceMultipathDuplicate || multipath == ServiceMultipathStripe
+}
+
+func IsValidServicePriority(priority string) bool {
+	return priority == "" || priority == ServicePriorityInteractive || priority == ServicePriorityBulk || priority == ServicePriorityBackground
+}
+
 type Service struct {
 	boltz.BaseExtEntity
 	Name               string        `json:"name"`
 	MaxIdleTime        time.Duration `json:"maxIdleTime"`
 	TerminatorStrategy string        `json:"terminatorStrategy"`
+	Multipath          string        `json:"multipath"`
+	Priority           string        `json:"priority"`
 }
 
 func (entity *Service) GetEntityType() string {
@@ -77,6 +102,8 @@ func (store *serviceStoreImpl) initializeLocal() {
 	store.indexName = store.AddUniqueIndex(symbolName)
 
 	store.AddSymbol(FieldServiceTerminatorStrategy, ast.NodeTypeString)
+	store.AddSymbol(FieldServiceMultipath, ast.NodeTypeString)
+	store.AddSymbol(FieldServicePriority, ast.NodeTypeString)
 	store.terminatorsSymbol = store.AddFkSetSymbol(EntityTypeTerminators, store.stores.terminator)
 }
 
@@ -96,6 +123,8 @@ func (store *serviceStoreImpl) FillEntity(entity *Service, bucket *boltz.TypedBu
 	entity.Name = bucket.GetStringOrError(FieldName)
 	entity.TerminatorStrategy = bucket.GetStringWithDefault(FieldServiceTerminatorStrategy, "")
 	entity.MaxIdleTime = time.Duration(bucket.GetInt64WithDefault(FieldServiceMaxIdleTime, 0))
+	entity.Multipath = bucket.GetStringWithDefault(FieldServiceMultipath, "")
+	entity.Priority = bucket.GetStringWithDefault(FieldServicePriority, "")
 }
 
 func (store *serviceStoreImpl) PersistEntity(entity *Service, ctx *boltz.PersistContext) {
@@ -103,6 +132,18 @@ func (store *serviceStoreImpl) PersistEntity(entity *Service, ctx *boltz.Persist
 	ctx.SetString(FieldName, entity.Name)
 	ctx.SetInt64(FieldServiceMaxIdleTime, int64(entity.MaxIdleTime))
 
+	if !IsValidServiceMultipath(entity.Multipath) {
+		ctx.Bucket.SetError(errorz.NewFieldError("invalid multipath mode, must be one of 'duplicate' or 'stripe'", FieldServiceMultipath, entity.Multipath))
+		return
+	}
+	ctx.SetString(FieldServiceMultipath, entity.Multipath)
+
+	if !IsValidServicePriority(entity.Priority) {
+		ctx.Bucket.SetError(errorz.NewFieldError("invalid priority class, must be one of 'interactive', 'bulk' or 'background'", FieldServicePriority, entity.Priority))
+		return
+	}
+	ctx.SetString(FieldServicePriority, entity.Priority)
+
 	if entity.TerminatorStrategy == "" {
 		entity.TerminatorStrategy = xt_smartrouting.Name
 	}
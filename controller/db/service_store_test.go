@@ -63,6 +63,20 @@ func (ctx *TestContext) testCreateInvalidServices(t *testing.T) {
 	service.TerminatorStrategy = uuid.New().String()
 	err = boltztest.Create(ctx, service)
 	ctx.EqualError(err, fmt.Sprintf("terminatorStrategy with name %v not found", service.TerminatorStrategy))
+
+	service.Id = uuid.New().String()
+	service.TerminatorStrategy = ""
+	service.Multipath = "triplicate"
+	err = boltztest.Create(ctx, service)
+	ctx.Error(err)
+	ctx.ErrorContains(err, "invalid multipath mode")
+
+	service.Id = uuid.New().String()
+	service.Multipath = ""
+	service.Priority = "urgent"
+	err = boltztest.Create(ctx, service)
+	ctx.Error(err)
+	ctx.ErrorContains(err, "invalid priority class")
 }
 
 func (ctx *TestContext) testCreateServices(t *testing.T) {
@@ -75,6 +89,22 @@ func (ctx *TestContext) testCreateServices(t *testing.T) {
 	}
 	boltztest.RequireCreate(ctx, service)
 	boltztest.ValidateBaseline(ctx, service)
+
+	multipathService := &Service{
+		BaseExtEntity: boltz.BaseExtEntity{Id: uuid.New().String()},
+		Name:          uuid.New().String(),
+		Multipath:     ServiceMultipathStripe,
+	}
+	boltztest.RequireCreate(ctx, multipathService)
+	boltztest.ValidateBaseline(ctx, multipathService)
+
+	interactiveService := &Service{
+		BaseExtEntity: boltz.BaseExtEntity{Id: uuid.New().String()},
+		Name:          uuid.New().String(),
+		Priority:      ServicePriorityInteractive,
+	}
+	boltztest.RequireCreate(ctx, interactiveService)
+	boltztest.ValidateBaseline(ctx, interactiveService)
 }
 
 type serviceTestEntities struct {
@@ -164,6 +164,40 @@ func (ae *AppEnv) CreateTotpTokenFromAccessClaims(issuer string, claims *common.
 	return tokenStr, totpClaims, nil
 }
 
+// CreateAttestationToken issues a token recording that a device attestation was verified for an API session. The
+// token carries the device certificate chain so that routers can evaluate attestation posture checks.
+func (ae *AppEnv) CreateAttestationToken(issuer string, identityId string, apiSessionId string, deviceCertificates [][]byte) (string, *common.AttestationClaims, error) {
+	if issuer == "" {
+		return "", nil, errors.New("issuer cannot be empty")
+	}
+
+	if apiSessionId == "" {
+		return "", nil, errors.New("api session id cannot be empty")
+	}
+
+	nowTime := jwt.NumericDate{Time: time.Now()}
+	attestationClaims := &common.AttestationClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:   issuer,
+			Subject:  identityId,
+			Audience: jwt.ClaimStrings{common.ClaimAudienceHanzo ZT},
+			IssuedAt: &nowTime,
+			ID:       uuid.NewString(),
+		},
+		ApiSessionId:       apiSessionId,
+		Type:               common.TokenTypeAttestation,
+		DeviceCertificates: deviceCertificates,
+	}
+
+	jwtSigner := ae.GetClientApiDefaultTlsJwtSigner()
+	tokenStr, err := jwtSigner.Generate(attestationClaims)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return tokenStr, attestationClaims, nil
+}
+
 // GetPeerControllerAddresses returns the network addresses of peer controllers.
 func (ae *AppEnv) GetPeerControllerAddresses() []string {
 	return ae.HostController.GetPeerAddresses()
@@ -775,6 +809,8 @@ func (ae *AppEnv) ProcessJwt(rc *response.RequestContext, token *jwt.Token) erro
 		ImproperClientCertChain: rc.Claims.ImproperClientCertChain,
 	}
 
+	ae.GetManagers().PostureResponse.SetSourceAddress(rc.Identity.Id, rc.ApiSession.Id, rc.Request.RemoteAddr)
+
 	rc.AuthPolicy, err = ae.GetManagers().AuthPolicy.Read(rc.Identity.AuthPolicyId)
 
 	if err != nil {
@@ -1028,8 +1064,12 @@ func NewAppEnv(host HostController) (*AppEnv, error) {
 	if host.GetConfig().Edge.Enabled {
 		enrollmentCert := host.GetConfig().Edge.Enrollment.SigningCert.Cert()
 		ae.ApiClientCsrSigner = cert.NewClientSigner(enrollmentCert.Leaf, enrollmentCert.PrivateKey)
-		ae.ApiServerCsrSigner = cert.NewServerSigner(enrollmentCert.Leaf, enrollmentCert.PrivateKey)
-		ae.ControlClientCsrSigner = cert.NewClientSigner(enrollmentCert.Leaf, enrollmentCert.PrivateKey)
+
+		// router certificates are signed by the next signing cert, if one is configured, so that routers move to it
+		// as they renew
+		routerCert := host.GetConfig().Edge.Enrollment.GetRouterSigningCert().Cert()
+		ae.ApiServerCsrSigner = cert.NewServerSigner(routerCert.Leaf, routerCert.PrivateKey)
+		ae.ControlClientCsrSigner = cert.NewClientSigner(routerCert.Leaf, routerCert.PrivateKey)
 	}
 
 	ae.FingerprintGenerator = cert.NewFingerprintGenerator()
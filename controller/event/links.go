@@ -32,6 +32,7 @@ const (
 	LinkFromRouterKnown            LinkEventType = "routerLinkKnown"
 	LinkFromRouterDisconnectedDest LinkEventType = "routerLinkDisconnectedDest"
 	LinkConnectionsChanged         LinkEventType = "connectionsChanged"
+	LinkQualityChanged             LinkEventType = "qualityChanged"
 )
 
 // A LinkConnection describes a physical connection that forms a link. A Link may be made
@@ -62,6 +63,8 @@ type LinkConnection struct {
 //   - duplicate - a link was removed because it was a duplicate. Happens when routers dial each other at the same time.
 //   - routerLinkKnown - A router informed the controller of a link, but the controller already knew about it.
 //   - routerLinkDisconnectedDest - A router created a link, but the destination router isn't currently connected to the controller.
+//   - connectionsChanged - The connections making up a link have changed.
+//   - qualityChanged - The packet loss reported by one of the link's routers has changed.
 //   - dialed - Deprecated. Happens when a link listener has been dialed. Only relevant if using legacy controller managed links.
 //   - connected - Deprecated. Happens when a link is connected. Only generated when using legacy controller managed links.
 //
@@ -95,6 +98,28 @@ type LinkConnection struct {
 //	  "cost": 1
 //	}
 //
+// Example: Link Quality Changed Event
+//
+//	{
+//	  "namespace": "link",
+//	  "event_src_id": "ctrl1",
+//	  "timestamp": "2025-03-04T11:42:07.120394523-05:00",
+//	  "event_type": "qualityChanged",
+//	  "link_id": "6slUYCqOB85YTfdiD8I5pl",
+//	  "src_router_id": "YPpTEd8JP",
+//	  "dst_router_id": "niY.XmLArx",
+//	  "protocol": "tls",
+//	  "dial_address": "tls:127.0.0.1:4023",
+//	  "cost": 1,
+//	  "src_latency": 1254210,
+//	  "dst_latency": 1198311,
+//	  "src_loss": 3.33,
+//	  "src_jitter": 210442,
+//	  "dst_jitter": 198730,
+//	  "src_available_bandwidth": 412883009,
+//	  "dst_available_bandwidth": 398112274
+//	}
+//
 // Example: Router Link Known Event
 //
 //	{
@@ -137,6 +162,30 @@ type LinkEvent struct {
 
 	// The connections making up the link.
 	Connections []*LinkConnection `json:"connections,omitempty"`
+
+	// The link latency reported by the source router, in nanoseconds.
+	SrcLatency int64 `json:"src_latency,omitempty"`
+
+	// The link latency reported by the destination router, in nanoseconds.
+	DstLatency int64 `json:"dst_latency,omitempty"`
+
+	// The percentage of link probes lost, as reported by the source router.
+	SrcLoss float64 `json:"src_loss,omitempty"`
+
+	// The percentage of link probes lost, as reported by the destination router.
+	DstLoss float64 `json:"dst_loss,omitempty"`
+
+	// The link jitter reported by the source router, in nanoseconds.
+	SrcJitter int64 `json:"src_jitter,omitempty"`
+
+	// The link jitter reported by the destination router, in nanoseconds.
+	DstJitter int64 `json:"dst_jitter,omitempty"`
+
+	// The available bandwidth estimated by the source router, in bits per second.
+	SrcAvailableBandwidth int64 `json:"src_available_bandwidth,omitempty"`
+
+	// The available bandwidth estimated by the destination router, in bits per second.
+	DstAvailableBandwidth int64 `json:"dst_available_bandwidth,omitempty"`
 }
 
 func (event *LinkEvent) String() string {
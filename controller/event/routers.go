@@ -26,17 +26,23 @@ type RouterEventType string
 const (
 	RouterEventNS = "router"
 
-	RouterOnline  RouterEventType = "router-online"
-	RouterOffline RouterEventType = "router-offline"
+	RouterOnline   RouterEventType = "router-online"
+	RouterOffline  RouterEventType = "router-offline"
+	RouterDraining RouterEventType = "router-draining"
+	RouterDrained  RouterEventType = "router-drained"
 )
 
-// A RouterEvent is generated when a router comes online or goes offline.
+// A RouterEvent is generated when a router comes online or goes offline. It's also generated when a router which
+// has been marked as draining reports that it's draining, and again when it reports that it's no longer carrying
+// any circuits, at which point it can be safely restarted.
 //
 // Note: In version prior to 1.4.0, the namespace was `fabric.routers`
 //
 // Valid values for router event type are:
 //   - router-online
 //   - router-offline
+//   - router-draining
+//   - router-drained
 //
 // Example: Router online event
 //
@@ -59,6 +65,17 @@ const (
 //	 "router_id": "JAoyjafljO",
 //	 "router_online": false
 //	}
+//
+// Example: Router drained event
+//
+//	{
+//	 "namespace": "router",
+//	 "event_src_id": "ctrl1",
+//	 "timestamp": "2021-04-22T11:31:07.51830912-04:00",
+//	 "event_type": "router-drained",
+//	 "router_id": "JAoyjafljO",
+//	 "router_online": true
+//	}
 type RouterEvent struct {
 	Namespace  string    `json:"namespace"`
 	Timestamp  time.Time `json:"timestamp"`
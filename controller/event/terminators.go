@@ -28,6 +28,8 @@ const (
 
 	TerminatorRouterOnline  TerminatorEventType = "router-online"
 	TerminatorRouterOffline TerminatorEventType = "router-offline"
+	TerminatorEjected       TerminatorEventType = "ejected"
+	TerminatorRestored      TerminatorEventType = "restored"
 )
 
 // A TerminatorEvent is emitted at various points in the terminator lifecycle.
@@ -37,6 +39,11 @@ const (
 // Valid values for terminator event types are:
 //   - router-online
 //   - router-offline
+//   - ejected
+//   - restored
+//
+// Terminators are ejected by outlier detection when they repeatedly fail dials, and are restored when the
+// ejection expires.
 //
 // Example: Terminator router offline event
 //
@@ -121,6 +128,9 @@ type TerminatorEvent struct {
 	// The number of online terminators with a required precedence for the service.
 	UsableRequiredTerminators int `json:"usable_required_terminators"`
 
+	// If the terminator has been ejected by outlier detection, the time at which the ejection expires.
+	EjectedUntil *time.Time `json:"ejected_until,omitempty"`
+
 	// For internal use.
 	PropagateIndicator bool `json:"-"`
 }
@@ -21,6 +21,8 @@ const (
 //   - usage.egress.tx  - A write to an external connection from an egress router
 //   - usage.fabric.rx  - A read from a fabric link to a router
 //   - usage.fabric.tx  - A write to a fabric link from a router
+//   - usage.ingress.shaped.rx - A read from an external connection which was delayed by a rate limit
+//   - usage.ingress.shaped.tx - A write to an external connection which was delayed by a rate limit
 //
 // Example: Ingress Data Received Usage Event
 //
@@ -113,6 +115,8 @@ type UsageEventHandlerWrapper interface {
 //   - egress.tx  - A write to an external connection from an egress router
 //   - fabric.rx  - A read from a fabric link to a router
 //   - fabric.tx  - A write to a fabric link from a router
+//   - ingress.shaped.rx - A read from an external connection which was delayed by a rate limit
+//   - ingress.shaped.tx - A write to an external connection which was delayed by a rate limit
 //
 // Example: Untagged Usage Data Event
 //
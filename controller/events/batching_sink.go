@@ -0,0 +1,590 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/pkg/errors"
+)
+
+// A sinkRecord is a single formatted event, along with the routing information needed by sinks which
+// send events to different destinations
+type sinkRecord struct {
+	EventType string `json:"t"`
+	Namespace string `json:"n,omitempty"`
+	Key       string `json:"k,omitempty"`
+	Payload   []byte `json:"p"`
+}
+
+type batchDeliverF func(ctx context.Context, batch []*sinkRecord) error
+
+type batchingSinkConfig struct {
+	bufferSize     int
+	batchSize      int
+	flushInterval  time.Duration
+	maxRetryTime   time.Duration
+	spillDir       string
+	spillMaxSizeMb int
+	keyFields      []string
+}
+
+func parseBatchingSinkConfig(config map[interface{}]interface{}) (*batchingSinkConfig, error) {
+	result := &batchingSinkConfig{
+		bufferSize:     1000,
+		batchSize:      100,
+		flushInterval:  time.Second,
+		maxRetryTime:   time.Minute,
+		spillMaxSizeMb: 100,
+	}
+
+	var err error
+	if result.bufferSize, err = getIntConfig(config, "bufferSize", result.bufferSize); err != nil {
+		return nil, err
+	}
+
+	if result.batchSize, err = getIntConfig(config, "batchSize", result.batchSize); err != nil {
+		return nil, err
+	}
+
+	if result.flushInterval, err = getDurationConfig(config, "flushInterval", result.flushInterval); err != nil {
+		return nil, err
+	}
+
+	if result.maxRetryTime, err = getDurationConfig(config, "maxRetryTime", result.maxRetryTime); err != nil {
+		return nil, err
+	}
+
+	if value, found := config["spillDir"]; found {
+		dir, ok := value.(string)
+		if !ok {
+			return nil, errors.Errorf("invalid spillDir value %v, must be a string", value)
+		}
+		if err = os.MkdirAll(dir, 0700); err != nil {
+			return nil, errors.Wrapf(err, "unable to create spill directory %s", dir)
+		}
+		result.spillDir = dir
+	}
+
+	if result.spillMaxSizeMb, err = getIntConfig(config, "spillMaxSizeMb", result.spillMaxSizeMb); err != nil {
+		return nil, err
+	}
+
+	if result.keyFields, err = getStringListConfig(config, "keyFields"); err != nil {
+		return nil, err
+	}
+
+	if len(result.keyFields) == 0 {
+		result.keyFields = []string{"circuit_id", "session_id", "api_session_id", "identity_id", "entity_id", "link_id", "terminator_id", "service_id", "router_id", "id", "source_id"}
+	}
+
+	if result.batchSize < 1 {
+		return nil, errors.Errorf("invalid batchSize %v, must be at least 1", result.batchSize)
+	}
+
+	return result, nil
+}
+
+// A batchingEventSink collects formatted events into batches and hands them to a delivery function. Batches
+// are delivered from their own goroutine, where failed deliveries are retried with exponential backoff, so
+// batching continues while a delivery is being retried. Events which can't be delivered, or which arrive
+// while the buffer or delivery queue is full, are written to a spill directory, if one is configured, and
+// replayed once deliveries succeed again.
+type batchingEventSink struct {
+	name    string
+	config  *batchingSinkConfig
+	deliver batchDeliverF
+	records chan *sinkRecord
+	batches chan []*sinkRecord
+	ctx     context.Context
+	cancel  context.CancelFunc
+	done    sync.WaitGroup
+
+	spillLock  sync.Mutex
+	spillFile  *os.File
+	spillSize  int64
+	nextReplay time.Time
+}
+
+func newBatchingEventSink(name string, config *batchingSinkConfig, deliver batchDeliverF) *batchingEventSink {
+	ctx, cancel := context.WithCancel(context.Background())
+	result := &batchingEventSink{
+		name:    name,
+		config:  config,
+		deliver: deliver,
+		records: make(chan *sinkRecord, config.bufferSize),
+		batches: make(chan []*sinkRecord, 1),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+	result.done.Add(2)
+	go result.run()
+	go result.deliverBatches()
+	return result
+}
+
+func (self *batchingEventSink) AcceptFormattedEvent(eventType string, formattedEvent []byte) {
+	record := &sinkRecord{
+		EventType: eventType,
+		Namespace: eventType,
+		Payload:   formattedEvent,
+	}
+	self.extractRouting(record)
+
+	select {
+	case self.records <- record:
+	case <-self.ctx.Done():
+	default:
+		pfxlog.Logger().WithField("sink", self.name).Warn("event buffer full, spilling event to disk")
+		self.spill([]*sinkRecord{record})
+	}
+}
+
+// extractRouting pulls the namespace and partitioning key out of JSON formatted events
+func (self *batchingEventSink) extractRouting(record *sinkRecord) {
+	if len(record.Payload) == 0 || record.Payload[0] != '{' {
+		return
+	}
+
+	fields := map[string]any{}
+	if err := json.Unmarshal(record.Payload, &fields); err != nil {
+		return
+	}
+
+	if ns, ok := fields["namespace"].(string); ok && ns != "" {
+		record.Namespace = ns
+	}
+
+	for _, keyField := range self.config.keyFields {
+		if v, ok := fields[keyField]; ok && v != nil && v != "" {
+			record.Key = fmt.Sprintf("%v", v)
+			return
+		}
+	}
+}
+
+func (self *batchingEventSink) Close() error {
+	self.cancel()
+	self.done.Wait()
+	self.closeSpillFile()
+	return nil
+}
+
+// run collects records into batches and queues them for delivery. If the previous batch is still being
+// delivered, the new batch is spilled rather than blocking intake.
+func (self *batchingEventSink) run() {
+	defer self.done.Done()
+
+	ticker := time.NewTicker(self.config.flushInterval)
+	defer ticker.Stop()
+
+	var batch []*sinkRecord
+
+	flush := func() {
+		if len(batch) > 0 {
+			select {
+			case self.batches <- batch:
+			default:
+				pfxlog.Logger().WithField("sink", self.name).Warn("event batch delivery backed up, spilling batch to disk")
+				self.spill(batch)
+			}
+			batch = nil
+		}
+	}
+
+	for {
+		select {
+		case record := <-self.records:
+			batch = append(batch, record)
+			if len(batch) >= self.config.batchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-self.ctx.Done():
+			for {
+				select {
+				case record := <-self.records:
+					batch = append(batch, record)
+				default:
+					self.spill(batch)
+					return
+				}
+			}
+		}
+	}
+}
+
+// deliverBatches sends queued batches, with retries, and replays spilled events while idle
+func (self *batchingEventSink) deliverBatches() {
+	defer self.done.Done()
+
+	ticker := time.NewTicker(self.config.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case batch := <-self.batches:
+			self.send(batch)
+		case <-ticker.C:
+			self.replaySpilled()
+		case <-self.ctx.Done():
+			for {
+				select {
+				case batch := <-self.batches:
+					self.spill(batch)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (self *batchingEventSink) send(batch []*sinkRecord) bool {
+	log := pfxlog.Logger().WithField("sink", self.name).WithField("batchSize", len(batch))
+
+	expBackoff := backoff.NewExponentialBackOff()
+	expBackoff.InitialInterval = 250 * time.Millisecond
+	expBackoff.MaxInterval = 30 * time.Second
+	expBackoff.MaxElapsedTime = self.config.maxRetryTime
+
+	operation := func() error {
+		err := self.deliver(self.ctx, batch)
+		if err != nil {
+			select {
+			case <-self.ctx.Done():
+				return backoff.Permanent(err)
+			default:
+			}
+			log.WithError(err).Debug("event batch delivery failed, will retry")
+		}
+		return err
+	}
+
+	if err := backoff.Retry(operation, backoff.WithContext(expBackoff, self.ctx)); err != nil {
+		log.WithError(err).Error("event batch delivery failed")
+		self.spill(batch)
+		return false
+	}
+
+	// the destination is reachable again, so spilled events can be replayed right away
+	self.nextReplay = time.Time{}
+	return true
+}
+
+func (self *batchingEventSink) spill(batch []*sinkRecord) {
+	if len(batch) == 0 {
+		return
+	}
+
+	log := pfxlog.Logger().WithField("sink", self.name)
+
+	if self.config.spillDir == "" {
+		log.WithField("count", len(batch)).Error("no spillDir configured, dropping events")
+		return
+	}
+
+	self.spillLock.Lock()
+	defer self.spillLock.Unlock()
+
+	if self.spillFile == nil {
+		name := filepath.Join(self.config.spillDir, fmt.Sprintf("spill-%020d.ndjson", time.Now().UnixNano()))
+		f, err := os.OpenFile(name, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+		if err != nil {
+			log.WithError(err).WithField("count", len(batch)).Error("unable to open spill file, dropping events")
+			return
+		}
+		self.spillFile = f
+		self.spillSize = 0
+	}
+
+	for _, record := range batch {
+		buf, err := json.Marshal(record)
+		if err != nil {
+			log.WithError(err).Error("unable to marshal event for spill file, dropping event")
+			continue
+		}
+		buf = append(buf, '\n')
+		n, err := self.spillFile.Write(buf)
+		self.spillSize += int64(n)
+		if err != nil {
+			log.WithError(err).Error("unable to write to spill file")
+			self.closeSpillFileLocked()
+			return
+		}
+	}
+
+	// start a new file once the current one is large, so replayed files can be removed independently
+	if self.spillSize > int64(self.config.spillMaxSizeMb)*1024*1024/10 {
+		self.closeSpillFileLocked()
+	}
+
+	self.enforceSpillLimit()
+}
+
+func (self *batchingEventSink) closeSpillFile() {
+	self.spillLock.Lock()
+	defer self.spillLock.Unlock()
+	self.closeSpillFileLocked()
+}
+
+func (self *batchingEventSink) closeSpillFileLocked() {
+	if self.spillFile != nil {
+		if err := self.spillFile.Close(); err != nil {
+			pfxlog.Logger().WithField("sink", self.name).WithError(err).Error("error closing spill file")
+		}
+		self.spillFile = nil
+	}
+}
+
+func (self *batchingEventSink) listSpillFiles() []string {
+	entries, err := os.ReadDir(self.config.spillDir)
+	if err != nil {
+		pfxlog.Logger().WithField("sink", self.name).WithError(err).Error("unable to list spill directory")
+		return nil
+	}
+
+	var result []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasPrefix(entry.Name(), "spill-") && strings.HasSuffix(entry.Name(), ".ndjson") {
+			result = append(result, filepath.Join(self.config.spillDir, entry.Name()))
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// enforceSpillLimit drops the oldest spill files once the total size exceeds the configured maximum
+func (self *batchingEventSink) enforceSpillLimit() {
+	files := self.listSpillFiles()
+	sizes := make([]int64, len(files))
+	var total int64
+	for idx, file := range files {
+		if info, err := os.Stat(file); err == nil {
+			sizes[idx] = info.Size()
+			total += info.Size()
+		}
+	}
+
+	limit := int64(self.config.spillMaxSizeMb) * 1024 * 1024
+	for idx := 0; total > limit && idx < len(files); idx++ {
+		if self.spillFile != nil && self.spillFile.Name() == files[idx] {
+			continue
+		}
+		pfxlog.Logger().WithField("sink", self.name).WithField("file", files[idx]).Warn("spill directory full, dropping oldest spilled events")
+		if err := os.Remove(files[idx]); err == nil {
+			total -= sizes[idx]
+		}
+	}
+}
+
+// rotateSpillFiles closes the spill file which is being appended to, so that it can be replayed, and returns
+// the spill files present at that point. Events spilled afterward go to a new file, which isn't included.
+func (self *batchingEventSink) rotateSpillFiles() []string {
+	self.spillLock.Lock()
+	defer self.spillLock.Unlock()
+
+	self.closeSpillFileLocked()
+	return self.listSpillFiles()
+}
+
+// replaySpilled attempts to deliver spilled events, oldest first. Replay stops at the first failure.
+func (self *batchingEventSink) replaySpilled() {
+	if self.config.spillDir == "" || time.Now().Before(self.nextReplay) {
+		return
+	}
+
+	for _, file := range self.rotateSpillFiles() {
+		if !self.replaySpillFile(file) {
+			self.nextReplay = time.Now().Add(30 * time.Second)
+			return
+		}
+	}
+}
+
+func (self *batchingEventSink) replaySpillFile(file string) bool {
+	log := pfxlog.Logger().WithField("sink", self.name).WithField("file", file)
+
+	f, err := os.Open(file)
+	if os.IsNotExist(err) {
+		// dropped by enforceSpillLimit
+		return true
+	}
+	if err != nil {
+		log.WithError(err).Error("unable to open spill file")
+		return false
+	}
+
+	var batch []*sinkRecord
+	var batches [][]*sinkRecord
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		record := &sinkRecord{}
+		if err = json.Unmarshal(scanner.Bytes(), record); err != nil {
+			log.WithError(err).Error("skipping invalid spilled event")
+			continue
+		}
+		batch = append(batch, record)
+		if len(batch) >= self.config.batchSize {
+			batches = append(batches, batch)
+			batch = nil
+		}
+	}
+
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	scanErr := scanner.Err()
+	_ = f.Close()
+
+	if scanErr != nil {
+		log.WithError(scanErr).Error("error reading spill file")
+		return false
+	}
+
+	for idx, next := range batches {
+		if err = self.deliver(self.ctx, next); err != nil {
+			log.WithError(err).Debug("replay of spilled events failed, will try again later")
+			if idx > 0 {
+				self.rewriteSpillFile(file, batches[idx:])
+			}
+			return false
+		}
+	}
+
+	if err = os.Remove(file); err != nil && !os.IsNotExist(err) {
+		log.WithError(err).Error("unable to remove replayed spill file")
+		return false
+	}
+
+	log.WithField("batches", len(batches)).Info("replayed spilled events")
+	return true
+}
+
+func (self *batchingEventSink) rewriteSpillFile(file string, batches [][]*sinkRecord) {
+	tmp := file + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		pfxlog.Logger().WithField("sink", self.name).WithError(err).Error("unable to rewrite spill file")
+		return
+	}
+
+	w := bufio.NewWriter(f)
+	for _, batch := range batches {
+		for _, record := range batch {
+			if buf, err := json.Marshal(record); err == nil {
+				_, _ = w.Write(buf)
+				_ = w.WriteByte('\n')
+			}
+		}
+	}
+
+	if err = w.Flush(); err == nil {
+		err = f.Close()
+	} else {
+		_ = f.Close()
+	}
+
+	if err == nil {
+		err = os.Rename(tmp, file)
+	}
+
+	if err != nil {
+		pfxlog.Logger().WithField("sink", self.name).WithError(err).Error("unable to rewrite spill file")
+	}
+}
+
+func getIntConfig(config map[interface{}]interface{}, key string, defaultValue int) (int, error) {
+	value, found := config[key]
+	if !found {
+		return defaultValue, nil
+	}
+	if v, ok := value.(int); ok {
+		return v, nil
+	}
+	return 0, errors.Errorf("invalid %s value %v, must be an integer", key, value)
+}
+
+func getDurationConfig(config map[interface{}]interface{}, key string, defaultValue time.Duration) (time.Duration, error) {
+	value, found := config[key]
+	if !found {
+		return defaultValue, nil
+	}
+	if v, ok := value.(string); ok {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return 0, errors.Wrapf(err, "invalid %s value %v", key, value)
+		}
+		return d, nil
+	}
+	return 0, errors.Errorf("invalid %s value %v, must be a duration string, such as 5s", key, value)
+}
+
+func getStringListConfig(config map[interface{}]interface{}, key string) ([]string, error) {
+	value, found := config[key]
+	if !found {
+		return nil, nil
+	}
+
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, errors.Errorf("invalid %s value %v, must be a list of strings", key, value)
+	}
+
+	var result []string
+	for _, v := range list {
+		s, ok := v.(string)
+		if !ok {
+			return nil, errors.Errorf("invalid %s value %v, must be a list of strings", key, value)
+		}
+		result = append(result, s)
+	}
+	return result, nil
+}
+
+func getStringMapConfig(config map[interface{}]interface{}, key string) (map[string]string, error) {
+	value, found := config[key]
+	if !found {
+		return nil, nil
+	}
+
+	m, ok := value.(map[interface{}]interface{})
+	if !ok {
+		return nil, errors.Errorf("invalid %s value %v, must be a map of strings", key, value)
+	}
+
+	result := map[string]string{}
+	for k, v := range m {
+		result[fmt.Sprintf("%v", k)] = fmt.Sprintf("%v", v)
+	}
+	return result, nil
+}
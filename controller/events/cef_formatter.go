@@ -0,0 +1,363 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hanzozt/zt/v2/common/version"
+)
+
+const (
+	CefDeviceVendor  = "Hanzo"
+	CefDeviceProduct = "ZT"
+
+	// syslog facility local0
+	syslogDefaultFacility = 16
+	syslogAppName         = "zt-controller"
+)
+
+var (
+	cefHeaderEscaper    = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r", " ", "\n", " ")
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\r\n", `\n`, "\n", `\n`, "\r", `\r`)
+)
+
+// cefStandardKeys maps event fields to keys from the CEF extension dictionary. Fields which aren't mapped are
+// included as custom extensions, prefixed with zt, for example ztCircuitId.
+var cefStandardKeys = []struct {
+	field string
+	key   string
+}{
+	{field: "event_src_id", key: "deviceExternalId"},
+	{field: "event_type", key: "act"},
+	{field: "eventType", key: "act"},
+	{field: "identity_id", key: "suid"},
+	{field: "remote_address", key: "src"},
+	{field: "ip_address", key: "src"},
+	{field: "src_addr", key: "src"},
+	{field: "dst_addr", key: "dst"},
+	{field: "reason", key: "reason"},
+	{field: "failure_cause", key: "reason"},
+	{field: "message", key: "msg"},
+}
+
+// CefEncoder formats events using the ArcSight Common Event Format. The signature id is the event type followed
+// by the event sub-type, for example authentication.fail, which allows SIEM rules to key off specific events.
+type CefEncoder struct {
+	deviceVendor  string
+	deviceProduct string
+	deviceVersion string
+}
+
+func NewCefEncoder() *CefEncoder {
+	return &CefEncoder{
+		deviceVendor:  CefDeviceVendor,
+		deviceProduct: CefDeviceProduct,
+		deviceVersion: version.GetVersion(),
+	}
+}
+
+func (self *CefEncoder) Encode(eventType string, evt any) ([]byte, error) {
+	record, _, err := self.encode(eventType, evt)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(record), nil
+}
+
+func (self *CefEncoder) encode(eventType string, evt any) (string, int, error) {
+	fields, err := getEventFields(evt)
+	if err != nil {
+		return "", 0, err
+	}
+
+	subType := getEventSubType(fields)
+	signatureId := eventType
+	name := eventType
+	if subType != "" {
+		signatureId += "." + subType
+		name += " " + subType
+	}
+
+	severity := getCefSeverity(fields, subType)
+
+	var extensions []string
+	addExtension := func(key, value string) {
+		extensions = append(extensions, key+"="+cefExtensionEscaper.Replace(value))
+	}
+
+	addExtension("rt", strconv.FormatInt(getEventTimestamp(fields).UnixMilli(), 10))
+
+	used := map[string]struct{}{
+		"namespace": {},
+		"timestamp": {},
+	}
+	mappedKeys := map[string]struct{}{}
+
+	for _, mapping := range cefStandardKeys {
+		val, found := fields[mapping.field]
+		if !found {
+			continue
+		}
+		used[mapping.field] = struct{}{}
+
+		if _, found = mappedKeys[mapping.key]; found {
+			continue
+		}
+
+		strVal := cefValueString(val)
+		if strVal == "" {
+			continue
+		}
+
+		// src and dst must be ip addresses, so strip any ports and leave non-ip values to the custom extensions
+		if mapping.key == "src" || mapping.key == "dst" {
+			host := strVal
+			if h, _, err := net.SplitHostPort(strVal); err == nil {
+				host = h
+			}
+			if net.ParseIP(host) == nil {
+				delete(used, mapping.field)
+				continue
+			}
+			strVal = host
+		}
+
+		mappedKeys[mapping.key] = struct{}{}
+		addExtension(mapping.key, strVal)
+	}
+
+	if success, ok := fields["success"].(bool); ok {
+		used["success"] = struct{}{}
+		if success {
+			addExtension("outcome", "success")
+		} else {
+			addExtension("outcome", "failure")
+		}
+	}
+
+	custom := map[string]string{}
+	for key, val := range fields {
+		if _, found := used[key]; !found {
+			flattenCefField(key, val, custom)
+		}
+	}
+
+	customKeys := make([]string, 0, len(custom))
+	for key := range custom {
+		customKeys = append(customKeys, key)
+	}
+	sort.Strings(customKeys)
+
+	for _, key := range customKeys {
+		addExtension(key, custom[key])
+	}
+
+	record := fmt.Sprintf("CEF:0|%s|%s|%s|%s|%s|%d|%s",
+		cefHeaderEscaper.Replace(self.deviceVendor),
+		cefHeaderEscaper.Replace(self.deviceProduct),
+		cefHeaderEscaper.Replace(self.deviceVersion),
+		cefHeaderEscaper.Replace(signatureId),
+		cefHeaderEscaper.Replace(name),
+		severity,
+		strings.Join(extensions, " "))
+
+	return record, severity, nil
+}
+
+// getCefSeverity returns a severity from 0 to 10. Failures are reported at a higher severity than other events,
+// and alerts, which are intended to be acted on by an operator, higher still.
+func getCefSeverity(fields map[string]any, subType string) int {
+	if severity, ok := fields["severity"].(string); ok {
+		switch strings.ToLower(severity) {
+		case "error":
+			return 8
+		case "warning", "warn":
+			return 6
+		default:
+			return 3
+		}
+	}
+
+	if success, ok := fields["success"].(bool); ok && !success {
+		return 7
+	}
+
+	switch subType {
+	case "fail", "failed", "failure":
+		return 6
+	}
+
+	return 3
+}
+
+func cefValueString(val any) string {
+	switch v := val.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		buf, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(buf)
+	}
+}
+
+func flattenCefField(name string, val any, result map[string]string) {
+	switch v := val.(type) {
+	case nil:
+		return
+	case map[string]any:
+		for key, child := range v {
+			flattenCefField(name+"_"+key, child, result)
+		}
+	case []any:
+		var values []string
+		for _, child := range v {
+			switch child.(type) {
+			case map[string]any, []any:
+				result[cefCustomKey(name)] = cefValueString(v)
+				return
+			}
+			values = append(values, cefValueString(child))
+		}
+		if len(values) > 0 {
+			result[cefCustomKey(name)] = strings.Join(values, ",")
+		}
+	default:
+		if strVal := cefValueString(v); strVal != "" {
+			result[cefCustomKey(name)] = strVal
+		}
+	}
+}
+
+// cefCustomKey converts a field name such as path.ingress_id to ztPathIngressId. CEF extension keys may only
+// contain letters and digits.
+func cefCustomKey(name string) string {
+	result := &strings.Builder{}
+	result.WriteString("zt")
+	upper := true
+	for _, r := range name {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		result.WriteRune(r)
+	}
+	return result.String()
+}
+
+// SyslogEncoder formats events as RFC 5424 syslog messages, with a CEF record as the message, which is how most
+// SIEMs expect to receive CEF over syslog
+type SyslogEncoder struct {
+	cef      *CefEncoder
+	facility int
+	hostname string
+	appName  string
+	procId   string
+}
+
+func NewSyslogEncoder() *SyslogEncoder {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+
+	return &SyslogEncoder{
+		cef:      NewCefEncoder(),
+		facility: syslogDefaultFacility,
+		hostname: syslogHeaderField(hostname, 255),
+		appName:  syslogAppName,
+		procId:   strconv.Itoa(os.Getpid()),
+	}
+}
+
+func (self *SyslogEncoder) Encode(eventType string, evt any) ([]byte, error) {
+	fields, err := getEventFields(evt)
+	if err != nil {
+		return nil, err
+	}
+
+	record, cefSeverity, err := self.cef.encode(eventType, evt)
+	if err != nil {
+		return nil, err
+	}
+
+	priority := self.facility*8 + cefToSyslogSeverity(cefSeverity)
+	timestamp := getEventTimestamp(fields).UTC().Format("2006-01-02T15:04:05.000000Z07:00")
+
+	msg := fmt.Sprintf("<%d>1 %s %s %s %s %s - %s",
+		priority,
+		timestamp,
+		self.hostname,
+		self.appName,
+		self.procId,
+		syslogHeaderField(eventType, 32),
+		record)
+
+	return []byte(msg), nil
+}
+
+func cefToSyslogSeverity(severity int) int {
+	switch {
+	case severity >= 8:
+		return 3 // error
+	case severity >= 6:
+		return 4 // warning
+	case severity >= 4:
+		return 5 // notice
+	default:
+		return 6 // informational
+	}
+}
+
+// syslogHeaderField restricts header fields to printable ascii without spaces, as required by RFC 5424
+func syslogHeaderField(val string, maxLen int) string {
+	result := strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return '_'
+		}
+		return r
+	}, val)
+
+	if len(result) > maxLen {
+		result = result[:maxLen]
+	}
+
+	if result == "" {
+		return "-"
+	}
+	return result
+}
@@ -87,10 +87,19 @@ func NewDispatcher(closeNotify <-chan struct{}) *Dispatcher {
 		return NewJsonFormatter(16, sink)
 	}))
 
+	for _, format := range []string{"cef", "syslog", "otlp", "protobuf"} {
+		encoder := newEventEncoder(format)
+		result.RegisterFormatterFactory(format, event.FormatterFactoryF(func(sink event.FormattedEventSink) io.Closer {
+			return NewEncodingFormatter(16, sink, encoder)
+		}))
+	}
+
 	result.RegisterEventHandlerFactory("file", FileEventLoggerFactory{})
 	result.RegisterEventHandlerFactory("stdout", StdOutLoggerFactory{})
 	result.RegisterEventHandlerFactory("amqp", AMQPEventLoggerFactory{})
 	result.RegisterEventHandlerFactory("servicebus", ServiceBusEventLoggerFactory{})
+	result.RegisterEventHandlerFactory("webhook", WebhookEventLoggerFactory{})
+	result.RegisterEventHandlerFactory("kafka", KafkaEventLoggerFactory{})
 
 	return result
 }
@@ -207,6 +216,8 @@ events:
       - type: fabric.circuits
         include:
           - created
+          - failed
+        filter: 'service.name = "billing"'
       - type: edge.sessions
         include:
           - created
@@ -215,6 +226,8 @@ events:
       format: json
       path: /tmp/zt-events.log
 
+Supported formats are json, cef, syslog (RFC 5424 with a CEF message), otlp (OTLP/JSON log records) and
+protobuf (length-delimited event_pb.Event messages).
 */
 func (self *Dispatcher) WireEventHandlers(eventHandlerConfigs []*EventHandlerConfig) error {
 	logger := pfxlog.Logger()
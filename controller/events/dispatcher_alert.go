@@ -44,13 +44,21 @@ func (self *Dispatcher) AcceptAlertEvent(evt *event.AlertEvent) {
 	}
 }
 
-func (self *Dispatcher) registerAlertEventHandler(_ string, val interface{}, _ map[string]interface{}) error {
+func (self *Dispatcher) registerAlertEventHandler(eventType string, val interface{}, config map[string]interface{}) error {
 	handler, ok := val.(event.AlertEventHandler)
 
 	if !ok {
 		return errors.Errorf("type %T doesn't implement the event.AlertEventHandler interface", val)
 	}
 
+	filter, err := newEventFilter[event.AlertEvent](self, eventType, config)
+	if err != nil {
+		return err
+	}
+	if filter != nil {
+		handler = &alertEventExprFilter{filter: filter, wrapped: handler}
+	}
+
 	self.AddAlertEventHandler(handler)
 	return nil
 }
@@ -60,3 +68,24 @@ func (self *Dispatcher) unregisterAlertEventHandler(val interface{}) {
 		self.RemoveAlertEventHandler(handler)
 	}
 }
+
+type alertEventExprFilter struct {
+	filter  *eventFilter[event.AlertEvent]
+	wrapped event.AlertEventHandler
+}
+
+func (self *alertEventExprFilter) AcceptAlertEvent(evt *event.AlertEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptAlertEvent(evt)
+	}
+}
+
+func (self *alertEventExprFilter) IsWrapping(value event.AlertEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.AlertEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
@@ -101,6 +101,14 @@ func (self *Dispatcher) registerApiSessionEventHandler(eventType string, val int
 		}
 	}
 
+	filter, err := newEventFilter[event.ApiSessionEvent](self, eventType, config)
+	if err != nil {
+		return err
+	}
+	if filter != nil {
+		handler = &apiSessionEventExprFilter{filter: filter, wrapped: handler}
+	}
+
 	var includeList []string
 	if includeVar, ok := config["include"]; ok {
 		if includeStr, ok := includeVar.(string); ok {
@@ -179,3 +187,24 @@ func (self *apiSessionEventOldNsAdapter) IsWrapping(value event.ApiSessionEventH
 	}
 	return false
 }
+
+type apiSessionEventExprFilter struct {
+	filter  *eventFilter[event.ApiSessionEvent]
+	wrapped event.ApiSessionEventHandler
+}
+
+func (self *apiSessionEventExprFilter) AcceptApiSessionEvent(evt *event.ApiSessionEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptApiSessionEvent(evt)
+	}
+}
+
+func (self *apiSessionEventExprFilter) IsWrapping(value event.ApiSessionEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.ApiSessionEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
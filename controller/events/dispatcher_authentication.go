@@ -54,6 +54,14 @@ func (self *Dispatcher) registerAuthenticationEventHandler(eventType string, val
 		return errors.Errorf("type %T doesn't implement the event.AuthenticationEventHandler interface", val)
 	}
 
+	filter, err := newEventFilter[event.AuthenticationEvent](self, eventType, config)
+	if err != nil {
+		return err
+	}
+	if filter != nil {
+		handler = &authenticationEventExprFilter{filter: filter, wrapped: handler}
+	}
+
 	var includeList []string
 	if includeVar, ok := config["include"]; ok {
 		if includeStr, ok := includeVar.(string); ok {
@@ -111,3 +119,24 @@ func (self *authenticationEventAdapter) IsWrapping(value event.AuthenticationEve
 	}
 	return false
 }
+
+type authenticationEventExprFilter struct {
+	filter  *eventFilter[event.AuthenticationEvent]
+	wrapped event.AuthenticationEventHandler
+}
+
+func (self *authenticationEventExprFilter) AcceptAuthenticationEvent(evt *event.AuthenticationEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptAuthenticationEvent(evt)
+	}
+}
+
+func (self *authenticationEventExprFilter) IsWrapping(value event.AuthenticationEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.AuthenticationEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
@@ -62,6 +62,14 @@ func (self *Dispatcher) registerCircuitEventHandler(eventType string, val interf
 		}
 	}
 
+	filter, err := newEventFilter[event.CircuitEvent](self, eventType, config)
+	if err != nil {
+		return err
+	}
+	if filter != nil {
+		handler = &circuitEventExprFilter{filter: filter, wrapped: handler}
+	}
+
 	var includeList []string
 	if includeVar, ok := config["include"]; ok {
 		if includeStr, ok := includeVar.(string); ok {
@@ -149,3 +157,24 @@ func (self *circuitEventOldNsAdapter) IsWrapping(value event.CircuitEventHandler
 	}
 	return false
 }
+
+type circuitEventExprFilter struct {
+	filter  *eventFilter[event.CircuitEvent]
+	wrapped event.CircuitEventHandler
+}
+
+func (self *circuitEventExprFilter) AcceptCircuitEvent(evt *event.CircuitEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptCircuitEvent(evt)
+	}
+}
+
+func (self *circuitEventExprFilter) IsWrapping(value event.CircuitEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.CircuitEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
@@ -38,13 +38,17 @@ func (self *Dispatcher) AcceptClusterEvent(event *event.ClusterEvent) {
 	}()
 }
 
-func (self *Dispatcher) registerClusterEventHandler(_ string, val interface{}, _ map[string]interface{}) error {
+func (self *Dispatcher) registerClusterEventHandler(eventType string, val interface{}, config map[string]interface{}) error {
 	handler, ok := val.(event.ClusterEventHandler)
 
 	if !ok {
 		return errors.Errorf("type %v doesn't implement the event.ClusterEventHandler interface", val)
 	}
 
+	if err := rejectEventFilter(eventType, config); err != nil {
+		return err
+	}
+
 	self.clusterEventHandlers.Append(handler)
 
 	return nil
@@ -44,13 +44,21 @@ func (self *Dispatcher) AcceptConnectEvent(evt *event.ConnectEvent) {
 	}
 }
 
-func (self *Dispatcher) registerConnectEventHandler(_ string, val interface{}, _ map[string]interface{}) error {
+func (self *Dispatcher) registerConnectEventHandler(eventType string, val interface{}, config map[string]interface{}) error {
 	handler, ok := val.(event.ConnectEventHandler)
 
 	if !ok {
 		return errors.Errorf("type %T doesn't implement the event.ConnectEventHandler interface", val)
 	}
 
+	filter, err := newEventFilter[event.ConnectEvent](self, eventType, config)
+	if err != nil {
+		return err
+	}
+	if filter != nil {
+		handler = &connectEventExprFilter{filter: filter, wrapped: handler}
+	}
+
 	self.AddConnectEventHandler(handler)
 	return nil
 }
@@ -60,3 +68,24 @@ func (self *Dispatcher) unregisterConnectEventHandler(val interface{}) {
 		self.RemoveConnectEventHandler(handler)
 	}
 }
+
+type connectEventExprFilter struct {
+	filter  *eventFilter[event.ConnectEvent]
+	wrapped event.ConnectEventHandler
+}
+
+func (self *connectEventExprFilter) AcceptConnectEvent(evt *event.ConnectEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptConnectEvent(evt)
+	}
+}
+
+func (self *connectEventExprFilter) IsWrapping(value event.ConnectEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.ConnectEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
@@ -61,13 +61,21 @@ func (self *Dispatcher) AcceptEntityChangeEvent(event *event.EntityChangeEvent)
 	}
 }
 
-func (self *Dispatcher) registerEntityChangeEventHandler(_ string, val interface{}, options map[string]interface{}) error {
+func (self *Dispatcher) registerEntityChangeEventHandler(eventType string, val interface{}, options map[string]interface{}) error {
 	handler, ok := val.(event.EntityChangeEventHandler)
 
 	if !ok {
 		return errors.Errorf("type %T doesn't implement the event.EntityChangeEventHandler interface", val)
 	}
 
+	exprFilter, err := newEventFilter[event.EntityChangeEvent](self, eventType, options)
+	if err != nil {
+		return err
+	}
+	if exprFilter != nil {
+		handler = &entityChangeEventExprFilter{filter: exprFilter, wrapped: handler}
+	}
+
 	propagateAlways := false
 	if val, found := options["propagateAlways"]; found {
 		if b, ok := val.(bool); ok {
@@ -375,3 +383,24 @@ func (self *entityChangeEventFilter) AcceptEntityChangeEvent(evt *event.EntityCh
 
 	self.EntityChangeEventHandler.AcceptEntityChangeEvent(evt)
 }
+
+type entityChangeEventExprFilter struct {
+	filter  *eventFilter[event.EntityChangeEvent]
+	wrapped event.EntityChangeEventHandler
+}
+
+func (self *entityChangeEventExprFilter) AcceptEntityChangeEvent(evt *event.EntityChangeEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptEntityChangeEvent(evt)
+	}
+}
+
+func (self *entityChangeEventExprFilter) IsWrapping(value event.EntityChangeEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.EntityChangeEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
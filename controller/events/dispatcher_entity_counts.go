@@ -100,6 +100,10 @@ func (self *Dispatcher) registerEntityCountEventHandler(eventType string, val in
 		return errors.Errorf("type %T doesn't implement the events.EntityCountEventHandler interface", val)
 	}
 
+	if err := rejectEventFilter(eventType, config); err != nil {
+		return err
+	}
+
 	if eventType != event.EntityCountEventNS {
 		handler = &entityCountEventOldNsAdapter{
 			namespace: eventType,
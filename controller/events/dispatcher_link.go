@@ -45,7 +45,7 @@ func (self *Dispatcher) AcceptLinkEvent(event *event.LinkEvent) {
 	}()
 }
 
-func (self *Dispatcher) registerLinkEventHandler(eventType string, val interface{}, _ map[string]interface{}) error {
+func (self *Dispatcher) registerLinkEventHandler(eventType string, val interface{}, config map[string]interface{}) error {
 	handler, ok := val.(event.LinkEventHandler)
 
 	if !ok {
@@ -58,6 +58,14 @@ func (self *Dispatcher) registerLinkEventHandler(eventType string, val interface
 			wrapped:   handler,
 		}
 	}
+	filter, err := newEventFilter[event.LinkEvent](self, eventType, config)
+	if err != nil {
+		return err
+	}
+	if filter != nil {
+		handler = &linkEventExprFilter{filter: filter, wrapped: handler}
+	}
+
 	self.AddLinkEventHandler(handler)
 
 	return nil
@@ -89,3 +97,24 @@ func (self *linkEventOldNsAdapter) IsWrapping(value event.LinkEventHandler) bool
 	}
 	return false
 }
+
+type linkEventExprFilter struct {
+	filter  *eventFilter[event.LinkEvent]
+	wrapped event.LinkEventHandler
+}
+
+func (self *linkEventExprFilter) AcceptLinkEvent(evt *event.LinkEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptLinkEvent(evt)
+	}
+}
+
+func (self *linkEventExprFilter) IsWrapping(value event.LinkEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.LinkEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
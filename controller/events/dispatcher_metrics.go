@@ -85,12 +85,17 @@ func (self *Dispatcher) relayMessagesToEventsUnfiltered(msg *metrics_pb.MetricsM
 	}
 }
 
-func (self *Dispatcher) registerMetricsEventHandler(_ string, val interface{}, config map[string]interface{}) error {
+func (self *Dispatcher) registerMetricsEventHandler(eventType string, val interface{}, config map[string]interface{}) error {
 	handler, ok := val.(event.MetricsEventHandler)
 	if !ok {
 		return errors.Errorf("type %T doesn't implement the event.MetricsEventHandler interface", val)
 	}
 
+	// metrics events are filtered using sourceFilter and metricFilter
+	if err := rejectEventFilter(eventType, config); err != nil {
+		return err
+	}
+
 	var sourceFilterDef = ""
 	if sourceRegexVal, ok := config["sourceFilter"]; ok {
 		sourceFilterDef, ok = sourceRegexVal.(string)
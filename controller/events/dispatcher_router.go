@@ -56,7 +56,7 @@ func (self *Dispatcher) initRouterEvents(n *network.Network) {
 	n.AddRouterPresenceHandler(routerEvtAdapter)
 }
 
-func (self *Dispatcher) registerRouterEventHandler(eventType string, val interface{}, _ map[string]interface{}) error {
+func (self *Dispatcher) registerRouterEventHandler(eventType string, val interface{}, config map[string]interface{}) error {
 	handler, ok := val.(event.RouterEventHandler)
 
 	if !ok {
@@ -70,6 +70,14 @@ func (self *Dispatcher) registerRouterEventHandler(eventType string, val interfa
 		}
 	}
 
+	filter, err := newEventFilter[event.RouterEvent](self, eventType, config)
+	if err != nil {
+		return err
+	}
+	if filter != nil {
+		handler = &routerEventExprFilter{filter: filter, wrapped: handler}
+	}
+
 	self.AddRouterEventHandler(handler)
 
 	return nil
@@ -150,3 +158,24 @@ func (self *routerEventAdapter) routerChange(eventType event.RouterEventType, r
 		self.Dispatcher.AcceptConnectEvent(connectEvent)
 	}
 }
+
+type routerEventExprFilter struct {
+	filter  *eventFilter[event.RouterEvent]
+	wrapped event.RouterEventHandler
+}
+
+func (self *routerEventExprFilter) AcceptRouterEvent(evt *event.RouterEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptRouterEvent(evt)
+	}
+}
+
+func (self *routerEventExprFilter) IsWrapping(value event.RouterEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.RouterEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
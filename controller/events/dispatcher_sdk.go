@@ -44,13 +44,21 @@ func (self *Dispatcher) AcceptSdkEvent(evt *event.SdkEvent) {
 	}
 }
 
-func (self *Dispatcher) registerSdkEventHandler(_ string, val interface{}, _ map[string]interface{}) error {
+func (self *Dispatcher) registerSdkEventHandler(eventType string, val interface{}, config map[string]interface{}) error {
 	handler, ok := val.(event.SdkEventHandler)
 
 	if !ok {
 		return errors.Errorf("type %T doesn't implement the event.SdkEventHandler interface", val)
 	}
 
+	filter, err := newEventFilter[event.SdkEvent](self, eventType, config)
+	if err != nil {
+		return err
+	}
+	if filter != nil {
+		handler = &sdkEventExprFilter{filter: filter, wrapped: handler}
+	}
+
 	self.AddSdkEventHandler(handler)
 	return nil
 }
@@ -60,3 +68,24 @@ func (self *Dispatcher) unregisterSdkEventHandler(val interface{}) {
 		self.RemoveSdkEventHandler(handler)
 	}
 }
+
+type sdkEventExprFilter struct {
+	filter  *eventFilter[event.SdkEvent]
+	wrapped event.SdkEventHandler
+}
+
+func (self *sdkEventExprFilter) AcceptSdkEvent(evt *event.SdkEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptSdkEvent(evt)
+	}
+}
+
+func (self *sdkEventExprFilter) IsWrapping(value event.SdkEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.SdkEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
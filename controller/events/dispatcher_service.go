@@ -50,7 +50,7 @@ func (self *Dispatcher) AcceptServiceEvent(event *event.ServiceEvent) {
 	}()
 }
 
-func (self *Dispatcher) registerServiceEventHandler(eventType string, val interface{}, _ map[string]interface{}) error {
+func (self *Dispatcher) registerServiceEventHandler(eventType string, val interface{}, config map[string]interface{}) error {
 	handler, ok := val.(event.ServiceEventHandler)
 	if !ok {
 		return errors.Errorf("type %T doesn't implement the event.ServiceEventHandler interface", val)
@@ -62,6 +62,14 @@ func (self *Dispatcher) registerServiceEventHandler(eventType string, val interf
 			wrapped:   handler,
 		}
 	}
+	filter, err := newEventFilter[event.ServiceEvent](self, eventType, config)
+	if err != nil {
+		return err
+	}
+	if filter != nil {
+		handler = &serviceEventExprFilter{filter: filter, wrapped: handler}
+	}
+
 	self.AddServiceEventHandler(handler)
 
 	return nil
@@ -132,3 +140,24 @@ func (self *serviceEventAdapter) AcceptMetrics(message *metrics_pb.MetricsMessag
 		}
 	}
 }
+
+type serviceEventExprFilter struct {
+	filter  *eventFilter[event.ServiceEvent]
+	wrapped event.ServiceEventHandler
+}
+
+func (self *serviceEventExprFilter) AcceptServiceEvent(evt *event.ServiceEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptServiceEvent(evt)
+	}
+}
+
+func (self *serviceEventExprFilter) IsWrapping(value event.ServiceEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.ServiceEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
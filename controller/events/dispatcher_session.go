@@ -109,6 +109,14 @@ func (self *Dispatcher) registerSessionEventHandler(eventType string, val interf
 		}
 	}
 
+	filter, err := newEventFilter[event.SessionEvent](self, eventType, config)
+	if err != nil {
+		return err
+	}
+	if filter != nil {
+		handler = &sessionEventExprFilter{filter: filter, wrapped: handler}
+	}
+
 	var includeList []string
 	if includeVar, ok := config["include"]; ok {
 		if includeStr, ok := includeVar.(string); ok {
@@ -187,3 +195,24 @@ func (self *sessionEventOldNsAdapter) IsWrapping(value event.SessionEventHandler
 	}
 	return false
 }
+
+type sessionEventExprFilter struct {
+	filter  *eventFilter[event.SessionEvent]
+	wrapped event.SessionEventHandler
+}
+
+func (self *sessionEventExprFilter) AcceptSessionEvent(evt *event.SessionEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptSessionEvent(evt)
+	}
+}
+
+func (self *sessionEventExprFilter) IsWrapping(value event.SessionEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.SessionEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
@@ -68,6 +68,14 @@ func (self *Dispatcher) registerTerminatorEventHandler(eventType string, val int
 		}
 	}
 
+	filter, err := newEventFilter[event.TerminatorEvent](self, eventType, options)
+	if err != nil {
+		return err
+	}
+	if filter != nil {
+		handler = &terminatorEventExprFilter{filter: filter, wrapped: handler}
+	}
+
 	propagateAlways := false
 	if val, found := options["propagateAlways"]; found {
 		if b, ok := val.(bool); ok {
@@ -101,6 +109,7 @@ func (self *Dispatcher) initTerminatorEvents(n *network.Network) {
 	}
 
 	n.AddRouterPresenceHandler(terminatorEvtAdapter)
+	xt.GlobalOutlierDetector().AddHandler(terminatorEvtAdapter)
 }
 
 type terminatorEventOldNsAdapter struct {
@@ -157,6 +166,32 @@ func (self *terminatorEventAdapter) RouterDisconnected(r *model.Router) {
 	self.routerChange(event.TerminatorRouterOffline, r)
 }
 
+func (self *terminatorEventAdapter) TerminatorEjected(terminator xt.Terminator, _ time.Time) {
+	self.outlierStateChanged(event.TerminatorEjected, terminator)
+}
+
+func (self *terminatorEventAdapter) TerminatorRestored(terminator xt.Terminator) {
+	self.outlierStateChanged(event.TerminatorRestored, terminator)
+}
+
+func (self *terminatorEventAdapter) outlierStateChanged(eventType event.TerminatorEventType, t xt.Terminator) {
+	var terminator *db.Terminator
+	err := self.Network.GetDb().View(func(tx *bbolt.Tx) error {
+		var err error
+		terminator, _, err = self.Network.GetStores().Terminator.FindById(tx, t.GetId())
+		return err
+	})
+
+	if err != nil {
+		pfxlog.Logger().WithError(err).Errorf("failure while generating terminator %v event for terminator %v", eventType, t.GetId())
+		return
+	}
+
+	if terminator != nil {
+		self.createTerminatorEvent(eventType, terminator)
+	}
+}
+
 func (self *terminatorEventAdapter) routerChange(eventType event.TerminatorEventType, r *model.Router) {
 	var terminators []*db.Terminator
 	err := self.Network.GetDb().View(func(tx *bbolt.Tx) error {
@@ -230,5 +265,30 @@ func (self *terminatorEventAdapter) createTerminatorEvent(eventType event.Termin
 		PropagateIndicator:        self.Network.Dispatcher.IsLeaderOrLeaderless(),
 	}
 
+	if ejectedUntil, ejected := xt.GlobalOutlierDetector().GetEjectedUntil(terminator.Id); ejected {
+		evt.EjectedUntil = &ejectedUntil
+	}
+
 	self.Dispatcher.AcceptTerminatorEvent(evt)
 }
+
+type terminatorEventExprFilter struct {
+	filter  *eventFilter[event.TerminatorEvent]
+	wrapped event.TerminatorEventHandler
+}
+
+func (self *terminatorEventExprFilter) AcceptTerminatorEvent(evt *event.TerminatorEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptTerminatorEvent(evt)
+	}
+}
+
+func (self *terminatorEventExprFilter) IsWrapping(value event.TerminatorEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.TerminatorEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
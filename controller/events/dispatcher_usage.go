@@ -98,6 +98,15 @@ func (self *Dispatcher) registerUsageEventHandler(eventType string, val interfac
 				wrapped:   handler,
 			}
 		}
+
+		filter, err := newEventFilter[event.UsageEventV2](self, eventType, config)
+		if err != nil {
+			return err
+		}
+		if filter != nil {
+			handler = &usageEventV2ExprFilter{filter: filter, wrapped: handler}
+		}
+
 		self.AddUsageEventHandler(handler)
 	} else {
 		handler, ok := val.(event.UsageEventV3Handler)
@@ -112,6 +121,14 @@ func (self *Dispatcher) registerUsageEventHandler(eventType string, val interfac
 			}
 		}
 
+		filter, err := newEventFilter[event.UsageEventV3](self, eventType, config)
+		if err != nil {
+			return err
+		}
+		if filter != nil {
+			handler = &usageEventV3ExprFilter{filter: filter, wrapped: handler}
+		}
+
 		if includeListVal, found := config["include"]; found {
 			includes := map[string]struct{}{}
 			if list, ok := includeListVal.([]interface{}); ok {
@@ -330,3 +347,45 @@ func (self *usageEventV3OldNsAdapter) IsWrapping(value event.UsageEventV3Handler
 	}
 	return false
 }
+
+type usageEventV2ExprFilter struct {
+	filter  *eventFilter[event.UsageEventV2]
+	wrapped event.UsageEventHandler
+}
+
+func (self *usageEventV2ExprFilter) AcceptUsageEvent(evt *event.UsageEventV2) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptUsageEvent(evt)
+	}
+}
+
+func (self *usageEventV2ExprFilter) IsWrapping(value event.UsageEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.UsageEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+type usageEventV3ExprFilter struct {
+	filter  *eventFilter[event.UsageEventV3]
+	wrapped event.UsageEventV3Handler
+}
+
+func (self *usageEventV3ExprFilter) AcceptUsageEventV3(evt *event.UsageEventV3) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptUsageEventV3(evt)
+	}
+}
+
+func (self *usageEventV3ExprFilter) IsWrapping(value event.UsageEventV3Handler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.UsageEventV3HandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
@@ -0,0 +1,179 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/hanzozt/zt/v2/controller/event"
+)
+
+// An EventEncoder turns an event into its formatted representation. The event type is the same as the one
+// reported by the json formatter, for example circuit or usage.v3.
+type EventEncoder interface {
+	Encode(eventType string, evt any) ([]byte, error)
+}
+
+type encodedEvent struct {
+	eventType string
+	event     any
+	encoder   EventEncoder
+}
+
+func (self *encodedEvent) GetEventType() string {
+	return self.eventType
+}
+
+func (self *encodedEvent) Format() ([]byte, error) {
+	return self.encoder.Encode(self.eventType, self.event)
+}
+
+func NewEncodingFormatter(queueDepth int, sink event.FormattedEventSink, encoder EventEncoder) *EncodingFormatter {
+	result := &EncodingFormatter{
+		BaseFormatter: BaseFormatter{
+			events:      make(chan FormatterEvent, queueDepth),
+			closeNotify: make(chan struct{}),
+			sink:        sink,
+		},
+		encoder: encoder,
+	}
+	go result.Run()
+	return result
+}
+
+// EncodingFormatter accepts all event types and formats them using the given EventEncoder
+type EncodingFormatter struct {
+	BaseFormatter
+	encoder EventEncoder
+}
+
+func (formatter *EncodingFormatter) accept(eventType string, evt any) {
+	formatter.AcceptLoggingEvent(&encodedEvent{
+		eventType: eventType,
+		event:     evt,
+		encoder:   formatter.encoder,
+	})
+}
+
+func (formatter *EncodingFormatter) AcceptAlertEvent(evt *event.AlertEvent) {
+	formatter.accept("alert", evt)
+}
+
+func (formatter *EncodingFormatter) AcceptCircuitEvent(evt *event.CircuitEvent) {
+	formatter.accept("circuit", evt)
+}
+
+func (formatter *EncodingFormatter) AcceptLinkEvent(evt *event.LinkEvent) {
+	formatter.accept("link", evt)
+}
+
+func (formatter *EncodingFormatter) AcceptMetricsEvent(evt *event.MetricsEvent) {
+	formatter.accept("metrics", evt)
+}
+
+func (formatter *EncodingFormatter) AcceptServiceEvent(evt *event.ServiceEvent) {
+	formatter.accept("service", evt)
+}
+
+func (formatter *EncodingFormatter) AcceptTerminatorEvent(evt *event.TerminatorEvent) {
+	formatter.accept("terminator", evt)
+}
+
+func (formatter *EncodingFormatter) AcceptRouterEvent(evt *event.RouterEvent) {
+	formatter.accept("router", evt)
+}
+
+func (formatter *EncodingFormatter) AcceptUsageEvent(evt *event.UsageEventV2) {
+	formatter.accept("usage", evt)
+}
+
+func (formatter *EncodingFormatter) AcceptUsageEventV3(evt *event.UsageEventV3) {
+	formatter.accept("usage.v3", evt)
+}
+
+func (formatter *EncodingFormatter) AcceptClusterEvent(evt *event.ClusterEvent) {
+	formatter.accept("cluster", evt)
+}
+
+func (formatter *EncodingFormatter) AcceptConnectEvent(evt *event.ConnectEvent) {
+	formatter.accept("connect", evt)
+}
+
+func (formatter *EncodingFormatter) AcceptSdkEvent(evt *event.SdkEvent) {
+	formatter.accept("sdk", evt)
+}
+
+func (formatter *EncodingFormatter) AcceptEntityChangeEvent(evt *event.EntityChangeEvent) {
+	formatter.accept("entity.change", evt)
+}
+
+func (formatter *EncodingFormatter) AcceptApiSessionEvent(evt *event.ApiSessionEvent) {
+	formatter.accept("apiSession", evt)
+}
+
+func (formatter *EncodingFormatter) AcceptSessionEvent(evt *event.SessionEvent) {
+	formatter.accept("session", evt)
+}
+
+func (formatter *EncodingFormatter) AcceptEntityCountEvent(evt *event.EntityCountEvent) {
+	formatter.accept("entityCount", evt)
+}
+
+func (formatter *EncodingFormatter) AcceptAuthenticationEvent(evt *event.AuthenticationEvent) {
+	formatter.accept("authentication", evt)
+}
+
+// getEventFields returns the json representation of an event as a map, so that encoders can work with the same
+// field names as the json formatter. Numbers are returned as json.Number, so integers keep their precision.
+func getEventFields(evt any) (map[string]any, error) {
+	buf, err := json.Marshal(evt)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(buf))
+	decoder.UseNumber()
+
+	result := map[string]any{}
+	if err = decoder.Decode(&result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// getEventTimestamp returns the timestamp of an event, as extracted by getEventFields. If the event doesn't have
+// a valid timestamp, the current time is returned.
+func getEventTimestamp(fields map[string]any) time.Time {
+	if val, ok := fields["timestamp"].(string); ok {
+		if ts, err := time.Parse(time.RFC3339Nano, val); err == nil {
+			return ts
+		}
+	}
+	return time.Now()
+}
+
+// getEventSubType returns the event_type of an event, for example created or failed
+func getEventSubType(fields map[string]any) string {
+	for _, key := range []string{"event_type", "eventType"} {
+		if val, ok := fields[key].(string); ok && val != "" {
+			return val
+		}
+	}
+	return ""
+}
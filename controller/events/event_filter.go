@@ -0,0 +1,529 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hanzozt/storage/ast"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+// EventFilterOption is the subscription option which holds a filter expression. Filters use the same query
+// language as the REST APIs and are evaluated against the event fields, using the field names from the json
+// representation of the event. Nested fields are separated with periods and string lists can be used with
+// set functions.
+//
+// Events which carry an identity_id or service_id can also be filtered on the name and role attributes of
+// the referenced identity or service, using identity.name, identity.roleAttributes, service.name and
+// service.roleAttributes.
+//
+// Example configuration:
+//
+//	subscriptions:
+//	  - type: circuit
+//	    filter: 'event_type = "failed" and service.name = "billing"'
+//	  - type: session
+//	    filter: 'anyOf(identity.roleAttributes) = "contractors"'
+//	  - type: usage
+//	    version: 3
+//	    filter: 'tags.serviceId in ["abc", "def"]'
+const EventFilterOption = "filter"
+
+var (
+	eventFilterFieldName = regexp.MustCompile(`^[A-Za-z][A-Za-z_]*$`)
+	eventFilterTimeType  = reflect.TypeOf(time.Time{})
+)
+
+// eventFilterRelation allows filtering on attributes of an entity referenced by id from an event
+type eventFilterRelation struct {
+	prefix  string
+	idField string
+	load    func(lookup *eventFilterLookup, tx *bbolt.Tx, id string) *eventFilterRelatedEntity
+}
+
+type eventFilterRelatedEntity struct {
+	name           string
+	roleAttributes []string
+}
+
+var eventFilterRelations = []*eventFilterRelation{
+	{
+		prefix:  "identity",
+		idField: "identity_id",
+		load: func(lookup *eventFilterLookup, tx *bbolt.Tx, id string) *eventFilterRelatedEntity {
+			identity, err := lookup.dispatcher.stores.Identity.LoadById(tx, id)
+			if err != nil || identity == nil {
+				return nil
+			}
+			return &eventFilterRelatedEntity{name: identity.Name, roleAttributes: identity.RoleAttributes}
+		},
+	},
+	{
+		prefix:  "service",
+		idField: "service_id",
+		load: func(lookup *eventFilterLookup, tx *bbolt.Tx, id string) *eventFilterRelatedEntity {
+			service, err := lookup.dispatcher.stores.EdgeService.LoadById(tx, id)
+			if err != nil || service == nil {
+				return nil
+			}
+			return &eventFilterRelatedEntity{name: service.Name, roleAttributes: service.RoleAttributes}
+		},
+	},
+}
+
+type eventFilterSymbol struct {
+	nodeType ast.NodeType
+	isSet    bool
+	index    []int
+	relation *eventFilterRelation
+	attr     string
+}
+
+// eventFilterSymbolTypes describes the filterable fields of an event type. It's built once per subscription
+// using reflection and used both to validate the filter and to evaluate it.
+type eventFilterSymbolTypes struct {
+	symbols map[string]*eventFilterSymbol
+	maps    map[string][]int
+}
+
+func newEventFilterSymbolTypes(t reflect.Type) *eventFilterSymbolTypes {
+	result := &eventFilterSymbolTypes{
+		symbols: map[string]*eventFilterSymbol{},
+		maps:    map[string][]int{},
+	}
+	result.addStructFields(t, "", nil)
+
+	for _, relation := range eventFilterRelations {
+		if sym, found := result.symbols[relation.idField]; found && sym.relation == nil && sym.nodeType == ast.NodeTypeString {
+			result.symbols[relation.prefix+".name"] = &eventFilterSymbol{
+				nodeType: ast.NodeTypeString,
+				relation: relation,
+				attr:     "name",
+			}
+			result.symbols[relation.prefix+".roleAttributes"] = &eventFilterSymbol{
+				nodeType: ast.NodeTypeString,
+				isSet:    true,
+				relation: relation,
+				attr:     "roleAttributes",
+			}
+		}
+	}
+
+	return result
+}
+
+func (self *eventFilterSymbolTypes) addStructFields(t reflect.Type, prefix string, index []int) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		fieldIndex := append(append([]int{}, index...), i)
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			self.addStructFields(fieldType, prefix, fieldIndex)
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		// symbols must be valid identifiers in the query language, so fields such as m1_rate aren't filterable
+		if !eventFilterFieldName.MatchString(name) {
+			continue
+		}
+		name = prefix + name
+
+		switch fieldType.Kind() {
+		case reflect.String:
+			self.symbols[name] = &eventFilterSymbol{nodeType: ast.NodeTypeString, index: fieldIndex}
+		case reflect.Bool:
+			self.symbols[name] = &eventFilterSymbol{nodeType: ast.NodeTypeBool, index: fieldIndex}
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			self.symbols[name] = &eventFilterSymbol{nodeType: ast.NodeTypeInt64, index: fieldIndex}
+		case reflect.Float32, reflect.Float64:
+			self.symbols[name] = &eventFilterSymbol{nodeType: ast.NodeTypeFloat64, index: fieldIndex}
+		case reflect.Struct:
+			if fieldType == eventFilterTimeType {
+				self.symbols[name] = &eventFilterSymbol{nodeType: ast.NodeTypeDatetime, index: fieldIndex}
+			} else {
+				self.addStructFields(fieldType, name+".", fieldIndex)
+			}
+		case reflect.Slice:
+			if fieldType.Elem().Kind() == reflect.String {
+				self.symbols[name] = &eventFilterSymbol{nodeType: ast.NodeTypeString, isSet: true, index: fieldIndex}
+			}
+		case reflect.Map:
+			if fieldType.Key().Kind() == reflect.String {
+				self.maps[name] = fieldIndex
+			}
+		}
+	}
+}
+
+func (self *eventFilterSymbolTypes) getSymbol(name string) *eventFilterSymbol {
+	if sym, found := self.symbols[name]; found {
+		return sym
+	}
+
+	// map values, such as usage tags, can be accessed as <field>.<key> and are always treated as strings
+	if prefix, key, found := strings.Cut(name, "."); found && key != "" {
+		if index, found := self.maps[prefix]; found {
+			return &eventFilterSymbol{nodeType: ast.NodeTypeString, index: index, attr: key}
+		}
+	}
+
+	return nil
+}
+
+func (self *eventFilterSymbolTypes) GetSymbolType(name string) (ast.NodeType, bool) {
+	if sym := self.getSymbol(name); sym != nil {
+		return sym.nodeType, true
+	}
+	return 0, false
+}
+
+func (self *eventFilterSymbolTypes) GetSetSymbolTypes(string) ast.SymbolTypes {
+	return nil
+}
+
+func (self *eventFilterSymbolTypes) IsSet(name string) (bool, bool) {
+	if sym := self.getSymbol(name); sym != nil {
+		return sym.isSet, true
+	}
+	return false, false
+}
+
+// eventFilterLookup loads entities referenced by events, so that filters can use names and role attributes
+type eventFilterLookup struct {
+	dispatcher *Dispatcher
+}
+
+func (self *eventFilterLookup) load(relation *eventFilterRelation, id string) *eventFilterRelatedEntity {
+	if id == "" || self.dispatcher == nil || self.dispatcher.stores == nil || self.dispatcher.network == nil {
+		return nil
+	}
+
+	var result *eventFilterRelatedEntity
+	err := self.dispatcher.network.GetDb().View(func(tx *bbolt.Tx) error {
+		result = relation.load(self, tx, id)
+		return nil
+	})
+	if err != nil {
+		pfxlog.Logger().WithError(err).WithField("id", id).Errorf("unable to load %s for event filter", relation.prefix)
+	}
+	return result
+}
+
+// eventFilter evaluates a filter expression against events of type T
+type eventFilter[T any] struct {
+	query  ast.Query
+	types  *eventFilterSymbolTypes
+	lookup *eventFilterLookup
+}
+
+func (self *eventFilter[T]) String() string {
+	return self.query.String()
+}
+
+func (self *eventFilter[T]) Matches(evt *T) bool {
+	if evt == nil {
+		return false
+	}
+
+	symbols := &eventFilterSymbols{
+		eventFilterSymbolTypes: self.types,
+		lookup:                 self.lookup,
+		value:                  reflect.ValueOf(evt).Elem(),
+	}
+
+	return self.query.EvalBool(symbols)
+}
+
+// newEventFilter returns a filter for the given subscription, or nil if the subscription doesn't specify one
+func newEventFilter[T any](dispatcher *Dispatcher, eventType string, config map[string]interface{}) (*eventFilter[T], error) {
+	val, found := config[EventFilterOption]
+	if !found {
+		return nil, nil
+	}
+
+	expr, ok := val.(string)
+	if !ok {
+		return nil, errors.Errorf("invalid type %T for %v filter, must be string", val, eventType)
+	}
+
+	if strings.TrimSpace(expr) == "" {
+		return nil, nil
+	}
+
+	types := newEventFilterSymbolTypes(reflect.TypeOf((*T)(nil)).Elem())
+	query, err := ast.Parse(types, expr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %v filter '%v'", eventType, expr)
+	}
+
+	if len(query.GetSortFields()) > 0 || query.GetSkip() != nil || query.GetLimit() != nil {
+		return nil, errors.Errorf("invalid %v filter '%v', sort, skip and limit are not supported in event filters", eventType, expr)
+	}
+
+	return &eventFilter[T]{
+		query:  query,
+		types:  types,
+		lookup: &eventFilterLookup{dispatcher: dispatcher},
+	}, nil
+}
+
+// rejectEventFilter is used by event types which don't support filter expressions, so that a filter isn't
+// silently ignored
+func rejectEventFilter(eventType string, config map[string]interface{}) error {
+	if _, found := config[EventFilterOption]; found {
+		return errors.Errorf("filter expressions are not supported for %v events", eventType)
+	}
+	return nil
+}
+
+// eventFilterSymbols evaluates symbols against a single event. A new instance is used for each evaluation,
+// so filters can be evaluated concurrently.
+type eventFilterSymbols struct {
+	*eventFilterSymbolTypes
+	lookup  *eventFilterLookup
+	value   reflect.Value
+	cursors map[string]*eventFilterSetCursor
+	related map[*eventFilterRelation]*eventFilterRelatedEntity
+}
+
+func (self *eventFilterSymbols) getRelated(relation *eventFilterRelation) *eventFilterRelatedEntity {
+	if entity, found := self.related[relation]; found {
+		return entity
+	}
+
+	var entity *eventFilterRelatedEntity
+	if idField, ok := self.getField(self.symbols[relation.idField]); ok {
+		entity = self.lookup.load(relation, idField.String())
+	}
+
+	if self.related == nil {
+		self.related = map[*eventFilterRelation]*eventFilterRelatedEntity{}
+	}
+	self.related[relation] = entity
+	return entity
+}
+
+func (self *eventFilterSymbols) getField(sym *eventFilterSymbol) (reflect.Value, bool) {
+	if sym == nil || sym.relation != nil {
+		return reflect.Value{}, false
+	}
+
+	v := self.value
+	for _, idx := range sym.index {
+		for v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(idx)
+	}
+
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() == reflect.Map {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+		v = v.MapIndex(reflect.ValueOf(sym.attr).Convert(v.Type().Key()))
+		if !v.IsValid() {
+			return reflect.Value{}, false
+		}
+	}
+
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+		v = v.Elem()
+	}
+
+	return v, true
+}
+
+func (self *eventFilterSymbols) EvalBool(name string) *bool {
+	if v, ok := self.getField(self.getSymbol(name)); ok && v.Kind() == reflect.Bool {
+		result := v.Bool()
+		return &result
+	}
+	return nil
+}
+
+func (self *eventFilterSymbols) EvalString(name string) *string {
+	if cursor, found := self.cursors[name]; found && cursor.IsValid() {
+		result := cursor.values[cursor.idx]
+		return &result
+	}
+
+	sym := self.getSymbol(name)
+	if sym == nil {
+		return nil
+	}
+
+	if sym.relation != nil && sym.attr == "name" {
+		if entity := self.getRelated(sym.relation); entity != nil {
+			return &entity.name
+		}
+		return nil
+	}
+
+	if v, ok := self.getField(sym); ok {
+		var result string
+		if v.Kind() == reflect.String {
+			result = v.String()
+		} else {
+			result = fmt.Sprintf("%v", v.Interface())
+		}
+		return &result
+	}
+	return nil
+}
+
+func (self *eventFilterSymbols) EvalInt64(name string) *int64 {
+	if v, ok := self.getField(self.getSymbol(name)); ok {
+		var result int64
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			result = v.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			result = int64(v.Uint())
+		case reflect.Float32, reflect.Float64:
+			result = int64(v.Float())
+		default:
+			return nil
+		}
+		return &result
+	}
+	return nil
+}
+
+func (self *eventFilterSymbols) EvalFloat64(name string) *float64 {
+	if v, ok := self.getField(self.getSymbol(name)); ok {
+		var result float64
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			result = float64(v.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			result = float64(v.Uint())
+		case reflect.Float32, reflect.Float64:
+			result = v.Float()
+		default:
+			return nil
+		}
+		return &result
+	}
+	return nil
+}
+
+func (self *eventFilterSymbols) EvalDatetime(name string) *time.Time {
+	if v, ok := self.getField(self.getSymbol(name)); ok && v.Type() == eventFilterTimeType {
+		result := v.Interface().(time.Time)
+		return &result
+	}
+	return nil
+}
+
+func (self *eventFilterSymbols) IsNil(name string) bool {
+	if cursor, found := self.cursors[name]; found {
+		return !cursor.IsValid()
+	}
+
+	sym := self.getSymbol(name)
+	if sym == nil {
+		return true
+	}
+
+	if sym.relation != nil {
+		return self.getRelated(sym.relation) == nil
+	}
+
+	_, ok := self.getField(sym)
+	return !ok
+}
+
+func (self *eventFilterSymbols) OpenSetCursor(name string) ast.SetCursor {
+	cursor := &eventFilterSetCursor{}
+
+	if sym := self.getSymbol(name); sym != nil && sym.isSet {
+		if sym.relation != nil {
+			if entity := self.getRelated(sym.relation); entity != nil {
+				cursor.values = entity.roleAttributes
+			}
+		} else if v, ok := self.getField(sym); ok {
+			for i := 0; i < v.Len(); i++ {
+				cursor.values = append(cursor.values, v.Index(i).String())
+			}
+		}
+	}
+
+	if self.cursors == nil {
+		self.cursors = map[string]*eventFilterSetCursor{}
+	}
+	self.cursors[name] = cursor
+	return cursor
+}
+
+func (self *eventFilterSymbols) OpenSetCursorForQuery(string, ast.Query) ast.SetCursor {
+	return ast.NewEmptyCursor()
+}
+
+type eventFilterSetCursor struct {
+	values []string
+	idx    int
+}
+
+func (self *eventFilterSetCursor) Next() {
+	self.idx++
+}
+
+func (self *eventFilterSetCursor) IsValid() bool {
+	return self.idx < len(self.values)
+}
+
+func (self *eventFilterSetCursor) Current() []byte {
+	return []byte(self.values[self.idx])
+}
@@ -0,0 +1,188 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hanzozt/zt/v2/controller/event"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCircuitFilter(t *testing.T, expr string) *eventFilter[event.CircuitEvent] {
+	filter, err := newEventFilter[event.CircuitEvent](nil, event.CircuitEventNS, map[string]interface{}{
+		EventFilterOption: expr,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, filter)
+	return filter
+}
+
+func Test_EventFilterCircuits(t *testing.T) {
+	req := require.New(t)
+
+	cost := uint32(10)
+	failureCause := "NO_TERMINATORS"
+	evt := &event.CircuitEvent{
+		Namespace: event.CircuitEventNS,
+		Timestamp: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+		EventType: event.CircuitFailed,
+		CircuitId: "c1",
+		ServiceId: "svc1",
+		Path: event.CircuitPath{
+			Nodes: []string{"r1", "r2"},
+		},
+		LinkCount:    1,
+		Cost:         &cost,
+		FailureCause: &failureCause,
+	}
+
+	req.True(newTestCircuitFilter(t, `event_type = "failed" and service_id = "svc1"`).Matches(evt))
+	req.False(newTestCircuitFilter(t, `event_type = "failed" and service_id = "svc2"`).Matches(evt))
+	req.True(newTestCircuitFilter(t, `service_id in ["svc2", "svc1"]`).Matches(evt))
+	req.True(newTestCircuitFilter(t, `anyOf(path.nodes) = "r2"`).Matches(evt))
+	req.False(newTestCircuitFilter(t, `anyOf(path.nodes) = "r3"`).Matches(evt))
+	req.True(newTestCircuitFilter(t, `link_count >= 1 and path_cost < 20`).Matches(evt))
+	req.True(newTestCircuitFilter(t, `failure_cause contains "TERMINATORS"`).Matches(evt))
+	req.True(newTestCircuitFilter(t, `timestamp > datetime(2024-01-01T00:00:00Z)`).Matches(evt))
+	req.False(newTestCircuitFilter(t, `not (circuit_id = "c1")`).Matches(evt))
+
+	evt.FailureCause = nil
+	req.True(newTestCircuitFilter(t, `failure_cause = null`).Matches(evt))
+}
+
+func Test_EventFilterMapsAndRelations(t *testing.T) {
+	req := require.New(t)
+
+	filter, err := newEventFilter[event.UsageEventV3](nil, event.UsageEventNS, map[string]interface{}{
+		EventFilterOption: `tags.serviceId = "svc1" and anyOf(usage.keys) = "ingress.rx"`,
+	})
+	req.Error(err)
+	req.Nil(filter)
+
+	filter, err = newEventFilter[event.UsageEventV3](nil, event.UsageEventNS, map[string]interface{}{
+		EventFilterOption: `tags.serviceId = "svc1"`,
+	})
+	req.NoError(err)
+	req.True(filter.Matches(&event.UsageEventV3{Tags: map[string]string{"serviceId": "svc1"}}))
+	req.False(filter.Matches(&event.UsageEventV3{Tags: map[string]string{"serviceId": "svc2"}}))
+	req.False(filter.Matches(&event.UsageEventV3{}))
+
+	// role attributes are resolved from the stores, which aren't available here, so the lookup finds nothing
+	sessionFilter, err := newEventFilter[event.SessionEvent](nil, event.SessionEventNS, map[string]interface{}{
+		EventFilterOption: `anyOf(identity.roleAttributes) = "contractors"`,
+	})
+	req.NoError(err)
+	req.False(sessionFilter.Matches(&event.SessionEvent{IdentityId: "i1"}))
+
+	_, err = newEventFilter[event.CircuitEvent](nil, event.CircuitEventNS, map[string]interface{}{
+		EventFilterOption: `identity.name = "foo"`,
+	})
+	req.Error(err)
+}
+
+func Test_EventFilterValidation(t *testing.T) {
+	req := require.New(t)
+
+	filter, err := newEventFilter[event.CircuitEvent](nil, event.CircuitEventNS, map[string]interface{}{})
+	req.NoError(err)
+	req.Nil(filter)
+
+	_, err = newEventFilter[event.CircuitEvent](nil, event.CircuitEventNS, map[string]interface{}{
+		EventFilterOption: `not_a_field = "foo"`,
+	})
+	req.Error(err)
+
+	_, err = newEventFilter[event.CircuitEvent](nil, event.CircuitEventNS, map[string]interface{}{
+		EventFilterOption: `circuit_id = "foo" limit 5`,
+	})
+	req.Error(err)
+
+	_, err = newEventFilter[event.CircuitEvent](nil, event.CircuitEventNS, map[string]interface{}{
+		EventFilterOption: 5,
+	})
+	req.Error(err)
+
+	req.Error(rejectEventFilter(event.MetricsEventNS, map[string]interface{}{EventFilterOption: "true"}))
+}
+
+type testCircuitEventCollector struct {
+	sync.Mutex
+	events []*event.CircuitEvent
+}
+
+func (self *testCircuitEventCollector) AcceptCircuitEvent(evt *event.CircuitEvent) {
+	self.Lock()
+	defer self.Unlock()
+	self.events = append(self.events, evt)
+}
+
+func (self *testCircuitEventCollector) getEvents() []*event.CircuitEvent {
+	self.Lock()
+	defer self.Unlock()
+	return append([]*event.CircuitEvent(nil), self.events...)
+}
+
+func Test_EventFilterSubscription(t *testing.T) {
+	req := require.New(t)
+
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+
+	dispatcher := NewDispatcher(closeNotify)
+	collector := &testCircuitEventCollector{}
+
+	err := dispatcher.ProcessSubscriptions(collector, []*event.Subscription{
+		{
+			Type: event.CircuitEventNS,
+			Options: map[string]interface{}{
+				"include":         []interface{}{"failed"},
+				EventFilterOption: `service_id = "svc1"`,
+			},
+		},
+	})
+	req.NoError(err)
+
+	dispatcher.AcceptCircuitEvent(&event.CircuitEvent{EventType: event.CircuitFailed, CircuitId: "c1", ServiceId: "svc1"})
+	dispatcher.AcceptCircuitEvent(&event.CircuitEvent{EventType: event.CircuitFailed, CircuitId: "c2", ServiceId: "svc2"})
+	dispatcher.AcceptCircuitEvent(&event.CircuitEvent{EventType: event.CircuitCreated, CircuitId: "c3", ServiceId: "svc1"})
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) && len(collector.getEvents()) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	events := collector.getEvents()
+	req.Len(events, 1)
+	req.Equal("c1", events[0].CircuitId)
+
+	dispatcher.RemoveAllSubscriptions(collector)
+	req.Len(dispatcher.circuitEventHandlers.Value(), 0)
+
+	err = dispatcher.ProcessSubscriptions(collector, []*event.Subscription{
+		{
+			Type: event.CircuitEventNS,
+			Options: map[string]interface{}{
+				EventFilterOption: `no_such_field = 1`,
+			},
+		},
+	})
+	req.Error(err)
+}
@@ -0,0 +1,207 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hanzozt/zt/v2/common/pb/event_pb"
+	"github.com/hanzozt/zt/v2/controller/event"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protodelim"
+)
+
+func newTestAuthFailedEvent() *event.AuthenticationEvent {
+	return &event.AuthenticationEvent{
+		Namespace:       event.AuthenticationEventNS,
+		EventSrcId:      "ctrl1",
+		Timestamp:       time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+		EventType:       event.AuthenticationEventTypeFail,
+		IdentityId:      "i1",
+		RemoteAddress:   "10.0.0.5:4412",
+		Success:         false,
+		FailureReason:   "invalid | password = bad\nagain",
+		AuthenticatorId: "auth1",
+	}
+}
+
+func Test_CefEncoder(t *testing.T) {
+	req := require.New(t)
+
+	encoder := NewCefEncoder()
+	encoder.deviceVersion = "v1.2.3"
+
+	buf, err := encoder.Encode("authentication", newTestAuthFailedEvent())
+	req.NoError(err)
+
+	record := string(buf)
+	req.True(strings.HasPrefix(record, "CEF:0|Hanzo|ZT|v1.2.3|authentication.fail|authentication fail|7|"), record)
+	req.Contains(record, "rt=1714564800000 ")
+	req.Contains(record, " deviceExternalId=ctrl1 ")
+	req.Contains(record, " act=fail ")
+	req.Contains(record, " suid=i1 ")
+	req.Contains(record, " src=10.0.0.5 ")
+	req.Contains(record, ` reason=invalid | password \= bad\nagain `)
+	req.Contains(record, " outcome=failure ")
+	req.Contains(record, " ztAuthenticatorId=auth1")
+	req.NotContains(record, "\n")
+	req.NotContains(record, "namespace")
+}
+
+func Test_CefEncoderHeaderEscaping(t *testing.T) {
+	req := require.New(t)
+
+	encoder := NewCefEncoder()
+	encoder.deviceVersion = `v1|x\y`
+
+	buf, err := encoder.Encode("circuit", &event.CircuitEvent{
+		EventType: event.CircuitFailed,
+		CircuitId: "c1",
+		Path:      event.CircuitPath{Nodes: []string{"r1", "r2"}},
+	})
+	req.NoError(err)
+
+	record := string(buf)
+	req.True(strings.HasPrefix(record, `CEF:0|Hanzo|ZT|v1\|x\\y|circuit.failed|circuit failed|6|`), record)
+	req.Contains(record, "ztCircuitId=c1")
+	req.Contains(record, "ztPathNodes=r1,r2")
+}
+
+func Test_SyslogEncoder(t *testing.T) {
+	req := require.New(t)
+
+	encoder := NewSyslogEncoder()
+	encoder.hostname = "ctrl-host"
+	encoder.procId = "42"
+
+	buf, err := encoder.Encode("authentication", newTestAuthFailedEvent())
+	req.NoError(err)
+
+	// facility local0 (16) * 8 + warning (4)
+	req.True(strings.HasPrefix(string(buf),
+		"<132>1 2024-05-01T12:00:00.000000Z ctrl-host zt-controller 42 authentication - CEF:0|Hanzo|ZT|"), string(buf))
+}
+
+func Test_OtlpEncoder(t *testing.T) {
+	req := require.New(t)
+
+	buf, err := NewOtlpEncoder().Encode("authentication", newTestAuthFailedEvent())
+	req.NoError(err)
+
+	request := &otlpLogsRequest{}
+	req.NoError(json.Unmarshal(buf, request))
+	req.Len(request.ResourceLogs, 1)
+
+	resourceAttrs := map[string]string{}
+	for _, attr := range request.ResourceLogs[0].Resource.Attributes {
+		resourceAttrs[attr.Key] = *attr.Value.StringValue
+	}
+	req.Equal("zt-controller", resourceAttrs["service.name"])
+	req.Equal("ctrl1", resourceAttrs["service.instance.id"])
+
+	req.Len(request.ResourceLogs[0].ScopeLogs, 1)
+	req.Len(request.ResourceLogs[0].ScopeLogs[0].LogRecords, 1)
+	record := request.ResourceLogs[0].ScopeLogs[0].LogRecords[0]
+	req.Equal("1714564800000000000", record.TimeUnixNano)
+	req.Equal(otlpSeverityWarn, record.SeverityNumber)
+	req.Equal("WARN", record.SeverityText)
+	req.Equal("event.name", record.Attributes[0].Key)
+	req.Equal("zt.authentication", *record.Attributes[0].Value.StringValue)
+
+	body := map[string]*otlpAnyValue{}
+	for _, kv := range record.Body.KvlistValue.Values {
+		body[kv.Key] = kv.Value
+	}
+	req.Equal("i1", *body["identity_id"].StringValue)
+	req.False(*body["success"].BoolValue)
+
+	// integers are encoded as strings, per the OTLP/JSON spec
+	buf, err = NewOtlpEncoder().Encode("usage.v3", &event.UsageEventV3{
+		Usage: map[string]uint64{"ingress.rx": 100},
+	})
+	req.NoError(err)
+	req.Contains(string(buf), `{"key":"ingress.rx","value":{"intValue":"100"}}`)
+}
+
+func Test_ProtobufEncoder(t *testing.T) {
+	req := require.New(t)
+
+	ts := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	encoder := NewProtobufEncoder()
+
+	out := &bytes.Buffer{}
+
+	buf, err := encoder.Encode("usage.v3", &event.UsageEventV3{
+		Namespace:  event.UsageEventNS,
+		EventSrcId: "ctrl1",
+		Timestamp:  ts,
+		Version:    3,
+		CircuitId:  "c1",
+		Usage:      map[string]uint64{"ingress.rx": 100, "egress.tx": 200},
+		Tags:       map[string]string{"serviceId": "svc1"},
+	})
+	req.NoError(err)
+	out.Write(buf)
+
+	buf, err = encoder.Encode("metrics", &event.MetricsEvent{
+		Namespace: event.MetricsEventNS,
+		Timestamp: ts,
+		Metric:    "link.latency",
+		Metrics:   map[string]any{"count": int64(5), "p99": 1.5, "size": uint32(7)},
+	})
+	req.NoError(err)
+	out.Write(buf)
+
+	buf, err = encoder.Encode("authentication", newTestAuthFailedEvent())
+	req.NoError(err)
+	out.Write(buf)
+
+	reader := bufio.NewReader(out)
+
+	msg := &event_pb.Event{}
+	req.NoError(protodelim.UnmarshalFrom(reader, msg))
+	req.Equal(event.UsageEventNS, msg.Namespace)
+	req.Equal("ctrl1", msg.EventSrcId)
+	req.Equal(ts.UnixNano(), msg.Timestamp)
+	req.Equal("c1", msg.GetUsageV3().CircuitId)
+	req.Equal(uint64(200), msg.GetUsageV3().Usage["egress.tx"])
+	req.Equal("svc1", msg.GetUsageV3().Tags["serviceId"])
+
+	msg = &event_pb.Event{}
+	req.NoError(protodelim.UnmarshalFrom(reader, msg))
+	req.Equal("link.latency", msg.GetMetrics().Metric)
+	req.Equal(int64(5), msg.GetMetrics().IntMetrics["count"])
+	req.Equal(int64(7), msg.GetMetrics().IntMetrics["size"])
+	req.Equal(1.5, msg.GetMetrics().FloatMetrics["p99"])
+
+	msg = &event_pb.Event{}
+	req.NoError(protodelim.UnmarshalFrom(reader, msg))
+	req.Equal(event.AuthenticationEventNS, msg.Namespace)
+	req.Equal(ts.UnixNano(), msg.Timestamp)
+
+	authEvent := &event.AuthenticationEvent{}
+	req.NoError(json.Unmarshal(msg.GetJson(), authEvent))
+	req.Equal("i1", authEvent.IdentityId)
+
+	_, err = reader.ReadByte()
+	req.Error(err)
+}
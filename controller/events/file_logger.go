@@ -22,6 +22,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/hanzozt/zt/v2/controller/event"
 	"github.com/natefinch/lumberjack"
 	"github.com/pkg/errors"
 )
@@ -29,13 +30,43 @@ import (
 type fabricFormatterFactory struct{}
 
 func (f fabricFormatterFactory) NewLoggingHandler(format string, buffer int, out io.WriteCloser) (interface{}, error) {
+	return f.NewFormattedSinkHandler(format, buffer, NewWriterEventSink(out))
+}
+
+// NewFormattedSinkHandler is used by handlers which need to know the event type of each formatted event,
+// such as those which route events to different destinations.
+func (f fabricFormatterFactory) NewFormattedSinkHandler(format string, buffer int, sink event.FormattedEventSink) (interface{}, error) {
 	if strings.EqualFold(format, "json") {
-		return NewJsonFormatter(buffer, NewWriterEventSink(out)), nil
+		return NewJsonFormatter(buffer, sink), nil
+	}
+
+	if encoder := newEventEncoder(format); encoder != nil {
+		return NewEncodingFormatter(buffer, sink, encoder), nil
 	}
 
 	return nil, errors.Errorf("invalid 'format' for event log output file: %v", format)
 }
 
+// newEventEncoder returns the EventEncoder for the given format, or nil if the format isn't handled by an encoder
+func newEventEncoder(format string) EventEncoder {
+	switch strings.ToLower(format) {
+	case "cef":
+		return NewCefEncoder()
+	case "syslog":
+		return NewSyslogEncoder()
+	case "otlp":
+		return NewOtlpEncoder()
+	case "protobuf":
+		return NewProtobufEncoder()
+	}
+	return nil
+}
+
+// isBinaryEventFormat returns true for formats whose events are length-delimited rather than newline separated
+func isBinaryEventFormat(format string) bool {
+	return strings.EqualFold(format, "protobuf")
+}
+
 type StdOutLoggerFactory struct{}
 
 func (StdOutLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
@@ -57,7 +88,7 @@ func NewFileEventLogger(formatterFactory LoggingHandlerFactory, stdout bool, con
 		}
 	}
 
-	var output = &newlineWriter{out: os.Stdout}
+	var output io.WriteCloser = os.Stdout
 
 	if !stdout {
 		// allow config to override the max file size
@@ -94,17 +125,18 @@ func NewFileEventLogger(formatterFactory LoggingHandlerFactory, stdout bool, con
 			return nil, errors.New("missing required 'path' config for events FileLogger handler")
 		}
 
-		output = &newlineWriter{
-			out: &lumberjack.Logger{
-				Filename:   filepath,
-				MaxSize:    maxsize,
-				MaxBackups: maxBackupFiles,
-			},
+		output = &lumberjack.Logger{
+			Filename:   filepath,
+			MaxSize:    maxsize,
+			MaxBackups: maxBackupFiles,
 		}
 	}
 
 	if value, found := config["format"]; found {
 		if format, ok := value.(string); ok {
+			if !isBinaryEventFormat(format) {
+				output = &newlineWriter{out: output}
+			}
 			return formatterFactory.NewLoggingHandler(format, bufferSize, output)
 		}
 		return nil, errors.New("invalid 'format' for event log output file")
@@ -0,0 +1,277 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/pkg/errors"
+)
+
+var invalidKafkaTopicChars = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
+
+// KafkaEventLoggerFactory creates handlers which publish events to Kafka brokers. Each event namespace is
+// published to its own topic, and events are keyed by entity id, so that all events for a given circuit,
+// session, identity, etc. end up in the same partition.
+//
+// Example configuration:
+//
+//	handler:
+//	  type: kafka
+//	  format: json
+//	  brokers:
+//	    - kafka1:9092
+//	    - kafka2:9092
+//	  topicPrefix: zt.
+//	  topics:
+//	    usage: billing.usage
+//	  tls: true
+//	  username: zt
+//	  password: secret
+//	  batchSize: 500
+//	  spillDir: /var/lib/zt/kafka-spill
+//
+// With the configuration above, circuit events go to the zt.circuit topic, while usage events go to
+// billing.usage. If username is set, SASL/PLAIN authentication is used.
+type KafkaEventLoggerFactory struct{}
+
+func (KafkaEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
+	return NewKafkaEventLogger(fabricFormatterFactory{}, config)
+}
+
+type kafkaConfig struct {
+	brokers     []string
+	topicPrefix string
+	topics      map[string]string
+	producer    *sarama.Config
+}
+
+func parseKafkaConfig(config map[interface{}]interface{}) (*kafkaConfig, error) {
+	result := &kafkaConfig{
+		topicPrefix: "zt.",
+		producer:    sarama.NewConfig(),
+	}
+
+	var err error
+	if result.brokers, err = getStringListConfig(config, "brokers"); err != nil {
+		return nil, err
+	}
+
+	if len(result.brokers) == 0 {
+		return nil, errors.New("at least one kafka broker must be specified in brokers")
+	}
+
+	if value, found := config["topicPrefix"]; found {
+		if prefix, ok := value.(string); ok {
+			result.topicPrefix = prefix
+		} else {
+			return nil, errors.Errorf("invalid kafka topicPrefix %v, must be a string", value)
+		}
+	}
+
+	if result.topics, err = getStringMapConfig(config, "topics"); err != nil {
+		return nil, err
+	}
+
+	producerConfig := result.producer
+	producerConfig.ClientID = "zt-controller"
+	producerConfig.Producer.Return.Successes = true
+	producerConfig.Producer.RequiredAcks = sarama.WaitForAll
+	producerConfig.Producer.Partitioner = sarama.NewHashPartitioner
+
+	if value, found := config["clientId"]; found {
+		if clientId, ok := value.(string); ok && clientId != "" {
+			producerConfig.ClientID = clientId
+		} else {
+			return nil, errors.Errorf("invalid kafka clientId %v, must be a string", value)
+		}
+	}
+
+	if value, found := config["version"]; found {
+		if producerConfig.Version, err = sarama.ParseKafkaVersion(fmt.Sprintf("%v", value)); err != nil {
+			return nil, errors.Wrapf(err, "invalid kafka version %v", value)
+		}
+	}
+
+	timeout, err := getDurationConfig(config, "timeout", 10*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	producerConfig.Net.DialTimeout = timeout
+	producerConfig.Net.ReadTimeout = timeout
+	producerConfig.Net.WriteTimeout = timeout
+	producerConfig.Producer.Timeout = timeout
+
+	if value, found := config["tls"]; found {
+		if enabled, ok := value.(bool); ok {
+			producerConfig.Net.TLS.Enable = enabled
+			producerConfig.Net.TLS.Config = &tls.Config{MinVersion: tls.VersionTLS12}
+		} else {
+			return nil, errors.Errorf("invalid kafka tls %v, must be a boolean", value)
+		}
+	}
+
+	if value, found := config["username"]; found {
+		producerConfig.Net.SASL.Enable = true
+		producerConfig.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+		producerConfig.Net.SASL.User = fmt.Sprintf("%v", value)
+		if password, found := config["password"]; found {
+			producerConfig.Net.SASL.Password = fmt.Sprintf("%v", password)
+		}
+	}
+
+	if value, found := config["compression"]; found {
+		if err = producerConfig.Producer.Compression.UnmarshalText([]byte(fmt.Sprintf("%v", value))); err != nil {
+			return nil, errors.Wrapf(err, "invalid kafka compression %v", value)
+		}
+	}
+
+	if err = producerConfig.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid kafka configuration")
+	}
+
+	return result, nil
+}
+
+// kafkaSender produces batches of events using a sarama sync producer. The producer is created when the
+// first batch is delivered, so an unreachable cluster doesn't prevent the controller from starting. Failed
+// batches are retried by the batching sink.
+type kafkaSender struct {
+	config   *kafkaConfig
+	lock     sync.Mutex
+	producer sarama.SyncProducer
+}
+
+func (self *kafkaSender) getTopic(namespace string) string {
+	if topic, ok := self.config.topics[namespace]; ok {
+		return topic
+	}
+	return invalidKafkaTopicChars.ReplaceAllString(self.config.topicPrefix+namespace, "_")
+}
+
+func (self *kafkaSender) newMessages(batch []*sinkRecord) []*sarama.ProducerMessage {
+	msgs := make([]*sarama.ProducerMessage, 0, len(batch))
+	for _, record := range batch {
+		msg := &sarama.ProducerMessage{
+			Topic: self.getTopic(record.Namespace),
+			Value: sarama.ByteEncoder(record.Payload),
+		}
+		// un-keyed messages are spread across partitions by the hash partitioner
+		if record.Key != "" {
+			msg.Key = sarama.StringEncoder(record.Key)
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs
+}
+
+func (self *kafkaSender) getProducer() (sarama.SyncProducer, error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if self.producer == nil {
+		producer, err := sarama.NewSyncProducer(self.config.brokers, self.config.producer)
+		if err != nil {
+			return nil, err
+		}
+		self.producer = producer
+	}
+	return self.producer, nil
+}
+
+func (self *kafkaSender) send(msgs []*sarama.ProducerMessage) error {
+	producer, err := self.getProducer()
+	if err != nil {
+		return errors.Wrap(err, "unable to connect to kafka")
+	}
+
+	// if only some messages fail, the whole batch is retried, so consumers should be prepared for
+	// at-least-once delivery
+	if err = producer.SendMessages(msgs); err != nil {
+		var produceErrs sarama.ProducerErrors
+		if errors.As(err, &produceErrs) && len(produceErrs) > 0 {
+			return errors.Wrapf(produceErrs[0].Err, "failed to produce %d of %d events to kafka, topic %s", len(produceErrs), len(msgs), produceErrs[0].Msg.Topic)
+		}
+		return err
+	}
+	return nil
+}
+
+func (self *kafkaSender) deliver(_ context.Context, batch []*sinkRecord) error {
+	return self.send(self.newMessages(batch))
+}
+
+func (self *kafkaSender) Close() error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if self.producer != nil {
+		err := self.producer.Close()
+		self.producer = nil
+		return err
+	}
+	return nil
+}
+
+// kafkaEventSink closes the producer once the batching sink has flushed or spilled its remaining events
+type kafkaEventSink struct {
+	*batchingEventSink
+	sender *kafkaSender
+}
+
+func (self *kafkaEventSink) Close() error {
+	_ = self.batchingEventSink.Close()
+	return self.sender.Close()
+}
+
+func NewKafkaEventLogger(formatterFactory fabricFormatterFactory, config map[interface{}]interface{}) (interface{}, error) {
+	conf, err := parseKafkaConfig(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse kafka config")
+	}
+
+	batchConfig, err := parseBatchingSinkConfig(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse kafka config")
+	}
+
+	sender := &kafkaSender{
+		config: conf,
+	}
+
+	if value, found := config["format"]; found {
+		if format, ok := value.(string); ok {
+			sink := &kafkaEventSink{
+				batchingEventSink: newBatchingEventSink("kafka", batchConfig, sender.deliver),
+				sender:            sender,
+			}
+			handler, err := formatterFactory.NewFormattedSinkHandler(format, batchConfig.bufferSize, sink)
+			if err != nil {
+				_ = sink.Close()
+			}
+			return handler, err
+		}
+		return nil, errors.New("invalid 'format' for event kafka handler")
+	}
+	return nil, errors.New("'format' must be specified for event handler")
+}
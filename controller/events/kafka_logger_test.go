@@ -0,0 +1,178 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/hanzozt/zt/v2/controller/event"
+	"github.com/stretchr/testify/require"
+)
+
+func newMockKafkaBroker(t *testing.T, topics ...string) *sarama.MockBroker {
+	broker := sarama.NewMockBroker(t, 1)
+
+	metadata := sarama.NewMockMetadataResponse(t).
+		SetBroker(broker.Addr(), broker.BrokerID()).
+		SetController(broker.BrokerID())
+	for _, topic := range topics {
+		metadata.SetLeader(topic, 0, broker.BrokerID())
+		metadata.SetLeader(topic, 1, broker.BrokerID())
+	}
+
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": metadata,
+		"ProduceRequest":  sarama.NewMockProduceResponse(t),
+	})
+	return broker
+}
+
+func newTestKafkaSender(t *testing.T, broker *sarama.MockBroker, config map[interface{}]interface{}) *kafkaSender {
+	config["brokers"] = []interface{}{broker.Addr()}
+	conf, err := parseKafkaConfig(config)
+	require.NoError(t, err)
+	conf.producer.Producer.Retry.Max = 0
+	return &kafkaSender{config: conf}
+}
+
+func Test_KafkaSenderRoutesByNamespaceAndKey(t *testing.T) {
+	req := require.New(t)
+
+	broker := newMockKafkaBroker(t, "zt.circuit", "billing.usage")
+	defer broker.Close()
+
+	sender := newTestKafkaSender(t, broker, map[interface{}]interface{}{
+		"topics": map[interface{}]interface{}{
+			event.UsageEventNS: "billing.usage",
+		},
+	})
+	defer func() { _ = sender.Close() }()
+
+	msgs := sender.newMessages([]*sinkRecord{
+		{Namespace: event.CircuitEventNS, Key: "c1", Payload: []byte(`{"circuit_id":"c1"}`)},
+		{Namespace: event.UsageEventNS, Key: "c2", Payload: []byte(`{"circuit_id":"c2"}`)},
+		{Namespace: event.CircuitEventNS, Key: "c1", Payload: []byte(`{"circuit_id":"c1"}`)},
+		{Namespace: event.CircuitEventNS, Payload: []byte(`{}`)},
+	})
+	req.NoError(sender.send(msgs))
+
+	req.Equal("zt.circuit", msgs[0].Topic)
+	req.Equal("billing.usage", msgs[1].Topic)
+	req.Equal(sarama.StringEncoder("c1"), msgs[0].Key)
+	req.Nil(msgs[3].Key)
+
+	// events for the same entity land on the same partition
+	req.Equal(msgs[0].Partition, msgs[2].Partition)
+
+	produceRequests := 0
+	for _, rr := range broker.History() {
+		if _, ok := rr.Request.(*sarama.ProduceRequest); ok {
+			produceRequests++
+		}
+	}
+	req.True(produceRequests > 0)
+}
+
+func Test_KafkaSenderReportsProduceErrors(t *testing.T) {
+	req := require.New(t)
+
+	broker := sarama.NewMockBroker(t, 1)
+	defer broker.Close()
+
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetLeader("zt.circuit", 0, broker.BrokerID()),
+		"ProduceRequest": sarama.NewMockProduceResponse(t).
+			SetError("zt.circuit", 0, sarama.ErrNotEnoughReplicas),
+	})
+
+	sender := newTestKafkaSender(t, broker, map[interface{}]interface{}{})
+	defer func() { _ = sender.Close() }()
+
+	err := sender.deliver(context.Background(), []*sinkRecord{
+		{Namespace: event.CircuitEventNS, Key: "c1", Payload: []byte(`{"circuit_id":"c1"}`)},
+	})
+	req.ErrorIs(err, sarama.ErrNotEnoughReplicas)
+}
+
+func Test_KafkaEventLogger(t *testing.T) {
+	req := require.New(t)
+
+	broker := newMockKafkaBroker(t, "zt.circuit")
+	defer broker.Close()
+
+	handler, err := KafkaEventLoggerFactory{}.NewEventHandler(map[interface{}]interface{}{
+		"format":        "json",
+		"brokers":       []interface{}{broker.Addr()},
+		"flushInterval": "20ms",
+	})
+	req.NoError(err)
+
+	formatter := handler.(*JsonFormatter)
+
+	formatter.AcceptCircuitEvent(&event.CircuitEvent{
+		Namespace: event.CircuitEventNS,
+		CircuitId: "c1",
+	})
+
+	produced := func() bool {
+		for _, rr := range broker.History() {
+			if _, ok := rr.Request.(*sarama.ProduceRequest); ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) && !produced() {
+		time.Sleep(10 * time.Millisecond)
+	}
+	req.True(produced())
+	req.NoError(formatter.Close())
+}
+
+func Test_KafkaConfig(t *testing.T) {
+	req := require.New(t)
+
+	_, err := parseKafkaConfig(map[interface{}]interface{}{})
+	req.Error(err)
+
+	conf, err := parseKafkaConfig(map[interface{}]interface{}{
+		"brokers":     []interface{}{"localhost:9092"},
+		"compression": "zstd",
+		"version":     "2.8.0",
+		"username":    "zt",
+		"password":    "secret",
+	})
+	req.NoError(err)
+	req.Equal(sarama.CompressionZSTD, conf.producer.Producer.Compression)
+	req.True(conf.producer.Net.SASL.Enable)
+	req.Equal("zt", conf.producer.Net.SASL.User)
+}
+
+func Test_KafkaTopicNames(t *testing.T) {
+	req := require.New(t)
+
+	sender := &kafkaSender{config: &kafkaConfig{topicPrefix: "zt."}}
+	req.Equal("zt.entity.change", sender.getTopic("entity.change"))
+	req.Equal("zt.weird_name", sender.getTopic("weird/name"))
+}
@@ -45,7 +45,7 @@ func (self *linkMetricsMapper) mapMetrics(_ *metrics_pb.MetricsMessage, event *e
 		if parts := strings.Split(event.Metric, ":"); len(parts) == 2 {
 			name = parts[0]
 			linkId = parts[1]
-		} else if strings.HasSuffix(event.Metric, "latency") || strings.HasSuffix(event.Metric, "queue_time") {
+		} else if hasLinkMetricSuffix(event.Metric) {
 			name, linkId = ExtractId(event.Metric, "link.", 1)
 		} else {
 			name, linkId = ExtractId(event.Metric, "link.", 2)
@@ -65,6 +65,17 @@ func (self *linkMetricsMapper) mapMetrics(_ *metrics_pb.MetricsMessage, event *e
 	}
 }
 
+var singlePartLinkMetricSuffixes = []string{"latency", "queue_time", "loss", "jitter", "bandwidth"}
+
+func hasLinkMetricSuffix(name string) bool {
+	for _, suffix := range singlePartLinkMetricSuffixes {
+		if strings.HasSuffix(name, "."+suffix) {
+			return true
+		}
+	}
+	return false
+}
+
 func ExtractId(name string, prefix string, suffixLen int) (string, string) {
 	rest := strings.TrimPrefix(name, prefix)
 	vals := strings.Split(rest, ".")
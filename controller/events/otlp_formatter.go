@@ -0,0 +1,208 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hanzozt/zt/v2/common/version"
+)
+
+const (
+	OtlpServiceName = "zt-controller"
+	OtlpScopeName   = "github.com/hanzozt/zt/v2/controller/events"
+
+	otlpSeverityInfo  = 9
+	otlpSeverityWarn  = 13
+	otlpSeverityError = 17
+)
+
+// OtlpEncoder formats each event as an OTLP/JSON ExportLogsServiceRequest containing a single log record, so that
+// events can be fed to an OpenTelemetry collector, for example using the filelog receiver with the otlp_json parser.
+// The event fields are reported as the record body and the event type as the event.name attribute.
+type OtlpEncoder struct {
+	serviceVersion string
+}
+
+func NewOtlpEncoder() *OtlpEncoder {
+	return &OtlpEncoder{
+		serviceVersion: version.GetVersion(),
+	}
+}
+
+type otlpLogsRequest struct {
+	ResourceLogs []*otlpResourceLogs `json:"resourceLogs"`
+}
+
+type otlpResourceLogs struct {
+	Resource  otlpResource     `json:"resource"`
+	ScopeLogs []*otlpScopeLogs `json:"scopeLogs"`
+}
+
+type otlpResource struct {
+	Attributes []*otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeLogs struct {
+	Scope      otlpScope        `json:"scope"`
+	LogRecords []*otlpLogRecord `json:"logRecords"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano         string          `json:"timeUnixNano"`
+	ObservedTimeUnixNano string          `json:"observedTimeUnixNano"`
+	SeverityNumber       int             `json:"severityNumber"`
+	SeverityText         string          `json:"severityText"`
+	Body                 *otlpAnyValue   `json:"body"`
+	Attributes           []*otlpKeyValue `json:"attributes"`
+}
+
+type otlpKeyValue struct {
+	Key   string        `json:"key"`
+	Value *otlpAnyValue `json:"value"`
+}
+
+// otlpAnyValue follows the OTLP/JSON encoding, where 64 bit integers are encoded as strings
+type otlpAnyValue struct {
+	StringValue *string           `json:"stringValue,omitempty"`
+	BoolValue   *bool             `json:"boolValue,omitempty"`
+	IntValue    *string           `json:"intValue,omitempty"`
+	DoubleValue *float64          `json:"doubleValue,omitempty"`
+	ArrayValue  *otlpArrayValue   `json:"arrayValue,omitempty"`
+	KvlistValue *otlpKeyValueList `json:"kvlistValue,omitempty"`
+}
+
+type otlpArrayValue struct {
+	Values []*otlpAnyValue `json:"values"`
+}
+
+type otlpKeyValueList struct {
+	Values []*otlpKeyValue `json:"values"`
+}
+
+func otlpString(val string) *otlpAnyValue {
+	return &otlpAnyValue{StringValue: &val}
+}
+
+func (self *OtlpEncoder) Encode(eventType string, evt any) ([]byte, error) {
+	fields, err := getEventFields(evt)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceAttrs := []*otlpKeyValue{
+		{Key: "service.name", Value: otlpString(OtlpServiceName)},
+		{Key: "service.version", Value: otlpString(self.serviceVersion)},
+	}
+
+	if srcId, ok := fields["event_src_id"].(string); ok && srcId != "" {
+		resourceAttrs = append(resourceAttrs, &otlpKeyValue{Key: "service.instance.id", Value: otlpString(srcId)})
+	}
+
+	attrs := []*otlpKeyValue{
+		{Key: "event.name", Value: otlpString("zt." + eventType)},
+	}
+
+	subType := getEventSubType(fields)
+	if subType != "" {
+		attrs = append(attrs, &otlpKeyValue{Key: "zt.event_type", Value: otlpString(subType)})
+	}
+
+	severityNumber, severityText := getOtlpSeverity(fields, subType)
+
+	request := &otlpLogsRequest{
+		ResourceLogs: []*otlpResourceLogs{{
+			Resource: otlpResource{Attributes: resourceAttrs},
+			ScopeLogs: []*otlpScopeLogs{{
+				Scope: otlpScope{Name: OtlpScopeName, Version: self.serviceVersion},
+				LogRecords: []*otlpLogRecord{{
+					TimeUnixNano:         strconv.FormatInt(getEventTimestamp(fields).UnixNano(), 10),
+					ObservedTimeUnixNano: strconv.FormatInt(time.Now().UnixNano(), 10),
+					SeverityNumber:       severityNumber,
+					SeverityText:         severityText,
+					Body:                 toOtlpValue(fields),
+					Attributes:           attrs,
+				}},
+			}},
+		}},
+	}
+
+	return json.Marshal(request)
+}
+
+func getOtlpSeverity(fields map[string]any, subType string) (int, string) {
+	switch getCefSeverity(fields, subType) {
+	case 8:
+		return otlpSeverityError, "ERROR"
+	case 6, 7:
+		return otlpSeverityWarn, "WARN"
+	default:
+		return otlpSeverityInfo, "INFO"
+	}
+}
+
+func toOtlpValue(val any) *otlpAnyValue {
+	switch v := val.(type) {
+	case nil:
+		return &otlpAnyValue{}
+	case string:
+		return otlpString(v)
+	case bool:
+		return &otlpAnyValue{BoolValue: &v}
+	case json.Number:
+		str := v.String()
+		if !strings.ContainsAny(str, ".eE") {
+			if _, err := strconv.ParseInt(str, 10, 64); err == nil {
+				return &otlpAnyValue{IntValue: &str}
+			}
+		}
+		if f, err := v.Float64(); err == nil {
+			return &otlpAnyValue{DoubleValue: &f}
+		}
+		return otlpString(str)
+	case []any:
+		result := &otlpArrayValue{Values: make([]*otlpAnyValue, 0, len(v))}
+		for _, child := range v {
+			result.Values = append(result.Values, toOtlpValue(child))
+		}
+		return &otlpAnyValue{ArrayValue: result}
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		result := &otlpKeyValueList{Values: make([]*otlpKeyValue, 0, len(v))}
+		for _, key := range keys {
+			result.Values = append(result.Values, &otlpKeyValue{Key: key, Value: toOtlpValue(v[key])})
+		}
+		return &otlpAnyValue{KvlistValue: result}
+	default:
+		return otlpString(fmt.Sprintf("%v", v))
+	}
+}
@@ -0,0 +1,513 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hanzozt/zt/v2/controller/event"
+)
+
+const (
+	PrometheusContentType  = "text/plain; version=0.0.4; charset=utf-8"
+	OpenMetricsContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+)
+
+var invalidPrometheusNameChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// prometheusTagLabels maps well known metric and usage tags onto their prometheus label names. Tags not
+// listed here are converted from camel case to snake case.
+var prometheusTagLabels = map[string]string{
+	"clientId":       "identity_id",
+	"hostId":         "host_identity_id",
+	"identityId":     "identity_id",
+	"serviceId":      "service_id",
+	"terminatorId":   "terminator_id",
+	"sourceRouterId": "source_router_id",
+	"targetRouterId": "target_router_id",
+}
+
+var timerQuantiles = []struct {
+	key      string
+	quantile float64
+}{
+	{"p50", 0.5},
+	{"p75", 0.75},
+	{"p95", 0.95},
+	{"p99", 0.99},
+	{"p999", 0.999},
+	{"p9999", 0.9999},
+}
+
+// PrometheusMetricName converts a zt metric name into a valid prometheus metric name
+func PrometheusMetricName(metric string) string {
+	key := strings.ReplaceAll(metric, ":", "")
+	key = invalidPrometheusNameChars.ReplaceAllString(key, "_")
+
+	// Prometheus complains about metrics ending in _count, so "fix" that.
+	if strings.HasSuffix(key, "_count") {
+		key = strings.TrimSuffix(key, "_count") + "_c"
+	}
+
+	return "zt_" + key
+}
+
+// PrometheusLabelName converts a tag name into a valid prometheus label name
+func PrometheusLabelName(tag string) string {
+	if label, ok := prometheusTagLabels[tag]; ok {
+		return label
+	}
+
+	var sb strings.Builder
+	for i, r := range tag {
+		if r >= 'A' && r <= 'Z' {
+			if i > 0 {
+				sb.WriteRune('_')
+			}
+			sb.WriteRune(r - 'A' + 'a')
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+	return invalidPrometheusNameChars.ReplaceAllString(sb.String(), "_")
+}
+
+type PrometheusExpositionConfig struct {
+	// OpenMetrics selects the OpenMetrics 1.0 text format instead of the prometheus 0.0.4 text format
+	OpenMetrics bool
+
+	// IncludeTimestamps adds the metric timestamp to each sample
+	IncludeTimestamps bool
+
+	// IsRouter is used to determine if a metric source is a router, so it can be labeled with router_id
+	IsRouter func(id string) bool
+}
+
+type prometheusSample struct {
+	suffix    string
+	labels    map[string]string
+	value     float64
+	timestamp time.Time
+}
+
+type prometheusFamily struct {
+	name       string
+	metricType string
+	samples    []*prometheusSample
+}
+
+// A PrometheusExposition collects metrics events and counters and renders them in the prometheus or
+// OpenMetrics text exposition format. Samples are grouped by family, so each family is only described once,
+// regardless of how many routers or controllers reported it.
+type PrometheusExposition struct {
+	config   PrometheusExpositionConfig
+	families map[string]*prometheusFamily
+}
+
+func NewPrometheusExposition(config PrometheusExpositionConfig) *PrometheusExposition {
+	return &PrometheusExposition{
+		config:   config,
+		families: map[string]*prometheusFamily{},
+	}
+}
+
+func (self *PrometheusExposition) ContentType() string {
+	if self.config.OpenMetrics {
+		return OpenMetricsContentType
+	}
+	return PrometheusContentType
+}
+
+func (self *PrometheusExposition) getFamily(name, metricType string) *prometheusFamily {
+	if family, ok := self.families[name]; ok {
+		return family
+	}
+	family := &prometheusFamily{
+		name:       name,
+		metricType: metricType,
+	}
+	self.families[name] = family
+	return family
+}
+
+func (self *PrometheusExposition) addSample(family *prometheusFamily, suffix string, labels map[string]string, value float64, ts time.Time) {
+	family.samples = append(family.samples, &prometheusSample{
+		suffix:    suffix,
+		labels:    labels,
+		value:     value,
+		timestamp: ts,
+	})
+}
+
+// AddCounter adds a monotonically increasing value. The name should not include the _total suffix.
+func (self *PrometheusExposition) AddCounter(name string, labels map[string]string, value float64, ts time.Time) {
+	family := self.getFamily(name, "counter")
+	self.addSample(family, "_total", labels, value, ts)
+}
+
+// AddGauge adds a point in time value
+func (self *PrometheusExposition) AddGauge(name string, labels map[string]string, value float64, ts time.Time) {
+	family := self.getFamily(name, "gauge")
+	self.addSample(family, "", labels, value, ts)
+}
+
+// AddMetricsEvent converts the given metrics event into one or more prometheus samples
+func (self *PrometheusExposition) AddMetricsEvent(evt *event.MetricsEvent) error {
+	name := PrometheusMetricName(evt.Metric)
+	labels := self.GetLabels(evt)
+
+	switch evt.MetricType {
+	case "intValue", "floatValue":
+		if v, ok := prometheusValue(evt.Metrics["value"]); ok {
+			self.AddGauge(name, labels, v, evt.Timestamp)
+		}
+	case "meter":
+		if v, ok := prometheusValue(evt.Metrics["count"]); ok {
+			self.AddCounter(name, labels, v, evt.Timestamp)
+		}
+		if v, ok := prometheusValue(evt.Metrics["m1_rate"]); ok {
+			self.AddGauge(name+"_m1_rate", labels, v, evt.Timestamp)
+		}
+	case "histogram":
+		self.addSummary(name, labels, evt, 1)
+	case "timer":
+		self.addSummary(name+"_seconds", labels, evt, float64(time.Second))
+	default:
+		return fmt.Errorf("unhandled metric type %s", evt.MetricType)
+	}
+
+	return nil
+}
+
+// GetLabels returns the prometheus labels for the given metrics event. Besides the metric tags, the source and
+// entity ids are turned into router_id and link_id labels, where applicable.
+func (self *PrometheusExposition) GetLabels(evt *event.MetricsEvent) map[string]string {
+	labels := map[string]string{
+		"source_id": evt.SourceAppId,
+	}
+
+	if self.config.IsRouter != nil && self.config.IsRouter(evt.SourceAppId) {
+		labels["router_id"] = evt.SourceAppId
+	}
+
+	if evt.SourceEntityId != "" {
+		if strings.HasPrefix(evt.Metric, "link.") {
+			labels["link_id"] = evt.SourceEntityId
+		} else if strings.HasPrefix(evt.Metric, "ctrl.") {
+			labels["router_id"] = evt.SourceEntityId
+		} else {
+			labels["entity_id"] = evt.SourceEntityId
+		}
+	}
+
+	for k, v := range evt.Tags {
+		labels[PrometheusLabelName(k)] = v
+	}
+
+	return labels
+}
+
+// addSummary exposes histograms and timers. The underlying metrics only carry quantiles, not bucket counts, so
+// they are exposed as summaries.
+func (self *PrometheusExposition) addSummary(name string, labels map[string]string, evt *event.MetricsEvent, divisor float64) {
+	count, _ := prometheusValue(evt.Metrics["count"])
+	mean, _ := prometheusValue(evt.Metrics["mean"])
+
+	family := self.getFamily(name, "summary")
+	for _, q := range timerQuantiles {
+		if v, ok := prometheusValue(evt.Metrics[q.key]); ok {
+			self.addSample(family, "", withLabel(labels, "quantile", formatPrometheusFloat(q.quantile)), v/divisor, evt.Timestamp)
+		}
+	}
+	self.addSample(family, "_sum", labels, mean*count/divisor, evt.Timestamp)
+	self.addSample(family, "_count", labels, count, evt.Timestamp)
+}
+
+// WriteTo renders all collected families, sorted by name
+func (self *PrometheusExposition) WriteTo(w io.Writer) (int64, error) {
+	var names []string
+	for name := range self.families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var sb strings.Builder
+	for _, name := range names {
+		family := self.families[name]
+		typeName := family.name
+		if family.metricType == "counter" && !self.config.OpenMetrics {
+			typeName += "_total"
+		}
+
+		sb.WriteString(fmt.Sprintf("# HELP %s %s\n", typeName, family.name))
+		sb.WriteString(fmt.Sprintf("# TYPE %s %s\n", typeName, family.metricType))
+
+		lines := make([]string, 0, len(family.samples))
+		for _, sample := range family.samples {
+			lines = append(lines, self.formatSample(family, sample))
+		}
+		sort.Strings(lines)
+
+		for _, line := range lines {
+			sb.WriteString(line)
+		}
+	}
+
+	if self.config.OpenMetrics {
+		sb.WriteString("# EOF\n")
+	}
+
+	n, err := io.WriteString(w, sb.String())
+	return int64(n), err
+}
+
+func (self *PrometheusExposition) formatSample(family *prometheusFamily, sample *prometheusSample) string {
+	var sb strings.Builder
+	sb.WriteString(family.name)
+	sb.WriteString(sample.suffix)
+
+	if len(sample.labels) > 0 {
+		var keys []string
+		for k := range sample.labels {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		sb.WriteRune('{')
+		for idx, k := range keys {
+			if idx > 0 {
+				sb.WriteRune(',')
+			}
+			sb.WriteString(k)
+			sb.WriteString(`="`)
+			sb.WriteString(escapePrometheusLabelValue(sample.labels[k]))
+			sb.WriteRune('"')
+		}
+		sb.WriteRune('}')
+	}
+
+	sb.WriteRune(' ')
+	sb.WriteString(formatPrometheusFloat(sample.value))
+
+	if self.config.IncludeTimestamps && !sample.timestamp.IsZero() {
+		if self.config.OpenMetrics {
+			sb.WriteString(" " + strconv.FormatFloat(float64(sample.timestamp.UnixMilli())/1000, 'f', 3, 64))
+		} else {
+			sb.WriteString(fmt.Sprintf(" %d", sample.timestamp.UnixMilli()))
+		}
+	}
+
+	sb.WriteRune('\n')
+	return sb.String()
+}
+
+func withLabel(labels map[string]string, name, value string) map[string]string {
+	result := make(map[string]string, len(labels)+1)
+	for k, v := range labels {
+		result[k] = v
+	}
+	result[name] = value
+	return result
+}
+
+func escapePrometheusLabelValue(v string) string {
+	v = strings.ReplaceAll(v, `\`, `\\`)
+	v = strings.ReplaceAll(v, "\n", `\n`)
+	return strings.ReplaceAll(v, `"`, `\"`)
+}
+
+func formatPrometheusFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+func prometheusValue(v any) (float64, bool) {
+	switch val := v.(type) {
+	case int:
+		return float64(val), true
+	case int32:
+		return float64(val), true
+	case int64:
+		return float64(val), true
+	case uint32:
+		return float64(val), true
+	case uint64:
+		return float64(val), true
+	case float32:
+		return float64(val), true
+	case float64:
+		return val, true
+	}
+	return 0, false
+}
+
+const (
+	DefaultUsageMaxSeries    = 10000
+	DefaultUsageSeriesExpiry = time.Hour
+)
+
+type usageCounterKey struct {
+	routerId       string
+	usageType      string
+	serviceId      string
+	identityId     string
+	hostIdentityId string
+}
+
+type serviceCounterKey struct {
+	eventType    string
+	serviceId    string
+	terminatorId string
+}
+
+type usageCounter struct {
+	value       uint64
+	lastUpdated time.Time
+}
+
+// A PrometheusUsageCollector accumulates usage and service events into counters, so they can be exposed
+// alongside the polled metrics. Counters are aggregated by router, service and identity. Circuit ids are
+// not used as labels. To keep cardinality bounded, series which haven't been updated within the expiry
+// are dropped, and once maxSeries series are tracked, new series are counted as dropped instead of added.
+type PrometheusUsageCollector struct {
+	lock          sync.Mutex
+	maxSeries     int
+	expiry        time.Duration
+	usage         map[usageCounterKey]*usageCounter
+	serviceEvents map[serviceCounterKey]*usageCounter
+	dropped       uint64
+}
+
+func NewPrometheusUsageCollector(maxSeries int, expiry time.Duration) *PrometheusUsageCollector {
+	return &PrometheusUsageCollector{
+		maxSeries:     maxSeries,
+		expiry:        expiry,
+		usage:         map[usageCounterKey]*usageCounter{},
+		serviceEvents: map[serviceCounterKey]*usageCounter{},
+	}
+}
+
+func (self *PrometheusUsageCollector) seriesCount() int {
+	return len(self.usage) + len(self.serviceEvents)
+}
+
+func (self *PrometheusUsageCollector) AcceptUsageEventV3(evt *event.UsageEventV3) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	now := time.Now()
+	for usageType, amount := range evt.Usage {
+		key := usageCounterKey{
+			routerId:       evt.SourceId,
+			usageType:      usageType,
+			serviceId:      evt.Tags["serviceId"],
+			identityId:     evt.Tags["clientId"],
+			hostIdentityId: evt.Tags["hostId"],
+		}
+		counter, found := self.usage[key]
+		if !found {
+			if self.seriesCount() >= self.maxSeries {
+				self.dropped++
+				continue
+			}
+			counter = &usageCounter{}
+			self.usage[key] = counter
+		}
+		counter.value += amount
+		counter.lastUpdated = now
+	}
+}
+
+func (self *PrometheusUsageCollector) AcceptServiceEvent(evt *event.ServiceEvent) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	key := serviceCounterKey{
+		eventType:    evt.EventType,
+		serviceId:    evt.ServiceId,
+		terminatorId: evt.TerminatorId,
+	}
+	counter, found := self.serviceEvents[key]
+	if !found {
+		if self.seriesCount() >= self.maxSeries {
+			self.dropped++
+			return
+		}
+		counter = &usageCounter{}
+		self.serviceEvents[key] = counter
+	}
+	counter.value += evt.Count
+	counter.lastUpdated = time.Now()
+}
+
+// Collect drops expired series and adds the remaining counters to the given exposition
+func (self *PrometheusUsageCollector) Collect(exposition *PrometheusExposition) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	expired := time.Now().Add(-self.expiry)
+
+	for key, counter := range self.usage {
+		if counter.lastUpdated.Before(expired) {
+			delete(self.usage, key)
+			continue
+		}
+		labels := map[string]string{
+			"router_id": key.routerId,
+			"type":      key.usageType,
+		}
+		addLabelIfSet(labels, "service_id", key.serviceId)
+		addLabelIfSet(labels, "identity_id", key.identityId)
+		addLabelIfSet(labels, "host_identity_id", key.hostIdentityId)
+		exposition.AddCounter("zt_usage_bytes", labels, float64(counter.value), time.Time{})
+	}
+
+	for key, counter := range self.serviceEvents {
+		if counter.lastUpdated.Before(expired) {
+			delete(self.serviceEvents, key)
+			continue
+		}
+		labels := map[string]string{
+			"service_id": key.serviceId,
+		}
+		addLabelIfSet(labels, "terminator_id", key.terminatorId)
+		exposition.AddCounter(PrometheusMetricName(key.eventType), labels, float64(counter.value), time.Time{})
+	}
+
+	exposition.AddCounter("zt_usage_series_dropped", nil, float64(self.dropped), time.Time{})
+}
+
+func addLabelIfSet(labels map[string]string, name, value string) {
+	if value != "" {
+		labels[name] = value
+	}
+}
@@ -0,0 +1,170 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hanzozt/zt/v2/controller/event"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PrometheusNames(t *testing.T) {
+	req := require.New(t)
+	req.Equal("zt_link_latency", PrometheusMetricName("link.latency"))
+	req.Equal("zt_xgress_tx_msg_size_c", PrometheusMetricName("xgress.tx_msg_size.count"))
+	req.Equal("zt_api_session_create", PrometheusMetricName("api-session.create"))
+
+	req.Equal("service_id", PrometheusLabelName("serviceId"))
+	req.Equal("identity_id", PrometheusLabelName("clientId"))
+	req.Equal("some_custom_tag", PrometheusLabelName("someCustomTag"))
+}
+
+func Test_PrometheusExpositionGroupsFamilies(t *testing.T) {
+	req := require.New(t)
+
+	exposition := NewPrometheusExposition(PrometheusExpositionConfig{
+		IsRouter: func(id string) bool {
+			return strings.HasPrefix(id, "router")
+		},
+	})
+
+	for _, routerId := range []string{"router1", "router2"} {
+		req.NoError(exposition.AddMetricsEvent(&event.MetricsEvent{
+			MetricType:     "histogram",
+			SourceAppId:    routerId,
+			SourceEntityId: "link1",
+			Metric:         "link.latency",
+			Metrics: map[string]any{
+				"count": int64(2),
+				"mean":  float64(10),
+				"p50":   int64(10),
+				"p99":   int64(12),
+			},
+			Tags: map[string]string{"sourceRouterId": routerId},
+		}))
+	}
+
+	sb := &strings.Builder{}
+	_, err := exposition.WriteTo(sb)
+	req.NoError(err)
+
+	output := sb.String()
+	req.Equal(1, strings.Count(output, "# TYPE zt_link_latency summary"))
+	req.Contains(output, `zt_link_latency{link_id="link1",quantile="0.5",router_id="router1",source_id="router1",source_router_id="router1"} 10`)
+	req.Contains(output, `zt_link_latency_count{link_id="link1",router_id="router2",source_id="router2",source_router_id="router2"} 2`)
+	req.Contains(output, `zt_link_latency_sum{link_id="link1",router_id="router2",source_id="router2",source_router_id="router2"} 20`)
+	req.False(strings.Contains(output, "# EOF"))
+}
+
+func Test_PrometheusExpositionTimers(t *testing.T) {
+	req := require.New(t)
+
+	exposition := NewPrometheusExposition(PrometheusExpositionConfig{
+		OpenMetrics: true,
+	})
+
+	req.NoError(exposition.AddMetricsEvent(&event.MetricsEvent{
+		MetricType:  "timer",
+		SourceAppId: "ctrl1",
+		Metric:      "api-session.create",
+		Metrics: map[string]any{
+			"count": int64(100),
+			"mean":  float64(5 * time.Millisecond),
+			"min":   int64(2 * time.Millisecond),
+			"p50":   int64(5 * time.Millisecond),
+			"p99":   int64(20 * time.Millisecond),
+			"max":   int64(50 * time.Millisecond),
+		},
+	}))
+
+	sb := &strings.Builder{}
+	_, err := exposition.WriteTo(sb)
+	req.NoError(err)
+
+	output := sb.String()
+	req.Contains(output, "# TYPE zt_api_session_create_seconds summary\n")
+	req.Contains(output, `zt_api_session_create_seconds{quantile="0.5",source_id="ctrl1"} 0.005`)
+	req.Contains(output, `zt_api_session_create_seconds{quantile="0.99",source_id="ctrl1"} 0.02`)
+	req.Contains(output, `zt_api_session_create_seconds_sum{source_id="ctrl1"} 0.5`)
+	req.Contains(output, `zt_api_session_create_seconds_count{source_id="ctrl1"} 100`)
+	req.False(strings.Contains(output, "_bucket"))
+	req.True(strings.HasSuffix(output, "# EOF\n"))
+}
+
+func Test_PrometheusUsageCollector(t *testing.T) {
+	req := require.New(t)
+
+	collector := NewPrometheusUsageCollector(DefaultUsageMaxSeries, DefaultUsageSeriesExpiry)
+	for i := 0; i < 2; i++ {
+		collector.AcceptUsageEventV3(&event.UsageEventV3{
+			SourceId: "router1",
+			Usage:    map[string]uint64{"ingress.rx": 100},
+			Tags:     map[string]string{"serviceId": "svc1", "clientId": "id1"},
+		})
+	}
+	collector.AcceptServiceEvent(&event.ServiceEvent{
+		EventType: "service.dial.success",
+		ServiceId: "svc1",
+		Count:     3,
+	})
+
+	exposition := NewPrometheusExposition(PrometheusExpositionConfig{})
+	collector.Collect(exposition)
+
+	sb := &strings.Builder{}
+	_, err := exposition.WriteTo(sb)
+	req.NoError(err)
+
+	output := sb.String()
+	req.Contains(output, "# TYPE zt_usage_bytes_total counter\n")
+	req.Contains(output, `zt_usage_bytes_total{identity_id="id1",router_id="router1",service_id="svc1",type="ingress.rx"} 200`)
+	req.Contains(output, `zt_service_dial_success_total{service_id="svc1"} 3`)
+}
+
+func Test_PrometheusUsageCollectorBounds(t *testing.T) {
+	req := require.New(t)
+
+	collector := NewPrometheusUsageCollector(2, time.Minute)
+	for _, identityId := range []string{"id1", "id2", "id3"} {
+		collector.AcceptUsageEventV3(&event.UsageEventV3{
+			SourceId: "router1",
+			Usage:    map[string]uint64{"ingress.rx": 100},
+			Tags:     map[string]string{"serviceId": "svc1", "clientId": identityId},
+		})
+	}
+
+	exposition := NewPrometheusExposition(PrometheusExpositionConfig{})
+	collector.Collect(exposition)
+
+	sb := &strings.Builder{}
+	_, err := exposition.WriteTo(sb)
+	req.NoError(err)
+
+	output := sb.String()
+	req.Contains(output, `identity_id="id2"`)
+	req.NotContains(output, `identity_id="id3"`)
+	req.Contains(output, "zt_usage_series_dropped_total 1\n")
+
+	for _, counter := range collector.usage {
+		counter.lastUpdated = time.Now().Add(-2 * time.Minute)
+	}
+	collector.Collect(NewPrometheusExposition(PrometheusExpositionConfig{}))
+	req.Equal(0, len(collector.usage))
+}
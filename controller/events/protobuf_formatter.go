@@ -0,0 +1,174 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+
+	"github.com/hanzozt/zt/v2/common/pb/event_pb"
+	"github.com/hanzozt/zt/v2/controller/event"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// ProtobufEncoder formats events as length-delimited event_pb.Event messages. Usage and metrics events, which are
+// by far the highest volume events, are encoded using dedicated messages. All other events are carried as json.
+// Since the output is binary, each encoded event is prefixed by its size, encoded as a varint, rather than
+// being separated by newlines.
+type ProtobufEncoder struct{}
+
+func NewProtobufEncoder() *ProtobufEncoder {
+	return &ProtobufEncoder{}
+}
+
+func (self *ProtobufEncoder) Encode(eventType string, evt any) ([]byte, error) {
+	msg, err := self.toProto(eventType, evt)
+	if err != nil {
+		return nil, err
+	}
+
+	buf, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	size := uint64(len(buf))
+	result := protowire.AppendVarint(make([]byte, 0, protowire.SizeVarint(size)+len(buf)), size)
+	return append(result, buf...), nil
+}
+
+func (self *ProtobufEncoder) toProto(eventType string, evt any) (*event_pb.Event, error) {
+	switch e := evt.(type) {
+	case *event.UsageEventV2:
+		return &event_pb.Event{
+			Namespace:  e.Namespace,
+			EventSrcId: e.EventSrcId,
+			Timestamp:  e.Timestamp.UnixNano(),
+			Payload: &event_pb.Event_Usage{
+				Usage: &event_pb.UsageEvent{
+					Version:          e.Version,
+					EventType:        e.EventType,
+					SourceId:         e.SourceId,
+					CircuitId:        e.CircuitId,
+					Usage:            e.Usage,
+					IntervalStartUTC: e.IntervalStartUTC,
+					IntervalLength:   e.IntervalLength,
+					Tags:             e.Tags,
+				},
+			},
+		}, nil
+	case *event.UsageEventV3:
+		return &event_pb.Event{
+			Namespace:  e.Namespace,
+			EventSrcId: e.EventSrcId,
+			Timestamp:  e.Timestamp.UnixNano(),
+			Payload: &event_pb.Event_UsageV3{
+				UsageV3: &event_pb.UsageEventV3{
+					Version:          e.Version,
+					SourceId:         e.SourceId,
+					CircuitId:        e.CircuitId,
+					Usage:            e.Usage,
+					IntervalStartUTC: e.IntervalStartUTC,
+					IntervalLength:   e.IntervalLength,
+					Tags:             e.Tags,
+				},
+			},
+		}, nil
+	case *event.MetricsEvent:
+		return &event_pb.Event{
+			Namespace:  e.Namespace,
+			EventSrcId: e.EventSrcId,
+			Timestamp:  e.Timestamp.UnixNano(),
+			Payload: &event_pb.Event_Metrics{
+				Metrics: toProtoMetricsEvent(e),
+			},
+		}, nil
+	}
+
+	buf, err := json.Marshal(evt)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := getEventFields(evt)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &event_pb.Event{
+		Timestamp: getEventTimestamp(fields).UnixNano(),
+		Payload:   &event_pb.Event_Json{Json: buf},
+	}
+
+	if namespace, ok := fields["namespace"].(string); ok && namespace != "" {
+		result.Namespace = namespace
+	} else {
+		result.Namespace = eventType
+	}
+
+	if srcId, ok := fields["event_src_id"].(string); ok {
+		result.EventSrcId = srcId
+	}
+
+	return result, nil
+}
+
+func toProtoMetricsEvent(evt *event.MetricsEvent) *event_pb.MetricsEvent {
+	result := &event_pb.MetricsEvent{
+		Version:        evt.Version,
+		MetricType:     evt.MetricType,
+		SourceId:       evt.SourceAppId,
+		SourceEntityId: evt.SourceEntityId,
+		Metric:         evt.Metric,
+		Tags:           evt.Tags,
+		SourceEventId:  evt.SourceEventId,
+	}
+
+	for name, val := range evt.Metrics {
+		rv := reflect.ValueOf(val)
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			setIntMetric(result, name, rv.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if rv.Uint() > math.MaxInt64 {
+				setFloatMetric(result, name, float64(rv.Uint()))
+			} else {
+				setIntMetric(result, name, int64(rv.Uint()))
+			}
+		case reflect.Float32, reflect.Float64:
+			setFloatMetric(result, name, rv.Float())
+		}
+	}
+
+	return result
+}
+
+func setIntMetric(evt *event_pb.MetricsEvent, name string, val int64) {
+	if evt.IntMetrics == nil {
+		evt.IntMetrics = map[string]int64{}
+	}
+	evt.IntMetrics[name] = val
+}
+
+func setFloatMetric(evt *event_pb.MetricsEvent, name string, val float64) {
+	if evt.FloatMetrics == nil {
+		evt.FloatMetrics = map[string]float64{}
+	}
+	evt.FloatMetrics[name] = val
+}
@@ -0,0 +1,201 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	WebhookSignatureHeader = "X-ZT-Signature"
+	WebhookTimestampHeader = "X-ZT-Timestamp"
+)
+
+// WebhookEventLoggerFactory creates handlers which POST batches of events to an HTTP endpoint
+//
+// Example configuration:
+//
+//	handler:
+//	  type: webhook
+//	  format: json
+//	  url: https://siem.example.com/ingest
+//	  headers:
+//	    Authorization: Bearer abc123
+//	  hmacSecret: my-shared-secret
+//	  batchSize: 100
+//	  flushInterval: 1s
+//	  maxRetryTime: 1m
+//	  spillDir: /var/lib/zt/webhook-spill
+//
+// Batches are sent as newline delimited events. If an hmacSecret (or hmacSecretFile) is configured, each
+// request carries an X-ZT-Timestamp header and an X-ZT-Signature header of the form sha256=<hex>, which is
+// the HMAC-SHA256 of the timestamp, a period and the request body.
+type WebhookEventLoggerFactory struct{}
+
+func (WebhookEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
+	return NewWebhookEventLogger(fabricFormatterFactory{}, config)
+}
+
+type webhookConfig struct {
+	url         string
+	headers     map[string]string
+	hmacSecret  []byte
+	timeout     time.Duration
+	contentType string
+}
+
+func parseWebhookConfig(config map[interface{}]interface{}) (*webhookConfig, error) {
+	result := &webhookConfig{
+		timeout:     10 * time.Second,
+		contentType: "application/x-ndjson",
+	}
+
+	if value, found := config["url"]; !found {
+		return nil, errors.New("missing webhook url")
+	} else if u, ok := value.(string); ok && (strings.HasPrefix(u, "http://") || strings.HasPrefix(u, "https://")) {
+		result.url = u
+	} else {
+		return nil, errors.Errorf("invalid webhook url %v, must be an http or https url", value)
+	}
+
+	var err error
+	if result.headers, err = getStringMapConfig(config, "headers"); err != nil {
+		return nil, err
+	}
+
+	if value, found := config["hmacSecret"]; found {
+		if secret, ok := value.(string); ok && secret != "" {
+			result.hmacSecret = []byte(secret)
+		} else {
+			return nil, errors.Errorf("invalid webhook hmacSecret, must be a non-empty string")
+		}
+	}
+
+	if value, found := config["hmacSecretFile"]; found {
+		path, ok := value.(string)
+		if !ok {
+			return nil, errors.Errorf("invalid webhook hmacSecretFile %v, must be a string", value)
+		}
+		secret, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read webhook hmacSecretFile %s", path)
+		}
+		result.hmacSecret = bytes.TrimSpace(secret)
+	}
+
+	if result.timeout, err = getDurationConfig(config, "timeout", result.timeout); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+type webhookSender struct {
+	config *webhookConfig
+	client *http.Client
+}
+
+func (self *webhookSender) deliver(ctx context.Context, batch []*sinkRecord) error {
+	body := &bytes.Buffer{}
+	for _, record := range batch {
+		body.Write(record.Payload)
+		body.WriteByte('\n')
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, self.config.url, bytes.NewReader(body.Bytes()))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", self.config.contentType)
+	for k, v := range self.config.headers {
+		req.Header.Set(k, v)
+	}
+
+	if len(self.config.hmacSecret) > 0 {
+		ts := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(WebhookTimestampHeader, ts)
+		req.Header.Set(WebhookSignatureHeader, "sha256="+SignWebhookPayload(self.config.hmacSecret, ts, body.Bytes()))
+	}
+
+	resp, err := self.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook %s returned status %d", self.config.url, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// SignWebhookPayload returns the hex encoded HMAC-SHA256 of the timestamp and payload, which receivers can
+// use to validate the X-ZT-Signature header
+func SignWebhookPayload(secret []byte, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func NewWebhookEventLogger(formatterFactory fabricFormatterFactory, config map[interface{}]interface{}) (interface{}, error) {
+	conf, err := parseWebhookConfig(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse webhook config")
+	}
+
+	batchConfig, err := parseBatchingSinkConfig(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse webhook config")
+	}
+
+	sender := &webhookSender{
+		config: conf,
+		client: &http.Client{Timeout: conf.timeout},
+	}
+
+	if value, found := config["format"]; found {
+		if format, ok := value.(string); ok {
+			sink := newBatchingEventSink("webhook:"+conf.url, batchConfig, sender.deliver)
+			handler, err := formatterFactory.NewFormattedSinkHandler(format, batchConfig.bufferSize, sink)
+			if err != nil {
+				_ = sink.Close()
+			}
+			return handler, err
+		}
+		return nil, errors.New("invalid 'format' for event webhook")
+	}
+	return nil, errors.New("'format' must be specified for event handler")
+}
@@ -0,0 +1,262 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hanzozt/zt/v2/controller/event"
+	"github.com/stretchr/testify/require"
+)
+
+type webhookTestServer struct {
+	sync.Mutex
+	*httptest.Server
+	fail   atomic.Bool
+	bodies []string
+}
+
+func newWebhookTestServer(req *require.Assertions, secret string) *webhookTestServer {
+	result := &webhookTestServer{}
+	result.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if result.fail.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		req.NoError(err)
+
+		if secret != "" {
+			ts := r.Header.Get(WebhookTimestampHeader)
+			expected := "sha256=" + SignWebhookPayload([]byte(secret), ts, body)
+			if r.Header.Get(WebhookSignatureHeader) != expected {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+		}
+
+		req.Equal("yes", r.Header.Get("X-Test"))
+
+		result.Lock()
+		result.bodies = append(result.bodies, string(body))
+		result.Unlock()
+	}))
+	return result
+}
+
+func (self *webhookTestServer) lines() []string {
+	self.Lock()
+	defer self.Unlock()
+	var result []string
+	for _, body := range self.bodies {
+		result = append(result, strings.Split(strings.TrimSpace(body), "\n")...)
+	}
+	return result
+}
+
+func (self *webhookTestServer) waitForLines(count int) []string {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if lines := self.lines(); len(lines) >= count {
+			return lines
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return self.lines()
+}
+
+func Test_WebhookEventLogger(t *testing.T) {
+	req := require.New(t)
+
+	server := newWebhookTestServer(req, "secret")
+	defer server.Close()
+
+	handler, err := WebhookEventLoggerFactory{}.NewEventHandler(map[interface{}]interface{}{
+		"format":        "json",
+		"url":           server.URL,
+		"hmacSecret":    "secret",
+		"batchSize":     2,
+		"flushInterval": "50ms",
+		"headers": map[interface{}]interface{}{
+			"X-Test": "yes",
+		},
+	})
+	req.NoError(err)
+
+	formatter := handler.(*JsonFormatter)
+	defer func() { _ = formatter.Close() }()
+
+	for _, circuitId := range []string{"c1", "c2", "c3"} {
+		formatter.AcceptCircuitEvent(&event.CircuitEvent{
+			Namespace: event.CircuitEventNS,
+			CircuitId: circuitId,
+		})
+	}
+
+	lines := server.waitForLines(3)
+	req.Len(lines, 3)
+	req.Contains(lines[0], `"circuit_id":"c1"`)
+	req.Contains(lines[2], `"circuit_id":"c3"`)
+}
+
+func Test_WebhookEventLoggerSpill(t *testing.T) {
+	req := require.New(t)
+
+	server := newWebhookTestServer(req, "")
+	defer server.Close()
+	server.fail.Store(true)
+
+	spillDir, err := os.MkdirTemp("", "webhook-spill")
+	req.NoError(err)
+	defer func() { _ = os.RemoveAll(spillDir) }()
+
+	config := map[interface{}]interface{}{
+		"url":           server.URL,
+		"flushInterval": "20ms",
+		"maxRetryTime":  "50ms",
+		"spillDir":      spillDir,
+		"headers": map[interface{}]interface{}{
+			"X-Test": "yes",
+		},
+	}
+
+	webhookConfig, err := parseWebhookConfig(config)
+	req.NoError(err)
+	batchConfig, err := parseBatchingSinkConfig(config)
+	req.NoError(err)
+
+	sender := &webhookSender{config: webhookConfig, client: http.DefaultClient}
+	sink := newBatchingEventSink("test", batchConfig, sender.deliver)
+	defer func() { _ = sink.Close() }()
+
+	sink.AcceptFormattedEvent("circuit", []byte(`{"namespace":"circuit","circuit_id":"c1"}`))
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) && len(sink.listSpillFiles()) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	req.Len(sink.listSpillFiles(), 1)
+
+	server.fail.Store(false)
+
+	// a successful send triggers replay of the spilled events
+	sink.AcceptFormattedEvent("circuit", []byte(`{"namespace":"circuit","circuit_id":"c2"}`))
+
+	lines := server.waitForLines(2)
+	req.ElementsMatch([]string{
+		`{"namespace":"circuit","circuit_id":"c1"}`,
+		`{"namespace":"circuit","circuit_id":"c2"}`,
+	}, lines)
+
+	deadline = time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) && len(sink.listSpillFiles()) != 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	req.Len(sink.listSpillFiles(), 0)
+}
+
+func Test_WebhookConfigValidation(t *testing.T) {
+	req := require.New(t)
+
+	_, err := parseWebhookConfig(map[interface{}]interface{}{})
+	req.Error(err)
+
+	_, err = parseWebhookConfig(map[interface{}]interface{}{"url": "ftp://example.com"})
+	req.Error(err)
+
+	_, err = parseBatchingSinkConfig(map[interface{}]interface{}{"flushInterval": 5})
+	req.Error(err)
+}
+
+func Test_BatchingSinkSpillDuringReplay(t *testing.T) {
+	req := require.New(t)
+
+	spillDir, err := os.MkdirTemp("", "batching-spill")
+	req.NoError(err)
+	defer func() { _ = os.RemoveAll(spillDir) }()
+
+	batchConfig, err := parseBatchingSinkConfig(map[interface{}]interface{}{
+		"flushInterval": "20ms",
+		"maxRetryTime":  "50ms",
+		"spillDir":      spillDir,
+	})
+	req.NoError(err)
+
+	inReplay := make(chan struct{})
+	release := make(chan struct{})
+
+	lock := sync.Mutex{}
+	var delivered []string
+
+	deliver := func(ctx context.Context, batch []*sinkRecord) error {
+		for _, record := range batch {
+			if string(record.Payload) == "c1" {
+				select {
+				case <-inReplay:
+				default:
+					close(inReplay)
+					<-release
+				}
+			}
+			lock.Lock()
+			delivered = append(delivered, string(record.Payload))
+			lock.Unlock()
+		}
+		return nil
+	}
+
+	sink := newBatchingEventSink("test", batchConfig, deliver)
+	defer func() { _ = sink.Close() }()
+
+	sink.spill([]*sinkRecord{{EventType: "circuit", Namespace: "circuit", Payload: []byte("c1")}})
+
+	select {
+	case <-inReplay:
+	case <-time.After(5 * time.Second):
+		req.Fail("spilled events not replayed")
+	}
+
+	// events spilled while a replay is in progress must not be lost when the replayed file is removed
+	sink.spill([]*sinkRecord{{EventType: "circuit", Namespace: "circuit", Payload: []byte("c2")}})
+	close(release)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		lock.Lock()
+		count := len(delivered)
+		lock.Unlock()
+		if count >= 2 && len(sink.listSpillFiles()) == 0 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	req.ElementsMatch([]string{"c1", "c2"}, delivered)
+	req.Len(sink.listSpillFiles(), 0)
+}
@@ -0,0 +1,82 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_ctrl
+
+import (
+	"crypto/x509"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/hanzozt/channel/v4"
+	"github.com/hanzozt/zt/v2/common/pb/ctrl_pb"
+	"github.com/hanzozt/zt/v2/controller/model"
+	"github.com/hanzozt/zt/v2/controller/network"
+	"google.golang.org/protobuf/proto"
+)
+
+type certStatusHandler struct {
+	baseHandler
+}
+
+func newCertStatusHandler(router *model.Router, network *network.Network) *certStatusHandler {
+	return &certStatusHandler{
+		baseHandler: baseHandler{
+			router:  router,
+			network: network,
+		},
+	}
+}
+
+func (self *certStatusHandler) ContentType() int32 {
+	return int32(ctrl_pb.ContentType_CertStatusType)
+}
+
+func (self *certStatusHandler) HandleReceive(msg *channel.Message, ch channel.Channel) {
+	log := pfxlog.ContextLogger(ch.Label()).WithField("routerId", self.router.Id)
+
+	statusMsg := &ctrl_pb.CertStatus{}
+	if err := proto.Unmarshal(msg.Body, statusMsg); err != nil {
+		log.WithError(err).Error("unexpected error unmarshalling cert status")
+		return
+	}
+
+	state := &model.RouterCertState{
+		RenewalError: statusMsg.RenewalError,
+	}
+
+	var err error
+	if len(statusMsg.ClientCert) > 0 {
+		if state.ClientCert, err = x509.ParseCertificate(statusMsg.ClientCert); err != nil {
+			log.WithError(err).Error("unable to parse client cert in cert status")
+			return
+		}
+	}
+
+	if len(statusMsg.ServerCert) > 0 {
+		if state.ServerCert, err = x509.ParseCertificate(statusMsg.ServerCert); err != nil {
+			log.WithError(err).Error("unable to parse server cert in cert status")
+			return
+		}
+	}
+
+	if state.RenewalError != "" {
+		log.WithField("error", state.RenewalError).Error("router failed to renew certificates")
+	} else {
+		log.WithField("expiresAt", state.ExpiresAt()).Debug("router cert status received")
+	}
+
+	self.router.SetCertState(state)
+}
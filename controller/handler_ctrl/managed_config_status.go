@@ -0,0 +1,63 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_ctrl
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/hanzozt/channel/v4"
+	"github.com/hanzozt/zt/v2/common/pb/ctrl_pb"
+	"github.com/hanzozt/zt/v2/controller/model"
+	"github.com/hanzozt/zt/v2/controller/network"
+	"google.golang.org/protobuf/proto"
+)
+
+type managedConfigStatusHandler struct {
+	baseHandler
+}
+
+func newManagedConfigStatusHandler(router *model.Router, network *network.Network) *managedConfigStatusHandler {
+	return &managedConfigStatusHandler{
+		baseHandler: baseHandler{
+			router:  router,
+			network: network,
+		},
+	}
+}
+
+func (self *managedConfigStatusHandler) ContentType() int32 {
+	return int32(ctrl_pb.ContentType_ManagedConfigStatusType)
+}
+
+func (self *managedConfigStatusHandler) HandleReceive(msg *channel.Message, ch channel.Channel) {
+	log := pfxlog.ContextLogger(ch.Label()).WithField("routerId", self.router.Id)
+
+	statusMsg := &ctrl_pb.ManagedConfigStatus{}
+	if err := proto.Unmarshal(msg.Body, statusMsg); err != nil {
+		log.WithError(err).Error("unexpected error unmarshalling managed config status")
+		return
+	}
+
+	log = log.WithField("version", statusMsg.Version)
+
+	if statusMsg.Error != "" {
+		log.WithField("error", statusMsg.Error).Error("router failed to apply managed config")
+	} else {
+		log.WithField("changes", statusMsg.Changes).Info("router applied managed config")
+	}
+
+	self.router.SetConfigApplied(statusMsg.Version, statusMsg.Error, statusMsg.Changes)
+}
@@ -19,6 +19,7 @@ package handler_ctrl
 import (
 	"bytes"
 	"encoding/binary"
+	"time"
 
 	"github.com/hanzozt/channel/v4"
 	"github.com/hanzozt/zt/v2/common/ctrl_msg"
@@ -62,7 +63,7 @@ func (self *routeResultHandler) handleRouteResult(msg *channel.Message) {
 			circuitId := string(msg.Body)
 			peerData := xt.PeerData{}
 			for k, v := range msg.Headers {
-				if k > 0 && (k < ctrl_msg.RouteResultSuccessHeader || k > ctrl_msg.RouteResultErrorCodeHeader) {
+				if k > 0 && (k < ctrl_msg.RouteResultSuccessHeader || k > ctrl_msg.RouteResultDialTimeHeader) {
 					peerData[uint32(k)] = v
 				}
 			}
@@ -80,6 +81,10 @@ func (self *routeResultHandler) handleRouteResult(msg *channel.Message) {
 				rs.ErrorCode = &errCode
 			}
 
+			if dialTime, hasDialTime := msg.GetUint64Header(ctrl_msg.RouteResultDialTimeHeader); hasDialTime {
+				rs.DialDuration = time.Duration(dialTime)
+			}
+
 			routing := self.network.RouteResult(rs)
 			if !routing && attempt != network.SmartRerouteAttempt {
 				go self.notRoutingCircuit(circuitId)
@@ -0,0 +1,86 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_ctrl
+
+import (
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/hanzozt/channel/v4"
+	"github.com/hanzozt/zt/v2/common/pb/ctrl_pb"
+	"github.com/hanzozt/zt/v2/controller/event"
+	"github.com/hanzozt/zt/v2/controller/model"
+	"github.com/hanzozt/zt/v2/controller/network"
+	"google.golang.org/protobuf/proto"
+)
+
+type routerDrainStatusHandler struct {
+	baseHandler
+}
+
+func newRouterDrainStatusHandler(router *model.Router, network *network.Network) *routerDrainStatusHandler {
+	return &routerDrainStatusHandler{
+		baseHandler: baseHandler{
+			router:  router,
+			network: network,
+		},
+	}
+}
+
+func (self *routerDrainStatusHandler) ContentType() int32 {
+	return int32(ctrl_pb.ContentType_RouterDrainStatusType)
+}
+
+func (self *routerDrainStatusHandler) HandleReceive(msg *channel.Message, ch channel.Channel) {
+	log := pfxlog.ContextLogger(ch.Label()).WithField("routerId", self.router.Id)
+
+	statusMsg := &ctrl_pb.RouterDrainStatus{}
+	if err := proto.Unmarshal(msg.Body, statusMsg); err != nil {
+		log.WithError(err).Error("unexpected error unmarshalling router drain status")
+		return
+	}
+
+	log = log.WithField("draining", statusMsg.Draining).WithField("circuits", statusMsg.Circuits)
+	log.Debug("router drain status received")
+
+	wasDrained := self.router.IsDrained()
+	prev := self.router.SetDrainStatus(&model.RouterDrainStatus{
+		Draining: statusMsg.Draining,
+		Circuits: statusMsg.Circuits,
+	})
+
+	if statusMsg.Draining && (prev == nil || !prev.Draining) {
+		log.Info("router draining")
+		self.dispatchEvent(event.RouterDraining)
+	}
+
+	if !wasDrained && self.router.IsDrained() {
+		log.Info("router drained")
+		self.dispatchEvent(event.RouterDrained)
+	}
+}
+
+func (self *routerDrainStatusHandler) dispatchEvent(eventType event.RouterEventType) {
+	self.network.GetEventDispatcher().AcceptRouterEvent(&event.RouterEvent{
+		Namespace:    event.RouterEventNS,
+		EventSrcId:   self.network.GetAppId(),
+		EventType:    eventType,
+		Timestamp:    time.Now(),
+		RouterId:     self.router.Id,
+		RouterOnline: true,
+	})
+}
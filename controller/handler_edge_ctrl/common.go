@@ -4,17 +4,23 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/hashicorp/golang-lru/v2/expirable"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/hanzozt/channel/v4"
 	"github.com/hanzozt/identity"
 	"github.com/hanzozt/sdk-golang/zt/edge"
 	"github.com/hanzozt/storage/boltz"
 	"github.com/hanzozt/zt/v2/common"
+	"github.com/hanzozt/zt/v2/common/ctrl_msg"
 	"github.com/hanzozt/zt/v2/common/logcontext"
 	"github.com/hanzozt/zt/v2/common/pb/edge_ctrl_pb"
+	"github.com/hanzozt/zt/v2/common/ratelimit"
 	"github.com/hanzozt/zt/v2/controller/change"
 	"github.com/hanzozt/zt/v2/controller/db"
 	"github.com/hanzozt/zt/v2/controller/env"
@@ -122,6 +128,7 @@ type baseSessionRequestContext struct {
 	logContext   logcontext.Context
 	env          model.Env
 	accessClaims *common.AccessClaims
+	rateLimit    *edge_ctrl_pb.RateLimit
 }
 
 func (self *baseSessionRequestContext) getApiSessionId() string {
@@ -533,6 +540,8 @@ func (self *baseSessionRequestContext) updateTerminator(terminator *model.Termin
 }
 
 func (self *baseSessionRequestContext) newCircuitCreateParms(serviceId string, peerData map[uint32][]byte) model.CreateCircuitParams {
+	peerData = self.addCallerIdentity(self.session.IdentityId, peerData)
+	self.rateLimit = self.getRateLimit(self.session.IdentityId)
 	return &sessionCircuitParams{
 		serviceId:    serviceId,
 		sourceRouter: self.sourceRouter,
@@ -540,10 +549,13 @@ func (self *baseSessionRequestContext) newCircuitCreateParms(serviceId string, p
 		logCtx:       self.logContext,
 		deadline:     time.Now().Add(self.handler.getAppEnv().GetHostController().GetNetwork().GetOptions().RouteTimeout),
 		reqCtx:       self,
+		constraints:  self.getRouteConstraints(self.session.IdentityId),
 	}
 }
 
 func (self *baseSessionRequestContext) newTunnelCircuitCreateParms(serviceId string, peerData map[uint32][]byte) model.CreateCircuitParams {
+	self.rateLimit = self.getRateLimit(self.sourceRouter.Id)
+	peerData = self.addCallerIdentity(self.sourceRouter.Id, peerData)
 	return &tunnelCircuitParams{
 		serviceId:    serviceId,
 		sourceRouter: self.sourceRouter,
@@ -551,9 +563,197 @@ func (self *baseSessionRequestContext) newTunnelCircuitCreateParms(serviceId str
 		logCtx:       self.logContext,
 		deadline:     time.Now().Add(self.handler.getAppEnv().GetHostController().GetNetwork().GetOptions().RouteTimeout),
 		reqCtx:       self,
+		constraints:  self.getRouteConstraints(self.sourceRouter.Id),
 	}
 }
 
+// getRateLimit returns the rate limit for circuits to the service created by the given identity, or nil if the
+// circuits aren't rate limited
+func (self *baseSessionRequestContext) getRateLimit(identityId string) *edge_ctrl_pb.RateLimit {
+	log := pfxlog.Logger().WithField("serviceId", self.service.Id).WithField("identityId", identityId)
+
+	data, err := self.handler.getAppEnv().Managers.EdgeService.ReadConfigForIdentity(self.service.Id, identityId, ratelimit.ConfigTypeV1)
+	if err != nil {
+		log.WithError(err).Error("unable to load rate limit config, circuit will not be rate limited")
+		return nil
+	}
+	if data == nil {
+		return nil
+	}
+
+	config, err := ratelimit.ParseConfig(data)
+	if err != nil {
+		log.WithError(err).Error("invalid rate limit config, circuit will not be rate limited")
+		return nil
+	}
+	return config.ToProto()
+}
+
+// getRouteConstraints returns the route constraints for circuits to the service created by the given identity, or nil
+// if the circuits aren't constrained. Since the constraints may be required for compliance, circuit creation fails if
+// they can't be evaluated.
+func (self *baseSessionRequestContext) getRouteConstraints(identityId string) *model.RouteConstraints {
+	data, err := self.handler.getAppEnv().Managers.EdgeService.ReadConfigForIdentity(self.service.Id, identityId, db.RouteConstraintsV1TypeId)
+	if err != nil {
+		self.err = internalError(fmt.Errorf("unable to load route constraints config (%w)", err))
+		return nil
+	}
+	if data == nil {
+		return nil
+	}
+
+	config, err := model.ParseRouteConstraintsConfig(data)
+	if err != nil {
+		self.err = internalError(err)
+		return nil
+	}
+
+	constraints, err := self.handler.getAppEnv().Managers.EdgeRouter.ResolveRouteConstraints(config)
+	if err != nil {
+		self.err = internalError(fmt.Errorf("unable to evaluate route constraints (%w)", err))
+		return nil
+	}
+	return constraints
+}
+
+// callerIdentityServices caches whether the host configs of a service ask for callers to be identified to the hosted
+// application, so the configs aren't read for every circuit
+var callerIdentityServices = expirable.NewLRU[string, bool](1024, nil, 30*time.Second)
+
+// callerIdentities caches the identities added to circuits, so the identity isn't read for every circuit. The cache
+// time is kept short, so role attribute changes are picked up quickly.
+var callerIdentities = expirable.NewLRU[string, *ctrl_msg.DialerIdentity](4096, nil, time.Minute)
+
+// callerTokens caches the caller tokens added to circuits by identity and service, so a token isn't signed for every
+// circuit
+var callerTokens = expirable.NewLRU[string, string](4096, nil, time.Minute)
+
+// addCallerIdentity adds the id and name of the given identity to the peer data, replacing anything the initiating
+// router may have set. If the host config of the service asks for the caller to be identified to the hosted
+// application, the role attributes of the identity and a token identifying it are added as well. Since the hosted
+// application may rely on the token for authorization, circuit creation fails if it can't be created.
+func (self *baseSessionRequestContext) addCallerIdentity(identityId string, peerData map[uint32][]byte) map[uint32][]byte {
+	delete(peerData, ctrl_msg.DialerIdentityRoleAttributesHeader)
+	delete(peerData, ctrl_msg.DialerIdentityTokenHeader)
+
+	if self.err != nil {
+		return peerData
+	}
+
+	caller := self.getCallerIdentity(identityId)
+	if caller == nil {
+		return peerData
+	}
+
+	if self.identifiesCaller() {
+		token := self.getCallerToken(caller)
+		if token == "" {
+			return peerData
+		}
+		withToken := *caller
+		withToken.Token = token
+		caller = &withToken
+	}
+
+	return caller.AddToPeerData(peerData)
+}
+
+func (self *baseSessionRequestContext) getCallerIdentity(identityId string) *ctrl_msg.DialerIdentity {
+	if result, found := callerIdentities.Get(identityId); found {
+		return result
+	}
+
+	identity, err := self.handler.getAppEnv().Managers.Identity.Read(identityId)
+	if err != nil {
+		if !boltz.IsErrNotFoundErr(err) {
+			self.err = internalError(fmt.Errorf("unable to load calling identity (%w)", err))
+		}
+		return nil
+	}
+
+	result := &ctrl_msg.DialerIdentity{
+		Id:             identity.Id,
+		Name:           identity.Name,
+		RoleAttributes: identity.RoleAttributes,
+	}
+	callerIdentities.Add(identityId, result)
+	return result
+}
+
+func (self *baseSessionRequestContext) getCallerToken(caller *ctrl_msg.DialerIdentity) string {
+	cacheKey := caller.Id + ":" + self.service.Id
+	if result, found := callerTokens.Get(cacheKey); found {
+		return result
+	}
+
+	roleAttributes := caller.RoleAttributes
+	if roleAttributes == nil {
+		roleAttributes = []string{}
+	}
+
+	appEnv := self.handler.getAppEnv()
+	now := time.Now()
+	claims := &common.CallerClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    appEnv.RootIssuer(),
+			Subject:   caller.Id,
+			Audience:  jwt.ClaimStrings{self.service.Id},
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(common.DefaultCallerTokenDuration)),
+			ID:        uuid.NewString(),
+		},
+		IdentityName:   caller.Name,
+		RoleAttributes: roleAttributes,
+		TokenType:      common.TokenTypeCaller,
+	}
+
+	token, err := appEnv.GetRootTlsJwtSigner().Generate(claims)
+	if err != nil {
+		self.err = internalError(fmt.Errorf("unable to sign caller token (%w)", err))
+		return ""
+	}
+
+	callerTokens.Add(cacheKey, token)
+	return token
+}
+
+// identifiesCaller returns true if the host config of the service, as seen by any of the router embedded tunnelers
+// hosting it, asks for the caller to be identified to the hosted application. SDK hosted terminators are only given
+// the id and name of the caller, so there's no point signing a token for them.
+func (self *baseSessionRequestContext) identifiesCaller() bool {
+	if result, found := callerIdentityServices.Get(self.service.Id); found {
+		return result
+	}
+
+	configs, err := self.handler.getAppEnv().Managers.EdgeService.ReadHostConfigs(self.service.Id, common.TunnelBinding, db.HostV1ConfigTypeId, db.HostV2ConfigTypeId)
+	if err != nil {
+		self.err = internalError(fmt.Errorf("unable to load host configs (%w)", err))
+		return false
+	}
+
+	result := slices.ContainsFunc(configs, hostConfigIdentifiesCaller)
+	callerIdentityServices.Add(self.service.Id, result)
+	return result
+}
+
+// hostConfigIdentifiesCaller returns true if the given host.v1 config, or any of the terminators of the given host.v2
+// config, serves HTTP and so forwards the identity of the caller to the hosted application
+func hostConfigIdentifiesCaller(config map[string]interface{}) bool {
+	if _, found := config["httpProxy"]; found {
+		return true
+	}
+	terminators, _ := config["terminators"].([]interface{})
+	for _, val := range terminators {
+		if terminator, ok := val.(map[string]interface{}); ok {
+			if _, found := terminator["httpProxy"]; found {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 type circuitParamsFactory = func(serviceId string, peerData map[uint32][]byte) model.CreateCircuitParams
 
 func (self *baseSessionRequestContext) createCircuit(terminatorInstanceId string, peerData map[uint32][]byte, paramsFactory circuitParamsFactory) (*model.Circuit, map[uint32][]byte) {
@@ -573,6 +773,10 @@ func (self *baseSessionRequestContext) createCircuit(terminatorInstanceId string
 
 		n := self.handler.getAppEnv().GetHostController().GetNetwork()
 		params := paramsFactory(serviceId, peerData)
+		if self.err != nil {
+			return nil, nil
+		}
+
 		var err error
 		circuit, err = n.CreateCircuit(params)
 		if err != nil {
@@ -648,6 +852,7 @@ type sessionCircuitParams struct {
 	logCtx       logcontext.Context
 	deadline     time.Time
 	reqCtx       *baseSessionRequestContext
+	constraints  *model.RouteConstraints
 }
 
 func (self *sessionCircuitParams) GetServiceId() string {
@@ -663,19 +868,16 @@ func (self *sessionCircuitParams) GetClientId() *identity.TokenId {
 }
 
 func (self *sessionCircuitParams) GetCircuitTags(t xt.CostedTerminator) map[string]string {
-	if t == nil {
-		return map[string]string{
-			"serviceId": self.serviceId,
-			"clientId":  self.reqCtx.session.IdentityId,
-		}
-	}
-
-	hostId := t.GetHostId()
-	return map[string]string{
+	result := map[string]string{
 		"serviceId": self.serviceId,
 		"clientId":  self.reqCtx.session.IdentityId,
-		"hostId":    hostId,
 	}
+
+	if t != nil {
+		result["hostId"] = t.GetHostId()
+	}
+
+	return result
 }
 
 func (self *sessionCircuitParams) GetLogContext() logcontext.Context {
@@ -686,6 +888,10 @@ func (self *sessionCircuitParams) GetDeadline() time.Time {
 	return self.deadline
 }
 
+func (self *sessionCircuitParams) GetRouteConstraints() *model.RouteConstraints {
+	return self.constraints
+}
+
 type tunnelCircuitParams struct {
 	serviceId    string
 	sourceRouter *model.Router
@@ -693,6 +899,7 @@ type tunnelCircuitParams struct {
 	logCtx       logcontext.Context
 	deadline     time.Time
 	reqCtx       *baseSessionRequestContext
+	constraints  *model.RouteConstraints
 }
 
 func (self *tunnelCircuitParams) GetServiceId() string {
@@ -708,19 +915,16 @@ func (self *tunnelCircuitParams) GetClientId() *identity.TokenId {
 }
 
 func (self *tunnelCircuitParams) GetCircuitTags(t xt.CostedTerminator) map[string]string {
-	if t == nil {
-		return map[string]string{
-			"serviceId": self.serviceId,
-			"clientId":  self.sourceRouter.Id,
-		}
-	}
-
-	hostId := t.GetHostId()
-	return map[string]string{
+	result := map[string]string{
 		"serviceId": self.serviceId,
 		"clientId":  self.sourceRouter.Id,
-		"hostId":    hostId,
 	}
+
+	if t != nil {
+		result["hostId"] = t.GetHostId()
+	}
+
+	return result
 }
 
 func (self *tunnelCircuitParams) GetLogContext() logcontext.Context {
@@ -730,3 +934,7 @@ func (self *tunnelCircuitParams) GetLogContext() logcontext.Context {
 func (self *tunnelCircuitParams) GetDeadline() time.Time {
 	return self.deadline
 }
+
+func (self *tunnelCircuitParams) GetRouteConstraints() *model.RouteConstraints {
+	return self.constraints
+}
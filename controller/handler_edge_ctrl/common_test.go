@@ -0,0 +1,46 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_edge_ctrl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_HostConfigIdentifiesCaller(t *testing.T) {
+	req := require.New(t)
+
+	req.False(hostConfigIdentifiesCaller(nil))
+	req.False(hostConfigIdentifiesCaller(map[string]interface{}{"protocol": "tcp"}))
+	req.True(hostConfigIdentifiesCaller(map[string]interface{}{
+		"protocol":  "tcp",
+		"httpProxy": map[string]interface{}{},
+	}))
+
+	req.False(hostConfigIdentifiesCaller(map[string]interface{}{
+		"terminators": []interface{}{
+			map[string]interface{}{"protocol": "tcp"},
+		},
+	}))
+	req.True(hostConfigIdentifiesCaller(map[string]interface{}{
+		"terminators": []interface{}{
+			map[string]interface{}{"protocol": "udp"},
+			map[string]interface{}{"protocol": "tcp", "httpProxy": map[string]interface{}{}},
+		},
+	}))
+}
@@ -79,12 +79,13 @@ func (self *createCircuitHandler) HandleReceiveCreateCircuitV1(msg *channel.Mess
 	self.CreateCircuit(ctx, self.CreateCircuitV1Response)
 }
 
-func (self *createCircuitHandler) CreateCircuitV1Response(circuitInfo *model.Circuit, peerData map[uint32][]byte) (*channel.Message, error) {
+func (self *createCircuitHandler) CreateCircuitV1Response(circuitInfo *model.Circuit, peerData map[uint32][]byte, rateLimit *edge_ctrl_pb.RateLimit) (*channel.Message, error) {
 	response := &edge_ctrl_pb.CreateCircuitResponse{
 		CircuitId: circuitInfo.Id,
 		Address:   circuitInfo.Path.IngressId,
 		PeerData:  peerData,
 		Tags:      circuitInfo.Tags,
+		RateLimit: rateLimit,
 	}
 
 	body, err := proto.Marshal(response)
@@ -110,15 +111,16 @@ func (self *createCircuitHandler) HandleReceiveCreateCircuitV2(msg *channel.Mess
 	self.CreateCircuit(ctx, self.CreateCircuitV2Response)
 }
 
-func (self *createCircuitHandler) CreateCircuitV2Response(circuitInfo *model.Circuit, peerData map[uint32][]byte) (*channel.Message, error) {
+func (self *createCircuitHandler) CreateCircuitV2Response(circuitInfo *model.Circuit, peerData map[uint32][]byte, rateLimit *edge_ctrl_pb.RateLimit) (*channel.Message, error) {
 	response := &ctrl_msg.CreateCircuitResponse{
 		CircuitId: circuitInfo.Id,
 		Address:   circuitInfo.Path.IngressId,
 		PeerData:  peerData,
 		Tags:      circuitInfo.Tags,
+		RateLimit: rateLimit,
 	}
 
-	return response.ToMessage(), nil
+	return response.ToMessage()
 }
 
 func (self *createCircuitHandler) CreateCircuit(ctx *CreateCircuitRequestContext, f createCircuitResponseFactory) {
@@ -142,9 +144,10 @@ func (self *createCircuitHandler) CreateCircuit(ctx *CreateCircuitRequestContext
 	}
 
 	log := pfxlog.ContextLogger(self.ch.Label()).WithField("token", ctx.req.GetSessionToken())
-	responseMsg, err := f(circuitInfo, peerData)
+	responseMsg, err := f(circuitInfo, peerData, ctx.rateLimit)
 	if err != nil {
 		log.WithError(err).Error("error generating create circuit response")
+		return
 	}
 	log.Debugf("responding with successful circuit setup")
 
@@ -154,7 +157,7 @@ func (self *createCircuitHandler) CreateCircuit(ctx *CreateCircuitRequestContext
 	}
 }
 
-type createCircuitResponseFactory func(*model.Circuit, map[uint32][]byte) (*channel.Message, error)
+type createCircuitResponseFactory func(*model.Circuit, map[uint32][]byte, *edge_ctrl_pb.RateLimit) (*channel.Message, error)
 
 var _ CreateCircuitRequest = (*edge_ctrl_pb.CreateCircuitRequest)(nil)
 
@@ -94,6 +94,7 @@ func (self *createTunnelCircuitV2Handler) CreateCircuit(ctx *CreateTunnelCircuit
 		Address:   circuitInfo.Path.IngressId,
 		PeerData:  peerData,
 		Tags:      circuitInfo.Tags,
+		RateLimit: ctx.rateLimit,
 	}
 
 	self.sendResponse(ctx, response)
@@ -201,15 +201,7 @@ func (handler *validateCircuitsHandler) validRouterCircuits(router *model.Router
 	circuitMgr := handler.appEnv.Managers.Circuit
 
 	for _, circuit := range circuitMgr.All() {
-		routerRelevant := false
-		for _, pathRouter := range circuit.Path.Nodes {
-			if pathRouter.Id == router.Id {
-				routerRelevant = true
-				break
-			}
-		}
-
-		if !routerRelevant {
+		if !circuit.HasRouter(router.Id) {
 			continue
 		}
 
@@ -0,0 +1,122 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package policy
+
+import (
+	"bytes"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/hanzozt/channel/v4"
+	"github.com/hanzozt/zt/v2/common/ctrlchan"
+	"github.com/hanzozt/zt/v2/common/pb/edge_ctrl_pb"
+	"github.com/hanzozt/zt/v2/controller/db"
+	"github.com/hanzozt/zt/v2/controller/model"
+	"go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+// Routers don't carry edge router policies in their data model, so each edge router is sent the schedules which
+// restrict the identities that may use it. A router is sent the schedules when it connects, and again whenever they
+// change. Routers evaluate the schedules themselves, so the message doesn't need to be resent as windows open and close.
+
+// sentEdgeRouterSchedules records what was last sent to a router, and over which control channel
+type sentEdgeRouterSchedules struct {
+	control ctrlchan.CtrlChannel
+	body    []byte
+}
+
+func (enforcer *PolicyScheduleEnforcer) RouterConnected(r *model.Router) {
+	enforcer.sendEdgeRouterSchedules([]*model.Router{r})
+}
+
+func (enforcer *PolicyScheduleEnforcer) RouterDisconnected(r *model.Router) {
+	enforcer.routerLock.Lock()
+	defer enforcer.routerLock.Unlock()
+	delete(enforcer.sentSchedules, r.Id)
+}
+
+func (enforcer *PolicyScheduleEnforcer) syncEdgeRouterSchedules() {
+	enforcer.sendEdgeRouterSchedules(enforcer.appEnv.GetManagers().Router.AllConnected())
+}
+
+func (enforcer *PolicyScheduleEnforcer) sendEdgeRouterSchedules(routers []*model.Router) {
+	if len(routers) == 0 {
+		return
+	}
+
+	enforcer.routerLock.Lock()
+	defer enforcer.routerLock.Unlock()
+
+	var scheduledAccess map[string]map[string][]*db.EdgeRouterPolicy
+	err := enforcer.appEnv.GetDb().View(func(tx *bbolt.Tx) error {
+		scheduledAccess = enforcer.appEnv.GetManagers().EdgeRouter.GetScheduledIdentityAccess(tx)
+		return nil
+	})
+
+	if err != nil {
+		pfxlog.Logger().WithError(err).Error("unable to determine edge router policy schedules")
+		return
+	}
+
+	for _, router := range routers {
+		if router.Control == nil {
+			continue
+		}
+
+		log := pfxlog.Logger().WithField("routerId", router.Id)
+
+		body, err := proto.MarshalOptions{Deterministic: true}.Marshal(newEdgeRouterPolicySchedules(scheduledAccess[router.Id]))
+		if err != nil {
+			log.WithError(err).Error("unable to marshal edge router policy schedules")
+			continue
+		}
+
+		if sent, found := enforcer.sentSchedules[router.Id]; found && sent.control == router.Control && bytes.Equal(sent.body, body) {
+			continue
+		}
+
+		msg := channel.NewMessage(int32(edge_ctrl_pb.ContentType_EdgeRouterPolicySchedulesType), body)
+		if err = router.Control.GetDefaultSender().Send(msg); err != nil {
+			log.WithError(err).Error("unable to send edge router policy schedules")
+			continue
+		}
+
+		enforcer.sentSchedules[router.Id] = &sentEdgeRouterSchedules{
+			control: router.Control,
+			body:    body,
+		}
+	}
+}
+
+func newEdgeRouterPolicySchedules(identities map[string][]*db.EdgeRouterPolicy) *edge_ctrl_pb.EdgeRouterPolicySchedules {
+	result := &edge_ctrl_pb.EdgeRouterPolicySchedules{
+		Identities: map[string]*edge_ctrl_pb.EdgeRouterPolicySchedules_IdentityPolicies{},
+	}
+
+	for identityId, policies := range identities {
+		identityPolicies := &edge_ctrl_pb.EdgeRouterPolicySchedules_IdentityPolicies{}
+		for _, policy := range policies {
+			identityPolicies.Policies = append(identityPolicies.Policies, &edge_ctrl_pb.EdgeRouterPolicySchedules_Policy{
+				Id:       policy.Id,
+				Schedule: edge_ctrl_pb.NewPolicySchedule(policy.Schedule),
+			})
+		}
+		result.Identities[identityId] = identityPolicies
+	}
+
+	return result
+}
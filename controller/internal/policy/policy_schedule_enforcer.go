@@ -0,0 +1,227 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package policy
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/hanzozt/storage/ast"
+	"github.com/hanzozt/zt/v2/common/runner"
+	"github.com/hanzozt/zt/v2/common/schedule"
+	"github.com/hanzozt/zt/v2/controller/change"
+	"github.com/hanzozt/zt/v2/controller/db"
+	"github.com/hanzozt/zt/v2/controller/env"
+	"github.com/hanzozt/zt/v2/controller/model"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	PolicyScheduleEnforcerRun     = "policy.schedule.enforcer.run"
+	PolicyScheduleEnforcerDeletes = "policy.schedule.enforcer.run.deletes"
+)
+
+// PolicyScheduleEnforcer removes sessions which are no longer permitted because the schedules on the service policies
+// or edge router policies which granted them have closed. Routers separately close connections made with JWT backed
+// sessions, using the schedules in the router data model for service policies and the schedules sent to each router
+// by the enforcer for edge router policies.
+//
+// The enforcer tracks whether each scheduled policy was in force on the previous run and only looks at sessions when a
+// policy closes. Policies are treated as having been in force the first time they're seen, so that sessions which
+// outlived a schedule while the controller was down are still cleaned up.
+type PolicyScheduleEnforcer struct {
+	appEnv model.Env
+	*runner.BaseOperation
+	policyActive map[string]bool
+
+	routerLock    sync.Mutex
+	sentSchedules map[string]*sentEdgeRouterSchedules
+}
+
+func NewPolicyScheduleEnforcer(appEnv *env.AppEnv, f time.Duration) *PolicyScheduleEnforcer {
+	result := &PolicyScheduleEnforcer{
+		appEnv:        appEnv,
+		BaseOperation: runner.NewBaseOperation("PolicyScheduleEnforcer", f),
+		policyActive:  map[string]bool{},
+		sentSchedules: map[string]*sentEdgeRouterSchedules{},
+	}
+	appEnv.AddRouterPresenceHandler(result)
+	return result
+}
+
+func (enforcer *PolicyScheduleEnforcer) Run() error {
+	startTime := time.Now()
+
+	defer func() {
+		enforcer.appEnv.GetMetricsRegistry().Timer(PolicyScheduleEnforcerRun).UpdateSince(startTime)
+	}()
+
+	enforcer.syncEdgeRouterSchedules()
+
+	var sessionsToRemove []string
+	err := enforcer.appEnv.GetDb().View(func(tx *bbolt.Tx) error {
+		closedServicePolicies, closedEdgeRouterPolicies := enforcer.getClosedPolicies(tx, startTime)
+		if len(closedServicePolicies) == 0 && len(closedEdgeRouterPolicies) == 0 {
+			return nil
+		}
+
+		candidates, err := enforcer.getCandidateSessions(tx, closedServicePolicies, closedEdgeRouterPolicies)
+		if err != nil {
+			return err
+		}
+
+		stores := enforcer.appEnv.GetStores()
+		managers := enforcer.appEnv.GetManagers()
+		isAdmin := map[string]bool{}
+
+		for _, sessionId := range candidates {
+			session, err := stores.Session.LoadById(tx, sessionId)
+			if err != nil {
+				return err
+			}
+
+			admin, found := isAdmin[session.IdentityId]
+			if !found {
+				identity, err := stores.Identity.LoadById(tx, session.IdentityId)
+				if err != nil {
+					return err
+				}
+				admin = identity.IsAdmin
+				isAdmin[session.IdentityId] = admin
+			}
+
+			if admin {
+				continue
+			}
+
+			policyType := db.PolicyTypeDial
+			if session.Type == db.SessionTypeBind {
+				policyType = db.PolicyTypeBind
+			}
+
+			if !managers.EdgeService.IsServiceAccessScheduled(tx, session.ServiceId, session.IdentityId, policyType, startTime) ||
+				!managers.EdgeRouter.IsSharedEdgeRouterPresentInTx(tx, session.IdentityId, session.ServiceId) {
+				sessionsToRemove = append(sessionsToRemove, session.Id)
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	ctx := change.New().SetSourceType("policy-schedule.enforcer").SetChangeAuthorType(change.AuthorTypeController)
+	for _, sessionId := range sessionsToRemove {
+		if err = enforcer.appEnv.GetManagers().Session.Delete(sessionId, ctx); err != nil {
+			pfxlog.Logger().WithError(err).WithField("sessionId", sessionId).Error("unable to delete session outside of policy schedule")
+		}
+	}
+
+	enforcer.appEnv.GetMetricsRegistry().Meter(PolicyScheduleEnforcerDeletes).Mark(int64(len(sessionsToRemove)))
+
+	return nil
+}
+
+// getClosedPolicies evaluates the schedule of every scheduled policy, records whether it's in force and returns the
+// ids of the service policies and edge router policies which have closed since the last run
+func (enforcer *PolicyScheduleEnforcer) getClosedPolicies(tx *bbolt.Tx, now time.Time) ([]string, []string) {
+	stores := enforcer.appEnv.GetStores()
+	policyActive := map[string]bool{}
+
+	var closedServicePolicies []string
+	for cursor := stores.ServicePolicy.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+		policy, _ := stores.ServicePolicy.LoadById(tx, string(cursor.Current()))
+		if policy == nil || policy.Schedule == nil {
+			continue
+		}
+		if enforcer.updatePolicyState(policyActive, policy.Id, policy.Schedule, now) {
+			closedServicePolicies = append(closedServicePolicies, policy.Id)
+		}
+	}
+
+	var closedEdgeRouterPolicies []string
+	for cursor := stores.EdgeRouterPolicy.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+		policy, _ := stores.EdgeRouterPolicy.LoadById(tx, string(cursor.Current()))
+		if policy == nil || policy.Schedule == nil {
+			continue
+		}
+		if enforcer.updatePolicyState(policyActive, policy.Id, policy.Schedule, now) {
+			closedEdgeRouterPolicies = append(closedEdgeRouterPolicies, policy.Id)
+		}
+	}
+
+	// replacing the map rather than updating it drops deleted and unscheduled policies
+	enforcer.policyActive = policyActive
+
+	return closedServicePolicies, closedEdgeRouterPolicies
+}
+
+// updatePolicyState records whether the policy is currently in force and returns true if it has closed since the
+// last run
+func (enforcer *PolicyScheduleEnforcer) updatePolicyState(policyActive map[string]bool, policyId string, spec *schedule.Spec, now time.Time) bool {
+	s, err := schedule.Parse(spec)
+	if err != nil {
+		pfxlog.Logger().WithError(err).WithField("policyId", policyId).Error("invalid policy schedule, policy will not grant access")
+	}
+
+	active := s.IsActive(now)
+	policyActive[policyId] = active
+
+	wasActive, found := enforcer.policyActive[policyId]
+	return !active && (wasActive || !found)
+}
+
+// getCandidateSessions returns the sessions which may have been granted by the given policies. Each still needs to be
+// checked, since access may also be granted by other policies which are in force.
+func (enforcer *PolicyScheduleEnforcer) getCandidateSessions(tx *bbolt.Tx, servicePolicyIds, edgeRouterPolicyIds []string) ([]string, error) {
+	stores := enforcer.appEnv.GetStores()
+
+	var filters []string
+	for _, policyId := range servicePolicyIds {
+		identityIds := stores.ServicePolicy.GetRelatedEntitiesIdList(tx, policyId, db.EntityTypeIdentities)
+		serviceIds := stores.ServicePolicy.GetRelatedEntitiesIdList(tx, policyId, db.EntityTypeServices)
+		if len(identityIds) > 0 && len(serviceIds) > 0 {
+			filters = append(filters, fmt.Sprintf("(apiSession.identity in %s and service in %s)",
+				toQueryList(identityIds), toQueryList(serviceIds)))
+		}
+	}
+
+	for _, policyId := range edgeRouterPolicyIds {
+		if identityIds := stores.EdgeRouterPolicy.GetRelatedEntitiesIdList(tx, policyId, db.EntityTypeIdentities); len(identityIds) > 0 {
+			filters = append(filters, fmt.Sprintf("apiSession.identity in %s", toQueryList(identityIds)))
+		}
+	}
+
+	if len(filters) == 0 {
+		return nil, nil
+	}
+
+	ids, _, err := stores.Session.QueryIds(tx, strings.Join(filters, " or ")+" limit none")
+	return ids, err
+}
+
+func toQueryList(ids []string) string {
+	quoted := make([]string, 0, len(ids))
+	for _, id := range ids {
+		quoted = append(quoted, fmt.Sprintf(`"%s"`, id))
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
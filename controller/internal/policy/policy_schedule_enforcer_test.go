@@ -0,0 +1,153 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package policy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hanzozt/zt/v2/common/eid"
+	"github.com/hanzozt/zt/v2/common/runner"
+	"github.com/hanzozt/zt/v2/common/schedule"
+	"github.com/hanzozt/zt/v2/controller/change"
+	"github.com/hanzozt/zt/v2/controller/db"
+	"github.com/hanzozt/zt/v2/controller/model"
+	"go.etcd.io/bbolt"
+)
+
+func Test_PolicyScheduleEnforcer(t *testing.T) {
+	ctx := &enforcerTestContext{
+		TestContext: model.NewTestContext(t),
+	}
+	ctx.Init()
+	defer ctx.Cleanup()
+
+	ctx.testPolicyScheduleEnforcer()
+}
+
+func (ctx *enforcerTestContext) testPolicyScheduleEnforcer() {
+	ctx.CleanupAll()
+
+	managers := ctx.GetManagers()
+
+	identity := &model.Identity{Name: eid.New(), IdentityTypeId: db.DefaultIdentityType}
+	ctx.NoError(managers.Identity.Create(identity, change.New()))
+
+	service := &model.EdgeService{Name: eid.New()}
+	ctx.NoError(managers.EdgeService.Create(service, change.New()))
+
+	edgeRouter := &model.EdgeRouter{Name: eid.New()}
+	ctx.NoError(managers.EdgeRouter.Create(edgeRouter, change.New()))
+
+	servicePolicy := &model.ServicePolicy{
+		Name:          eid.New(),
+		Semantic:      db.SemanticAllOf,
+		IdentityRoles: []string{"@" + identity.Id},
+		ServiceRoles:  []string{"@" + service.Id},
+		PolicyType:    db.PolicyTypeDialName,
+		Schedule:      openSchedule(),
+	}
+	ctx.NoError(managers.ServicePolicy.Create(servicePolicy, change.New()))
+
+	edgeRouterPolicy := &model.EdgeRouterPolicy{
+		Name:            eid.New(),
+		Semantic:        db.SemanticAllOf,
+		IdentityRoles:   []string{"@" + identity.Id},
+		EdgeRouterRoles: []string{"#all"},
+		Schedule:        openSchedule(),
+	}
+	ctx.NoError(managers.EdgeRouterPolicy.Create(edgeRouterPolicy, change.New()))
+
+	serp := &model.ServiceEdgeRouterPolicy{
+		Name:            eid.New(),
+		Semantic:        db.SemanticAllOf,
+		ServiceRoles:    []string{"#all"},
+		EdgeRouterRoles: []string{"#all"},
+	}
+	ctx.NoError(managers.ServiceEdgeRouterPolicy.Create(serp, change.New()))
+
+	apiSession := &model.ApiSession{
+		Token:          uuid.NewString(),
+		IdentityId:     identity.Id,
+		Identity:       identity,
+		LastActivityAt: time.Now(),
+	}
+	_, err := managers.ApiSession.Create(nil, apiSession, nil)
+	ctx.NoError(err)
+
+	newSession := func() *model.Session {
+		session := &model.Session{
+			Token:        uuid.NewString(),
+			IdentityId:   identity.Id,
+			ApiSessionId: apiSession.Id,
+			ServiceId:    service.Id,
+			Type:         db.SessionTypeDial,
+		}
+		_, err := managers.Session.Create(session, change.New())
+		ctx.NoError(err)
+		return session
+	}
+
+	enforcer := &PolicyScheduleEnforcer{
+		appEnv:        ctx,
+		BaseOperation: runner.NewBaseOperation("PolicyScheduleEnforcer", time.Minute),
+		policyActive:  map[string]bool{},
+		sentSchedules: map[string]*sentEdgeRouterSchedules{},
+	}
+
+	session := newSession()
+	ctx.NoError(enforcer.Run())
+	ctx.requireSessionPresent(session.Id, true)
+
+	// closing the service policy removes sessions granted by it
+	servicePolicy.Schedule = closedSchedule()
+	ctx.NoError(managers.ServicePolicy.Update(servicePolicy, nil, change.New()))
+	ctx.NoError(enforcer.Run())
+	ctx.requireSessionPresent(session.Id, false)
+
+	servicePolicy.Schedule = openSchedule()
+	ctx.NoError(managers.ServicePolicy.Update(servicePolicy, nil, change.New()))
+	ctx.NoError(enforcer.Run())
+
+	session = newSession()
+	ctx.NoError(enforcer.Run())
+	ctx.requireSessionPresent(session.Id, true)
+
+	// closing the only edge router policy removes sessions which can no longer reach an edge router
+	edgeRouterPolicy.Schedule = closedSchedule()
+	ctx.NoError(managers.EdgeRouterPolicy.Update(edgeRouterPolicy, nil, change.New()))
+	ctx.NoError(enforcer.Run())
+	ctx.requireSessionPresent(session.Id, false)
+}
+
+func (ctx *enforcerTestContext) requireSessionPresent(sessionId string, expected bool) {
+	ctx.NoError(ctx.GetDb().View(func(tx *bbolt.Tx) error {
+		ctx.Equal(expected, ctx.GetStores().Session.IsEntityPresent(tx, sessionId))
+		return nil
+	}))
+}
+
+func openSchedule() *schedule.Spec {
+	notAfter := time.Now().Add(time.Hour)
+	return &schedule.Spec{NotAfter: &notAfter}
+}
+
+func closedSchedule() *schedule.Spec {
+	notAfter := time.Now().Add(-time.Hour)
+	return &schedule.Spec{NotAfter: &notAfter}
+}
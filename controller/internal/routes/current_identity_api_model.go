@@ -150,12 +150,13 @@ func MapCurrentIdentityEdgeRouterToRestModel(ae *env.AppEnv, router *model.EdgeR
 	routerState := ae.Broker.GetEdgeRouterState(router.Id)
 
 	syncStatus := string(routerState.SyncStatus)
+	isOnline := isEdgeRouterAvailable(ae, router.Id, routerState.IsOnline)
 
 	ret := &rest_model.CurrentIdentityEdgeRouterDetail{
 		BaseEntity: BaseEntityToRestModel(router, EdgeRouterLinkFactory),
 		CommonEdgeRouterProperties: rest_model.CommonEdgeRouterProperties{
 			Hostname:           &hostname,
-			IsOnline:           &routerState.IsOnline,
+			IsOnline:           &isOnline,
 			Name:               &router.Name,
 			SupportedProtocols: routerState.Protocols,
 			SyncStatus:         &syncStatus,
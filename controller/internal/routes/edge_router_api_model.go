@@ -234,3 +234,9 @@ func GetNamedEdgeRouterRoles(edgeRouterHandler *model.EdgeRouterManager, roles [
 	}
 	return result
 }
+
+// isEdgeRouterAvailable returns whether clients should be told an edge router is online. Draining routers are
+// reported as offline, so clients move to other edge routers.
+func isEdgeRouterAvailable(ae *env.AppEnv, id string, isOnline bool) bool {
+	return isOnline && !ae.Managers.Router.IsDraining(id)
+}
@@ -101,7 +101,14 @@ func MapPatchEdgeRouterPolicyToModel(id string, policy *rest_model.EdgeRouterPol
 }
 
 func MapEdgeRouterPolicyToRestEntity(ae *env.AppEnv, _ *response.RequestContext, policy *model.EdgeRouterPolicy) (interface{}, error) {
-	return MapEdgeRouterPolicyToRestModel(ae, policy)
+	detail, err := MapEdgeRouterPolicyToRestModel(ae, policy)
+	if err != nil {
+		return nil, err
+	}
+	return &EdgeRouterPolicyExtendedDetail{
+		EdgeRouterPolicyDetail: detail,
+		Schedule:               policy.Schedule,
+	}, nil
 }
 
 func MapEdgeRouterPolicyToRestModel(ae *env.AppEnv, policy *model.EdgeRouterPolicy) (*rest_model.EdgeRouterPolicyDetail, error) {
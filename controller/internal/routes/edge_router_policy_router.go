@@ -19,6 +19,7 @@ package routes
 import (
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/hanzozt/edge-api/rest_management_api_server/operations/edge_router_policy"
+	"github.com/hanzozt/zt/v2/controller/db"
 	"github.com/hanzozt/zt/v2/controller/env"
 	"github.com/hanzozt/zt/v2/controller/fields"
 	"github.com/hanzozt/zt/v2/controller/model"
@@ -95,7 +96,12 @@ func (r *EdgeRouterPolicyRouter) Detail(ae *env.AppEnv, rc *response.RequestCont
 
 func (r *EdgeRouterPolicyRouter) Create(ae *env.AppEnv, rc *response.RequestContext, params edge_router_policy.CreateEdgeRouterPolicyParams) {
 	Create(rc, rc, EdgeRouterPolicyLinkFactory, func() (string, error) {
-		return MapCreate(ae.Managers.EdgeRouterPolicy.Create, MapCreateEdgeRouterPolicyToModel(params.Policy), rc)
+		edgeRouterPolicy := MapCreateEdgeRouterPolicyToModel(params.Policy)
+		var err error
+		if edgeRouterPolicy.Schedule, err = GetPolicySchedule(rc); err != nil {
+			return "", err
+		}
+		return MapCreate(ae.Managers.EdgeRouterPolicy.Create, edgeRouterPolicy, rc)
 	})
 }
 
@@ -105,13 +111,23 @@ func (r *EdgeRouterPolicyRouter) Delete(ae *env.AppEnv, rc *response.RequestCont
 
 func (r *EdgeRouterPolicyRouter) Update(ae *env.AppEnv, rc *response.RequestContext, params edge_router_policy.UpdateEdgeRouterPolicyParams) {
 	Update(rc, func(id string) error {
-		return ae.Managers.EdgeRouterPolicy.Update(MapUpdateEdgeRouterPolicyToModel(params.ID, params.Policy), nil, rc.NewChangeContext())
+		edgeRouterPolicy := MapUpdateEdgeRouterPolicyToModel(params.ID, params.Policy)
+		var err error
+		if edgeRouterPolicy.Schedule, err = GetPolicySchedule(rc); err != nil {
+			return err
+		}
+		return ae.Managers.EdgeRouterPolicy.Update(edgeRouterPolicy, nil, rc.NewChangeContext())
 	})
 }
 
 func (r *EdgeRouterPolicyRouter) Patch(ae *env.AppEnv, rc *response.RequestContext, params edge_router_policy.PatchEdgeRouterPolicyParams) {
 	Patch(rc, func(id string, fields fields.UpdatedFields) error {
-		return ae.Managers.EdgeRouterPolicy.Update(MapPatchEdgeRouterPolicyToModel(params.ID, params.Policy), fields.FilterMaps("tags"), rc.NewChangeContext())
+		edgeRouterPolicy := MapPatchEdgeRouterPolicyToModel(params.ID, params.Policy)
+		var err error
+		if edgeRouterPolicy.Schedule, err = GetPolicySchedule(rc); err != nil {
+			return err
+		}
+		return ae.Managers.EdgeRouterPolicy.Update(edgeRouterPolicy, fields.FilterMaps("tags", db.FieldPolicySchedule), rc.NewChangeContext())
 	})
 }
 
@@ -17,6 +17,7 @@
 package routes
 
 import (
+	"github.com/go-openapi/strfmt"
 	"github.com/hanzozt/foundation/v2/util"
 	"github.com/hanzozt/zt/v2/controller/env"
 	"github.com/hanzozt/zt/v2/controller/model"
@@ -59,6 +60,7 @@ func MapCreateFabricRouterToModel(router *rest_model.RouterCreate) *model.Router
 		Cost:        uint16(Int64OrDefault(router.Cost)),
 		NoTraversal: BoolOrDefault(router.NoTraversal),
 		Disabled:    BoolOrDefault(router.Disabled),
+		ConfigId:    stringz.OrEmpty(router.ConfigID),
 	}
 
 	return ret
@@ -75,6 +77,8 @@ func MapUpdateFabricRouterToModel(id string, router *rest_model.RouterUpdate) *m
 		Cost:        uint16(Int64OrDefault(router.Cost)),
 		NoTraversal: BoolOrDefault(router.NoTraversal),
 		Disabled:    BoolOrDefault(router.Disabled),
+		Draining:    BoolOrDefault(router.Draining),
+		ConfigId:    stringz.OrEmpty(router.ConfigID),
 	}
 
 	return ret
@@ -91,11 +95,32 @@ func MapPatchFabricRouterToModel(id string, router *rest_model.RouterPatch) *mod
 		Cost:        uint16(Int64OrDefault(router.Cost)),
 		NoTraversal: BoolOrDefault(router.NoTraversal),
 		Disabled:    BoolOrDefault(router.Disabled),
+		Draining:    BoolOrDefault(router.Draining),
+		ConfigId:    stringz.OrEmpty(router.ConfigID),
 	}
 
 	return ret
 }
 
+const (
+	RouterCertSignerCurrent = "current"
+	RouterCertSignerNext    = "next"
+	RouterCertSignerUnknown = "unknown"
+)
+
+// routerCertSigner returns which of the enrollment signers issued a router's certificates. While a signing CA
+// rollover is in progress, routers move from the current signer to the next one as they renew.
+func routerCertSigner(ae *env.AppEnv, state *model.RouterCertState) string {
+	enrollment := ae.GetConfig().Edge.Enrollment
+	if enrollment.NextSigningCert != nil && state.IsSignedBy(enrollment.NextSigningCert.Cert().Leaf) {
+		return RouterCertSignerNext
+	}
+	if enrollment.SigningCert != nil && state.IsSignedBy(enrollment.SigningCert.Cert().Leaf) {
+		return RouterCertSignerCurrent
+	}
+	return RouterCertSignerUnknown
+}
+
 type FabricRouterModelMapper struct{}
 
 func (FabricRouterModelMapper) ToApi(ae *env.AppEnv, _ *response.RequestContext, router *model.Router) (interface{}, error) {
@@ -113,6 +138,7 @@ func (FabricRouterModelMapper) ToApi(ae *env.AppEnv, _ *response.RequestContext,
 	}
 
 	isConnected := connected != nil
+	isDrained := connected != nil && connected.IsDrained()
 	cost := int64(router.Cost)
 	ret := &rest_model.RouterDetail{
 		BaseEntity:  FabricEntityToRestModel(router, FabricRouterLinkFactory),
@@ -123,9 +149,27 @@ func (FabricRouterModelMapper) ToApi(ae *env.AppEnv, _ *response.RequestContext,
 		Cost:        &cost,
 		NoTraversal: &router.NoTraversal,
 		Disabled:    &router.Disabled,
+		Draining:    &router.Draining,
+		Drained:     &isDrained,
+		ConfigID:    router.ConfigId,
 	}
 
 	if connected != nil {
+		if configState := connected.GetConfigState(); configState != nil {
+			ret.ConfigVersion = configState.Version
+			ret.ConfigAppliedVersion = configState.AppliedVersion
+			ret.ConfigError = configState.Error
+			ret.ConfigChanges = configState.Changes
+			ret.ConfigRestartRequired = configState.RestartRequired()
+		}
+
+		if certState := connected.GetCertState(); certState != nil {
+			expiresAt := strfmt.DateTime(certState.ExpiresAt())
+			ret.CertExpiresAt = &expiresAt
+			ret.CertRenewalError = certState.RenewalError
+			ret.CertSigner = routerCertSigner(ae, certState)
+		}
+
 		for _, listener := range connected.Listeners {
 			advAddr := listener.GetAddress()
 			linkProtocol := listener.GetProtocol()
@@ -55,6 +55,8 @@ func MapCreateFabricServiceToModel(service *rest_model.ServiceCreate) *model.Ser
 		Name:               stringz.OrEmpty(service.Name),
 		TerminatorStrategy: service.TerminatorStrategy,
 		MaxIdleTime:        time.Duration(service.MaxIdleTimeMillis) * time.Millisecond,
+		Multipath:          service.Multipath,
+		Priority:           service.Priority,
 	}
 
 	if ret.Id == "" {
@@ -77,6 +79,8 @@ func MapUpdateFabricServiceToModel(id string, service *rest_model.ServiceUpdate)
 		Name:               stringz.OrEmpty(service.Name),
 		TerminatorStrategy: service.TerminatorStrategy,
 		MaxIdleTime:        time.Duration(service.MaxIdleTimeMillis) * time.Millisecond,
+		Multipath:          service.Multipath,
+		Priority:           service.Priority,
 	}
 
 	return ret
@@ -91,6 +95,8 @@ func MapPatchFabricServiceToModel(id string, service *rest_model.ServicePatch) *
 		Name:               service.Name,
 		TerminatorStrategy: service.TerminatorStrategy,
 		MaxIdleTime:        time.Duration(service.MaxIdleTimeMillis) * time.Millisecond,
+		Multipath:          service.Multipath,
+		Priority:           service.Priority,
 	}
 
 	return ret
@@ -105,5 +111,7 @@ func (FabricServiceModelMapper) ToApi(_ *env.AppEnv, _ *response.RequestContext,
 		Name:               &service.Name,
 		TerminatorStrategy: &service.TerminatorStrategy,
 		MaxIdleTimeMillis:  &maxIdleTime,
+		Multipath:          service.Multipath,
+		Priority:           service.Priority,
 	}, nil
 }
@@ -17,6 +17,7 @@
 package routes
 
 import (
+	"github.com/go-openapi/strfmt"
 	"github.com/hanzozt/foundation/v2/stringz"
 	"github.com/hanzozt/zt/v2/controller/env"
 	"github.com/hanzozt/zt/v2/controller/model"
@@ -136,5 +137,10 @@ func MapFabricTerminatorToRestModel(ae *env.AppEnv, terminator *model.Terminator
 
 	ret.Precedence = &resultPrecedence
 
+	if ejectedUntil, ejected := xt.GlobalOutlierDetector().GetEjectedUntil(terminator.Id); ejected {
+		restEjectedUntil := strfmt.DateTime(ejectedUntil)
+		ret.EjectedUntil = &restEjectedUntil
+	}
+
 	return ret, nil
 }
@@ -71,6 +71,13 @@ func MapLinkToRestModel(ae *env.AppEnv, _ *response.RequestContext, link *model.
 		StaticCost:    &staticCost,
 		Protocol:      &link.Protocol,
 		Iteration:     &iteration,
+
+		SourceLoss:               float64(link.GetSrcLoss()) / 100,
+		DestLoss:                 float64(link.GetDstLoss()) / 100,
+		SourceJitter:             link.GetSrcJitter(),
+		DestJitter:               link.GetDstJitter(),
+		SourceAvailableBandwidth: link.GetSrcBandwidth(),
+		DestAvailableBandwidth:   link.GetDstBandwidth(),
 	}
 
 	if connState := link.GetConnsState(); connState != nil {
@@ -0,0 +1,91 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"encoding/json"
+
+	"github.com/hanzozt/edge-api/rest_model"
+	"github.com/hanzozt/foundation/v2/errorz"
+	"github.com/hanzozt/zt/v2/common/schedule"
+	"github.com/hanzozt/zt/v2/controller/db"
+	"github.com/hanzozt/zt/v2/controller/response"
+)
+
+// Service policies and edge router policies have a schedule property, which isn't part of the management API spec.
+// It's read from the raw create, update and patch bodies and added to the generated detail models when rendered.
+// The value is an object with the optional fields timeZone, notBefore, notAfter and windows. For example:
+//
+//	"schedule": {"timeZone": "Europe/London", "windows": ["* 9-16 * * MON-FRI"]}
+
+// GetPolicySchedule reads and validates the schedule property of a policy create, update or patch body
+func GetPolicySchedule(rc *response.RequestContext) (*schedule.Spec, error) {
+	if len(rc.Body) == 0 {
+		return nil, nil
+	}
+
+	body := struct {
+		Schedule json.RawMessage `json:"schedule"`
+	}{}
+
+	if err := json.Unmarshal(rc.Body, &body); err != nil || len(body.Schedule) == 0 {
+		// malformed bodies are reported by the generated handlers
+		return nil, nil
+	}
+
+	spec := &schedule.Spec{}
+	if err := json.Unmarshal(body.Schedule, spec); err != nil {
+		return nil, errorz.NewFieldError(err.Error(), db.FieldPolicySchedule, string(body.Schedule))
+	}
+
+	if err := spec.Validate(); err != nil {
+		return nil, errorz.NewFieldError(err.Error(), db.FieldPolicySchedule, string(body.Schedule))
+	}
+
+	if spec.IsEmpty() {
+		return nil, nil
+	}
+
+	return spec, nil
+}
+
+// ServicePolicyExtendedDetail is the REST representation of a service policy, including its schedule
+type ServicePolicyExtendedDetail struct {
+	*rest_model.ServicePolicyDetail
+	Schedule *schedule.Spec
+}
+
+func (m *ServicePolicyExtendedDetail) MarshalJSON() ([]byte, error) {
+	return marshalWithPolicySchedule(m.ServicePolicyDetail, m.Schedule)
+}
+
+// EdgeRouterPolicyExtendedDetail is the REST representation of an edge router policy, including its schedule
+type EdgeRouterPolicyExtendedDetail struct {
+	*rest_model.EdgeRouterPolicyDetail
+	Schedule *schedule.Spec
+}
+
+func (m *EdgeRouterPolicyExtendedDetail) MarshalJSON() ([]byte, error) {
+	return marshalWithPolicySchedule(m.EdgeRouterPolicyDetail, m.Schedule)
+}
+
+func marshalWithPolicySchedule(detail json.Marshaler, spec *schedule.Spec) ([]byte, error) {
+	if spec.IsEmpty() {
+		return detail.MarshalJSON()
+	}
+	return marshalWithProperties(detail, map[string]interface{}{db.FieldPolicySchedule: spec})
+}
@@ -0,0 +1,86 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hanzozt/edge-api/rest_model"
+	"github.com/hanzozt/foundation/v2/errorz"
+	"github.com/hanzozt/zt/v2/common/schedule"
+	"github.com/hanzozt/zt/v2/controller/api"
+	"github.com/hanzozt/zt/v2/controller/db"
+	"github.com/hanzozt/zt/v2/controller/response"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetPolicySchedule(t *testing.T) {
+	req := require.New(t)
+
+	rc := &response.RequestContext{Body: []byte(`{"name": "test", "tags": {"zt.schedule": "just a tag"}}`)}
+	spec, err := GetPolicySchedule(rc)
+	req.NoError(err)
+	req.Nil(spec)
+
+	rc.Body = []byte(`{"name": "test", "schedule": {"timeZone": "Europe/London", "windows": ["* 9-16 * * MON-FRI"]}}`)
+	spec, err = GetPolicySchedule(rc)
+	req.NoError(err)
+	req.Equal(&schedule.Spec{TimeZone: "Europe/London", Windows: []string{"* 9-16 * * MON-FRI"}}, spec)
+
+	// a patch which only changes the schedule marks the schedule as a whole as updated
+	jsonFields, err := api.GetFields(rc.Body)
+	req.NoError(err)
+	req.True(jsonFields.FilterMaps("tags", db.FieldPolicySchedule).IsUpdated(db.FieldPolicySchedule))
+
+	rc.Body = []byte(`{"schedule": null}`)
+	spec, err = GetPolicySchedule(rc)
+	req.NoError(err)
+	req.Nil(spec)
+
+	rc.Body = []byte(`{"schedule": {"windows": ["* 25 * * *"]}}`)
+	_, err = GetPolicySchedule(rc)
+	var fieldErr *errorz.FieldError
+	req.ErrorAs(err, &fieldErr)
+	req.Equal(db.FieldPolicySchedule, fieldErr.FieldName)
+}
+
+func Test_ServicePolicyExtendedDetailMarshal(t *testing.T) {
+	req := require.New(t)
+
+	name := "test"
+	detail := &ServicePolicyExtendedDetail{
+		ServicePolicyDetail: &rest_model.ServicePolicyDetail{Name: &name},
+	}
+
+	buf, err := json.Marshal(detail)
+	req.NoError(err)
+
+	result := map[string]any{}
+	req.NoError(json.Unmarshal(buf, &result))
+	req.Equal("test", result["name"])
+	req.NotContains(result, "schedule")
+
+	detail.Schedule = &schedule.Spec{Windows: []string{"* 9-16 * * *"}}
+	buf, err = json.Marshal(detail)
+	req.NoError(err)
+
+	result = map[string]any{}
+	req.NoError(json.Unmarshal(buf, &result))
+	req.Equal("test", result["name"])
+	req.Equal(map[string]any{"windows": []any{"* 9-16 * * *"}}, result["schedule"])
+}
@@ -372,6 +372,12 @@ func MapPostureCheckToRestModel(ae *env.AppEnv, rc *response.RequestContext, i *
 
 		ret = detail
 		setBaseEntityDetailsOnPostureCheck(ret, i)
+	case *model.PostureCheckGeoIp:
+		ret = MapGeoIpPostureCheckToRestModel(subType)
+		setBaseEntityDetailsOnPostureCheck(ret, i)
+	case *model.PostureCheckAttestation:
+		ret = MapAttestationPostureCheckToRestModel(subType)
+		setBaseEntityDetailsOnPostureCheck(ret, i)
 	}
 
 	return ret, nil
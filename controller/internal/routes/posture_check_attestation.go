@@ -0,0 +1,57 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"encoding/json"
+
+	"github.com/hanzozt/zt/v2/controller/db"
+	"github.com/hanzozt/zt/v2/controller/model"
+)
+
+// PostureCheckAttestationProperties are the type specific fields of an ATTESTATION posture check
+type PostureCheckAttestationProperties struct {
+	TrustedCaPem   string `json:"trustedCaPem"`
+	TimeoutSeconds int64  `json:"timeoutSeconds"`
+}
+
+// PostureCheckAttestationRequest is the create, update and patch body of an ATTESTATION posture check
+type PostureCheckAttestationRequest struct {
+	PostureCheckBaseRequest
+	PostureCheckAttestationProperties
+}
+
+func parseAttestationPostureCheck(id string, body []byte) (*model.PostureCheck, error) {
+	req := &PostureCheckAttestationRequest{}
+	if err := json.Unmarshal(body, req); err != nil {
+		return nil, err
+	}
+
+	return req.toModel(id, db.PostureCheckTypeAttestation, &model.PostureCheckAttestation{
+		TrustedCaPem:   req.TrustedCaPem,
+		TimeoutSeconds: req.TimeoutSeconds,
+	}), nil
+}
+
+func MapAttestationPostureCheckToRestModel(check *model.PostureCheckAttestation) *PostureCheckExtendedDetail {
+	return &PostureCheckExtendedDetail{
+		Properties: &PostureCheckAttestationProperties{
+			TrustedCaPem:   check.TrustedCaPem,
+			TimeoutSeconds: check.TimeoutSeconds,
+		},
+	}
+}
@@ -0,0 +1,335 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/hanzozt/edge-api/rest_model"
+	"github.com/hanzozt/foundation/v2/errorz"
+	"github.com/hanzozt/foundation/v2/stringz"
+	"github.com/hanzozt/zt/v2/controller/db"
+	"github.com/hanzozt/zt/v2/controller/env"
+	"github.com/hanzozt/zt/v2/controller/fields"
+	"github.com/hanzozt/zt/v2/controller/model"
+	"github.com/hanzozt/zt/v2/controller/models"
+	"github.com/hanzozt/zt/v2/controller/permissions"
+	"github.com/hanzozt/zt/v2/controller/response"
+)
+
+// extendedPostureCheckParser parses a create, update or patch body for a posture check type which isn't part of the
+// management API spec
+type extendedPostureCheckParser func(id string, body []byte) (*model.PostureCheck, error)
+
+var extendedPostureCheckTypes = map[string]extendedPostureCheckParser{
+	db.PostureCheckTypeGeoIp:       parseGeoIpPostureCheck,
+	db.PostureCheckTypeAttestation: parseAttestationPostureCheck,
+}
+
+// AddMiddleware hijacks the posture check create, update and patch endpoints for posture check types which aren't in
+// the management API spec. The spec uses typeId as a discriminator and rejects types it doesn't know about, so bodies
+// for these types are parsed here and all other types are passed through to the generated handlers.
+func (r *PostureCheckRouter) AddMiddleware(ae *env.AppEnv) {
+	ae.ManagementApi.AddMiddlewareFor(http.MethodPost, r.BasePath, func(next http.Handler) http.Handler {
+		return r.extendedTypeMiddleware(ae, next, permissions.Create, r.CreateExtended)
+	})
+
+	ae.ManagementApi.AddMiddlewareFor(http.MethodPut, r.BasePath+"/{id}", func(next http.Handler) http.Handler {
+		return r.extendedTypeMiddleware(ae, next, permissions.Update, r.UpdateExtended)
+	})
+
+	ae.ManagementApi.AddMiddlewareFor(http.MethodPatch, r.BasePath+"/{id}", func(next http.Handler) http.Handler {
+		return r.extendedTypeMiddleware(ae, next, permissions.Update, r.PatchExtended)
+	})
+}
+
+type extendedPostureCheckHandler func(ae *env.AppEnv, rc *response.RequestContext, parser extendedPostureCheckParser)
+
+func (r *PostureCheckRouter) extendedTypeMiddleware(ae *env.AppEnv, next http.Handler, action permissions.Action, handler extendedPostureCheckHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, request *http.Request) {
+		rc, _ := env.GetRequestContextFromHttpContext(request)
+		if rc == nil {
+			next.ServeHTTP(w, request)
+			return
+		}
+
+		id := ""
+		if route := middleware.MatchedRouteFrom(request); route != nil {
+			id = route.Params.Get("id")
+		}
+
+		parser := extendedPostureCheckTypes[getPostureCheckRequestTypeId(ae, rc, id)]
+		if parser == nil {
+			next.ServeHTTP(w, request)
+			return
+		}
+
+		ae.InitPermissionsContext(request, permissions.Management, "posture-check", action)
+		ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) {
+			handler(ae, rc, parser)
+		}, request, id, "", permissions.DefaultManagementAccess()).WriteResponse(w, rc.GetProducer())
+	})
+}
+
+// getPostureCheckRequestTypeId returns the type of the posture check a request body is for. Patches may omit the
+// typeId, in which case the type of the existing check is used.
+func getPostureCheckRequestTypeId(ae *env.AppEnv, rc *response.RequestContext, id string) string {
+	body := struct {
+		TypeId *string `json:"typeId"`
+	}{}
+
+	if err := json.Unmarshal(rc.Body, &body); err != nil {
+		return ""
+	}
+
+	if body.TypeId != nil {
+		return strings.ToUpper(*body.TypeId)
+	}
+
+	if rc.Request.Method != http.MethodPatch || id == "" {
+		return ""
+	}
+
+	if check, err := ae.Managers.PostureCheck.Read(id); err == nil {
+		return check.TypeId
+	}
+	return ""
+}
+
+func (r *PostureCheckRouter) CreateExtended(ae *env.AppEnv, rc *response.RequestContext, parser extendedPostureCheckParser) {
+	check, err := parser("", rc.Body)
+	if err != nil {
+		rc.RespondWithCouldNotParseBody(err)
+		return
+	}
+
+	if check.Name == "" {
+		rc.RespondWithFieldError(errorz.NewFieldError("name is required", "name", check.Name))
+		return
+	}
+
+	Create(rc, rc, PostureCheckLinkFactory, func() (string, error) {
+		return MapCreate(ae.Managers.PostureCheck.Create, check, rc)
+	})
+}
+
+func (r *PostureCheckRouter) UpdateExtended(ae *env.AppEnv, rc *response.RequestContext, parser extendedPostureCheckParser) {
+	id, _ := rc.GetEntityId()
+	check, err := parser(id, rc.Body)
+	if err != nil {
+		rc.RespondWithCouldNotParseBody(err)
+		return
+	}
+
+	if check.Name == "" {
+		rc.RespondWithFieldError(errorz.NewFieldError("name is required", "name", check.Name))
+		return
+	}
+
+	Update(rc, func(id string) error {
+		return ae.Managers.PostureCheck.Update(check, nil, rc.NewChangeContext())
+	})
+}
+
+func (r *PostureCheckRouter) PatchExtended(ae *env.AppEnv, rc *response.RequestContext, parser extendedPostureCheckParser) {
+	id, _ := rc.GetEntityId()
+	check, err := parser(id, rc.Body)
+	if err != nil {
+		rc.RespondWithCouldNotParseBody(err)
+		return
+	}
+
+	Patch(rc, func(id string, fields fields.UpdatedFields) error {
+		return ae.Managers.PostureCheck.Update(check, fields.FilterMaps("tags"), rc.NewChangeContext())
+	})
+}
+
+// PostureCheckBaseRequest holds the fields common to all posture check create, update and patch bodies
+type PostureCheckBaseRequest struct {
+	Name           *string                `json:"name"`
+	TypeId         string                 `json:"typeId"`
+	RoleAttributes *rest_model.Attributes `json:"roleAttributes"`
+	Tags           *rest_model.Tags       `json:"tags"`
+}
+
+func (req *PostureCheckBaseRequest) toModel(id string, typeId string, subType model.PostureCheckSubType) *model.PostureCheck {
+	return &model.PostureCheck{
+		BaseEntity: models.BaseEntity{
+			Id:   id,
+			Tags: TagsOrDefault(req.Tags),
+		},
+		Name:           stringz.OrEmpty(req.Name),
+		TypeId:         typeId,
+		Version:        1,
+		RoleAttributes: AttributesOrDefault(req.RoleAttributes),
+		SubType:        subType,
+	}
+}
+
+var _ rest_model.PostureCheckDetail = &PostureCheckExtendedDetail{}
+
+// PostureCheckExtendedDetail is the REST representation of a posture check type which isn't part of the management
+// API spec. The type specific fields are held in Properties and rendered alongside the common fields.
+type PostureCheckExtendedDetail struct {
+	links          rest_model.Links
+	createdAt      *strfmt.DateTime
+	id             *string
+	name           *string
+	roleAttributes *rest_model.Attributes
+	tags           *rest_model.Tags
+	typeId         string
+	updatedAt      *strfmt.DateTime
+	version        *int64
+
+	Properties interface{}
+}
+
+func (m *PostureCheckExtendedDetail) Links() rest_model.Links {
+	return m.links
+}
+
+func (m *PostureCheckExtendedDetail) SetLinks(val rest_model.Links) {
+	m.links = val
+}
+
+func (m *PostureCheckExtendedDetail) CreatedAt() *strfmt.DateTime {
+	return m.createdAt
+}
+
+func (m *PostureCheckExtendedDetail) SetCreatedAt(val *strfmt.DateTime) {
+	m.createdAt = val
+}
+
+func (m *PostureCheckExtendedDetail) ID() *string {
+	return m.id
+}
+
+func (m *PostureCheckExtendedDetail) SetID(val *string) {
+	m.id = val
+}
+
+func (m *PostureCheckExtendedDetail) Name() *string {
+	return m.name
+}
+
+func (m *PostureCheckExtendedDetail) SetName(val *string) {
+	m.name = val
+}
+
+func (m *PostureCheckExtendedDetail) RoleAttributes() *rest_model.Attributes {
+	return m.roleAttributes
+}
+
+func (m *PostureCheckExtendedDetail) SetRoleAttributes(val *rest_model.Attributes) {
+	m.roleAttributes = val
+}
+
+func (m *PostureCheckExtendedDetail) Tags() *rest_model.Tags {
+	return m.tags
+}
+
+func (m *PostureCheckExtendedDetail) SetTags(val *rest_model.Tags) {
+	m.tags = val
+}
+
+func (m *PostureCheckExtendedDetail) TypeID() string {
+	return m.typeId
+}
+
+func (m *PostureCheckExtendedDetail) SetTypeID(val string) {
+	m.typeId = val
+}
+
+func (m *PostureCheckExtendedDetail) UpdatedAt() *strfmt.DateTime {
+	return m.updatedAt
+}
+
+func (m *PostureCheckExtendedDetail) SetUpdatedAt(val *strfmt.DateTime) {
+	m.updatedAt = val
+}
+
+func (m *PostureCheckExtendedDetail) Version() *int64 {
+	return m.version
+}
+
+func (m *PostureCheckExtendedDetail) SetVersion(val *int64) {
+	m.version = val
+}
+
+func (m *PostureCheckExtendedDetail) Validate(strfmt.Registry) error {
+	return nil
+}
+
+func (m *PostureCheckExtendedDetail) ContextValidate(context.Context, strfmt.Registry) error {
+	return nil
+}
+
+func (m *PostureCheckExtendedDetail) MarshalJSON() ([]byte, error) {
+	base, err := json.Marshal(struct {
+		Links          rest_model.Links       `json:"_links"`
+		CreatedAt      *strfmt.DateTime       `json:"createdAt"`
+		ID             *string                `json:"id"`
+		Name           *string                `json:"name"`
+		RoleAttributes *rest_model.Attributes `json:"roleAttributes"`
+		Tags           *rest_model.Tags       `json:"tags"`
+		TypeID         string                 `json:"typeId"`
+		UpdatedAt      *strfmt.DateTime       `json:"updatedAt"`
+		Version        *int64                 `json:"version"`
+	}{
+		Links:          m.links,
+		CreatedAt:      m.createdAt,
+		ID:             m.id,
+		Name:           m.name,
+		RoleAttributes: m.roleAttributes,
+		Tags:           m.tags,
+		TypeID:         m.typeId,
+		UpdatedAt:      m.updatedAt,
+		Version:        m.version,
+	})
+
+	if err != nil || m.Properties == nil {
+		return base, err
+	}
+
+	result := map[string]json.RawMessage{}
+	if err = json.Unmarshal(base, &result); err != nil {
+		return nil, err
+	}
+
+	properties, err := json.Marshal(m.Properties)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(properties, &result); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+func stringListOrEmpty(val []string) []string {
+	if val == nil {
+		return []string{}
+	}
+	return val
+}
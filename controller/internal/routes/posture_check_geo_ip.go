@@ -0,0 +1,63 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"encoding/json"
+
+	"github.com/hanzozt/zt/v2/controller/db"
+	"github.com/hanzozt/zt/v2/controller/model"
+)
+
+// PostureCheckGeoIpProperties are the type specific fields of a GEO_IP posture check
+type PostureCheckGeoIpProperties struct {
+	AllowedCidrs     []string `json:"allowedCidrs"`
+	DeniedCidrs      []string `json:"deniedCidrs"`
+	AllowedCountries []string `json:"allowedCountries"`
+	DeniedCountries  []string `json:"deniedCountries"`
+}
+
+// PostureCheckGeoIpRequest is the create, update and patch body of a GEO_IP posture check
+type PostureCheckGeoIpRequest struct {
+	PostureCheckBaseRequest
+	PostureCheckGeoIpProperties
+}
+
+func parseGeoIpPostureCheck(id string, body []byte) (*model.PostureCheck, error) {
+	req := &PostureCheckGeoIpRequest{}
+	if err := json.Unmarshal(body, req); err != nil {
+		return nil, err
+	}
+
+	return req.toModel(id, db.PostureCheckTypeGeoIp, &model.PostureCheckGeoIp{
+		AllowedCidrs:     req.AllowedCidrs,
+		DeniedCidrs:      req.DeniedCidrs,
+		AllowedCountries: req.AllowedCountries,
+		DeniedCountries:  req.DeniedCountries,
+	}), nil
+}
+
+func MapGeoIpPostureCheckToRestModel(check *model.PostureCheckGeoIp) *PostureCheckExtendedDetail {
+	return &PostureCheckExtendedDetail{
+		Properties: &PostureCheckGeoIpProperties{
+			AllowedCidrs:     stringListOrEmpty(check.AllowedCidrs),
+			DeniedCidrs:      stringListOrEmpty(check.DeniedCidrs),
+			AllowedCountries: stringListOrEmpty(check.AllowedCountries),
+			DeniedCountries:  stringListOrEmpty(check.DeniedCountries),
+		},
+	}
+}
@@ -0,0 +1,169 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hanzozt/foundation/v2/errorz"
+	nfpem "github.com/hanzozt/foundation/v2/pem"
+	"github.com/hanzozt/storage/boltz"
+	"github.com/hanzozt/zt/v2/common/attestation"
+	"github.com/hanzozt/zt/v2/controller/db"
+	"github.com/hanzozt/zt/v2/controller/env"
+	"github.com/hanzozt/zt/v2/controller/model"
+	"github.com/hanzozt/zt/v2/controller/permissions"
+	"github.com/hanzozt/zt/v2/controller/response"
+)
+
+// PostureResponseAttestationCreate is a device attestation submitted for an ATTESTATION posture check. Signature is
+// the base64 encoded signature over attestation.Payload for the caller's API session and Timestamp, made with the
+// key of the first certificate in Certificates.
+type PostureResponseAttestationCreate struct {
+	ID           string `json:"id"`
+	TypeId       string `json:"typeId"`
+	Certificates string `json:"certificates"`
+	Timestamp    string `json:"timestamp"`
+	Signature    string `json:"signature"`
+}
+
+// PostureResponseAttestationToken is returned for a verified attestation. The token is presented to edge routers as
+// a posture response token, so that they can evaluate attestation posture checks.
+type PostureResponseAttestationToken struct {
+	Token    string          `json:"token"`
+	IssuedAt strfmt.DateTime `json:"issuedAt"`
+}
+
+// AddMiddleware hijacks the posture response endpoint for device attestations, which aren't part of the client API
+// spec. All other posture responses are passed through to the generated handler.
+func (r *PostureResponseRouter) AddMiddleware(ae *env.AppEnv) {
+	ae.ClientApi.AddMiddlewareFor(http.MethodPost, r.BasePath, func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, request *http.Request) {
+			rc, _ := env.GetRequestContextFromHttpContext(request)
+			if rc == nil || !isAttestationPostureResponse(rc.Body) {
+				next.ServeHTTP(w, request)
+				return
+			}
+
+			ae.IsAllowed(r.CreateAttestation, request, "", "", permissions.IsAuthenticated()).WriteResponse(w, rc.GetProducer())
+		})
+	})
+}
+
+func isAttestationPostureResponse(body []byte) bool {
+	val := struct {
+		TypeId string `json:"typeId"`
+	}{}
+
+	if err := json.Unmarshal(body, &val); err != nil {
+		return false
+	}
+	return strings.EqualFold(val.TypeId, db.PostureCheckTypeAttestation)
+}
+
+func (r *PostureResponseRouter) CreateAttestation(ae *env.AppEnv, rc *response.RequestContext) {
+	req := &PostureResponseAttestationCreate{}
+	if err := json.Unmarshal(rc.Body, req); err != nil {
+		rc.RespondWithCouldNotParseBody(err)
+		return
+	}
+
+	check, err := ae.Managers.PostureCheck.Read(req.ID)
+	if err != nil {
+		if boltz.IsErrNotFoundErr(err) {
+			rc.RespondWithFieldError(errorz.NewFieldError("posture check not found", "id", req.ID))
+			return
+		}
+		rc.RespondWithError(err)
+		return
+	}
+
+	attestationCheck, ok := check.SubType.(*model.PostureCheckAttestation)
+	if !ok {
+		rc.RespondWithFieldError(errorz.NewFieldError("posture check is not an attestation check", "id", req.ID))
+		return
+	}
+
+	certs, err := attestation.ParseCertificates(req.Certificates)
+	if err != nil {
+		rc.RespondWithFieldError(errorz.NewFieldError(err.Error(), "certificates", ""))
+		return
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(req.Signature)
+	if err != nil {
+		rc.RespondWithFieldError(errorz.NewFieldError("signature must be base64 encoded", "signature", req.Signature))
+		return
+	}
+
+	now := time.Now()
+	evidence := &attestation.Evidence{
+		Certificates: certs,
+		Timestamp:    req.Timestamp,
+		Signature:    signature,
+	}
+
+	if err = evidence.Verify(rc.ApiSession.Id, now); err != nil {
+		rc.RespondWithFieldError(errorz.NewFieldError(err.Error(), "signature", req.Signature))
+		return
+	}
+
+	var deviceCertificates [][]byte
+	for _, cert := range certs {
+		deviceCertificates = append(deviceCertificates, cert.Raw)
+	}
+
+	if err = attestationCheck.Verify(deviceCertificates, now); err != nil {
+		rc.RespondWithFieldError(errorz.NewFieldError("device certificate is not trusted: "+err.Error(), "certificates", ""))
+		return
+	}
+
+	postureResponse := &model.PostureResponse{
+		PostureCheckId: check.Id,
+		TypeId:         check.TypeId,
+		LastUpdatedAt:  now,
+		TimedOut:       false,
+	}
+
+	subType := &model.PostureResponseAttestation{
+		ApiSessionId:       rc.ApiSession.Id,
+		DeviceCertificates: deviceCertificates,
+		Fingerprint:        nfpem.FingerprintFromCertificate(certs[0]),
+		AttestedAt:         now.UTC(),
+	}
+
+	subType.PostureResponse = postureResponse
+	postureResponse.SubType = subType
+
+	ae.Managers.PostureResponse.Create(rc.Identity.Id, []*model.PostureResponse{postureResponse})
+
+	tokenStr, tokenClaims, err := ae.CreateAttestationToken(ae.RootIssuer(), rc.Identity.Id, rc.ApiSession.Id, deviceCertificates)
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.RespondWithOk(&PostureResponseAttestationToken{
+		Token:    tokenStr,
+		IssuedAt: strfmt.DateTime(tokenClaims.IssuedAt.Time),
+	}, nil)
+}
@@ -116,7 +116,15 @@ func GetServiceMapper(ae *env.AppEnv) func(ae *env.AppEnv, rc *response.RequestC
 }
 
 func MapServiceToRestEntity(ae *env.AppEnv, rc *response.RequestContext, service *model.ServiceDetail, fillPostureData bool) (interface{}, error) {
-	return MapServiceToRestModel(ae, rc, service, fillPostureData)
+	detail, err := MapServiceToRestModel(ae, rc, service, fillPostureData)
+	if err != nil {
+		return nil, err
+	}
+	return &ServiceExtendedDetail{
+		ServiceDetail: detail,
+		Multipath:     service.Multipath,
+		Priority:      service.Priority,
+	}, nil
 }
 
 func FillServicePostureCheckData(ae *env.AppEnv) bool {
@@ -109,7 +109,10 @@ func MapPatchServicePolicyToModel(id string, policy *rest_model.ServicePolicyPat
 }
 
 func MapServicePolicyToRestEntity(ae *env.AppEnv, _ *response.RequestContext, policy *model.ServicePolicy) (interface{}, error) {
-	return MapServicePolicyToRestModel(ae, policy), nil
+	return &ServicePolicyExtendedDetail{
+		ServicePolicyDetail: MapServicePolicyToRestModel(ae, policy),
+		Schedule:            policy.Schedule,
+	}, nil
 }
 
 func MapServicePolicyToRestModel(ae *env.AppEnv, policy *model.ServicePolicy) *rest_model.ServicePolicyDetail {
@@ -19,6 +19,7 @@ package routes
 import (
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/hanzozt/edge-api/rest_management_api_server/operations/service_policy"
+	"github.com/hanzozt/zt/v2/controller/db"
 	"github.com/hanzozt/zt/v2/controller/env"
 	"github.com/hanzozt/zt/v2/controller/fields"
 	"github.com/hanzozt/zt/v2/controller/model"
@@ -100,7 +101,12 @@ func (r *ServicePolicyRouter) Detail(ae *env.AppEnv, rc *response.RequestContext
 
 func (r *ServicePolicyRouter) Create(ae *env.AppEnv, rc *response.RequestContext, params service_policy.CreateServicePolicyParams) {
 	Create(rc, rc, ServicePolicyLinkFactory, func() (string, error) {
-		return MapCreate(ae.Managers.ServicePolicy.Create, MapCreateServicePolicyToModel(params.Policy), rc)
+		servicePolicy := MapCreateServicePolicyToModel(params.Policy)
+		var err error
+		if servicePolicy.Schedule, err = GetPolicySchedule(rc); err != nil {
+			return "", err
+		}
+		return MapCreate(ae.Managers.ServicePolicy.Create, servicePolicy, rc)
 	})
 }
 
@@ -110,13 +116,23 @@ func (r *ServicePolicyRouter) Delete(ae *env.AppEnv, rc *response.RequestContext
 
 func (r *ServicePolicyRouter) Update(ae *env.AppEnv, rc *response.RequestContext, params service_policy.UpdateServicePolicyParams) {
 	Update(rc, func(id string) error {
-		return ae.Managers.ServicePolicy.Update(MapUpdateServicePolicyToModel(params.ID, params.Policy), nil, rc.NewChangeContext())
+		servicePolicy := MapUpdateServicePolicyToModel(params.ID, params.Policy)
+		var err error
+		if servicePolicy.Schedule, err = GetPolicySchedule(rc); err != nil {
+			return err
+		}
+		return ae.Managers.ServicePolicy.Update(servicePolicy, nil, rc.NewChangeContext())
 	})
 }
 
 func (r *ServicePolicyRouter) Patch(ae *env.AppEnv, rc *response.RequestContext, params service_policy.PatchServicePolicyParams) {
 	Patch(rc, func(id string, fields fields.UpdatedFields) error {
-		return ae.Managers.ServicePolicy.Update(MapPatchServicePolicyToModel(params.ID, params.Policy), fields.FilterMaps("tags"), rc.NewChangeContext())
+		servicePolicy := MapPatchServicePolicyToModel(params.ID, params.Policy)
+		var err error
+		if servicePolicy.Schedule, err = GetPolicySchedule(rc); err != nil {
+			return err
+		}
+		return ae.Managers.ServicePolicy.Update(servicePolicy, fields.FilterMaps("tags", db.FieldPolicySchedule), rc.NewChangeContext())
 	})
 }
 
@@ -0,0 +1,114 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"encoding/json"
+
+	"github.com/hanzozt/edge-api/rest_model"
+	"github.com/hanzozt/foundation/v2/errorz"
+	"github.com/hanzozt/zt/v2/controller/db"
+	"github.com/hanzozt/zt/v2/controller/model"
+	"github.com/hanzozt/zt/v2/controller/response"
+)
+
+// Services have multipath and priority properties, which aren't part of the edge management API spec. They're read
+// from the raw create, update and patch bodies and added to the generated detail model when rendered. For example:
+//
+//	"multipath": "duplicate",
+//	"priority": "interactive"
+
+// GetServiceProperties reads and validates the service properties which aren't part of the management API spec from
+// a service create, update or patch body, setting them on the given service
+func GetServiceProperties(rc *response.RequestContext, service *model.EdgeService) error {
+	if len(rc.Body) == 0 {
+		return nil
+	}
+
+	body := struct {
+		Multipath json.RawMessage `json:"multipath"`
+		Priority  json.RawMessage `json:"priority"`
+	}{}
+
+	if err := json.Unmarshal(rc.Body, &body); err != nil {
+		// malformed bodies are reported by the generated handlers
+		return nil
+	}
+
+	var err error
+	service.Multipath, err = getServiceStringProperty(body.Multipath, db.FieldServiceMultipath, db.IsValidServiceMultipath,
+		"invalid multipath mode, must be one of 'duplicate' or 'stripe'")
+	if err != nil {
+		return err
+	}
+
+	service.Priority, err = getServiceStringProperty(body.Priority, db.FieldServicePriority, db.IsValidServicePriority,
+		"invalid priority class, must be one of 'interactive', 'bulk' or 'background'")
+	return err
+}
+
+func getServiceStringProperty(raw json.RawMessage, field string, isValid func(string) bool, invalidMsg string) (string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", nil
+	}
+
+	var result string
+	if err := json.Unmarshal(raw, &result); err != nil || !isValid(result) {
+		return "", errorz.NewFieldError(invalidMsg, field, string(raw))
+	}
+	return result, nil
+}
+
+// ServiceExtendedDetail is the REST representation of a service, including the properties which aren't part of the
+// management API spec
+type ServiceExtendedDetail struct {
+	*rest_model.ServiceDetail
+	Multipath string
+	Priority  string
+}
+
+func (m *ServiceExtendedDetail) MarshalJSON() ([]byte, error) {
+	properties := map[string]interface{}{}
+	if m.Multipath != "" {
+		properties[db.FieldServiceMultipath] = m.Multipath
+	}
+	if m.Priority != "" {
+		properties[db.FieldServicePriority] = m.Priority
+	}
+	return marshalWithProperties(m.ServiceDetail, properties)
+}
+
+// marshalWithProperties renders the given generated model, adding the given properties
+func marshalWithProperties(detail json.Marshaler, properties map[string]interface{}) ([]byte, error) {
+	base, err := detail.MarshalJSON()
+	if err != nil || len(properties) == 0 {
+		return base, err
+	}
+
+	result := map[string]json.RawMessage{}
+	if err = json.Unmarshal(base, &result); err != nil {
+		return nil, err
+	}
+
+	for k, v := range properties {
+		if result[k], err = json.Marshal(v); err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(result)
+}
@@ -0,0 +1,91 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hanzozt/edge-api/rest_model"
+	"github.com/hanzozt/foundation/v2/errorz"
+	"github.com/hanzozt/zt/v2/controller/db"
+	"github.com/hanzozt/zt/v2/controller/model"
+	"github.com/hanzozt/zt/v2/controller/response"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetServiceProperties(t *testing.T) {
+	req := require.New(t)
+
+	service := &model.EdgeService{}
+	rc := &response.RequestContext{Body: []byte(`{"name": "test", "tags": {"zt.multipath": "stripe"}}`)}
+	req.NoError(GetServiceProperties(rc, service))
+	req.Equal("", service.Multipath)
+
+	rc.Body = []byte(`{"name": "test", "multipath": "duplicate", "priority": "interactive"}`)
+	req.NoError(GetServiceProperties(rc, service))
+	req.Equal(db.ServiceMultipathDuplicate, service.Multipath)
+	req.Equal(db.ServicePriorityInteractive, service.Priority)
+
+	rc.Body = []byte(`{"multipath": null}`)
+	req.NoError(GetServiceProperties(rc, service))
+	req.Equal("", service.Multipath)
+	req.Equal("", service.Priority)
+
+	for _, body := range []string{`{"multipath": "triplicate"}`, `{"multipath": 2}`} {
+		rc.Body = []byte(body)
+		err := GetServiceProperties(rc, service)
+		var fieldErr *errorz.FieldError
+		req.ErrorAs(err, &fieldErr)
+		req.Equal(db.FieldServiceMultipath, fieldErr.FieldName)
+	}
+
+	rc.Body = []byte(`{"priority": "urgent"}`)
+	err := GetServiceProperties(rc, service)
+	var fieldErr *errorz.FieldError
+	req.ErrorAs(err, &fieldErr)
+	req.Equal(db.FieldServicePriority, fieldErr.FieldName)
+}
+
+func Test_ServiceExtendedDetailMarshal(t *testing.T) {
+	req := require.New(t)
+
+	name := "test"
+	detail := &ServiceExtendedDetail{
+		ServiceDetail: &rest_model.ServiceDetail{Name: &name},
+	}
+
+	buf, err := json.Marshal(detail)
+	req.NoError(err)
+
+	result := map[string]any{}
+	req.NoError(json.Unmarshal(buf, &result))
+	req.Equal("test", result["name"])
+	req.NotContains(result, "multipath")
+	req.NotContains(result, "priority")
+
+	detail.Multipath = db.ServiceMultipathStripe
+	detail.Priority = db.ServicePriorityBackground
+	buf, err = json.Marshal(detail)
+	req.NoError(err)
+
+	result = map[string]any{}
+	req.NoError(json.Unmarshal(buf, &result))
+	req.Equal("test", result["name"])
+	req.Equal("stripe", result["multipath"])
+	req.Equal("background", result["priority"])
+}
@@ -266,7 +266,11 @@ func (r *ServiceRouter) Detail(ae *env.AppEnv, rc *response.RequestContext) {
 
 func (r *ServiceRouter) Create(ae *env.AppEnv, rc *response.RequestContext, params managementService.CreateServiceParams) {
 	Create(rc, rc, ServiceLinkFactory, func() (string, error) {
-		return MapCreate(ae.Managers.EdgeService.Create, MapCreateServiceToModel(params.Service), rc)
+		service := MapCreateServiceToModel(params.Service)
+		if err := GetServiceProperties(rc, service); err != nil {
+			return "", err
+		}
+		return MapCreate(ae.Managers.EdgeService.Create, service, rc)
 	})
 }
 
@@ -276,13 +280,21 @@ func (r *ServiceRouter) Delete(ae *env.AppEnv, rc *response.RequestContext) {
 
 func (r *ServiceRouter) Update(ae *env.AppEnv, rc *response.RequestContext, params managementService.UpdateServiceParams) {
 	Update(rc, func(id string) error {
-		return ae.Managers.EdgeService.Update(MapUpdateServiceToModel(params.ID, params.Service), nil, rc.NewChangeContext())
+		service := MapUpdateServiceToModel(params.ID, params.Service)
+		if err := GetServiceProperties(rc, service); err != nil {
+			return err
+		}
+		return ae.Managers.EdgeService.Update(service, nil, rc.NewChangeContext())
 	})
 }
 
 func (r *ServiceRouter) Patch(ae *env.AppEnv, rc *response.RequestContext, params managementService.PatchServiceParams) {
 	Patch(rc, func(id string, fields fields.UpdatedFields) error {
-		return ae.Managers.EdgeService.Update(MapPatchServiceToModel(params.ID, params.Service), fields.FilterMaps("tags").MapField("maxIdleTimeMillis", "maxIdleTime"), rc.NewChangeContext())
+		service := MapPatchServiceToModel(params.ID, params.Service)
+		if err := GetServiceProperties(rc, service); err != nil {
+			return err
+		}
+		return ae.Managers.EdgeService.Update(service, fields.FilterMaps("tags").MapField("maxIdleTimeMillis", "maxIdleTime"), rc.NewChangeContext())
 	})
 }
 
@@ -402,9 +414,10 @@ func getServiceEdgeRouters(ae *env.AppEnv, rc *response.RequestContext, serviceI
 
 		syncStatus := string(state.SyncStatus)
 		cost := int64(edgeRouter.Cost)
+		isOnline := isEdgeRouterAvailable(ae, edgeRouter.Id, state.IsOnline)
 		er := &rest_model.CommonEdgeRouterProperties{
 			Hostname:           &state.Hostname,
-			IsOnline:           &state.IsOnline,
+			IsOnline:           &isOnline,
 			Name:               &edgeRouter.Name,
 			SupportedProtocols: state.Protocols,
 			SyncStatus:         &syncStatus,
@@ -161,10 +161,11 @@ func getSessionEdgeRouters(ae *env.AppEnv, ns *model.Session) ([]*rest_model.Ses
 
 		syncStatus := string(state.SyncStatus)
 		cost := int64(edgeRouter.Cost)
+		isOnline := isEdgeRouterAvailable(ae, edgeRouter.Id, state.IsOnline)
 		restModel := &rest_model.SessionEdgeRouter{
 			CommonEdgeRouterProperties: rest_model.CommonEdgeRouterProperties{
 				Hostname:           &state.Hostname,
-				IsOnline:           &state.IsOnline,
+				IsOnline:           &isOnline,
 				Name:               &edgeRouter.Name,
 				SupportedProtocols: state.Protocols,
 				SyncStatus:         &syncStatus,
@@ -36,11 +36,17 @@ type Circuit struct {
 	ServiceId  string
 	Terminator xt.CostedTerminator
 	Path       *Path
+	AltPath    *Path
+	Multipath  string
+	Priority   string
 	Tags       map[string]string
 	Rerouting  atomic.Bool
 	PeerData   xt.PeerData
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
+
+	// RouteConstraints restricts the routers the circuit may be routed over, including when it's rerouted
+	RouteConstraints *RouteConstraints
 }
 
 func (self *Circuit) GetId() string {
@@ -75,7 +81,7 @@ func (self *Circuit) HasRouter(routerId string) bool {
 	if self == nil || self.Path == nil {
 		return false
 	}
-	for _, node := range self.Path.Nodes {
+	for _, node := range self.GetRouters() {
 		if node.Id == routerId {
 			return true
 		}
@@ -83,6 +89,21 @@ func (self *Circuit) HasRouter(routerId string) bool {
 	return false
 }
 
+// GetRouters returns the routers the circuit is routed through. For multipath circuits, the transit routers of the
+// alternate path follow the routers of the primary path.
+func (self *Circuit) GetRouters() []*Router {
+	if self.AltPath == nil || len(self.AltPath.Nodes) < 3 {
+		return self.Path.Nodes
+	}
+	result := append([]*Router{}, self.Path.Nodes...)
+	return append(result, self.AltPath.Nodes[1:len(self.AltPath.Nodes)-1]...)
+}
+
+// UsesLink returns true if either the primary or, for multipath circuits, the alternate path uses the given link
+func (self *Circuit) UsesLink(l *Link) bool {
+	return self.Path.UsesLink(l) || (self.AltPath != nil && self.AltPath.UsesLink(l))
+}
+
 func (self *Circuit) IsEndpointRouter(routerId string) bool {
 	if self == nil || self.Path == nil || len(self.Path.Nodes) == 0 {
 		return false
@@ -164,4 +185,5 @@ type CreateCircuitParams interface {
 	GetCircuitTags(terminator xt.CostedTerminator) map[string]string
 	GetLogContext() logcontext.Context
 	GetDeadline() time.Time
+	GetRouteConstraints() *RouteConstraints
 }
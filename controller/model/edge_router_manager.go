@@ -19,6 +19,7 @@ package model
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/michaelquigley/pfxlog"
 	"github.com/hanzozt/storage/boltz"
@@ -227,7 +228,8 @@ func (self *EdgeRouterManager) IsAccessToEdgeRouterAllowed(identityId, serviceId
 
 		identityCount := identityEdgeRouters.GetLinkCount(tx, []byte(identityId), []byte(edgeRouterId))
 		serviceCount := serviceEdgeRouters.GetLinkCount(tx, []byte(serviceId), []byte(edgeRouterId))
-		result = identityCount != nil && *identityCount > 0 && serviceCount != nil && *serviceCount > 0
+		result = identityCount != nil && *identityCount > 0 && serviceCount != nil && *serviceCount > 0 &&
+			self.IsEdgeRouterAccessScheduled(tx, identityId, edgeRouterId, time.Now())
 		return nil
 	})
 	if err != nil {
@@ -243,23 +245,23 @@ func (self *EdgeRouterManager) ListForIdentityAndServiceWithTx(tx *bbolt.Tx, ide
 	if err := self.ListWithTx(tx, query, result.collect); err != nil {
 		return nil, err
 	}
+
+	now := time.Now()
+	var edgeRouters []*EdgeRouter
+	for _, edgeRouter := range result.EdgeRouters {
+		if self.IsEdgeRouterAccessScheduled(tx, identityId, edgeRouter.Id, now) {
+			edgeRouters = append(edgeRouters, edgeRouter)
+		}
+	}
+	result.EdgeRouters = edgeRouters
+
 	return result, nil
 }
 
 func (self *EdgeRouterManager) IsSharedEdgeRouterPresent(identityId, serviceId string) (bool, error) {
 	var result bool
 	err := self.GetDb().View(func(tx *bbolt.Tx) error {
-		identityEdgeRouters := self.env.GetStores().Identity.GetRefCountedLinkCollection(db.EntityTypeRouters)
-		serviceEdgeRouters := self.env.GetStores().EdgeService.GetRefCountedLinkCollection(db.FieldEdgeRouters)
-
-		cursor := identityEdgeRouters.IterateLinks(tx, []byte(identityId), true)
-		for cursor.IsValid() {
-			serviceCount := serviceEdgeRouters.GetLinkCount(tx, []byte(serviceId), cursor.Current())
-			if result = serviceCount != nil && *serviceCount > 0; result {
-				return nil
-			}
-			cursor.Next()
-		}
+		result = self.IsSharedEdgeRouterPresentInTx(tx, identityId, serviceId)
 		return nil
 	})
 	if err != nil {
@@ -268,6 +270,22 @@ func (self *EdgeRouterManager) IsSharedEdgeRouterPresent(identityId, serviceId s
 	return result, nil
 }
 
+func (self *EdgeRouterManager) IsSharedEdgeRouterPresentInTx(tx *bbolt.Tx, identityId, serviceId string) bool {
+	identityEdgeRouters := self.env.GetStores().Identity.GetRefCountedLinkCollection(db.EntityTypeRouters)
+	serviceEdgeRouters := self.env.GetStores().EdgeService.GetRefCountedLinkCollection(db.FieldEdgeRouters)
+
+	now := time.Now()
+	cursor := identityEdgeRouters.IterateLinks(tx, []byte(identityId), true)
+	for cursor.IsValid() {
+		serviceCount := serviceEdgeRouters.GetLinkCount(tx, []byte(serviceId), cursor.Current())
+		if serviceCount != nil && *serviceCount > 0 && self.IsEdgeRouterAccessScheduled(tx, identityId, string(cursor.Current()), now) {
+			return true
+		}
+		cursor.Next()
+	}
+	return false
+}
+
 func (self *EdgeRouterManager) QueryRoleAttributes(queryString string) ([]string, *models.QueryMetaData, error) {
 	index := self.env.GetStores().EdgeRouter.GetRoleAttributesIndex()
 	return self.queryRoleAttributes(index, queryString)
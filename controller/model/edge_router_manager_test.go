@@ -14,6 +14,7 @@ func TestEdgeRouterManager(t *testing.T) {
 	ctx.Init()
 
 	t.Run("test get edge routers for service and identity", ctx.testGetEdgeRoutersForServiceAndIdentity)
+	t.Run("test resolve route constraints", ctx.testResolveRouteConstraints)
 }
 
 func (ctx *TestContext) testGetEdgeRoutersForServiceAndIdentity(*testing.T) {
@@ -48,6 +49,31 @@ func (ctx *TestContext) testGetEdgeRoutersForServiceAndIdentity(*testing.T) {
 
 }
 
+func (ctx *TestContext) testResolveRouteConstraints(*testing.T) {
+	attr := eid.New()
+	edgeRouter := &EdgeRouter{
+		Name:           eid.New(),
+		RoleAttributes: []string{attr},
+	}
+	ctx.NoError(ctx.managers.EdgeRouter.Create(edgeRouter, change.New()))
+
+	fabricRouter := &Router{Name: eid.New()}
+	ctx.NoError(ctx.managers.Router.Create(fabricRouter, change.New()))
+
+	config := &RouteConstraintsConfig{
+		AllowedRouterRoles:  ss("#all"),
+		DeniedRouterRoles:   ss("@"+fabricRouter.Id, "@"+eid.New()),
+		RequiredRouterRoles: ss("#"+attr, "@"+fabricRouter.Id),
+	}
+	constraints, err := ctx.managers.EdgeRouter.ResolveRouteConstraints(config)
+	ctx.NoError(err)
+	ctx.Same(config, constraints.Config)
+	ctx.Contains(constraints.Allowed, edgeRouter.Id)
+	ctx.Contains(constraints.Allowed, fabricRouter.Id)
+	ctx.Equal(map[string]struct{}{fabricRouter.Id: {}}, constraints.Denied)
+	ctx.Equal(map[string]struct{}{edgeRouter.Id: {}, fabricRouter.Id: {}}, constraints.Required)
+}
+
 func (ctx *TestContext) isEdgeRouterAccessible(edgeRouterId, identityId, serviceId string) bool {
 	found := false
 	err := ctx.GetDb().View(func(tx *bbolt.Tx) error {
@@ -76,6 +76,7 @@ func (self *EdgeRouterPolicyManager) Marshall(entity *EdgeRouterPolicy) ([]byte,
 		Semantic:        entity.Semantic,
 		EdgeRouterRoles: entity.EdgeRouterRoles,
 		IdentityRoles:   entity.IdentityRoles,
+		Schedule:        edge_cmd_pb.EncodePolicySchedule(entity.Schedule),
 	}
 
 	return proto.Marshal(msg)
@@ -96,5 +97,6 @@ func (self *EdgeRouterPolicyManager) Unmarshall(bytes []byte) (*EdgeRouterPolicy
 		Semantic:        msg.Semantic,
 		IdentityRoles:   msg.IdentityRoles,
 		EdgeRouterRoles: msg.EdgeRouterRoles,
+		Schedule:        edge_cmd_pb.DecodePolicySchedule(msg.Schedule),
 	}, nil
 }
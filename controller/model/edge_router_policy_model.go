@@ -18,6 +18,7 @@ package model
 
 import (
 	"github.com/hanzozt/storage/boltz"
+	"github.com/hanzozt/zt/v2/common/schedule"
 	"github.com/hanzozt/zt/v2/controller/db"
 	"github.com/hanzozt/zt/v2/controller/models"
 	"go.etcd.io/bbolt"
@@ -29,6 +30,7 @@ type EdgeRouterPolicy struct {
 	Semantic        string
 	IdentityRoles   []string
 	EdgeRouterRoles []string
+	Schedule        *schedule.Spec
 }
 
 func (entity *EdgeRouterPolicy) toBoltEntity() (*db.EdgeRouterPolicy, error) {
@@ -38,6 +40,7 @@ func (entity *EdgeRouterPolicy) toBoltEntity() (*db.EdgeRouterPolicy, error) {
 		Semantic:        entity.Semantic,
 		IdentityRoles:   entity.IdentityRoles,
 		EdgeRouterRoles: entity.EdgeRouterRoles,
+		Schedule:        entity.Schedule,
 	}, nil
 }
 
@@ -55,5 +58,6 @@ func (entity *EdgeRouterPolicy) fillFrom(_ Env, _ *bbolt.Tx, boltEdgeRouterPolic
 	entity.Semantic = boltEdgeRouterPolicy.Semantic
 	entity.EdgeRouterRoles = boltEdgeRouterPolicy.EdgeRouterRoles
 	entity.IdentityRoles = boltEdgeRouterPolicy.IdentityRoles
+	entity.Schedule = boltEdgeRouterPolicy.Schedule
 	return nil
 }
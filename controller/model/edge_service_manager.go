@@ -132,7 +132,8 @@ func (self *EdgeServiceManager) IsBindableByIdentity(id string, identityId strin
 	result := false
 	err := self.GetDb().View(func(tx *bbolt.Tx) error {
 		var err error
-		result = self.env.GetStores().EdgeService.IsBindableByIdentity(tx, id, identityId)
+		result = self.env.GetStores().EdgeService.IsBindableByIdentity(tx, id, identityId) &&
+			self.IsServiceAccessScheduled(tx, id, identityId, db.PolicyTypeBind, time.Now())
 		return err
 	})
 	return result, err
@@ -143,6 +144,10 @@ func (self *EdgeServiceManager) ReadForIdentityInTx(tx *bbolt.Tx, id string, ide
 	isBindable := edgeServiceStore.IsBindableByIdentity(tx, id, identityId)
 	isDialable := edgeServiceStore.IsDialableByIdentity(tx, id, identityId)
 
+	now := time.Now()
+	isBindable = isBindable && self.IsServiceAccessScheduled(tx, id, identityId, db.PolicyTypeBind, now)
+	isDialable = isDialable && self.IsServiceAccessScheduled(tx, id, identityId, db.PolicyTypeDial, now)
+
 	if !isBindable && !isDialable && !isMgmtAccess { // admin can view services even if policies don't permit bind/dial {
 		return nil, boltz.NewNotFoundError(self.GetStore().GetSingularEntityType(), "id", id)
 	}
@@ -198,6 +203,7 @@ func (self *EdgeServiceManager) queryServices(query ast.Query, identityId string
 		}
 	} else {
 		cursorProvider := self.env.GetStores().Identity.GetIdentityServicesCursorProvider(identityId)
+		cursorProvider = self.scheduledServicesCursorProvider(identityId, cursorProvider)
 		if err := self.PreparedListIndexed(cursorProvider, query, result.collect); err != nil {
 			return nil, err
 		}
@@ -225,6 +231,8 @@ func (self *EdgeServiceManager) Marshall(entity *EdgeService) ([]byte, error) {
 		RoleAttributes:     entity.RoleAttributes,
 		Configs:            entity.Configs,
 		EncryptionRequired: entity.EncryptionRequired,
+		Multipath:          entity.Multipath,
+		Priority:           entity.Priority,
 	}
 
 	return proto.Marshal(msg)
@@ -247,6 +255,8 @@ func (self *EdgeServiceManager) Unmarshall(bytes []byte) (*EdgeService, error) {
 		RoleAttributes:     msg.RoleAttributes,
 		Configs:            msg.Configs,
 		EncryptionRequired: msg.EncryptionRequired,
+		Multipath:          msg.Multipath,
+		Priority:           msg.Priority,
 	}, nil
 }
 
@@ -278,6 +288,70 @@ func (result *ServiceListResult) collect(tx *bbolt.Tx, ids []string, queryMetaDa
 	return nil
 }
 
+// ReadConfigForIdentity returns the data of the config of the given type which applies to the service for the given
+// identity, taking identity service config overrides into account. Nil is returned if no config of that type applies.
+func (self *EdgeServiceManager) ReadConfigForIdentity(id string, identityId string, configTypeId string) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := self.GetDb().View(func(tx *bbolt.Tx) error {
+		service, err := self.readInTx(tx, id)
+		if err != nil {
+			return err
+		}
+
+		configTypeName := self.env.GetStores().ConfigType.GetName(tx, configTypeId)
+		if configTypeName == nil {
+			return nil
+		}
+
+		configTypes := map[string]struct{}{configTypeId: {}}
+		identityServiceConfigs := self.env.GetStores().Identity.LoadServiceConfigsByServiceAndType(tx, identityId, configTypes)
+		self.mergeConfigs(tx, configTypes, service, identityServiceConfigs)
+		result = service.Config[*configTypeName]
+		return nil
+	})
+	return result, err
+}
+
+// ReadHostConfigs returns the data of the configs of the given types which apply to the service for each of the
+// identities hosting it with the given binding, taking identity service config overrides into account. An empty
+// binding matches all terminators. If the service has no terminators, the configs attached to the service are returned.
+func (self *EdgeServiceManager) ReadHostConfigs(id string, binding string, configTypeIds ...string) ([]map[string]interface{}, error) {
+	var result []map[string]interface{}
+	err := self.GetDb().View(func(tx *bbolt.Tx) error {
+		service, err := self.readInTx(tx, id)
+		if err != nil {
+			return err
+		}
+
+		hostIds := map[string]struct{}{}
+		terminatorIds := self.env.GetStores().Service.GetRelatedEntitiesIdList(tx, id, db.EntityTypeTerminators)
+		for _, terminatorId := range terminatorIds {
+			terminator, _ := self.env.GetStores().Terminator.LoadById(tx, terminatorId)
+			if terminator != nil && terminator.HostId != "" && (binding == "" || terminator.Binding == binding) {
+				hostIds[terminator.HostId] = struct{}{}
+			}
+		}
+		if len(terminatorIds) == 0 {
+			hostIds[""] = struct{}{}
+		}
+
+		configTypes := map[string]struct{}{}
+		for _, configTypeId := range configTypeIds {
+			configTypes[configTypeId] = struct{}{}
+		}
+
+		for hostId := range hostIds {
+			identityServiceConfigs := self.env.GetStores().Identity.LoadServiceConfigsByServiceAndType(tx, hostId, configTypes)
+			self.mergeConfigs(tx, configTypes, service, identityServiceConfigs)
+			for _, config := range service.Config {
+				result = append(result, config)
+			}
+		}
+		return nil
+	})
+	return result, err
+}
+
 func (self *EdgeServiceManager) mergeConfigs(tx *bbolt.Tx, configTypes map[string]struct{}, service *ServiceDetail,
 	identityServiceConfigs map[string]map[string]map[string]interface{}) {
 	service.Config = map[string]map[string]interface{}{}
@@ -35,6 +35,8 @@ type EdgeService struct {
 	RoleAttributes     []string      `json:"roleAttributes"`
 	Configs            []string      `json:"configs"`
 	EncryptionRequired bool          `json:"encryptionRequired"`
+	Multipath          string        `json:"multipath"`
+	Priority           string        `json:"priority"`
 }
 
 func (entity *EdgeService) toBoltEntity(tx *bbolt.Tx, env Env) (*db.EdgeService, error) {
@@ -48,6 +50,8 @@ func (entity *EdgeService) toBoltEntity(tx *bbolt.Tx, env Env) (*db.EdgeService,
 			Name:               entity.Name,
 			MaxIdleTime:        entity.MaxIdleTime,
 			TerminatorStrategy: entity.TerminatorStrategy,
+			Multipath:          entity.Multipath,
+			Priority:           entity.Priority,
 		},
 		RoleAttributes:     entity.RoleAttributes,
 		Configs:            entity.Configs,
@@ -99,6 +103,8 @@ func (entity *EdgeService) fillFrom(_ Env, _ *bbolt.Tx, boltService *db.EdgeServ
 	entity.RoleAttributes = boltService.RoleAttributes
 	entity.Configs = boltService.Configs
 	entity.EncryptionRequired = boltService.EncryptionRequired
+	entity.Multipath = boltService.Multipath
+	entity.Priority = boltService.Priority
 	return nil
 }
 
@@ -112,6 +118,8 @@ type ServiceDetail struct {
 	Configs            []string                          `json:"configs"`
 	Config             map[string]map[string]interface{} `json:"config"`
 	EncryptionRequired bool                              `json:"encryptionRequired"`
+	Multipath          string                            `json:"multipath"`
+	Priority           string                            `json:"priority"`
 }
 
 func (entity *ServiceDetail) toBoltEntityForCreate(*bbolt.Tx, Env) (*db.EdgeService, error) {
@@ -130,6 +138,8 @@ func (entity *ServiceDetail) fillFrom(_ Env, _ *bbolt.Tx, boltService *db.EdgeSe
 	entity.RoleAttributes = boltService.RoleAttributes
 	entity.Configs = boltService.Configs
 	entity.EncryptionRequired = boltService.EncryptionRequired
+	entity.Multipath = boltService.Multipath
+	entity.Priority = boltService.Priority
 
 	return nil
 }
@@ -35,18 +35,22 @@ type LinkManager struct {
 	linkTable      *linkTable
 	lock           sync.Mutex
 	initialLatency time.Duration
+	costConfig     *config.LinkCostConfig
 	models.BaseObjectStoreManager[*Link]
 }
 
 func NewLinkManager(env Env) *LinkManager {
 	initialLatency := config.DefaultOptionsInitialLinkLatency
+	var costConfig *config.LinkCostConfig
 	if env != nil {
 		initialLatency = env.GetConfig().Network.InitialLinkLatency
+		costConfig = &env.GetConfig().Network.LinkCost
 	}
 
 	result := &LinkManager{
 		linkTable:      newLinkTable(),
 		initialLatency: initialLatency,
+		costConfig:     costConfig,
 	}
 
 	result.InitStore(objectz.NewObjectStore[*Link](func() objectz.ObjectIterator[*Link] {
@@ -159,7 +163,7 @@ func (self *LinkManager) RouterReportedLink(reportedLink *ctrl_pb.RouterLinks_Ro
 		log.Infof("replaced link with newer iteration %v => %v", link.Iteration, reportedLink.Iteration)
 	}
 
-	link = newLink(reportedLink.Id, reportedLink.LinkProtocol, reportedLink.DialAddress, self.initialLatency)
+	link = newLink(reportedLink.Id, reportedLink.LinkProtocol, reportedLink.DialAddress, self.initialLatency, self.costConfig)
 	link.Iteration = reportedLink.Iteration
 	link.Src = src
 	link.Dst.Store(dst)
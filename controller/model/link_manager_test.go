@@ -20,7 +20,9 @@ import (
 	"sync/atomic"
 	"testing"
 
+	"github.com/hanzozt/zt/v2/controller/config"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // A simple test to check for failure of alignment on atomic operations for 64 bit variables in a struct
@@ -35,9 +37,47 @@ func Test64BitAlignment(t *testing.T) {
 
 	atomic.LoadInt64(&link.SrcLatency)
 	atomic.LoadInt64(&link.DstLatency)
+	atomic.LoadInt64(&link.SrcLoss)
+	atomic.LoadInt64(&link.DstLoss)
+	atomic.LoadInt64(&link.SrcJitter)
+	atomic.LoadInt64(&link.DstJitter)
+	atomic.LoadInt64(&link.SrcBandwidth)
+	atomic.LoadInt64(&link.DstBandwidth)
 	atomic.LoadInt64(&link.Cost)
 }
 
+func TestLinkQualityCost(t *testing.T) {
+	req := require.New(t)
+
+	costConfig := &config.LinkCostConfig{}
+	link := newLink("l0", "tls", "tls:localhost:1234", 0, costConfig)
+	link.SetSrcLatency(2_000_000)
+	link.SetDstLatency(3_000_000)
+	req.Equal(int64(6), link.GetCost())
+
+	// quality figures don't contribute to cost unless configured
+	link.SetSrcQuality(250, 4_000_000, 5_000_000)
+	link.SetDstQuality(100, 2_000_000, 0)
+	req.Equal(int64(6), link.GetCost())
+
+	costConfig.LossCost = 10
+	link.RecalculateCost()
+	req.Equal(int64(6+25), link.GetCost())
+
+	costConfig.JitterFactor = 0.5
+	link.RecalculateCost()
+	req.Equal(int64(6+25+3), link.GetCost())
+
+	costConfig.MinAvailableBandwidth = 10_000_000
+	costConfig.LowBandwidthCost = 100
+	link.RecalculateCost()
+	req.Equal(int64(6+25+3+100), link.GetCost())
+
+	// unknown bandwidth isn't treated as low bandwidth
+	link.SetSrcQuality(250, 4_000_000, 0)
+	req.Equal(int64(6+25+3), link.GetCost())
+}
+
 func TestLifecycle(t *testing.T) {
 	linkController := NewLinkManager(nil)
 
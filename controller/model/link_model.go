@@ -23,29 +23,37 @@ import (
 
 	"github.com/hanzozt/foundation/v2/concurrenz"
 	"github.com/hanzozt/zt/v2/common/pb/ctrl_pb"
+	"github.com/hanzozt/zt/v2/controller/config"
 )
 
 // TODO: Add CreateDate
 type Link struct {
-	Id          string
-	SrcLatency  int64
-	DstLatency  int64
-	Cost        int64
-	Iteration   uint32
-	Src         *Router
-	DstId       string
-	Dst         concurrenz.AtomicValue[*Router]
-	Protocol    string
-	DialAddress string
-	state       LinkState
-	down        bool
-	StaticCost  int32
-	connState   concurrenz.AtomicValue[*ctrl_pb.LinkConnState]
-	usable      atomic.Bool
-	lock        sync.Mutex
-}
-
-func newLink(id string, linkProtocol string, dialAddress string, initialLatency time.Duration) *Link {
+	Id           string
+	SrcLatency   int64
+	DstLatency   int64
+	SrcLoss      int64 // hundredths of a percent
+	DstLoss      int64 // hundredths of a percent
+	SrcJitter    int64
+	DstJitter    int64
+	SrcBandwidth int64 // bits per second, zero if unknown
+	DstBandwidth int64 // bits per second, zero if unknown
+	Cost         int64
+	Iteration    uint32
+	Src          *Router
+	DstId        string
+	Dst          concurrenz.AtomicValue[*Router]
+	Protocol     string
+	DialAddress  string
+	state        LinkState
+	down         bool
+	StaticCost   int32
+	connState    concurrenz.AtomicValue[*ctrl_pb.LinkConnState]
+	usable       atomic.Bool
+	lock         sync.Mutex
+	costConfig   *config.LinkCostConfig
+}
+
+func newLink(id string, linkProtocol string, dialAddress string, initialLatency time.Duration, costConfig *config.LinkCostConfig) *Link {
 	l := &Link{
 		Id:          id,
 		Protocol:    linkProtocol,
@@ -58,6 +66,7 @@ func newLink(id string, linkProtocol string, dialAddress string, initialLatency
 		StaticCost: 1,
 		SrcLatency: initialLatency.Nanoseconds(),
 		DstLatency: initialLatency.Nanoseconds(),
+		costConfig: costConfig,
 	}
 	l.RecalculateCost()
 	l.recalculateUsable()
@@ -155,8 +164,65 @@ func (link *Link) SetDstLatency(latency int64) {
 	link.RecalculateCost()
 }
 
+// SetSrcQuality sets the loss, in hundredths of a percent, the jitter, in nanoseconds, and the available bandwidth, in
+// bits per second, reported by the source router
+func (link *Link) SetSrcQuality(loss, jitter, bandwidth int64) {
+	atomic.StoreInt64(&link.SrcLoss, loss)
+	atomic.StoreInt64(&link.SrcJitter, jitter)
+	atomic.StoreInt64(&link.SrcBandwidth, bandwidth)
+	link.RecalculateCost()
+}
+
+// SetDstQuality sets the loss, in hundredths of a percent, the jitter, in nanoseconds, and the available bandwidth, in
+// bits per second, reported by the destination router
+func (link *Link) SetDstQuality(loss, jitter, bandwidth int64) {
+	atomic.StoreInt64(&link.DstLoss, loss)
+	atomic.StoreInt64(&link.DstJitter, jitter)
+	atomic.StoreInt64(&link.DstBandwidth, bandwidth)
+	link.RecalculateCost()
+}
+
+func (link *Link) GetSrcLoss() int64 {
+	return atomic.LoadInt64(&link.SrcLoss)
+}
+
+func (link *Link) GetDstLoss() int64 {
+	return atomic.LoadInt64(&link.DstLoss)
+}
+
+func (link *Link) GetSrcJitter() int64 {
+	return atomic.LoadInt64(&link.SrcJitter)
+}
+
+func (link *Link) GetDstJitter() int64 {
+	return atomic.LoadInt64(&link.DstJitter)
+}
+
+func (link *Link) GetSrcBandwidth() int64 {
+	return atomic.LoadInt64(&link.SrcBandwidth)
+}
+
+func (link *Link) GetDstBandwidth() int64 {
+	return atomic.LoadInt64(&link.DstBandwidth)
+}
+
 func (link *Link) RecalculateCost() {
 	cost := int64(link.GetStaticCost()) + link.GetSrcLatency()/1_000_000 + link.GetDstLatency()/1_000_000
+
+	if costConfig := link.costConfig; costConfig != nil {
+		cost += int64(costConfig.LossCost) * max(link.GetSrcLoss(), link.GetDstLoss()) / 100
+		cost += int64(costConfig.JitterFactor * float64(link.GetSrcJitter()+link.GetDstJitter()) / 1_000_000)
+
+		if costConfig.MinAvailableBandwidth > 0 {
+			isLow := func(bandwidth int64) bool {
+				return bandwidth > 0 && bandwidth < costConfig.MinAvailableBandwidth
+			}
+			if isLow(link.GetSrcBandwidth()) || isLow(link.GetDstBandwidth()) {
+				cost += int64(costConfig.LowBandwidthCost)
+			}
+		}
+	}
+
 	atomic.StoreInt64(&link.Cost, cost)
 }
 
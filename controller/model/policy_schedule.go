@@ -0,0 +1,203 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/hanzozt/storage/ast"
+	"github.com/hanzozt/zt/v2/common/schedule"
+	"github.com/hanzozt/zt/v2/controller/db"
+	"go.etcd.io/bbolt"
+)
+
+// Policy links are denormalized without regard to policy schedules, so that schedules opening and closing doesn't
+// require rewriting links. The functions here are applied on top of the denormalized links to check that at least one
+// of the policies providing access is currently in force.
+
+// IsServiceAccessScheduled returns true if at least one of the identity's policies of the given type which include
+// the service is currently within its schedule
+func (self *EdgeServiceManager) IsServiceAccessScheduled(tx *bbolt.Tx, serviceId, identityId string, policyType db.PolicyType, now time.Time) bool {
+	servicePolicyStore := self.env.GetStores().ServicePolicy
+	serviceLinks := servicePolicyStore.GetLinkCollection(db.EntityTypeServices)
+
+	cursor := self.env.GetStores().Identity.GetRelatedEntitiesCursor(tx, identityId, db.EntityTypeServicePolicies, true)
+	for cursor.IsValid() {
+		policyId := cursor.Current()
+		cursor.Next()
+
+		if !serviceLinks.IsLinked(tx, policyId, []byte(serviceId)) {
+			continue
+		}
+
+		policy, err := servicePolicyStore.LoadById(tx, string(policyId))
+		if err != nil {
+			pfxlog.Logger().WithError(err).WithField("servicePolicyId", string(policyId)).Error("unable to load service policy")
+			continue
+		}
+
+		if policy.PolicyType == policyType && isPolicyScheduleActive(policy.Id, policy.Schedule, now) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// scheduledServicesCursorProvider wraps the cursor over the services an identity has policy access to, dropping
+// services which the identity only has access to through policies which are outside their schedules
+func (self *EdgeServiceManager) scheduledServicesCursorProvider(identityId string, provider ast.SetCursorProvider) ast.SetCursorProvider {
+	return func(tx *bbolt.Tx, forward bool) ast.SetCursor {
+		cursor := provider(tx, forward)
+
+		activePolicies, scheduled := self.getActiveServicePolicies(tx, identityId, time.Now())
+		if !scheduled {
+			return cursor
+		}
+
+		serviceLinks := self.env.GetStores().ServicePolicy.GetLinkCollection(db.EntityTypeServices)
+		return ast.NewFilteredCursor(cursor, func(serviceId []byte) bool {
+			for _, policyId := range activePolicies {
+				if serviceLinks.IsLinked(tx, []byte(policyId), serviceId) {
+					return true
+				}
+			}
+			return false
+		})
+	}
+}
+
+// getActiveServicePolicies returns the ids of the identity's service policies which are currently in force. It also
+// returns whether any of the identity's policies have a schedule. If none do, all the policies are in force.
+func (self *EdgeServiceManager) getActiveServicePolicies(tx *bbolt.Tx, identityId string, now time.Time) ([]string, bool) {
+	servicePolicyStore := self.env.GetStores().ServicePolicy
+
+	var active []string
+	scheduled := false
+
+	cursor := self.env.GetStores().Identity.GetRelatedEntitiesCursor(tx, identityId, db.EntityTypeServicePolicies, true)
+	for cursor.IsValid() {
+		policyId := string(cursor.Current())
+		cursor.Next()
+
+		policy, err := servicePolicyStore.LoadById(tx, policyId)
+		if err != nil {
+			pfxlog.Logger().WithError(err).WithField("servicePolicyId", policyId).Error("unable to load service policy")
+			continue
+		}
+
+		if policy.Schedule != nil {
+			scheduled = true
+		}
+
+		if isPolicyScheduleActive(policy.Id, policy.Schedule, now) {
+			active = append(active, policyId)
+		}
+	}
+
+	return active, scheduled
+}
+
+// IsEdgeRouterAccessScheduled returns true if at least one of the identity's edge router policies which include the
+// edge router is currently within its schedule
+func (self *EdgeRouterManager) IsEdgeRouterAccessScheduled(tx *bbolt.Tx, identityId, edgeRouterId string, now time.Time) bool {
+	edgeRouterPolicyStore := self.env.GetStores().EdgeRouterPolicy
+	edgeRouterLinks := edgeRouterPolicyStore.GetLinkCollection(db.EntityTypeRouters)
+
+	cursor := self.env.GetStores().Identity.GetRelatedEntitiesCursor(tx, identityId, db.EntityTypeEdgeRouterPolicies, true)
+	for cursor.IsValid() {
+		policyId := cursor.Current()
+		cursor.Next()
+
+		if !edgeRouterLinks.IsLinked(tx, policyId, []byte(edgeRouterId)) {
+			continue
+		}
+
+		policy, err := edgeRouterPolicyStore.LoadById(tx, string(policyId))
+		if err != nil {
+			pfxlog.Logger().WithError(err).WithField("edgeRouterPolicyId", string(policyId)).Error("unable to load edge router policy")
+			continue
+		}
+
+		if isPolicyScheduleActive(policy.Id, policy.Schedule, now) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetScheduledIdentityAccess returns, for each edge router, the identities whose access to the router is only granted
+// by edge router policies with schedules, along with those policies. Routers use this to refuse and close connections
+// from identities whose policy schedules have closed.
+func (self *EdgeRouterManager) GetScheduledIdentityAccess(tx *bbolt.Tx) map[string]map[string][]*db.EdgeRouterPolicy {
+	stores := self.env.GetStores()
+	result := map[string]map[string][]*db.EdgeRouterPolicy{}
+	scheduledPolicies := map[string]struct{}{}
+
+	for cursor := stores.EdgeRouterPolicy.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+		policy, err := stores.EdgeRouterPolicy.LoadById(tx, string(cursor.Current()))
+		if err != nil {
+			pfxlog.Logger().WithError(err).WithField("edgeRouterPolicyId", string(cursor.Current())).Error("unable to load edge router policy")
+			continue
+		}
+
+		if policy.Schedule == nil {
+			continue
+		}
+
+		scheduledPolicies[policy.Id] = struct{}{}
+
+		identityIds := stores.EdgeRouterPolicy.GetRelatedEntitiesIdList(tx, policy.Id, db.EntityTypeIdentities)
+		for _, routerId := range stores.EdgeRouterPolicy.GetRelatedEntitiesIdList(tx, policy.Id, db.EntityTypeRouters) {
+			identities, found := result[routerId]
+			if !found {
+				identities = map[string][]*db.EdgeRouterPolicy{}
+				result[routerId] = identities
+			}
+			for _, identityId := range identityIds {
+				identities[identityId] = append(identities[identityId], policy)
+			}
+		}
+	}
+
+	// identities which also reach the router through a policy without a schedule aren't restricted
+	edgeRouterLinks := stores.EdgeRouterPolicy.GetLinkCollection(db.EntityTypeRouters)
+	for routerId, identities := range result {
+		for identityId := range identities {
+			cursor := stores.Identity.GetRelatedEntitiesCursor(tx, identityId, db.EntityTypeEdgeRouterPolicies, true)
+			for ; cursor.IsValid(); cursor.Next() {
+				policyId := cursor.Current()
+				if _, scheduled := scheduledPolicies[string(policyId)]; !scheduled && edgeRouterLinks.IsLinked(tx, policyId, []byte(routerId)) {
+					delete(identities, identityId)
+					break
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+func isPolicyScheduleActive(policyId string, spec *schedule.Spec, now time.Time) bool {
+	s, err := schedule.Parse(spec)
+	if err != nil {
+		pfxlog.Logger().WithError(err).WithField("policyId", policyId).Error("invalid policy schedule, policy will not grant access")
+	}
+	return s.IsActive(now)
+}
@@ -0,0 +1,84 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hanzozt/storage/ast"
+	"github.com/hanzozt/zt/v2/common/schedule"
+	"github.com/hanzozt/zt/v2/controller/change"
+	"github.com/hanzozt/zt/v2/controller/db"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+func TestPolicySchedules(t *testing.T) {
+	ctx := NewTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	t.Run("test service list filtered by policy schedules", ctx.testServiceListFilteredBySchedule)
+	t.Run("test scheduled edge router access", ctx.testScheduledIdentityAccess)
+}
+
+func (ctx *TestContext) testServiceListFilteredBySchedule(t *testing.T) {
+	req := require.New(t)
+
+	identity := ctx.requireNewIdentity(false)
+	service := ctx.requireNewService()
+	scheduledService := ctx.requireNewService()
+
+	ctx.requireNewServicePolicy(db.PolicyTypeDialName, ss("@"+identity.Id), ss("@"+service.Id))
+	policy := ctx.requireNewServicePolicy(db.PolicyTypeDialName, ss("@"+identity.Id), ss("@"+scheduledService.Id))
+
+	listServiceIds := func() []string {
+		query, err := ast.Parse(ctx.GetStores().EdgeService, "true")
+		req.NoError(err)
+		result, err := ctx.managers.EdgeService.QueryForIdentity(identity.Id, nil, query)
+		req.NoError(err)
+		var ids []string
+		for _, svc := range result.Services {
+			ids = append(ids, svc.Id)
+		}
+		return ids
+	}
+
+	req.ElementsMatch([]string{service.Id, scheduledService.Id}, listServiceIds())
+
+	notAfter := time.Now().Add(-time.Hour)
+	policy.Schedule = &schedule.Spec{NotAfter: &notAfter}
+	ctx.NoError(ctx.managers.ServicePolicy.Update(policy, nil, change.New()))
+
+	req.ElementsMatch([]string{service.Id}, listServiceIds())
+
+	notAfter = time.Now().Add(time.Hour)
+	ctx.NoError(ctx.managers.ServicePolicy.Update(policy, nil, change.New()))
+
+	req.ElementsMatch([]string{service.Id, scheduledService.Id}, listServiceIds())
+}
+
+func (ctx *TestContext) testScheduledIdentityAccess(t *testing.T) {
+	req := require.New(t)
+
+	identity := ctx.requireNewIdentity(false)
+	otherIdentity := ctx.requireNewIdentity(false)
+	edgeRouter := ctx.requireNewEdgeRouter()
+
+	notAfter := time.Now().Add(time.Hour)
+	policy := ctx.requireNewEdgeRouterPolicy(ss("@"+identity.Id, "@"+otherIdentity.Id), ss("@"+edgeRouter.Id))
+	policy.Schedule = &schedule.Spec{NotAfter: &notAfter}
+	ctx.NoError(ctx.managers.EdgeRouterPolicy.Update(policy, nil, change.New()))
+
+	// other identity also has access without a schedule, so isn't restricted
+	ctx.requireNewEdgeRouterPolicy(ss("@"+otherIdentity.Id), ss("@"+edgeRouter.Id))
+
+	ctx.NoError(ctx.GetDb().View(func(tx *bbolt.Tx) error {
+		access := ctx.managers.EdgeRouter.GetScheduledIdentityAccess(tx)
+		identities := access[edgeRouter.Id]
+		req.Len(identities, 1)
+		req.Len(identities[identity.Id], 1)
+		req.Equal(policy.Id, identities[identity.Id][0].Id)
+		req.NotNil(identities[identity.Id][0].Schedule)
+		return nil
+	}))
+}
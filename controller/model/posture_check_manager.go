@@ -138,6 +138,12 @@ func (self *PostureCheckManager) IsUpdated(field string) bool {
 		strings.EqualFold(field, db.FieldPostureCheckProcessMultiPath) ||
 		strings.EqualFold(field, db.FieldPostureCheckProcessMultiSignerFingerprints) ||
 		strings.EqualFold(field, db.FieldPostureCheckProcessMultiProcesses) ||
+		strings.EqualFold(field, db.FieldPostureCheckGeoIpAllowedCidrs) ||
+		strings.EqualFold(field, db.FieldPostureCheckGeoIpDeniedCidrs) ||
+		strings.EqualFold(field, db.FieldPostureCheckGeoIpAllowedCountries) ||
+		strings.EqualFold(field, db.FieldPostureCheckGeoIpDeniedCountries) ||
+		strings.EqualFold(field, db.FieldPostureCheckAttestationTrustedCaPem) ||
+		strings.EqualFold(field, db.FieldPostureCheckAttestationTimeoutSeconds) ||
 		strings.EqualFold(field, db.FieldSemantic)
 }
 
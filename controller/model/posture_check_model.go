@@ -66,6 +66,8 @@ const (
 	PostureCheckTypeProcessMulti = "PROCESS_MULTI"
 	PostureCheckTypeMAC          = "MAC"
 	PostureCheckTypeMFA          = "MFA"
+	PostureCheckTypeGeoIp        = "GEO_IP"
+	PostureCheckTypeAttestation  = "ATTESTATION"
 )
 
 var postureCheckSubTypeMap = map[string]newPostureCheckSubType{
@@ -75,6 +77,8 @@ var postureCheckSubTypeMap = map[string]newPostureCheckSubType{
 	PostureCheckTypeProcessMulti: newPostureCheckProcessMulti,
 	PostureCheckTypeMAC:          newPostureCheckMacAddresses,
 	PostureCheckTypeMFA:          newPostureCheckMfa,
+	PostureCheckTypeGeoIp:        newPostureCheckGeoIp,
+	PostureCheckTypeAttestation:  newPostureCheckAttestation,
 }
 
 func newSubType(typeId string) PostureCheckSubType {
@@ -0,0 +1,203 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hanzozt/zt/v2/common/attestation"
+	"github.com/hanzozt/zt/v2/common/pb/edge_cmd_pb"
+	"github.com/hanzozt/zt/v2/controller/db"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+var _ PostureCheckSubType = &PostureCheckAttestation{}
+
+// PostureCheckAttestation requires that an API session has a verified device attestation whose device certificate
+// chains to one of the check's trusted CAs. If TimeoutSeconds is set, the attestation must be repeated within that
+// many seconds.
+type PostureCheckAttestation struct {
+	TrustedCaPem   string
+	TimeoutSeconds int64
+}
+
+func (p *PostureCheckAttestation) TypeId() string {
+	return db.PostureCheckTypeAttestation
+}
+
+func (p *PostureCheckAttestation) fillProtobuf(msg *edge_cmd_pb.PostureCheck) {
+	msg.Subtype = &edge_cmd_pb.PostureCheck_Attestation_{
+		Attestation: &edge_cmd_pb.PostureCheck_Attestation{
+			TrustedCaPem:   p.TrustedCaPem,
+			TimeoutSeconds: p.TimeoutSeconds,
+		},
+	}
+}
+
+func (p *PostureCheckAttestation) fillFromProtobuf(msg *edge_cmd_pb.PostureCheck) error {
+	if attestation_, ok := msg.Subtype.(*edge_cmd_pb.PostureCheck_Attestation_); ok {
+		if attestationCheck := attestation_.Attestation; attestationCheck != nil {
+			p.TrustedCaPem = attestationCheck.TrustedCaPem
+			p.TimeoutSeconds = attestationCheck.TimeoutSeconds
+		}
+	} else {
+		return errors.Errorf("expected posture check sub type data of attestation, but got %T", msg.Subtype)
+	}
+	return nil
+}
+
+func (p *PostureCheckAttestation) getAttestation(apiSessionId string, pd *PostureData) *PostureResponseAttestation {
+	if pd == nil {
+		return nil
+	}
+
+	apiSessionData := pd.ApiSessions[apiSessionId]
+	if apiSessionData == nil {
+		return nil
+	}
+	return apiSessionData.Attestation
+}
+
+func (p *PostureCheckAttestation) LastUpdatedAt(apiSessionId string, pd *PostureData) *time.Time {
+	if attestationData := p.getAttestation(apiSessionId, pd); attestationData != nil {
+		return &attestationData.AttestedAt
+	}
+	return nil
+}
+
+func (p *PostureCheckAttestation) GetTimeoutSeconds() int64 {
+	if p.TimeoutSeconds > 0 {
+		return p.TimeoutSeconds
+	}
+
+	return PostureCheckNoTimeout
+}
+
+func (p *PostureCheckAttestation) GetTimeoutRemainingSeconds(apiSessionId string, pd *PostureData) int64 {
+	return p.getTimeoutRemainingAtSeconds(apiSessionId, pd, time.Now())
+}
+
+func (p *PostureCheckAttestation) getTimeoutRemainingAtSeconds(apiSessionId string, pd *PostureData, now time.Time) int64 {
+	if p.TimeoutSeconds <= 0 {
+		return PostureCheckNoTimeout
+	}
+
+	attestationData := p.getAttestation(apiSessionId, pd)
+	if attestationData == nil {
+		return 0
+	}
+
+	timeoutRemaining := p.TimeoutSeconds - int64(now.Sub(attestationData.AttestedAt).Seconds())
+	if timeoutRemaining <= 0 {
+		return 0
+	}
+	return timeoutRemaining
+}
+
+// Verify checks the device certificate chain of an attestation against the check's trusted CAs
+func (p *PostureCheckAttestation) Verify(deviceCertificates [][]byte, now time.Time) error {
+	roots, err := attestation.NewCertPool(p.TrustedCaPem)
+	if err != nil {
+		return errors.Wrap(err, "invalid trusted CA bundle")
+	}
+
+	chain, err := attestation.ParseDerCertificates(deviceCertificates)
+	if err != nil {
+		return errors.Wrap(err, "invalid device certificate")
+	}
+
+	return attestation.VerifyChain(roots, chain, now)
+}
+
+func (p *PostureCheckAttestation) evaluateAt(apiSessionId string, pd *PostureData, now time.Time) error {
+	attestationData := p.getAttestation(apiSessionId, pd)
+	if attestationData == nil {
+		return errors.New("no device attestation")
+	}
+
+	if p.TimeoutSeconds > 0 && p.getTimeoutRemainingAtSeconds(apiSessionId, pd, now) == 0 {
+		return errors.New("device attestation has timed out")
+	}
+
+	return p.Verify(attestationData.DeviceCertificates, now)
+}
+
+func (p *PostureCheckAttestation) Evaluate(apiSessionId string, pd *PostureData) bool {
+	return p.evaluateAt(apiSessionId, pd, time.Now()) == nil
+}
+
+func (p *PostureCheckAttestation) FailureValues(apiSessionId string, pd *PostureData) PostureCheckFailureValues {
+	actual := map[string]interface{}{
+		"attested":   false,
+		"attestedAt": nil,
+	}
+
+	if attestationData := p.getAttestation(apiSessionId, pd); attestationData != nil {
+		actual["attested"] = true
+		actual["attestedAt"] = attestationData.AttestedAt
+		actual["deviceCertificateFingerprint"] = attestationData.Fingerprint
+	}
+
+	if err := p.evaluateAt(apiSessionId, pd, time.Now()); err != nil {
+		actual["reason"] = err.Error()
+	}
+
+	return &PostureCheckFailureValuesAttestation{
+		ActualValue: actual,
+		ExpectedValue: map[string]interface{}{
+			"timeoutSeconds": p.TimeoutSeconds,
+		},
+	}
+}
+
+func newPostureCheckAttestation() PostureCheckSubType {
+	return &PostureCheckAttestation{}
+}
+
+func (p *PostureCheckAttestation) fillFrom(_ Env, _ *bbolt.Tx, _ *db.PostureCheck, subType db.PostureCheckSubType) error {
+	subCheck, ok := subType.(*db.PostureCheckAttestation)
+
+	if !ok || subCheck == nil {
+		return fmt.Errorf("could not convert attestation check to bolt type")
+	}
+
+	p.TrustedCaPem = subCheck.TrustedCaPem
+	p.TimeoutSeconds = subCheck.TimeoutSeconds
+	return nil
+}
+
+func (p *PostureCheckAttestation) toBoltEntityForCreate(*bbolt.Tx, Env) (db.PostureCheckSubType, error) {
+	return &db.PostureCheckAttestation{
+		TrustedCaPem:   p.TrustedCaPem,
+		TimeoutSeconds: p.TimeoutSeconds,
+	}, nil
+}
+
+type PostureCheckFailureValuesAttestation struct {
+	ActualValue   map[string]interface{}
+	ExpectedValue map[string]interface{}
+}
+
+func (p PostureCheckFailureValuesAttestation) Expected() interface{} {
+	return p.ExpectedValue
+}
+
+func (p PostureCheckFailureValuesAttestation) Actual() interface{} {
+	return p.ActualValue
+}
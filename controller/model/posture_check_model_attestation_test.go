@@ -0,0 +1,117 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostureCheckModelAttestation_Evaluate(t *testing.T) {
+	const apiSessionId = "api-session-1"
+
+	newCert := func(name string, isCa bool, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		template := &x509.Certificate{
+			SerialNumber:          big.NewInt(time.Now().UnixNano()),
+			Subject:               pkix.Name{CommonName: name},
+			NotBefore:             time.Now().Add(-time.Hour),
+			NotAfter:              time.Now().Add(time.Hour),
+			BasicConstraintsValid: true,
+			IsCA:                  isCa,
+			KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		}
+
+		if parent == nil {
+			parent, parentKey = template, key
+		}
+
+		der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+		require.NoError(t, err)
+
+		cert, err := x509.ParseCertificate(der)
+		require.NoError(t, err)
+		return cert, key
+	}
+
+	ca, caKey := newCert("ca", true, nil, nil)
+	device, _ := newCert("device", false, ca, caKey)
+	otherCa, otherCaKey := newCert("other-ca", true, nil, nil)
+	otherDevice, _ := newCert("other-device", false, otherCa, otherCaKey)
+
+	newCheckAndData := func(deviceCert *x509.Certificate, attestedAt time.Time) (*PostureCheckAttestation, *PostureData) {
+		check := &PostureCheckAttestation{
+			TrustedCaPem:   string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw})),
+			TimeoutSeconds: -1,
+		}
+
+		postureData := newPostureData()
+		response := &PostureResponseAttestation{
+			PostureResponse:    &PostureResponse{},
+			ApiSessionId:       apiSessionId,
+			DeviceCertificates: [][]byte{deviceCert.Raw},
+			AttestedAt:         attestedAt,
+		}
+		response.Apply(postureData)
+
+		return check, postureData
+	}
+
+	t.Run("returns true for a device certificate from a trusted CA", func(t *testing.T) {
+		check, postureData := newCheckAndData(device, time.Now())
+		require.True(t, check.Evaluate(apiSessionId, postureData))
+	})
+
+	t.Run("returns false for a device certificate from an untrusted CA", func(t *testing.T) {
+		check, postureData := newCheckAndData(otherDevice, time.Now())
+		require.False(t, check.Evaluate(apiSessionId, postureData))
+	})
+
+	t.Run("returns false if the api session has no attestation", func(t *testing.T) {
+		check, postureData := newCheckAndData(device, time.Now())
+		require.False(t, check.Evaluate("other-session", postureData))
+	})
+
+	t.Run("returns false if the attestation has timed out", func(t *testing.T) {
+		check, postureData := newCheckAndData(device, time.Now().Add(-2*time.Minute))
+		check.TimeoutSeconds = 60
+
+		req := require.New(t)
+		req.False(check.Evaluate(apiSessionId, postureData))
+		req.Equal(int64(0), check.GetTimeoutRemainingSeconds(apiSessionId, postureData))
+	})
+
+	t.Run("returns true if the attestation is within the timeout", func(t *testing.T) {
+		check, postureData := newCheckAndData(device, time.Now().Add(-30*time.Second))
+		check.TimeoutSeconds = 60
+
+		req := require.New(t)
+		req.True(check.Evaluate(apiSessionId, postureData))
+		req.Greater(check.GetTimeoutRemainingSeconds(apiSessionId, postureData), int64(0))
+	})
+}
@@ -0,0 +1,180 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"fmt"
+	"net/netip"
+	"time"
+
+	"github.com/hanzozt/zt/v2/common/geoip"
+	"github.com/hanzozt/zt/v2/common/pb/edge_cmd_pb"
+	"github.com/hanzozt/zt/v2/controller/db"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+var _ PostureCheckSubType = &PostureCheckGeoIp{}
+
+// PostureCheckGeoIp evaluates the source address of an API session, as seen by the controller, against CIDR and
+// country allow and deny lists. Countries are resolved using the GeoIP database configured for the controller, if
+// any.
+type PostureCheckGeoIp struct {
+	AllowedCidrs     []string
+	DeniedCidrs      []string
+	AllowedCountries []string
+	DeniedCountries  []string
+}
+
+func (p *PostureCheckGeoIp) TypeId() string {
+	return db.PostureCheckTypeGeoIp
+}
+
+func (p *PostureCheckGeoIp) rules() *geoip.Rules {
+	return &geoip.Rules{
+		AllowedCidrs:     p.AllowedCidrs,
+		DeniedCidrs:      p.DeniedCidrs,
+		AllowedCountries: p.AllowedCountries,
+		DeniedCountries:  p.DeniedCountries,
+	}
+}
+
+func (p *PostureCheckGeoIp) fillProtobuf(msg *edge_cmd_pb.PostureCheck) {
+	msg.Subtype = &edge_cmd_pb.PostureCheck_GeoIp_{
+		GeoIp: &edge_cmd_pb.PostureCheck_GeoIp{
+			AllowedCidrs:     p.AllowedCidrs,
+			DeniedCidrs:      p.DeniedCidrs,
+			AllowedCountries: p.AllowedCountries,
+			DeniedCountries:  p.DeniedCountries,
+		},
+	}
+}
+
+func (p *PostureCheckGeoIp) fillFromProtobuf(msg *edge_cmd_pb.PostureCheck) error {
+	if geoIp_, ok := msg.Subtype.(*edge_cmd_pb.PostureCheck_GeoIp_); ok {
+		if geoIp := geoIp_.GeoIp; geoIp != nil {
+			p.AllowedCidrs = geoIp.AllowedCidrs
+			p.DeniedCidrs = geoIp.DeniedCidrs
+			p.AllowedCountries = geoIp.AllowedCountries
+			p.DeniedCountries = geoIp.DeniedCountries
+		}
+	} else {
+		return errors.Errorf("expected posture check sub type data of geo ip, but got %T", msg.Subtype)
+	}
+	return nil
+}
+
+func (p *PostureCheckGeoIp) LastUpdatedAt(string, *PostureData) *time.Time {
+	return nil
+}
+
+func (p *PostureCheckGeoIp) GetTimeoutSeconds() int64 {
+	return PostureCheckNoTimeout
+}
+
+func (p *PostureCheckGeoIp) GetTimeoutRemainingSeconds(_ string, _ *PostureData) int64 {
+	return PostureCheckNoTimeout
+}
+
+func (p *PostureCheckGeoIp) source(apiSessionId string, pd *PostureData) (netip.Addr, string) {
+	apiSessionData := pd.ApiSessions[apiSessionId]
+	if apiSessionData == nil || apiSessionData.SourceAddress == "" {
+		return netip.Addr{}, ""
+	}
+
+	addr, err := netip.ParseAddr(apiSessionData.SourceAddress)
+	if err != nil {
+		return netip.Addr{}, ""
+	}
+	return addr, apiSessionData.SourceCountry
+}
+
+func (p *PostureCheckGeoIp) FailureValues(apiSessionId string, pd *PostureData) PostureCheckFailureValues {
+	addr, country := p.source(apiSessionId, pd)
+
+	actual := map[string]interface{}{
+		"sourceAddress": "",
+		"country":       country,
+	}
+
+	if addr.IsValid() {
+		actual["sourceAddress"] = addr.String()
+	}
+
+	if err := p.rules().Evaluate(addr, country); err != nil {
+		actual["reason"] = err.Error()
+	}
+
+	return &PostureCheckFailureValuesGeoIp{
+		ActualValue:   actual,
+		ExpectedValue: p.ExpectedValue(),
+	}
+}
+
+func (p *PostureCheckGeoIp) ExpectedValue() map[string]interface{} {
+	return map[string]interface{}{
+		"allowedCidrs":     p.AllowedCidrs,
+		"deniedCidrs":      p.DeniedCidrs,
+		"allowedCountries": p.AllowedCountries,
+		"deniedCountries":  p.DeniedCountries,
+	}
+}
+
+func (p *PostureCheckGeoIp) Evaluate(apiSessionId string, pd *PostureData) bool {
+	addr, country := p.source(apiSessionId, pd)
+	return p.rules().Evaluate(addr, country) == nil
+}
+
+func newPostureCheckGeoIp() PostureCheckSubType {
+	return &PostureCheckGeoIp{}
+}
+
+func (p *PostureCheckGeoIp) fillFrom(_ Env, _ *bbolt.Tx, _ *db.PostureCheck, subType db.PostureCheckSubType) error {
+	subCheck, ok := subType.(*db.PostureCheckGeoIp)
+
+	if !ok || subCheck == nil {
+		return fmt.Errorf("could not convert geo ip check to bolt type")
+	}
+
+	p.AllowedCidrs = subCheck.AllowedCidrs
+	p.DeniedCidrs = subCheck.DeniedCidrs
+	p.AllowedCountries = subCheck.AllowedCountries
+	p.DeniedCountries = subCheck.DeniedCountries
+	return nil
+}
+
+func (p *PostureCheckGeoIp) toBoltEntityForCreate(*bbolt.Tx, Env) (db.PostureCheckSubType, error) {
+	return &db.PostureCheckGeoIp{
+		AllowedCidrs:     p.AllowedCidrs,
+		DeniedCidrs:      p.DeniedCidrs,
+		AllowedCountries: p.AllowedCountries,
+		DeniedCountries:  p.DeniedCountries,
+	}, nil
+}
+
+type PostureCheckFailureValuesGeoIp struct {
+	ActualValue   map[string]interface{}
+	ExpectedValue map[string]interface{}
+}
+
+func (p PostureCheckFailureValuesGeoIp) Expected() interface{} {
+	return p.ExpectedValue
+}
+
+func (p PostureCheckFailureValuesGeoIp) Actual() interface{} {
+	return p.ActualValue
+}
@@ -0,0 +1,87 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostureCheckModelGeoIp_Evaluate(t *testing.T) {
+	const apiSessionId = "api-session-1"
+
+	newCheckAndData := func(sourceAddress, country string) (*PostureCheckGeoIp, *PostureData) {
+		check := &PostureCheckGeoIp{
+			AllowedCidrs:     []string{"10.0.0.0/8"},
+			DeniedCidrs:      []string{"10.66.0.0/16"},
+			AllowedCountries: []string{"US"},
+			DeniedCountries:  []string{"KP"},
+		}
+
+		postureData := &PostureData{
+			ApiSessions: map[string]*ApiSessionPostureData{
+				apiSessionId: {
+					SourceAddress: sourceAddress,
+					SourceCountry: country,
+				},
+			},
+		}
+
+		return check, postureData
+	}
+
+	t.Run("returns true for an address in an allowed CIDR", func(t *testing.T) {
+		check, postureData := newCheckAndData("10.1.2.3", "")
+		require.True(t, check.Evaluate(apiSessionId, postureData))
+	})
+
+	t.Run("returns true for an address in an allowed country", func(t *testing.T) {
+		check, postureData := newCheckAndData("8.8.8.8", "US")
+		require.True(t, check.Evaluate(apiSessionId, postureData))
+	})
+
+	t.Run("returns false for an address in a denied CIDR", func(t *testing.T) {
+		check, postureData := newCheckAndData("10.66.1.1", "US")
+		require.False(t, check.Evaluate(apiSessionId, postureData))
+	})
+
+	t.Run("returns false for an address in a denied country", func(t *testing.T) {
+		check, postureData := newCheckAndData("8.8.8.8", "KP")
+		require.False(t, check.Evaluate(apiSessionId, postureData))
+	})
+
+	t.Run("returns false for an address not allowed", func(t *testing.T) {
+		check, postureData := newCheckAndData("8.8.8.8", "FR")
+		require.False(t, check.Evaluate(apiSessionId, postureData))
+	})
+
+	t.Run("returns false if the source address is unknown", func(t *testing.T) {
+		check, postureData := newCheckAndData("10.1.2.3", "")
+		require.False(t, check.Evaluate("other-session", postureData))
+	})
+
+	t.Run("failure values include the reason", func(t *testing.T) {
+		check, postureData := newCheckAndData("8.8.8.8", "KP")
+		actual := check.FailureValues(apiSessionId, postureData).Actual().(map[string]interface{})
+
+		req := require.New(t)
+		req.Equal("8.8.8.8", actual["sourceAddress"])
+		req.Equal("KP", actual["country"])
+		req.Contains(actual["reason"], "denied country")
+	})
+}
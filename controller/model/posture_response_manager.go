@@ -23,6 +23,7 @@ import (
 
 	"github.com/michaelquigley/pfxlog"
 	"github.com/hanzozt/storage/ast"
+	"github.com/hanzozt/zt/v2/common/geoip"
 	"github.com/hanzozt/zt/v2/controller/change"
 	"github.com/hanzozt/zt/v2/controller/db"
 	"go.etcd.io/bbolt"
@@ -34,6 +35,16 @@ func NewPostureResponseManager(env Env) *PostureResponseManager {
 		postureCache: newPostureCache(env),
 	}
 
+	if path := env.GetConfig().Edge.GeoIpDatabase; path != "" {
+		geoIpDb, err := geoip.Load(path)
+		if err != nil {
+			pfxlog.Logger().WithError(err).Error("unable to load geoip database, geo ip posture checks will not match countries")
+		} else {
+			pfxlog.Logger().WithField("path", path).WithField("ranges", geoIpDb.Len()).Info("loaded geoip database")
+			manager.geoIpDb = geoIpDb
+		}
+	}
+
 	manager.AddPostureDataListener(manager.postureDataUpdated)
 	return manager
 }
@@ -41,6 +52,7 @@ func NewPostureResponseManager(env Env) *PostureResponseManager {
 type PostureResponseManager struct {
 	env          Env
 	postureCache *PostureCache
+	geoIpDb      *geoip.Database
 }
 
 func (self *PostureResponseManager) Create(identityId string, postureResponses []*PostureResponse) {
@@ -255,6 +267,50 @@ func (self *PostureResponseManager) SetSdkInfo(identityId, apiSessionId string,
 	})
 }
 
+// SetSourceAddress records the address an API session is being used from, along with the country it resolves to,
+// for use by geo ip posture checks. Posture data listeners are only notified if the address changes.
+func (self *PostureResponseManager) SetSourceAddress(identityId, apiSessionId, remoteAddr string) {
+	if identityId == "" || apiSessionId == "" || remoteAddr == "" {
+		return
+	}
+
+	addr, err := geoip.ParseAddr(remoteAddr)
+	if err != nil {
+		pfxlog.Logger().WithError(err).WithField("remoteAddr", remoteAddr).Debug("unable to parse api session source address")
+		return
+	}
+
+	sourceAddress := addr.String()
+	sourceCountry := self.geoIpDb.Lookup(addr)
+	changed := false
+
+	self.postureCache.Upsert(identityId, false, func(exist bool, valueInMap *PostureData, newValue *PostureData) *PostureData {
+		var postureData *PostureData
+		if exist {
+			postureData = valueInMap
+		} else {
+			postureData = newValue
+		}
+
+		if _, ok := postureData.ApiSessions[apiSessionId]; !ok {
+			postureData.ApiSessions[apiSessionId] = &ApiSessionPostureData{}
+		}
+
+		apiSessionData := postureData.ApiSessions[apiSessionId]
+		if apiSessionData.SourceAddress != sourceAddress {
+			apiSessionData.SourceAddress = sourceAddress
+			apiSessionData.SourceCountry = sourceCountry
+			changed = true
+		}
+
+		return postureData
+	})
+
+	if changed {
+		self.postureCache.Emit(EventIdentityPostureDataAltered, identityId)
+	}
+}
+
 type ServiceWithTimeout struct {
 	Service *EdgeService
 	Timeout int64
@@ -352,6 +352,9 @@ type ApiSessionPostureData struct {
 	Mfa           *PostureResponseMfa           `json:"mfa"`
 	EndpointState *PostureResponseEndpointState `json:"endpointState"`
 	SdkInfo       *SdkInfo
+	SourceAddress string                      `json:"sourceAddress"`
+	SourceCountry string                      `json:"sourceCountry"`
+	Attestation   *PostureResponseAttestation `json:"attestation"`
 }
 
 func (self *ApiSessionPostureData) GetPassedMfaAt() *time.Time {
@@ -0,0 +1,49 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+)
+
+// PostureResponseAttestation is a device attestation which has been verified for an API session
+type PostureResponseAttestation struct {
+	*PostureResponse
+	ApiSessionId       string
+	DeviceCertificates [][]byte
+	Fingerprint        string
+	AttestedAt         time.Time
+}
+
+func (pr *PostureResponseAttestation) Apply(postureData *PostureData) {
+	if pr.ApiSessionId == "" {
+		pfxlog.Logger().Error("invalid attempt to apply attestation posture, empty API Session id")
+		return
+	}
+
+	if postureData.ApiSessions == nil {
+		postureData.ApiSessions = map[string]*ApiSessionPostureData{}
+	}
+
+	if postureData.ApiSessions[pr.ApiSessionId] == nil {
+		postureData.ApiSessions[pr.ApiSessionId] = &ApiSessionPostureData{}
+	}
+
+	postureData.ApiSessions[pr.ApiSessionId].Attestation = pr
+}
@@ -0,0 +1,179 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hanzozt/foundation/v2/stringz"
+	"github.com/hanzozt/storage/ast"
+	"github.com/hanzozt/zt/v2/controller/db"
+	"go.etcd.io/bbolt"
+)
+
+// RouteConstraintsConfig is the contents of a route-constraints.v1 config. Each field is a list of router roles,
+// either role attributes prefixed with # or router ids prefixed with @. A router matches if it matches any of the
+// roles. Only edge routers have role attributes, so fabric routers can only be matched by id or by #all.
+type RouteConstraintsConfig struct {
+	// AllowedRouterRoles, if set, limits paths to the matching routers
+	AllowedRouterRoles []string `json:"allowedRouterRoles,omitempty"`
+	// DeniedRouterRoles excludes the matching routers from paths
+	DeniedRouterRoles []string `json:"deniedRouterRoles,omitempty"`
+	// RequiredRouterRoles, if set, requires paths to pass through at least one of the matching routers
+	RequiredRouterRoles []string `json:"requiredRouterRoles,omitempty"`
+}
+
+func (self *RouteConstraintsConfig) IsEmpty() bool {
+	return len(self.AllowedRouterRoles) == 0 && len(self.DeniedRouterRoles) == 0 && len(self.RequiredRouterRoles) == 0
+}
+
+func ParseRouteConstraintsConfig(data map[string]interface{}) (*RouteConstraintsConfig, error) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	result := &RouteConstraintsConfig{}
+	if err = json.Unmarshal(encoded, result); err != nil {
+		return nil, fmt.Errorf("invalid route constraints config (%w)", err)
+	}
+	return result, nil
+}
+
+// RouteConstraints restricts the routers which a circuit path may use. Each set holds router ids. A nil Allowed set
+// permits all routers which aren't denied and a nil Required set requires nothing. Config is the config the sets were
+// resolved from, so they can be resolved again when the circuit is rerouted.
+type RouteConstraints struct {
+	Config   *RouteConstraintsConfig
+	Allowed  map[string]struct{}
+	Denied   map[string]struct{}
+	Required map[string]struct{}
+}
+
+// Permits returns true if the given router may be part of a path
+func (self *RouteConstraints) Permits(r *Router) bool {
+	if self == nil {
+		return true
+	}
+	if _, denied := self.Denied[r.Id]; denied {
+		return false
+	}
+	if self.Allowed != nil {
+		_, allowed := self.Allowed[r.Id]
+		return allowed
+	}
+	return true
+}
+
+// HasRequired returns true if paths must pass through one of a set of required routers
+func (self *RouteConstraints) HasRequired() bool {
+	return self != nil && self.Required != nil
+}
+
+// IsRequired returns true if the given router is one of the routers a path must pass through
+func (self *RouteConstraints) IsRequired(r *Router) bool {
+	if !self.HasRequired() {
+		return false
+	}
+	_, required := self.Required[r.Id]
+	return required
+}
+
+// PermitsPath returns true if the given path satisfies the constraints
+func (self *RouteConstraints) PermitsPath(path []*Router) bool {
+	if self == nil {
+		return true
+	}
+	requiredFound := !self.HasRequired()
+	for _, r := range path {
+		if !self.Permits(r) {
+			return false
+		}
+		if self.IsRequired(r) {
+			requiredFound = true
+		}
+	}
+	return requiredFound
+}
+
+// ResolveRouteConstraints evaluates the router roles of the given config against the current routers
+func (self *EdgeRouterManager) ResolveRouteConstraints(config *RouteConstraintsConfig) (*RouteConstraints, error) {
+	if config == nil || config.IsEmpty() {
+		return nil, nil
+	}
+
+	result := &RouteConstraints{Config: config}
+	err := self.GetDb().View(func(tx *bbolt.Tx) error {
+		var err error
+		if result.Allowed, err = self.resolveRouterRoles(tx, config.AllowedRouterRoles); err != nil {
+			return err
+		}
+		if result.Denied, err = self.resolveRouterRoles(tx, config.DeniedRouterRoles); err != nil {
+			return err
+		}
+		result.Required, err = self.resolveRouterRoles(tx, config.RequiredRouterRoles)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (self *EdgeRouterManager) resolveRouterRoles(tx *bbolt.Tx, roles []string) (map[string]struct{}, error) {
+	if len(roles) == 0 {
+		return nil, nil
+	}
+
+	result := map[string]struct{}{}
+
+	if stringz.Contains(roles, db.AllRole) {
+		for cursor := self.env.GetStores().Router.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+			result[string(cursor.Current())] = struct{}{}
+		}
+		return result, nil
+	}
+
+	// ids are checked against all routers, while role attributes are evaluated against edge routers, since fabric
+	// routers don't have any
+	var roleAttributes []string
+	for _, role := range roles {
+		if strings.HasPrefix(role, db.EntityPrefix) {
+			routerId := strings.TrimPrefix(role, db.EntityPrefix)
+			if self.env.GetStores().Router.IsEntityPresent(tx, routerId) {
+				result[routerId] = struct{}{}
+			}
+		} else {
+			roleAttributes = append(roleAttributes, role)
+		}
+	}
+
+	if len(roleAttributes) == 0 {
+		return result, nil
+	}
+
+	cursorProvider, err := self.env.GetStores().EdgeRouter.GetRoleAttributesCursorProvider(roleAttributes, db.SemanticAnyOf)
+	if err != nil {
+		return nil, err
+	}
+
+	for cursor := cursorProvider(tx, true); cursor.IsValid(); cursor.Next() {
+		result[string(cursor.Current())] = struct{}{}
+	}
+	return result, nil
+}
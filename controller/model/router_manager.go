@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/hanzozt/channel/v4/protobufs"
+	"github.com/hanzozt/foundation/v2/concurrenz"
 	"github.com/hanzozt/zt/v2/common/inspect"
 	"github.com/hanzozt/zt/v2/common/pb/cmd_pb"
 	"github.com/hanzozt/zt/v2/common/pb/ctrl_pb"
@@ -76,10 +77,22 @@ func NewRouter(id, name, fingerprint string, cost uint16, noTraversal bool) *Rou
 	return result
 }
 
+// RouterDrainListener is notified when a connected router is marked as draining, or is no longer draining
+type RouterDrainListener interface {
+	RouterDrainChanged(r *Router)
+}
+
+// RouterConfigListener is notified when a connected router is assigned a different managed config
+type RouterConfigListener interface {
+	RouterConfigChanged(r *Router)
+}
+
 type RouterManager struct {
 	baseEntityManager[*Router, *db.Router]
-	cache     cmap.ConcurrentMap[string, *Router]
-	connected cmap.ConcurrentMap[string, *Router]
+	cache           cmap.ConcurrentMap[string, *Router]
+	connected       cmap.ConcurrentMap[string, *Router]
+	drainListeners  concurrenz.CopyOnWriteSlice[RouterDrainListener]
+	configListeners concurrenz.CopyOnWriteSlice[RouterConfigListener]
 }
 
 func newRouterManager(env Env) *RouterManager {
@@ -158,6 +171,22 @@ func (self *RouterManager) AllConnected() []*Router {
 	return routers
 }
 
+// IsDraining returns true if the router is connected and has been marked as draining
+func (self *RouterManager) IsDraining(id string) bool {
+	if router := self.GetConnected(id); router != nil {
+		return router.Draining
+	}
+	return false
+}
+
+func (self *RouterManager) AddDrainListener(listener RouterDrainListener) {
+	self.drainListeners.Append(listener)
+}
+
+func (self *RouterManager) AddConfigListener(listener RouterConfigListener) {
+	self.configListeners.Append(listener)
+}
+
 func (self *RouterManager) ConnectedCount() int {
 	return self.connected.Count()
 }
@@ -383,6 +412,8 @@ func (self *RouterManager) UpdateCachedRouter(id string) {
 			v.Cost = router.Cost
 			v.NoTraversal = router.NoTraversal
 			v.Disabled = router.Disabled
+			v.Draining = router.Draining
+			v.ConfigId = router.ConfigId
 
 			if v.Disabled {
 				if ctrl := v.Control; ctrl != nil {
@@ -394,8 +425,28 @@ func (self *RouterManager) UpdateCachedRouter(id string) {
 			return false
 		}
 
+		drainChanged := false
+		configChanged := false
+		self.connected.RemoveCb(id, func(key string, v *Router, exist bool) bool {
+			drainChanged = exist && v.Draining != router.Draining
+			configChanged = exist && v.ConfigId != router.ConfigId
+			return updateCb(key, v, exist)
+		})
 		self.cache.RemoveCb(id, updateCb)
-		self.connected.RemoveCb(id, updateCb)
+
+		if connected := self.GetConnected(id); drainChanged && connected != nil {
+			log.WithField("draining", connected.Draining).Info("router drain state changed")
+			for _, listener := range self.drainListeners.Value() {
+				listener.RouterDrainChanged(connected)
+			}
+		}
+
+		if connected := self.GetConnected(id); configChanged && connected != nil {
+			log.WithField("configId", connected.ConfigId).Info("router managed config changed")
+			for _, listener := range self.configListeners.Value() {
+				listener.RouterConfigChanged(connected)
+			}
+		}
 	}
 }
 
@@ -421,6 +472,8 @@ func (self *RouterManager) Marshall(entity *Router) ([]byte, error) {
 		Cost:        uint32(entity.Cost),
 		NoTraversal: entity.NoTraversal,
 		Disabled:    entity.Disabled,
+		Draining:    entity.Draining,
+		ConfigId:    entity.ConfigId,
 		Tags:        tags,
 	}
 
@@ -460,6 +513,8 @@ func (self *RouterManager) Unmarshall(bytes []byte) (*Router, error) {
 		Cost:        uint16(msg.Cost),
 		NoTraversal: msg.NoTraversal,
 		Disabled:    msg.Disabled,
+		Draining:    msg.Draining,
+		ConfigId:    msg.ConfigId,
 	}
 
 	for _, intf := range msg.Interfaces {
@@ -17,14 +17,20 @@
 package model
 
 import (
+	"crypto/x509"
+	"fmt"
+	"slices"
 	"sync/atomic"
 	"time"
 
+	"github.com/hanzozt/foundation/v2/errorz"
 	"github.com/hanzozt/foundation/v2/genext"
+	"github.com/hanzozt/foundation/v2/stringz"
 	"github.com/hanzozt/foundation/v2/versions"
 	"github.com/hanzozt/storage/boltz"
 	"github.com/hanzozt/zt/v2/common/ctrlchan"
 	"github.com/hanzozt/zt/v2/common/pb/ctrl_pb"
+	"github.com/hanzozt/zt/v2/common/routerconfig"
 	"github.com/hanzozt/zt/v2/controller/db"
 	"github.com/hanzozt/zt/v2/controller/models"
 	"go.etcd.io/bbolt"
@@ -49,8 +55,73 @@ type Router struct {
 	Cost        uint16
 	NoTraversal bool
 	Disabled    bool
+	Draining    bool
+	ConfigId    string
 	Metadata    *ctrl_pb.RouterMetadata
 	Interfaces  []*Interface
+	drainStatus atomic.Pointer[RouterDrainStatus]
+	configState atomic.Pointer[RouterConfigState]
+	certState   atomic.Pointer[RouterCertState]
+}
+
+// RouterDrainStatus is the drain state most recently reported by a connected router
+type RouterDrainStatus struct {
+	Draining bool
+	Circuits uint32
+}
+
+// RouterConfigState tracks the managed config most recently pushed to a connected router, and what the router
+// reported back when it applied it
+type RouterConfigState struct {
+	Version        string
+	AppliedVersion string
+	Error          string
+	// Changes holds the managed settings which changed, mapped to whether the router must be restarted for them to
+	// take effect
+	Changes map[string]bool
+}
+
+// RestartRequired returns the managed settings which only take effect once the router is restarted
+func (self *RouterConfigState) RestartRequired() []string {
+	var result []string
+	for key, restartRequired := range self.Changes {
+		if restartRequired {
+			result = append(result, key)
+		}
+	}
+	slices.Sort(result)
+	return result
+}
+
+// RouterCertState holds the certificates a connected router is using, and the outcome of its last certificate renewal
+type RouterCertState struct {
+	ClientCert   *x509.Certificate
+	ServerCert   *x509.Certificate
+	RenewalError string
+}
+
+// ExpiresAt returns the time at which the first of the router's certificates expires
+func (self *RouterCertState) ExpiresAt() time.Time {
+	var result time.Time
+	for _, cert := range []*x509.Certificate{self.ClientCert, self.ServerCert} {
+		if cert != nil && (result.IsZero() || cert.NotAfter.Before(result)) {
+			result = cert.NotAfter
+		}
+	}
+	return result
+}
+
+// IsSignedBy returns true if all the router's known certificates were signed by the given certificate
+func (self *RouterCertState) IsSignedBy(signer *x509.Certificate) bool {
+	if signer == nil || self.ClientCert == nil {
+		return false
+	}
+	for _, cert := range []*x509.Certificate{self.ClientCert, self.ServerCert} {
+		if cert != nil && cert.CheckSignatureFrom(signer) != nil {
+			return false
+		}
+	}
+	return true
 }
 
 func (entity *Router) GetLinks() []*Link {
@@ -61,7 +132,19 @@ func (entity *Router) toBoltEntityForUpdate(tx *bbolt.Tx, env Env, _ boltz.Field
 	return entity.toBoltEntityForCreate(tx, env)
 }
 
-func (entity *Router) toBoltEntityForCreate(*bbolt.Tx, Env) (*db.Router, error) {
+func (entity *Router) toBoltEntityForCreate(tx *bbolt.Tx, env Env) (*db.Router, error) {
+	var configId *string
+	if entity.ConfigId != "" {
+		config, err := env.GetStores().Config.LoadById(tx, entity.ConfigId)
+		if err != nil {
+			return nil, err
+		}
+		if config.TypeId != routerconfig.ConfigTypeV1 {
+			return nil, errorz.NewFieldError(fmt.Sprintf("config must be of type %s", routerconfig.ConfigTypeV1), "configId", entity.ConfigId)
+		}
+		configId = &entity.ConfigId
+	}
+
 	return &db.Router{
 		BaseExtEntity: *boltz.NewExtEntity(entity.Id, entity.Tags),
 		Name:          entity.Name,
@@ -69,6 +152,8 @@ func (entity *Router) toBoltEntityForCreate(*bbolt.Tx, Env) (*db.Router, error)
 		Cost:          entity.Cost,
 		NoTraversal:   entity.NoTraversal,
 		Disabled:      entity.Disabled,
+		Draining:      entity.Draining,
+		ConfigId:      configId,
 		Interfaces:    InterfacesToBolt(entity.Interfaces),
 	}, nil
 }
@@ -79,6 +164,8 @@ func (entity *Router) fillFrom(_ Env, _ *bbolt.Tx, boltRouter *db.Router) error
 	entity.Cost = boltRouter.Cost
 	entity.NoTraversal = boltRouter.NoTraversal
 	entity.Disabled = boltRouter.Disabled
+	entity.Draining = boltRouter.Draining
+	entity.ConfigId = stringz.OrEmpty(boltRouter.ConfigId)
 	entity.Interfaces = InterfacesFromBolt(boltRouter.Interfaces)
 	entity.FillCommon(boltRouter)
 	return nil
@@ -105,6 +192,81 @@ func (entity *Router) HasCapability(capability ctrl_pb.RouterCapability) bool {
 	return entity.Metadata != nil && genext.Contains(entity.Metadata.Capabilities, capability)
 }
 
+// SetDrainStatus records the drain state reported by the router and returns the previously reported state
+func (entity *Router) SetDrainStatus(status *RouterDrainStatus) *RouterDrainStatus {
+	return entity.drainStatus.Swap(status)
+}
+
+func (entity *Router) GetDrainStatus() *RouterDrainStatus {
+	return entity.drainStatus.Load()
+}
+
+// IsDrained returns true if the router is draining and has reported that it's no longer carrying any circuits
+func (entity *Router) IsDrained() bool {
+	status := entity.drainStatus.Load()
+	return entity.Draining && status != nil && status.Draining && status.Circuits == 0
+}
+
+// SetConfigPushed records the version of the managed config most recently pushed to the router
+func (entity *Router) SetConfigPushed(version string) {
+	for {
+		current := entity.configState.Load()
+		next := &RouterConfigState{Version: version}
+		if current != nil {
+			next.AppliedVersion = current.AppliedVersion
+		}
+		if entity.configState.CompareAndSwap(current, next) {
+			return
+		}
+	}
+}
+
+// SetConfigApplied records the outcome of the router applying a managed config
+func (entity *Router) SetConfigApplied(version string, err string, changes map[string]bool) {
+	for {
+		current := entity.configState.Load()
+		next := &RouterConfigState{
+			Error:   err,
+			Changes: changes,
+		}
+		if current != nil {
+			next.Version = current.Version
+			next.AppliedVersion = current.AppliedVersion
+		}
+		if err == "" {
+			next.AppliedVersion = version
+		}
+		if entity.configState.CompareAndSwap(current, next) {
+			return
+		}
+	}
+}
+
+func (entity *Router) GetConfigState() *RouterConfigState {
+	return entity.configState.Load()
+}
+
+// SetCertState records the certificate state reported by the router
+func (entity *Router) SetCertState(state *RouterCertState) {
+	entity.certState.Store(state)
+}
+
+// GetCertState returns the certificate state reported by the router. Routers which haven't reported their state are
+// described by the certificate they presented when connecting
+func (entity *Router) GetCertState() *RouterCertState {
+	if state := entity.certState.Load(); state != nil {
+		return state
+	}
+
+	if entity.Control != nil {
+		if certs := entity.Control.GetChannel().Certificates(); len(certs) > 0 {
+			return &RouterCertState{ClientCert: certs[0]}
+		}
+	}
+
+	return nil
+}
+
 func (entity *Router) SupportsRouterLinkMgmt() bool {
 	if entity.VersionInfo == nil {
 		return true
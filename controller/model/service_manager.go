@@ -167,6 +167,8 @@ func (self *ServiceManager) Marshall(entity *Service) ([]byte, error) {
 		MaxIdleTime:        int64(entity.MaxIdleTime),
 		TerminatorStrategy: entity.TerminatorStrategy,
 		Tags:               tags,
+		Multipath:          entity.Multipath,
+		Priority:           entity.Priority,
 	}
 
 	return proto.Marshal(msg)
@@ -186,5 +188,7 @@ func (self *ServiceManager) Unmarshall(bytes []byte) (*Service, error) {
 		Name:               msg.Name,
 		MaxIdleTime:        time.Duration(msg.MaxIdleTime),
 		TerminatorStrategy: msg.TerminatorStrategy,
+		Multipath:          msg.Multipath,
+		Priority:           msg.Priority,
 	}, nil
 }
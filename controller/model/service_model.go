@@ -31,6 +31,8 @@ type Service struct {
 	TerminatorStrategy string
 	Terminators        []*Terminator
 	MaxIdleTime        time.Duration
+	Multipath          string
+	Priority           string
 }
 
 func (entity *Service) GetName() string {
@@ -47,6 +49,8 @@ func (entity *Service) toBoltEntityForCreate(*bbolt.Tx, Env) (*db.Service, error
 		Name:               entity.Name,
 		MaxIdleTime:        entity.MaxIdleTime,
 		TerminatorStrategy: entity.TerminatorStrategy,
+		Multipath:          entity.Multipath,
+		Priority:           entity.Priority,
 	}, nil
 }
 
@@ -54,6 +58,8 @@ func (entity *Service) fillFrom(env Env, tx *bbolt.Tx, boltService *db.Service)
 	entity.Name = boltService.Name
 	entity.MaxIdleTime = boltService.MaxIdleTime
 	entity.TerminatorStrategy = boltService.TerminatorStrategy
+	entity.Multipath = boltService.Multipath
+	entity.Priority = boltService.Priority
 	entity.FillCommon(boltService)
 
 	terminatorIds := env.GetStores().Service.GetRelatedEntitiesIdList(tx, entity.Id, db.EntityTypeTerminators)
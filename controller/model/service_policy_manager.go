@@ -79,6 +79,7 @@ func (self *ServicePolicyManager) Marshall(entity *ServicePolicy) ([]byte, error
 		ServiceRoles:      entity.ServiceRoles,
 		PostureCheckRoles: entity.PostureCheckRoles,
 		PolicyType:        entity.PolicyType,
+		Schedule:          edge_cmd_pb.EncodePolicySchedule(entity.Schedule),
 	}
 
 	return proto.Marshal(msg)
@@ -101,6 +102,7 @@ func (self *ServicePolicyManager) Unmarshall(bytes []byte) (*ServicePolicy, erro
 		ServiceRoles:      msg.ServiceRoles,
 		PostureCheckRoles: msg.PostureCheckRoles,
 		PolicyType:        msg.PolicyType,
+		Schedule:          edge_cmd_pb.DecodePolicySchedule(msg.Schedule),
 	}, nil
 }
 
@@ -22,6 +22,7 @@ import (
 
 	"github.com/hanzozt/foundation/v2/errorz"
 	"github.com/hanzozt/storage/boltz"
+	"github.com/hanzozt/zt/v2/common/schedule"
 	"github.com/hanzozt/zt/v2/controller/db"
 	"github.com/hanzozt/zt/v2/controller/models"
 	"go.etcd.io/bbolt"
@@ -35,6 +36,7 @@ type ServicePolicy struct {
 	IdentityRoles     []string
 	ServiceRoles      []string
 	PostureCheckRoles []string
+	Schedule          *schedule.Spec
 }
 
 func (entity *ServicePolicy) validatePolicyType() error {
@@ -67,6 +69,7 @@ func (entity *ServicePolicy) toBoltEntity(checker boltz.FieldChecker) (*db.Servi
 		IdentityRoles:     entity.IdentityRoles,
 		ServiceRoles:      entity.ServiceRoles,
 		PostureCheckRoles: entity.PostureCheckRoles,
+		Schedule:          entity.Schedule,
 	}, nil
 }
 
@@ -86,5 +89,6 @@ func (entity *ServicePolicy) fillFrom(_ Env, _ *bbolt.Tx, boltServicePolicy *db.
 	entity.ServiceRoles = boltServicePolicy.ServiceRoles
 	entity.IdentityRoles = boltServicePolicy.IdentityRoles
 	entity.PostureCheckRoles = boltServicePolicy.PostureCheckRoles
+	entity.Schedule = boltServicePolicy.Schedule
 	return nil
 }
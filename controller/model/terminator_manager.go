@@ -449,6 +449,9 @@ func (result *TerminatorListResult) collect(tx *bbolt.Tx, ids []string, qmd *mod
 
 type RoutingTerminator struct {
 	RouteCost uint32
+	// RoutePrecedence, if set, is used in place of the terminator's precedence when routing, for example while the
+	// terminator's router is draining
+	RoutePrecedence xt.Precedence
 	*Terminator
 }
 
@@ -456,6 +459,13 @@ func (r *RoutingTerminator) GetRouteCost() uint32 {
 	return r.RouteCost
 }
 
+func (r *RoutingTerminator) GetPrecedence() xt.Precedence {
+	if r.RoutePrecedence != nil {
+		return r.RoutePrecedence
+	}
+	return r.Terminator.GetPrecedence()
+}
+
 type DeleteTerminatorsBatchCommand struct {
 	Context *change.Context
 	Manager *TerminatorManager
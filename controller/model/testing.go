@@ -347,7 +347,7 @@ func ss(vals ...string) []string {
 }
 
 func NewTestLink(id string, src, dst *Router) *Link {
-	l := newLink(id, "tls", "tcp:localhost:1234", 0)
+	l := newLink(id, "tls", "tcp:localhost:1234", 0, nil)
 	l.Src = src
 	l.DstId = dst.Id
 	l.Dst.Store(dst)
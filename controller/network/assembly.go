@@ -37,6 +37,15 @@ func (network *Network) NotifyLinkEvent(link *model.Link, eventType event.LinkEv
 		Protocol:    link.Protocol,
 		Cost:        link.GetStaticCost(),
 		DialAddress: link.DialAddress,
+
+		SrcLatency:            link.GetSrcLatency(),
+		DstLatency:            link.GetDstLatency(),
+		SrcLoss:               float64(link.GetSrcLoss()) / 100,
+		DstLoss:               float64(link.GetDstLoss()) / 100,
+		SrcJitter:             link.GetSrcJitter(),
+		DstJitter:             link.GetDstJitter(),
+		SrcAvailableBandwidth: link.GetSrcBandwidth(),
+		DstAvailableBandwidth: link.GetDstBandwidth(),
 	}
 
 	if connState := link.GetConnsState(); connState != nil {
@@ -91,6 +91,7 @@ type Network struct {
 	capabilities           []string
 	closeNotify            <-chan struct{}
 	watchdogCh             chan struct{}
+	drainNotify            chan struct{}
 	lock                   sync.Mutex
 	strategyRegistry       xt.Registry
 	lastSnapshot           time.Time
@@ -133,6 +134,7 @@ func NewNetwork(config Config, env model.Env) (*Network, error) {
 		traceController:       trace.NewController(config.GetCloseNotify()),
 		closeNotify:           config.GetCloseNotify(),
 		watchdogCh:            make(chan struct{}, 1),
+		drainNotify:           make(chan struct{}, 1),
 		strategyRegistry:      xt.GlobalRegistry(),
 		lastSnapshot:          time.Now().Add(-time.Hour),
 		metricsRegistry:       config.GetMetricsRegistry(),
@@ -152,6 +154,8 @@ func NewNetwork(config Config, env model.Env) (*Network, error) {
 		config: config,
 	}
 
+	xt.GlobalOutlierDetector().SetConfig(config.GetOptions().OutlierDetection)
+
 	env.GetManagers().Command.Decoders.RegisterF(int32(cmd_pb.CommandType_SyncSnapshot), network.decodeSyncSnapshotCommand)
 
 	routerCommPool, err := network.createRouterCommPool(config)
@@ -162,6 +166,7 @@ func NewNetwork(config Config, env model.Env) (*Network, error) {
 	network.RouterMessaging = NewRouterMessaging(env, routerCommPool)
 
 	env.GetManagers().Router.Store.AddEntityIdListener(network.HandleRouterDelete, boltz.EntityDeletedAsync)
+	env.GetManagers().Router.AddDrainListener(network)
 
 	network.AddCapability("zt.fabric")
 	network.showOptions()
@@ -177,6 +182,17 @@ func (self *Network) HandleRouterDelete(id string) {
 	self.RouterMessaging.RouterDeleted(id)
 }
 
+// RouterDrainChanged schedules a pass of smart rerouting, so circuits are moved off routers as soon as they're
+// marked as draining, rather than at the next cycle
+func (self *Network) RouterDrainChanged(r *model.Router) {
+	if r.Draining {
+		select {
+		case self.drainNotify <- struct{}{}:
+		default:
+		}
+	}
+}
+
 func (self *Network) decodeSyncSnapshotCommand(_ int32, data []byte) (command.Command, error) {
 	msg := &cmd_pb.SyncSnapshotCommand{}
 	if err := proto.Unmarshal(data, msg); err != nil {
@@ -578,9 +594,10 @@ func (network *Network) CreateCircuit(params model.CreateCircuitParams) (*model.
 	logger := pfxlog.ChannelLogger(logcontext.SelectPath).Wire(ctx).Entry
 
 	circuit := &model.Circuit{
-		Id:        circuitId,
-		ClientId:  clientId.Token,
-		ServiceId: serviceId,
+		Id:               circuitId,
+		ClientId:         clientId.Token,
+		ServiceId:        serviceId,
+		RouteConstraints: params.GetRouteConstraints(),
 	}
 
 	attempt := uint32(0)
@@ -623,8 +640,11 @@ func (network *Network) CreateCircuit(params model.CreateCircuitParams) (*model.
 		circuit.Tags = tags
 
 		// 4a: Create Route Messages
-		rms := network.CreateRouteMessages(path, attempt, circuitId, terminator, deadline)
-		rms[len(rms)-1].Egress.PeerData = clientId.Data
+		routers, rms, altPath := network.createCircuitRouteMessages(svc.Multipath, svc.Priority, circuit.RouteConstraints, path, attempt, circuitId, terminator, deadline)
+		rms[len(path.Nodes)-1].Egress.PeerData = clientId.Data
+		circuit.AltPath = altPath
+		circuit.Multipath = svc.Multipath
+		circuit.Priority = svc.Priority
 		for _, msg := range rms {
 			msg.Context = &ctrl_pb.Context{
 				Fields:      ctx.GetStringFields(),
@@ -635,7 +655,7 @@ func (network *Network) CreateCircuit(params model.CreateCircuitParams) (*model.
 
 		// 5: Routing
 		logger.Debug("route attempt for circuit")
-		peerData, cleanups, circuitErr := rs.route(attempt, path, rms, strategy, terminator, ctx.Clone())
+		peerData, cleanups, circuitErr := rs.route(attempt, routers, rms, strategy, terminator, ctx.Clone())
 		for k, v := range cleanups {
 			allCleanups[k] = v
 		}
@@ -668,7 +688,7 @@ func (network *Network) CreateCircuit(params model.CreateCircuitParams) (*model.
 
 		// 5.a: Unroute Abandoned Routers (from Previous Attempts)
 		usedRouters := make(map[string]struct{})
-		for _, r := range path.Nodes {
+		for _, r := range routers {
 			usedRouters[r.Id] = struct{}{}
 		}
 		cleanupCount := 0
@@ -762,7 +782,7 @@ func (network *Network) selectPath(params model.CreateCircuitParams, svc *model.
 				continue
 			}
 
-			path, cost, err := network.shortestPath(params.GetSourceRouter(), dstR)
+			path, cost, err := network.shortestConstrainedPath(params.GetSourceRouter(), dstR, params.GetRouteConstraints(), nil)
 			if err != nil {
 				log.Debugf("error while calculating path for service %v: %v", svc.Id, err)
 				errList = append(errList, err)
@@ -776,11 +796,15 @@ func (network *Network) selectPath(params model.CreateCircuitParams, svc *model.
 
 		dynamicCost := xt.GlobalCosts().GetDynamicCost(terminator.Id)
 		unbiasedCost := uint32(terminator.Cost) + uint32(dynamicCost) + pathAndCost.cost
-		biasedCost := terminator.Precedence.GetBiasedCost(unbiasedCost)
 		costedTerminator := &model.RoutingTerminator{
 			Terminator: terminator,
-			RouteCost:  biasedCost,
 		}
+
+		// terminators on draining routers are only used if there's nothing else available
+		if dstR := pathAndCost.path[len(pathAndCost.path)-1]; dstR.Draining {
+			costedTerminator.RoutePrecedence = xt.Precedences.Failed
+		}
+		costedTerminator.RouteCost = costedTerminator.GetPrecedence().GetBiasedCost(unbiasedCost)
 		weightedTerminators = append(weightedTerminators, costedTerminator)
 	}
 
@@ -847,7 +871,7 @@ func (network *Network) RemoveCircuit(circuitId string, now bool) error {
 	log := pfxlog.Logger().WithField("circuitId", circuitId)
 
 	if circuit, found := network.Circuit.Get(circuitId); found {
-		for _, r := range circuit.Path.Nodes {
+		for _, r := range circuit.GetRouters() {
 			err := sendUnroute(r, circuit.Id, now)
 			if err != nil {
 				log.Errorf("error sending unroute to [r/%s] (%s)", r.Id, err)
@@ -894,7 +918,7 @@ func (network *Network) CreatePath(srcR, dstR *model.Router) (*model.Path, error
 	path.Nodes = append(path.Nodes, srcR)
 	path.Nodes = append(path.Nodes, dstR)
 
-	return network.UpdatePath(path)
+	return network.UpdatePath(path, nil)
 }
 
 func (network *Network) setLinks(path *model.Path) error {
@@ -930,6 +954,9 @@ func (network *Network) Run() {
 			network.smart()
 			network.Link.ScanForDeadLinks()
 
+		case <-network.drainNotify:
+			network.smart()
+
 		case <-network.closeNotify:
 			network.eventDispatcher.RemoveMetricsMessageHandler(network)
 			network.metricsRegistry.DisposeAll()
@@ -1053,7 +1080,7 @@ func (network *Network) RemoveLink(linkId string) {
 func (network *Network) rerouteLink(l *model.Link, deadline time.Time) error {
 	circuits := network.Circuit.All()
 	for _, circuit := range circuits {
-		if circuit.Path.UsesLink(l) {
+		if circuit.UsesLink(l) {
 			log := logrus.WithField("linkId", l.Id).
 				WithField("circuitId", circuit.Id)
 			log.Info("circuit uses link")
@@ -1095,18 +1122,24 @@ func (network *Network) rerouteCircuit(circuit *model.Circuit, deadline time.Tim
 
 		log.Warn("rerouting circuit")
 
-		if cq, err := network.UpdatePath(circuit.Path); err == nil {
+		constraints := network.resolveRouteConstraints(circuit)
+		if cq, err := network.UpdatePath(circuit.Path, constraints); err == nil {
+			routers, rms, altPath := network.createCircuitRouteMessages(circuit.Multipath, circuit.Priority, constraints, cq, SmartRerouteAttempt, circuit.Id, circuit.Terminator, deadline)
+
+			previousRouters := circuit.GetRouters()
+			circuit.RouteConstraints = constraints
 			circuit.Path = cq
+			circuit.AltPath = altPath
 			circuit.UpdatedAt = time.Now()
 
-			rms := network.CreateRouteMessages(cq, SmartRerouteAttempt, circuit.Id, circuit.Terminator, deadline)
-
-			for i := 0; i < len(cq.Nodes); i++ {
-				if _, err := sendRoute(cq.Nodes[i], rms[i], network.options.RouteTimeout); err != nil {
-					log.WithError(err).Errorf("error sending route to [r/%s]", cq.Nodes[i].Id)
+			for i := 0; i < len(routers); i++ {
+				if _, err := sendRoute(routers[i], rms[i], network.options.RouteTimeout); err != nil {
+					log.WithError(err).Errorf("error sending route to [r/%s]", routers[i].Id)
 				}
 			}
 
+			unrouteAbandonedRouters(circuit.Id, previousRouters, routers)
+
 			log.Info("rerouted circuit")
 
 			network.CircuitEvent(event.CircuitUpdated, circuit, nil)
@@ -1120,25 +1153,46 @@ func (network *Network) rerouteCircuit(circuit *model.Circuit, deadline time.Tim
 	}
 }
 
-func (network *Network) smartReroute(circuit *model.Circuit, cq *model.Path, deadline time.Time) bool {
+// resolveRouteConstraints evaluates the circuit's route constraints config again, so reroutes take router and role
+// attribute changes since the circuit was created into account. If that fails, the current constraints are kept.
+func (network *Network) resolveRouteConstraints(circuit *model.Circuit) *model.RouteConstraints {
+	constraints := circuit.RouteConstraints
+	if constraints == nil || constraints.Config == nil {
+		return constraints
+	}
+
+	result, err := network.EdgeRouter.ResolveRouteConstraints(constraints.Config)
+	if err != nil {
+		pfxlog.Logger().WithField("circuitId", circuit.Id).WithError(err).Error("unable to resolve route constraints, using previously resolved constraints")
+		return constraints
+	}
+	return result
+}
+
+func (network *Network) smartReroute(circuit *model.Circuit, cq *model.Path, constraints *model.RouteConstraints, deadline time.Time) bool {
 	retry := false
 	log := pfxlog.Logger().WithField("circuitId", circuit.Id)
 	if circuit.Rerouting.CompareAndSwap(false, true) {
 		defer circuit.Rerouting.Store(false)
 
+		routers, rms, altPath := network.createCircuitRouteMessages(circuit.Multipath, circuit.Priority, constraints, cq, SmartRerouteAttempt, circuit.Id, circuit.Terminator, deadline)
+
+		previousRouters := circuit.GetRouters()
+		circuit.RouteConstraints = constraints
 		circuit.Path = cq
+		circuit.AltPath = altPath
 		circuit.UpdatedAt = time.Now()
 
-		rms := network.CreateRouteMessages(cq, SmartRerouteAttempt, circuit.Id, circuit.Terminator, deadline)
-
-		for i := 0; i < len(cq.Nodes); i++ {
-			if _, err := sendRoute(cq.Nodes[i], rms[i], network.options.RouteTimeout); err != nil {
+		for i := 0; i < len(routers); i++ {
+			if _, err := sendRoute(routers[i], rms[i], network.options.RouteTimeout); err != nil {
 				retry = true
-				log.WithField("routerId", cq.Nodes[i].Id).WithError(err).Error("error sending smart route update to router")
+				log.WithField("routerId", routers[i].Id).WithError(err).Error("error sending smart route update to router")
 				break
 			}
 		}
 
+		unrouteAbandonedRouters(circuit.Id, previousRouters, routers)
+
 		if !retry {
 			logrus.Debug("rerouted circuit")
 			network.CircuitEvent(event.CircuitUpdated, circuit, nil)
@@ -1183,6 +1237,35 @@ func (network *Network) AcceptMetricsMsg(metrics *metrics_pb.MetricsMessage) {
 				log.Warnf("link not for router")
 			}
 		}
+
+		network.acceptLinkQualityMetrics(router, link, metrics)
+	}
+}
+
+// acceptLinkQualityMetrics updates the loss, jitter and available bandwidth of a link from the link probe metrics
+// reported by one of its routers. A link event is emitted when the reported loss changes.
+func (network *Network) acceptLinkQualityMetrics(router *model.Router, link *model.Link, metrics *metrics_pb.MetricsMessage) {
+	loss, found := metrics.IntValues["link."+link.Id+".loss"]
+	if !found {
+		return
+	}
+
+	jitter := metrics.IntValues["link."+link.Id+".jitter"]
+	bandwidth := metrics.IntValues["link."+link.Id+".bandwidth"]
+
+	var previousLoss int64
+	if link.Src.Id == router.Id {
+		previousLoss = link.GetSrcLoss()
+		link.SetSrcQuality(loss, jitter, bandwidth)
+	} else if link.DstId == router.Id {
+		previousLoss = link.GetDstLoss()
+		link.SetDstQuality(loss, jitter, bandwidth)
+	} else {
+		return
+	}
+
+	if loss != previousLoss {
+		network.NotifyLinkEvent(link, event.LinkQualityChanged)
 	}
 }
 
@@ -1220,6 +1303,32 @@ func sendRoute(r *model.Router, createMsg *ctrl_pb.Route, timeout time.Duration)
 	return nil, fmt.Errorf("unexpected response type %v received in reply to route request", msg.ContentType)
 }
 
+// unrouteAbandonedRouters sends unroutes to the routers a circuit was previously routed through which are not part of
+// its new primary or alternate path, so a swapped alternate path doesn't leave stale forwarding state behind
+func unrouteAbandonedRouters(circuitId string, previousRouters []*model.Router, routers []*model.Router) {
+	usedRouters := make(map[string]struct{}, len(routers))
+	for _, r := range routers {
+		usedRouters[r.Id] = struct{}{}
+	}
+
+	log := pfxlog.Logger().WithField("circuitId", circuitId)
+	for _, r := range previousRouters {
+		if _, found := usedRouters[r.Id]; found {
+			continue
+		}
+		usedRouters[r.Id] = struct{}{}
+		if !r.Connected.Load() {
+			log.WithField("routerId", r.Id).Debug("router not connected, not sending abandoned path unroute")
+			continue
+		}
+		if err := sendUnroute(r, circuitId, true); err != nil {
+			log.WithField("routerId", r.Id).WithError(err).Error("error sending abandoned path unroute for circuit to router")
+		} else {
+			log.WithField("routerId", r.Id).Debug("sent abandoned path unroute for circuit to router")
+		}
+	}
+}
+
 func sendUnroute(r *model.Router, circuitId string, now bool) error {
 	unroute := &ctrl_pb.Unroute{
 		CircuitId: circuitId,
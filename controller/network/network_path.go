@@ -3,12 +3,15 @@ package network
 import (
 	"fmt"
 	"math"
+	"sort"
 	"time"
 
 	"github.com/hanzozt/zt/v2/common/pb/ctrl_pb"
+	"github.com/hanzozt/zt/v2/controller/db"
 	"github.com/hanzozt/zt/v2/controller/idgen"
 	"github.com/hanzozt/zt/v2/controller/model"
 	"github.com/hanzozt/zt/v2/controller/xt"
+	"github.com/michaelquigley/pfxlog"
 	"github.com/pkg/errors"
 )
 
@@ -94,6 +97,76 @@ func (network *Network) CreateRouteMessages(path *model.Path, attempt uint32, ci
 	return routeMessages
 }
 
+// CreateMultipathRouteMessages creates the route messages for a circuit routed over both the given path and an
+// alternate path, which shares only its end routers with the given path. Each end router gets a single route with the
+// forwards for both paths, those for the given path first. The returned routers are aligned with the returned route
+// messages: the routers of the given path, followed by the transit routers of the alternate path.
+func (network *Network) CreateMultipathRouteMessages(path, altPath *model.Path, mode ctrl_pb.MultipathMode, attempt uint32, circuitId string, terminator xt.Terminator, deadline time.Time) ([]*model.Router, []*ctrl_pb.Route) {
+	routeMessages := network.CreateRouteMessages(path, attempt, circuitId, terminator, deadline)
+	altRouteMessages := network.CreateRouteMessages(altPath, attempt, circuitId, terminator, deadline)
+
+	ingress := routeMessages[0]
+	ingress.Forwards = append(ingress.Forwards, altRouteMessages[0].Forwards...)
+	ingress.Multipath = mode
+
+	egress := routeMessages[len(routeMessages)-1]
+	egress.Forwards = append(egress.Forwards, altRouteMessages[len(altRouteMessages)-1].Forwards...)
+	egress.Multipath = mode
+
+	routers := append([]*model.Router{}, path.Nodes...)
+	routers = append(routers, altPath.Nodes[1:len(altPath.Nodes)-1]...)
+	routeMessages = append(routeMessages, altRouteMessages[1:len(altRouteMessages)-1]...)
+
+	return routers, routeMessages
+}
+
+// createCircuitRouteMessages creates the route messages for a circuit over the given path, along with the routers to
+// send them to. Circuits for multipath services are also routed over an alternate path, which is returned, if a
+// disjoint one satisfying the circuit's route constraints can be found. Otherwise they fall back to the single path.
+// Every route carries the priority class of the service, so that each router on the path schedules the circuit's
+// payloads accordingly.
+func (network *Network) createCircuitRouteMessages(multipath string, priority string, constraints *model.RouteConstraints, path *model.Path, attempt uint32, circuitId string, terminator xt.Terminator, deadline time.Time) ([]*model.Router, []*ctrl_pb.Route, *model.Path) {
+	if mode := multipathMode(multipath); mode != ctrl_pb.MultipathMode_MultipathNone {
+		altPath, err := network.CreateAltPath(path, constraints)
+		if err == nil {
+			routers, routeMessages := network.CreateMultipathRouteMessages(path, altPath, mode, attempt, circuitId, terminator, deadline)
+			return routers, setRoutePriority(routeMessages, priority), altPath
+		}
+		pfxlog.Logger().WithField("circuitId", circuitId).WithError(err).Debug("no alternate path for multipath circuit, using single path")
+	}
+	return path.Nodes, setRoutePriority(network.CreateRouteMessages(path, attempt, circuitId, terminator, deadline), priority), nil
+}
+
+func setRoutePriority(routeMessages []*ctrl_pb.Route, priority string) []*ctrl_pb.Route {
+	priorityClass := priorityClass(priority)
+	for _, msg := range routeMessages {
+		msg.Priority = priorityClass
+	}
+	return routeMessages
+}
+
+func priorityClass(priority string) ctrl_pb.PriorityClass {
+	switch priority {
+	case db.ServicePriorityInteractive:
+		return ctrl_pb.PriorityClass_PriorityInteractive
+	case db.ServicePriorityBackground:
+		return ctrl_pb.PriorityClass_PriorityBackground
+	default:
+		return ctrl_pb.PriorityClass_PriorityBulk
+	}
+}
+
+func multipathMode(multipath string) ctrl_pb.MultipathMode {
+	switch multipath {
+	case db.ServiceMultipathDuplicate:
+		return ctrl_pb.MultipathMode_MultipathDuplicate
+	case db.ServiceMultipathStripe:
+		return ctrl_pb.MultipathMode_MultipathStripe
+	default:
+		return ctrl_pb.MultipathMode_MultipathNone
+	}
+}
+
 func (network *Network) CreatePathWithNodes(nodes []*model.Router) (*model.Path, CircuitError) {
 	ingressId, err := idgen.NewUUIDString()
 	if err != nil {
@@ -116,10 +189,12 @@ func (network *Network) CreatePathWithNodes(nodes []*model.Router) (*model.Path,
 	return path, nil
 }
 
-func (network *Network) UpdatePath(path *model.Path) (*model.Path, error) {
+// UpdatePath finds the current shortest path between the end routers of the given path which satisfies the given
+// route constraints, keeping the ingress and egress addresses of the given path
+func (network *Network) UpdatePath(path *model.Path, constraints *model.RouteConstraints) (*model.Path, error) {
 	srcR := path.Nodes[0]
 	dstR := path.Nodes[len(path.Nodes)-1]
-	nodes, _, err := network.shortestPath(srcR, dstR)
+	nodes, _, err := network.shortestConstrainedPath(srcR, dstR, constraints, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -139,11 +214,108 @@ func (network *Network) UpdatePath(path *model.Path) (*model.Path, error) {
 	return path2, nil
 }
 
+// CreateAltPath creates an alternate path for a multipath circuit, between the end routers of the given path. The
+// alternate path shares no links or transit routers with the given path, so that a failure along one path doesn't
+// affect the other, and uses the same ingress and egress addresses. It satisfies the same route constraints as the
+// given path.
+func (network *Network) CreateAltPath(path *model.Path, constraints *model.RouteConstraints) (*model.Path, error) {
+	if len(path.Links) == 0 {
+		return nil, errors.New("single router paths have no alternate path")
+	}
+
+	nodes, _, err := network.shortestConstrainedPath(path.Nodes[0], path.EgressRouter(), constraints, path)
+	if err != nil {
+		return nil, err
+	}
+
+	altPath := &model.Path{
+		Nodes:     nodes,
+		IngressId: path.IngressId,
+		EgressId:  path.EgressId,
+	}
+	if err = network.setLinks(altPath); err != nil {
+		return nil, err
+	}
+	return altPath, nil
+}
+
 func (network *Network) shortestPath(srcR *model.Router, dstR *model.Router) ([]*model.Router, int64, error) {
+	return network.shortestConstrainedPath(srcR, dstR, nil, nil)
+}
+
+// hopFilter returns true if the hop from u to r may be part of a path
+type hopFilter func(u, r *model.Router) bool
+
+// shortestConstrainedPath finds the shortest path between two routers which satisfies the given route constraints and
+// shares no hops or transit routers with the given path, if one is provided. If the constraints require the path to
+// pass through one of a set of routers, and neither end router is one of them, the cheapest path via each of the
+// required routers is calculated and the cheapest of those is used.
+func (network *Network) shortestConstrainedPath(srcR *model.Router, dstR *model.Router, constraints *model.RouteConstraints, disjointFrom *model.Path) ([]*model.Router, int64, error) {
 	if srcR == nil || dstR == nil {
 		return nil, 0, errors.New("not routable (!srcR||!dstR)")
 	}
 
+	if !constraints.Permits(srcR) || !constraints.Permits(dstR) {
+		return nil, 0, fmt.Errorf("can't route from %v -> %v. route constraints exclude an end router", srcR.Id, dstR.Id)
+	}
+
+	permitted := func(u, r *model.Router) bool {
+		return constraints.Permits(r) && (disjointFrom == nil || !sharesHop(disjointFrom, u, r))
+	}
+
+	if !constraints.HasRequired() || constraints.IsRequired(srcR) || constraints.IsRequired(dstR) {
+		return network.shortestFilteredPath(srcR, dstR, permitted)
+	}
+
+	var waypointIds []string
+	for id := range constraints.Required {
+		waypointIds = append(waypointIds, id)
+	}
+	sort.Strings(waypointIds)
+
+	var result []*model.Router
+	var resultCost int64
+	for _, waypointId := range waypointIds {
+		waypoint := network.Router.GetConnected(waypointId)
+		if waypoint == nil || waypoint.NoTraversal || waypoint.Draining || !permitted(srcR, waypoint) {
+			continue
+		}
+
+		toWaypoint, toCost, err := network.shortestFilteredPath(srcR, waypoint, func(u, r *model.Router) bool {
+			return r != dstR && permitted(u, r)
+		})
+		if err != nil {
+			continue
+		}
+
+		visited := map[*model.Router]struct{}{}
+		for _, r := range toWaypoint {
+			visited[r] = struct{}{}
+		}
+
+		fromWaypoint, fromCost, err := network.shortestFilteredPath(waypoint, dstR, func(u, r *model.Router) bool {
+			_, found := visited[r]
+			return !found && permitted(u, r)
+		})
+		if err != nil {
+			continue
+		}
+
+		if result == nil || toCost+fromCost < resultCost {
+			result = append(toWaypoint, fromWaypoint[1:]...)
+			resultCost = toCost + fromCost
+		}
+	}
+
+	if result == nil {
+		return nil, 0, fmt.Errorf("can't route from %v -> %v. no path through a required router", srcR.Id, dstR.Id)
+	}
+
+	return result, resultCost, nil
+}
+
+// shortestFilteredPath finds the shortest path between two routers using only hops accepted by the given filter
+func (network *Network) shortestFilteredPath(srcR *model.Router, dstR *model.Router, filter hopFilter) ([]*model.Router, int64, error) {
 	if srcR == dstR {
 		return []*model.Router{srcR}, 0, nil
 	}
@@ -169,10 +341,13 @@ func (network *Network) shortestPath(srcR *model.Router, dstR *model.Router) ([]
 
 		neighbors := network.Link.ConnectedNeighborsOfRouter(u)
 		for _, r := range neighbors {
+			if filter != nil && !filter(u, r) {
+				continue
+			}
 			if _, found := unvisited[r]; found {
 				var cost int64 = math.MaxInt32 + 1
 				if l, found := network.Link.LeastExpensiveLink(r, u); found {
-					if !r.NoTraversal || r == srcR || r == dstR {
+					if (!r.NoTraversal && !r.Draining) || r == srcR || r == dstR {
 						cost = l.GetCost() + int64(max(r.Cost, minRouterCost))
 					}
 				}
@@ -210,3 +385,19 @@ func (network *Network) shortestPath(srcR *model.Router, dstR *model.Router) ([]
 
 	return routerPath, dist[dstR], nil
 }
+
+// sharesHop returns true if the hop from u to r would share a transit router or a hop with the given path
+func sharesHop(path *model.Path, u, r *model.Router) bool {
+	for i := 1; i < len(path.Nodes)-1; i++ {
+		if path.Nodes[i].Id == r.Id {
+			return true
+		}
+	}
+	for i := 0; i < len(path.Nodes)-1; i++ {
+		a, b := path.Nodes[i].Id, path.Nodes[i+1].Id
+		if (a == u.Id && b == r.Id) || (a == r.Id && b == u.Id) {
+			return true
+		}
+	}
+	return false
+}
@@ -173,6 +173,69 @@ func TestCreateCircuit(t *testing.T) {
 	assert.Equal(t, CircuitFailureNoTerminators, cerr.Cause())
 }
 
+func TestSelectPathDeprioritizesDrainingRouters(t *testing.T) {
+	ctx := model.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config, ctx)
+	req.NoError(err)
+
+	transportAddr, err := tcp.AddressParser{}.Parse("tcp:0.0.0.0:0")
+	req.NoError(err)
+
+	r0 := model.NewRouterForTest("r0", "", transportAddr, nil, 0, false)
+	network.Router.MarkConnected(r0)
+
+	r1 := model.NewRouterForTest("r1", "", transportAddr, nil, 0, false)
+	network.Router.MarkConnected(r1)
+
+	r2 := model.NewRouterForTest("r2", "", transportAddr, nil, 100, false)
+	network.Router.MarkConnected(r2)
+
+	newPathTestLink(network, "l0", r0, r1)
+	newPathTestLink(network, "l1", r0, r2)
+
+	svc := &model.Service{
+		BaseEntity:         models.BaseEntity{Id: "svc"},
+		Name:               "svc",
+		TerminatorStrategy: "smartrouting",
+	}
+
+	for _, routerId := range []string{"r1", "r2"} {
+		svc.Terminators = append(svc.Terminators, &model.Terminator{
+			BaseEntity: models.BaseEntity{Id: "t-" + routerId},
+			Service:    "svc",
+			Router:     routerId,
+			Binding:    "transport",
+			Address:    "tcp:localhost:1001",
+			Precedence: xt.Precedences.Default,
+		})
+	}
+
+	lc := logcontext.NewContext()
+	params := newCircuitParams(svc, r0)
+
+	_, terminator, _, _, cerr := network.selectPath(params, svc, "", lc)
+	req.NoError(cerr)
+	req.Equal("t-r1", terminator.GetId())
+
+	r1.Draining = true
+	_, terminator, _, _, cerr = network.selectPath(params, svc, "", lc)
+	req.NoError(cerr)
+	req.Equal("t-r2", terminator.GetId())
+
+	// terminators on draining routers are still used if there's nothing else
+	r2.Draining = true
+	_, terminator, _, _, cerr = network.selectPath(params, svc, "", lc)
+	req.NoError(cerr)
+	req.Equal("t-r1", terminator.GetId())
+}
+
 type VersionProviderTest struct {
 }
 
@@ -214,8 +277,9 @@ func newCircuitParams(service *model.Service, router *model.Router) model.Create
 }
 
 type testCreateCircuitParams struct {
-	svc    *model.Service
-	router *model.Router
+	svc         *model.Service
+	router      *model.Router
+	constraints *model.RouteConstraints
 }
 
 func (t testCreateCircuitParams) GetServiceId() string {
@@ -241,3 +305,7 @@ func (t testCreateCircuitParams) GetLogContext() logcontext.Context {
 func (t testCreateCircuitParams) GetDeadline() time.Time {
 	return time.Now().Add(time.Second)
 }
+
+func (t testCreateCircuitParams) GetRouteConstraints() *model.RouteConstraints {
+	return t.constraints
+}
@@ -20,13 +20,18 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hanzozt/channel/v4"
+	"github.com/hanzozt/zt/v2/common/ctrlchan"
+	"github.com/hanzozt/zt/v2/common/pb/ctrl_pb"
 	config2 "github.com/hanzozt/zt/v2/controller/config"
+	"github.com/hanzozt/zt/v2/controller/db"
 	"github.com/hanzozt/zt/v2/controller/model"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/hanzozt/transport/v2/tcp"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestSimplePath2(t *testing.T) {
@@ -607,3 +612,262 @@ func newPathTestLink(network *Network, id string, srcR, destR *model.Router) *mo
 	network.Link.Add(l)
 	return l
 }
+
+func TestMultipathRoute(t *testing.T) {
+	ctx := model.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config, ctx)
+	req.NoError(err)
+
+	addr := "tcp:0.0.0.0:0"
+	transportAddr, err := tcp.AddressParser{}.Parse(addr)
+	req.NoError(err)
+
+	var routers []*model.Router
+	for _, id := range []string{"r0", "r1", "r2", "r3"} {
+		r := model.NewRouterForTest(id, "", transportAddr, nil, 1, false)
+		network.Router.MarkConnected(r)
+		routers = append(routers, r)
+	}
+	r0, r1, r2, r3 := routers[0], routers[1], routers[2], routers[3]
+
+	addLink := func(id string, src, dst *model.Router, cost int32) *model.Link {
+		link := model.NewTestLink(id, src, dst)
+		link.SetStaticCost(cost)
+		link.SetState(model.Connected)
+		network.Link.Add(link)
+		return link
+	}
+
+	l0 := addLink("l0", r0, r3, 1)
+	l1 := addLink("l1", r0, r1, 2)
+	l2 := addLink("l2", r1, r3, 2)
+	l3 := addLink("l3", r0, r2, 5)
+	addLink("l4", r2, r3, 5)
+
+	path, err := network.CreatePath(r0, r3)
+	req.NoError(err)
+	req.Equal([]*model.Link{l0}, path.Links)
+
+	// the direct hop is avoided, so the next cheapest path is used
+	altPath, err := network.CreateAltPath(path, nil)
+	req.NoError(err)
+	req.Equal([]*model.Router{r0, r1, r3}, altPath.Nodes)
+	req.Equal([]*model.Link{l1, l2}, altPath.Links)
+	req.Equal(path.IngressId, altPath.IngressId)
+	req.Equal(path.EgressId, altPath.EgressId)
+
+	// the alternate path may not share transit routers with the primary path
+	secondAltPath, err := network.CreateAltPath(altPath, nil)
+	req.NoError(err)
+	req.Equal([]*model.Router{r0, r3}, secondAltPath.Nodes)
+
+	terminator := &model.Terminator{Address: addr, Binding: "transport"}
+	deadline := time.Now().Add(config2.DefaultOptionsRouteTimeout)
+	routeRouters, routeMessages := network.CreateMultipathRouteMessages(path, altPath, ctrl_pb.MultipathMode_MultipathStripe, 0, "s0", terminator, deadline)
+	req.Equal([]*model.Router{r0, r3, r1}, routeRouters)
+	req.Equal(3, len(routeMessages))
+
+	// the end routers get the forwards for both paths, primary first
+	ingress := routeMessages[0]
+	req.Equal(ctrl_pb.MultipathMode_MultipathStripe, ingress.Multipath)
+	req.Equal(4, len(ingress.Forwards))
+	req.Equal(path.IngressId, ingress.Forwards[0].SrcAddress)
+	req.Equal(l0.Id, ingress.Forwards[0].DstAddress)
+	req.Equal(path.IngressId, ingress.Forwards[2].SrcAddress)
+	req.Equal(l1.Id, ingress.Forwards[2].DstAddress)
+
+	egress := routeMessages[1]
+	req.Equal(ctrl_pb.MultipathMode_MultipathStripe, egress.Multipath)
+	req.NotNil(egress.Egress)
+	req.Equal(4, len(egress.Forwards))
+	req.Equal(path.EgressId, egress.Forwards[0].SrcAddress)
+	req.Equal(l0.Id, egress.Forwards[0].DstAddress)
+	req.Equal(path.EgressId, egress.Forwards[2].SrcAddress)
+	req.Equal(l2.Id, egress.Forwards[2].DstAddress)
+
+	// transit routers of the alternate path get a regular route
+	transit := routeMessages[2]
+	req.Equal(ctrl_pb.MultipathMode_MultipathNone, transit.Multipath)
+	req.Nil(transit.Egress)
+	req.Equal(2, len(transit.Forwards))
+	req.Equal(l1.Id, transit.Forwards[0].SrcAddress)
+	req.Equal(l2.Id, transit.Forwards[0].DstAddress)
+
+	// when the cheapest alternate path is lost, the next disjoint path is used
+	network.Link.Remove(l1)
+	routeRouters, routeMessages, altPath = network.createCircuitRouteMessages(db.ServiceMultipathDuplicate, db.ServicePriorityInteractive, nil, path, 0, "s1", terminator, deadline)
+	req.Equal([]*model.Router{r0, r2, r3}, altPath.Nodes)
+	req.Equal([]*model.Router{r0, r3, r2}, routeRouters)
+	req.Equal(3, len(routeMessages))
+	req.Equal(ctrl_pb.MultipathMode_MultipathDuplicate, routeMessages[0].Multipath)
+	for _, routeMessage := range routeMessages {
+		req.Equal(ctrl_pb.PriorityClass_PriorityInteractive, routeMessage.Priority)
+	}
+
+	// without a disjoint path, circuits fall back to a single path
+	network.Link.Remove(l3)
+	routeRouters, routeMessages, altPath = network.createCircuitRouteMessages(db.ServiceMultipathDuplicate, "", nil, path, 0, "s2", terminator, deadline)
+	req.Nil(altPath)
+	req.Equal([]*model.Router{r0, r3}, routeRouters)
+	req.Equal(2, len(routeMessages))
+	req.Equal(ctrl_pb.MultipathMode_MultipathNone, routeMessages[0].Multipath)
+	req.Equal(ctrl_pb.PriorityClass_PriorityBulk, routeMessages[0].Priority)
+}
+
+func TestRouteConstraints(t *testing.T) {
+	ctx := model.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config, ctx)
+	req.NoError(err)
+
+	transportAddr, err := tcp.AddressParser{}.Parse("tcp:0.0.0.0:0")
+	req.NoError(err)
+
+	var routers []*model.Router
+	for _, id := range []string{"r0", "r1", "r2", "r3"} {
+		r := model.NewRouterForTest(id, "", transportAddr, nil, 1, false)
+		network.Router.MarkConnected(r)
+		routers = append(routers, r)
+	}
+	r0, r1, r2, r3 := routers[0], routers[1], routers[2], routers[3]
+
+	addLink := func(id string, src, dst *model.Router, cost int32) {
+		link := model.NewTestLink(id, src, dst)
+		link.SetStaticCost(cost)
+		link.SetState(model.Connected)
+		network.Link.Add(link)
+	}
+
+	addLink("l0", r0, r3, 1)
+	addLink("l1", r0, r1, 2)
+	addLink("l2", r1, r3, 2)
+	addLink("l3", r0, r2, 5)
+	addLink("l4", r2, r3, 5)
+
+	routerSet := func(routers ...*model.Router) map[string]struct{} {
+		result := map[string]struct{}{}
+		for _, r := range routers {
+			result[r.Id] = struct{}{}
+		}
+		return result
+	}
+
+	path, _, err := network.shortestConstrainedPath(r0, r3, nil, nil)
+	req.NoError(err)
+	req.Equal([]*model.Router{r0, r3}, path)
+
+	// the cheapest path through a required router is used
+	constraints := &model.RouteConstraints{Required: routerSet(r1, r2)}
+	path, _, err = network.shortestConstrainedPath(r0, r3, constraints, nil)
+	req.NoError(err)
+	req.Equal([]*model.Router{r0, r1, r3}, path)
+	req.True(constraints.PermitsPath(path))
+
+	// denied routers are avoided, even if they're required
+	constraints = &model.RouteConstraints{Denied: routerSet(r1), Required: routerSet(r1, r2)}
+	path, _, err = network.shortestConstrainedPath(r0, r3, constraints, nil)
+	req.NoError(err)
+	req.Equal([]*model.Router{r0, r2, r3}, path)
+
+	// end routers which are required satisfy the requirement
+	constraints = &model.RouteConstraints{Required: routerSet(r3)}
+	path, _, err = network.shortestConstrainedPath(r0, r3, constraints, nil)
+	req.NoError(err)
+	req.Equal([]*model.Router{r0, r3}, path)
+
+	// only allowed routers may be used
+	constraints = &model.RouteConstraints{Allowed: routerSet(r0, r2, r3), Required: routerSet(r1, r2)}
+	path, _, err = network.shortestConstrainedPath(r0, r3, constraints, nil)
+	req.NoError(err)
+	req.Equal([]*model.Router{r0, r2, r3}, path)
+
+	constraints = &model.RouteConstraints{Allowed: routerSet(r0, r3), Required: routerSet(r1, r2)}
+	_, _, err = network.shortestConstrainedPath(r0, r3, constraints, nil)
+	req.Error(err)
+
+	constraints = &model.RouteConstraints{Denied: routerSet(r3)}
+	_, _, err = network.shortestConstrainedPath(r0, r3, constraints, nil)
+	req.Error(err)
+
+	// reroutes keep to the constraints
+	circuitPath, err := network.CreatePath(r0, r3)
+	req.NoError(err)
+	req.Equal([]*model.Router{r0, r3}, circuitPath.Nodes)
+
+	constraints = &model.RouteConstraints{Required: routerSet(r1, r2)}
+	updatedPath, err := network.UpdatePath(circuitPath, constraints)
+	req.NoError(err)
+	req.Equal([]*model.Router{r0, r1, r3}, updatedPath.Nodes)
+	req.Equal(circuitPath.IngressId, updatedPath.IngressId)
+
+	// as do alternate paths, which have to use a different required router
+	altPath, err := network.CreateAltPath(updatedPath, constraints)
+	req.NoError(err)
+	req.Equal([]*model.Router{r0, r2, r3}, altPath.Nodes)
+
+	constraints = &model.RouteConstraints{Required: routerSet(r1)}
+	_, err = network.CreateAltPath(updatedPath, constraints)
+	req.Error(err)
+}
+
+type unrouteRecordingSender struct {
+	channel.Sender
+	unroutes []*ctrl_pb.Unroute
+}
+
+func (self *unrouteRecordingSender) Send(s channel.Sendable) error {
+	unroute := &ctrl_pb.Unroute{}
+	if err := proto.Unmarshal(s.Msg().Body, unroute); err != nil {
+		return err
+	}
+	self.unroutes = append(self.unroutes, unroute)
+	return nil
+}
+
+type unrouteRecordingCtrl struct {
+	ctrlchan.CtrlChannel
+	sender *unrouteRecordingSender
+}
+
+func (self *unrouteRecordingCtrl) GetHighPrioritySender() channel.Sender {
+	return self.sender
+}
+
+func TestUnrouteAbandonedRouters(t *testing.T) {
+	req := require.New(t)
+
+	var routers []*model.Router
+	var senders []*unrouteRecordingSender
+	for _, id := range []string{"r0", "r1", "r2", "r3", "r4"} {
+		sender := &unrouteRecordingSender{}
+		r := model.NewRouterForTest(id, "", nil, &unrouteRecordingCtrl{sender: sender}, 1, false)
+		r.Connected.Store(true)
+		routers = append(routers, r)
+		senders = append(senders, sender)
+	}
+	r0, r1, r2, r3, r4 := routers[0], routers[1], routers[2], routers[3], routers[4]
+	r4.Connected.Store(false)
+
+	// the circuit moves from an alternate path through r1 and r4 to one through r2
+	unrouteAbandonedRouters("c0", []*model.Router{r0, r3, r1, r4, r1}, []*model.Router{r0, r3, r2})
+
+	for _, idx := range []int{0, 2, 3, 4} {
+		req.Empty(senders[idx].unroutes, "router %v should not be unrouted", routers[idx].Id)
+	}
+	req.Equal(1, len(senders[1].unroutes))
+	req.Equal("c0", senders[1].unroutes[0].CircuitId)
+	req.True(senders[1].unroutes[0].Now)
+}
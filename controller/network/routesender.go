@@ -79,12 +79,12 @@ func newRouteSender(circuitId string, timeout time.Duration, serviceCounters Ser
 	}
 }
 
-func (self *routeSender) route(attempt uint32, path *model.Path, routeMsgs []*ctrl_pb.Route, strategy xt.Strategy, terminator xt.Terminator, ctx logcontext.Context) (peerData xt.PeerData, cleanups map[string]struct{}, err CircuitError) {
+func (self *routeSender) route(attempt uint32, routers []*model.Router, routeMsgs []*ctrl_pb.Route, strategy xt.Strategy, terminator xt.Terminator, ctx logcontext.Context) (peerData xt.PeerData, cleanups map[string]struct{}, err CircuitError) {
 	logger := pfxlog.ChannelLogger(logcontext.EstablishPath).Wire(ctx)
 
 	// send route messages
-	for i := 0; i < len(path.Nodes); i++ {
-		r := path.Nodes[i]
+	for i := 0; i < len(routers); i++ {
+		r := routers[i]
 		msg := routeMsgs[i]
 		logger.Debugf("sending route message to [r/%s] for attempt [#%d]", r.Id, msg.Attempt)
 		go self.sendRoute(r, msg, ctx)
@@ -98,7 +98,7 @@ attendance:
 		select {
 		case status := <-self.in:
 			var tmpPeerData xt.PeerData
-			tmpPeerData, cleanups, err = self.handleRouteSend(attempt, path, strategy, status, terminator, logger)
+			tmpPeerData, cleanups, err = self.handleRouteSend(attempt, routers, strategy, status, terminator, logger)
 			if err != nil {
 				return nil, cleanups, err
 			}
@@ -107,7 +107,7 @@ attendance:
 			}
 
 		case <-time.After(timeout):
-			cleanups = self.cleanups(path)
+			cleanups = self.cleanups(routers)
 			strategy.NotifyEvent(xt.NewDialFailedEvent(terminator))
 			self.serviceCounters.ServiceDialTimeout(terminator.GetServiceId(), terminator.GetId())
 			return nil, cleanups, newCircuitErrWrap(CircuitFailureRouterResponseTimeout, &routeTimeoutError{circuitId: self.circuitId})
@@ -129,7 +129,7 @@ attendance:
 	return peerData, nil, nil
 }
 
-func (self *routeSender) handleRouteSend(attempt uint32, path *model.Path, strategy xt.Strategy, status *RouteStatus, terminator xt.Terminator, logger *pfxlog.Builder) (peerData xt.PeerData, cleanups map[string]struct{}, err CircuitError) {
+func (self *routeSender) handleRouteSend(attempt uint32, routers []*model.Router, strategy xt.Strategy, status *RouteStatus, terminator xt.Terminator, logger *pfxlog.Builder) (peerData xt.PeerData, cleanups map[string]struct{}, err CircuitError) {
 	if status.Success == (status.ErrorCode != nil) {
 		logger.Errorf("route status success and error code differ. Success: %v ErrorCode: %v", status.Success, status.ErrorCode)
 	}
@@ -141,7 +141,7 @@ func (self *routeSender) handleRouteSend(attempt uint32, path *model.Path, strat
 			self.attendance[status.Router.Id] = true
 			if status.Router.Id == terminator.GetRouterId() {
 				peerData = status.PeerData
-				strategy.NotifyEvent(xt.NewDialSucceeded(terminator))
+				strategy.NotifyEvent(xt.NewTimedDialSucceeded(terminator, status.DialDuration))
 				self.serviceCounters.ServiceDialSuccess(terminator.GetServiceId(), terminator.GetId())
 			}
 		} else {
@@ -190,7 +190,7 @@ func (self *routeSender) handleRouteSend(attempt uint32, path *model.Path, strat
 			strategy.NotifyEvent(xt.NewDialFailedEvent(terminator))
 			self.serviceCounters.ServiceDialFail(terminator.GetServiceId(), terminator.GetId())
 		}
-		cleanups = self.cleanups(path)
+		cleanups = self.cleanups(routers)
 
 		return nil, cleanups, newCircuitErrorf(failureCause, "error creating route for [s/%s] on [r/%s] (%v)", self.circuitId, status.Router.Id, status.Err)
 	}
@@ -210,9 +210,9 @@ func (self *routeSender) sendRoute(r *model.Router, routeMsg *ctrl_pb.Route, ctx
 	}
 }
 
-func (self *routeSender) cleanups(path *model.Path) map[string]struct{} {
+func (self *routeSender) cleanups(routers []*model.Router) map[string]struct{} {
 	cleanups := make(map[string]struct{})
-	for _, r := range path.Nodes {
+	for _, r := range routers {
 		success, found := self.attendance[r.Id]
 		if found && success {
 			cleanups[r.Id] = struct{}{}
@@ -229,6 +229,10 @@ type RouteStatus struct {
 	Err       string
 	PeerData  xt.PeerData
 	ErrorCode *byte
+
+	// DialDuration is how long the terminating router took to dial the terminator. It is 0 for other routers
+	// and for routers which don't report it
+	DialDuration time.Duration
 }
 
 type routeTimeoutError struct {
@@ -53,7 +53,7 @@ func TestRouteSender_DestroysTerminatorWhenInvalidOnHandleRouteSendAndWeControl(
 		Err:       "THIS IS A TEST",
 	}
 
-	peerData, cleanup, err := rs.handleRouteSend(1, path, xt_smartrouting.NewFactory().NewStrategy(), status, term, logger)
+	peerData, cleanup, err := rs.handleRouteSend(1, path.Nodes, xt_smartrouting.NewFactory().NewStrategy(), status, term, logger)
 	ctx.Error(err)
 	ctx.ErrorContains(err, status.Err)
 	ctx.Nil(peerData)
@@ -106,7 +106,7 @@ func TestRouteSender_SetPrecidenceToNilTerminatorWhenInvalidOnHandleRouteSendAnd
 		Err:       "THIS IS A TEST",
 	}
 
-	peerData, cleanup, err := rs.handleRouteSend(1, path, xt_smartrouting.NewFactory().NewStrategy(), status, term, logger)
+	peerData, cleanup, err := rs.handleRouteSend(1, path.Nodes, xt_smartrouting.NewFactory().NewStrategy(), status, term, logger)
 	ctx.Error(err)
 	ctx.ErrorContains(err, status.Err)
 	ctx.Nil(peerData)
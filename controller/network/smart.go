@@ -32,7 +32,7 @@ func (network *Network) smart() {
 	candidates := network.getRerouteCandidates()
 
 	for _, update := range candidates {
-		if retry := network.smartReroute(update.circuit, update.path, time.Now().Add(config.DefaultOptionsRouteTimeout)); retry {
+		if retry := network.smartReroute(update.circuit, update.path, update.constraints, time.Now().Add(config.DefaultOptionsRouteTimeout)); retry {
 			go network.rerouteCircuitWithTries(update.circuit, config.DefaultOptionsCreateCircuitRetries)
 		}
 	}
@@ -97,17 +97,28 @@ func (network *Network) getRerouteCandidates() []*newCircuitPath {
 	log.Tracef("smart reroute ceiling [%d]", ceiling)
 	for _, circuitId := range orderedCircuits {
 		if circuit, found := network.GetCircuit(circuitId); found {
-			if updatedPath, err := network.UpdatePath(circuit.Path); err == nil {
+			constraints := network.resolveRouteConstraints(circuit)
+			if updatedPath, err := network.UpdatePath(circuit.Path, constraints); err == nil {
 				pathChanged := !updatedPath.EqualPath(circuit.Path)
 				oldCost := circuitCosts[circuitId]
 				newCost := updatedPath.Cost(minRouterCost)
 				costDelta := oldCost - newCost
 				log.Tracef("old cost: %v, new cost: %v, delta: %v", oldCost, newCost, costDelta)
-				if count < ceiling && pathChanged && costDelta >= int64(network.options.Smart.MinCostDelta) {
-					count++
+
+				// circuits are moved off draining routers whenever there's somewhere else for them to go, as are
+				// circuits whose path no longer satisfies their route constraints
+				drainingTransit := pathChanged && network.hasDrainingTransitRouter(circuit.Path) &&
+					!network.hasDrainingTransitRouter(updatedPath)
+				constraintsViolated := pathChanged && !constraints.PermitsPath(circuit.Path.Nodes)
+				forced := drainingTransit || constraintsViolated
+				if forced || (count < ceiling && pathChanged && costDelta >= int64(network.options.Smart.MinCostDelta)) {
+					if !forced {
+						count++
+					}
 					candidates = append(candidates, &newCircuitPath{
-						circuit: circuit,
-						path:    updatedPath,
+						circuit:     circuit,
+						path:        updatedPath,
+						constraints: constraints,
 					})
 					log.Debugf("rerouting [c/%s] [l:%d] %s ==> %s", circuit.Id, circuitCosts[circuit.Id], circuit.Path.String(), updatedPath.String())
 				}
@@ -118,7 +129,22 @@ func (network *Network) getRerouteCandidates() []*newCircuitPath {
 	return candidates
 }
 
+// hasDrainingTransitRouter returns true if any router in the path, other than the first and last, is draining
+func (network *Network) hasDrainingTransitRouter(path *model.Path) bool {
+	for i := 1; i < len(path.Nodes)-1; i++ {
+		r := path.Nodes[i]
+		if currentRouter := network.GetConnectedRouter(r.Id); currentRouter != nil {
+			r = currentRouter
+		}
+		if r.Draining {
+			return true
+		}
+	}
+	return false
+}
+
 type newCircuitPath struct {
-	circuit *model.Circuit
-	path    *model.Path
+	circuit     *model.Circuit
+	path        *model.Path
+	constraints *model.RouteConstraints
 }
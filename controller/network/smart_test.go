@@ -12,6 +12,7 @@ import (
 	"github.com/hanzozt/zt/v2/controller/models"
 	"github.com/hanzozt/zt/v2/controller/xt"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSmartRerouteMinCostDelta(t *testing.T) {
@@ -124,3 +125,88 @@ func TestSmartRerouteMinCostDelta(t *testing.T) {
 	assert.Equal(t, "l0", candidate.path.Links[0].Id)
 	assert.Equal(t, "l2", candidate.path.Links[1].Id)
 }
+
+func TestSmartRerouteDrainingTransitRouter(t *testing.T) {
+	ctx := model.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	config.options.MinRouterCost = 10
+	config.options.Smart.MinCostDelta = 15
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config, ctx)
+	req.NoError(err)
+
+	addr := "tcp:0.0.0.0:0"
+	transportAddr, err := tcp.AddressParser{}.Parse(addr)
+	req.NoError(err)
+
+	r0 := model.NewRouterForTest("r0", "", transportAddr, nil, 0, true)
+	network.Router.MarkConnected(r0)
+
+	r1 := model.NewRouterForTest("r1", "", transportAddr, nil, 15, false)
+	network.Router.MarkConnected(r1)
+
+	r2 := model.NewRouterForTest("r2", "", transportAddr, nil, 0, false)
+	network.Router.MarkConnected(r2)
+
+	r3 := model.NewRouterForTest("r3", "", transportAddr, nil, 0, true)
+	network.Router.MarkConnected(r3)
+
+	newPathTestLink(network, "l0", r0, r1)
+	newPathTestLink(network, "l1", r0, r2)
+	newPathTestLink(network, "l2", r1, r3)
+	newPathTestLink(network, "l3", r2, r3)
+
+	svc := &model.Service{
+		BaseEntity:         models.BaseEntity{Id: "svc"},
+		Name:               "svc",
+		TerminatorStrategy: "smartrouting",
+		Terminators: []*model.Terminator{
+			{
+				BaseEntity: models.BaseEntity{Id: "t0"},
+				Service:    "svc",
+				Router:     "r3",
+				Binding:    "transport",
+				Address:    "tcp:localhost:1001",
+				Precedence: xt.Precedences.Default,
+			},
+		},
+	}
+
+	params := newCircuitParams(svc, r0)
+	_, terminator, pathNodes, _, cerr := network.selectPath(params, svc, "", logcontext.NewContext())
+	req.NoError(cerr)
+
+	path, pathErr := network.CreatePathWithNodes(pathNodes)
+	req.NoError(pathErr)
+	req.Len(path.Links, 2)
+	req.Equal("l1", path.Links[0].Id)
+	req.Equal("l3", path.Links[1].Id)
+
+	network.Circuit.Add(&model.Circuit{
+		Id:         uuid.NewString(),
+		ServiceId:  svc.Id,
+		Path:       path,
+		Terminator: terminator,
+		CreatedAt:  time.Now(),
+	})
+
+	req.Empty(network.getRerouteCandidates())
+
+	// the path through r1 costs more, but circuits are moved off draining routers regardless of cost
+	r2.Draining = true
+
+	candidates := network.getRerouteCandidates()
+	req.Len(candidates, 1)
+	req.Len(candidates[0].path.Links, 2)
+	req.Equal("l0", candidates[0].path.Links[0].Id)
+	req.Equal("l2", candidates[0].path.Links[1].Id)
+
+	// if every transit router is draining, there's nowhere better to go, so circuits are left where they are
+	r1.Draining = true
+	req.Empty(network.getRerouteCandidates())
+}
@@ -51,10 +51,19 @@ type LinkDetail struct {
 	// Required: true
 	Cost *int64 `json:"cost"`
 
+	// The available bandwidth estimated by the destination router, in bits per second. Zero if no estimate is available
+	DestAvailableBandwidth int64 `json:"destAvailableBandwidth,omitempty"`
+
+	// The link jitter reported by the destination router, in nanoseconds
+	DestJitter int64 `json:"destJitter,omitempty"`
+
 	// dest latency
 	// Required: true
 	DestLatency *int64 `json:"destLatency"`
 
+	// The percentage of link probes lost, as reported by the destination router
+	DestLoss float64 `json:"destLoss,omitempty"`
+
 	// dest router
 	// Required: true
 	DestRouter *EntityRef `json:"destRouter"`
@@ -75,10 +84,19 @@ type LinkDetail struct {
 	// Required: true
 	Protocol *string `json:"protocol"`
 
+	// The available bandwidth estimated by the source router, in bits per second. Zero if no estimate is available
+	SourceAvailableBandwidth int64 `json:"sourceAvailableBandwidth,omitempty"`
+
+	// The link jitter reported by the source router, in nanoseconds
+	SourceJitter int64 `json:"sourceJitter,omitempty"`
+
 	// source latency
 	// Required: true
 	SourceLatency *int64 `json:"sourceLatency"`
 
+	// The percentage of link probes lost, as reported by the source router
+	SourceLoss float64 `json:"sourceLoss,omitempty"`
+
 	// source router
 	// Required: true
 	SourceRouter *EntityRef `json:"sourceRouter"`
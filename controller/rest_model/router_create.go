@@ -43,6 +43,9 @@ import (
 // swagger:model routerCreate
 type RouterCreate struct {
 
+	// config Id
+	ConfigID *string `json:"configId,omitempty"`
+
 	// cost
 	// Required: true
 	// Maximum: 65535
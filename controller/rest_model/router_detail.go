@@ -45,6 +45,34 @@ import (
 type RouterDetail struct {
 	BaseEntity
 
+	// The time at which the first of the router's certificates expires
+	// Format: date-time
+	CertExpiresAt *strfmt.DateTime `json:"certExpiresAt,omitempty"`
+
+	// The error the router reported, if its last certificate renewal failed
+	CertRenewalError string `json:"certRenewalError,omitempty"`
+
+	// The enrollment signer which issued the router's certificates: current, next or unknown
+	CertSigner string `json:"certSigner,omitempty"`
+
+	// The version of the managed config the router last reported as applied
+	ConfigAppliedVersion string `json:"configAppliedVersion,omitempty"`
+
+	// The managed config settings which changed, mapped to whether the router must be restarted for them to take effect
+	ConfigChanges map[string]bool `json:"configChanges,omitempty"`
+
+	// The error the router reported, if it failed to apply the managed config
+	ConfigError string `json:"configError,omitempty"`
+
+	// The router.v1 config which manages part of the router's configuration
+	ConfigID string `json:"configId,omitempty"`
+
+	// The managed config settings which only take effect once the router is restarted
+	ConfigRestartRequired []string `json:"configRestartRequired"`
+
+	// The version of the managed config most recently sent to the router
+	ConfigVersion string `json:"configVersion,omitempty"`
+
 	// connected
 	// Required: true
 	Connected *bool `json:"connected"`
@@ -59,6 +87,14 @@ type RouterDetail struct {
 	// Required: true
 	Disabled *bool `json:"disabled"`
 
+	// drained
+	// Required: true
+	Drained *bool `json:"drained"`
+
+	// draining
+	// Required: true
+	Draining *bool `json:"draining"`
+
 	// fingerprint
 	// Required: true
 	Fingerprint *string `json:"fingerprint"`
@@ -92,12 +128,34 @@ func (m *RouterDetail) UnmarshalJSON(raw []byte) error {
 
 	// AO1
 	var dataAO1 struct {
+		CertExpiresAt *strfmt.DateTime `json:"certExpiresAt,omitempty"`
+
+		CertRenewalError string `json:"certRenewalError,omitempty"`
+
+		CertSigner string `json:"certSigner,omitempty"`
+
+		ConfigAppliedVersion string `json:"configAppliedVersion,omitempty"`
+
+		ConfigChanges map[string]bool `json:"configChanges,omitempty"`
+
+		ConfigError string `json:"configError,omitempty"`
+
+		ConfigID string `json:"configId,omitempty"`
+
+		ConfigRestartRequired []string `json:"configRestartRequired"`
+
+		ConfigVersion string `json:"configVersion,omitempty"`
+
 		Connected *bool `json:"connected"`
 
 		Cost *int64 `json:"cost"`
 
 		Disabled *bool `json:"disabled"`
 
+		Drained *bool `json:"drained"`
+
+		Draining *bool `json:"draining"`
+
 		Fingerprint *string `json:"fingerprint"`
 
 		Interfaces []*Interface `json:"interfaces"`
@@ -114,12 +172,34 @@ func (m *RouterDetail) UnmarshalJSON(raw []byte) error {
 		return err
 	}
 
+	m.CertExpiresAt = dataAO1.CertExpiresAt
+
+	m.CertRenewalError = dataAO1.CertRenewalError
+
+	m.CertSigner = dataAO1.CertSigner
+
+	m.ConfigAppliedVersion = dataAO1.ConfigAppliedVersion
+
+	m.ConfigChanges = dataAO1.ConfigChanges
+
+	m.ConfigError = dataAO1.ConfigError
+
+	m.ConfigID = dataAO1.ConfigID
+
+	m.ConfigRestartRequired = dataAO1.ConfigRestartRequired
+
+	m.ConfigVersion = dataAO1.ConfigVersion
+
 	m.Connected = dataAO1.Connected
 
 	m.Cost = dataAO1.Cost
 
 	m.Disabled = dataAO1.Disabled
 
+	m.Drained = dataAO1.Drained
+
+	m.Draining = dataAO1.Draining
+
 	m.Fingerprint = dataAO1.Fingerprint
 
 	m.Interfaces = dataAO1.Interfaces
@@ -145,12 +225,34 @@ func (m RouterDetail) MarshalJSON() ([]byte, error) {
 	}
 	_parts = append(_parts, aO0)
 	var dataAO1 struct {
+		CertExpiresAt *strfmt.DateTime `json:"certExpiresAt,omitempty"`
+
+		CertRenewalError string `json:"certRenewalError,omitempty"`
+
+		CertSigner string `json:"certSigner,omitempty"`
+
+		ConfigAppliedVersion string `json:"configAppliedVersion,omitempty"`
+
+		ConfigChanges map[string]bool `json:"configChanges,omitempty"`
+
+		ConfigError string `json:"configError,omitempty"`
+
+		ConfigID string `json:"configId,omitempty"`
+
+		ConfigRestartRequired []string `json:"configRestartRequired"`
+
+		ConfigVersion string `json:"configVersion,omitempty"`
+
 		Connected *bool `json:"connected"`
 
 		Cost *int64 `json:"cost"`
 
 		Disabled *bool `json:"disabled"`
 
+		Drained *bool `json:"drained"`
+
+		Draining *bool `json:"draining"`
+
 		Fingerprint *string `json:"fingerprint"`
 
 		Interfaces []*Interface `json:"interfaces"`
@@ -164,12 +266,34 @@ func (m RouterDetail) MarshalJSON() ([]byte, error) {
 		VersionInfo *VersionInfo `json:"versionInfo,omitempty"`
 	}
 
+	dataAO1.CertExpiresAt = m.CertExpiresAt
+
+	dataAO1.CertRenewalError = m.CertRenewalError
+
+	dataAO1.CertSigner = m.CertSigner
+
+	dataAO1.ConfigAppliedVersion = m.ConfigAppliedVersion
+
+	dataAO1.ConfigChanges = m.ConfigChanges
+
+	dataAO1.ConfigError = m.ConfigError
+
+	dataAO1.ConfigID = m.ConfigID
+
+	dataAO1.ConfigRestartRequired = m.ConfigRestartRequired
+
+	dataAO1.ConfigVersion = m.ConfigVersion
+
 	dataAO1.Connected = m.Connected
 
 	dataAO1.Cost = m.Cost
 
 	dataAO1.Disabled = m.Disabled
 
+	dataAO1.Drained = m.Drained
+
+	dataAO1.Draining = m.Draining
+
 	dataAO1.Fingerprint = m.Fingerprint
 
 	dataAO1.Interfaces = m.Interfaces
@@ -199,6 +323,10 @@ func (m *RouterDetail) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateCertExpiresAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateConnected(formats); err != nil {
 		res = append(res, err)
 	}
@@ -211,6 +339,14 @@ func (m *RouterDetail) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDrained(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDraining(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateFingerprint(formats); err != nil {
 		res = append(res, err)
 	}
@@ -241,6 +377,19 @@ func (m *RouterDetail) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *RouterDetail) validateCertExpiresAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CertExpiresAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("certExpiresAt", "body", "date-time", m.CertExpiresAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *RouterDetail) validateConnected(formats strfmt.Registry) error {
 
 	if err := validate.Required("connected", "body", m.Connected); err != nil {
@@ -276,6 +425,24 @@ func (m *RouterDetail) validateDisabled(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *RouterDetail) validateDrained(formats strfmt.Registry) error {
+
+	if err := validate.Required("drained", "body", m.Drained); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *RouterDetail) validateDraining(formats strfmt.Registry) error {
+
+	if err := validate.Required("draining", "body", m.Draining); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *RouterDetail) validateFingerprint(formats strfmt.Registry) error {
 
 	if err := validate.Required("fingerprint", "body", m.Fingerprint); err != nil {
@@ -43,6 +43,9 @@ import (
 // swagger:model routerPatch
 type RouterPatch struct {
 
+	// config Id
+	ConfigID *string `json:"configId,omitempty"`
+
 	// cost
 	// Maximum: 65535
 	// Minimum: 0
@@ -51,6 +54,9 @@ type RouterPatch struct {
 	// disabled
 	Disabled *bool `json:"disabled,omitempty"`
 
+	// draining
+	Draining *bool `json:"draining,omitempty"`
+
 	// fingerprint
 	Fingerprint *string `json:"fingerprint,omitempty"`
 
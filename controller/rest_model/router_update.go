@@ -43,6 +43,9 @@ import (
 // swagger:model routerUpdate
 type RouterUpdate struct {
 
+	// config Id
+	ConfigID *string `json:"configId,omitempty"`
+
 	// cost
 	// Required: true
 	// Maximum: 65535
@@ -52,6 +55,9 @@ type RouterUpdate struct {
 	// disabled
 	Disabled *bool `json:"disabled,omitempty"`
 
+	// draining
+	Draining *bool `json:"draining,omitempty"`
+
 	// fingerprint
 	// Required: true
 	Fingerprint *string `json:"fingerprint"`
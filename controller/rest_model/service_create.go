@@ -46,10 +46,16 @@ type ServiceCreate struct {
 	// max idle time millis
 	MaxIdleTimeMillis int64 `json:"maxIdleTimeMillis,omitempty"`
 
+	// Set to duplicate or stripe to route circuits over two disjoint paths. Duplicate sends every payload over both paths, for reliability. Stripe alternates payloads between the paths, for throughput.
+	Multipath string `json:"multipath,omitempty"`
+
 	// name
 	// Required: true
 	Name *string `json:"name"`
 
+	// The priority class of the service's circuits, one of interactive, bulk or background. Interactive payloads are sent ahead of other traffic on router links, background payloads after it. Defaults to bulk.
+	Priority string `json:"priority,omitempty"`
+
 	// tags
 	Tags *Tags `json:"tags,omitempty"`
 
@@ -48,10 +48,16 @@ type ServiceDetail struct {
 	// Required: true
 	MaxIdleTimeMillis *int64 `json:"maxIdleTimeMillis"`
 
+	// Set to duplicate or stripe to route circuits over two disjoint paths. Duplicate sends every payload over both paths, for reliability. Stripe alternates payloads between the paths, for throughput.
+	Multipath string `json:"multipath,omitempty"`
+
 	// name
 	// Required: true
 	Name *string `json:"name"`
 
+	// The priority class of the service's circuits, one of interactive, bulk or background. Interactive payloads are sent ahead of other traffic on router links, background payloads after it. Defaults to bulk.
+	Priority string `json:"priority,omitempty"`
+
 	// terminator strategy
 	// Required: true
 	TerminatorStrategy *string `json:"terminatorStrategy"`
@@ -70,8 +76,12 @@ func (m *ServiceDetail) UnmarshalJSON(raw []byte) error {
 	var dataAO1 struct {
 		MaxIdleTimeMillis *int64 `json:"maxIdleTimeMillis"`
 
+		Multipath string `json:"multipath,omitempty"`
+
 		Name *string `json:"name"`
 
+		Priority string `json:"priority,omitempty"`
+
 		TerminatorStrategy *string `json:"terminatorStrategy"`
 	}
 	if err := swag.ReadJSON(raw, &dataAO1); err != nil {
@@ -80,8 +90,12 @@ func (m *ServiceDetail) UnmarshalJSON(raw []byte) error {
 
 	m.MaxIdleTimeMillis = dataAO1.MaxIdleTimeMillis
 
+	m.Multipath = dataAO1.Multipath
+
 	m.Name = dataAO1.Name
 
+	m.Priority = dataAO1.Priority
+
 	m.TerminatorStrategy = dataAO1.TerminatorStrategy
 
 	return nil
@@ -99,15 +113,23 @@ func (m ServiceDetail) MarshalJSON() ([]byte, error) {
 	var dataAO1 struct {
 		MaxIdleTimeMillis *int64 `json:"maxIdleTimeMillis"`
 
+		Multipath string `json:"multipath,omitempty"`
+
 		Name *string `json:"name"`
 
+		Priority string `json:"priority,omitempty"`
+
 		TerminatorStrategy *string `json:"terminatorStrategy"`
 	}
 
 	dataAO1.MaxIdleTimeMillis = m.MaxIdleTimeMillis
 
+	dataAO1.Multipath = m.Multipath
+
 	dataAO1.Name = m.Name
 
+	dataAO1.Priority = m.Priority
+
 	dataAO1.TerminatorStrategy = m.TerminatorStrategy
 
 	jsonDataAO1, errAO1 := swag.WriteJSON(dataAO1)
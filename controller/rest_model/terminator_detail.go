@@ -60,6 +60,10 @@ type TerminatorDetail struct {
 	// Required: true
 	DynamicCost *TerminatorCost `json:"dynamicCost"`
 
+	// If the terminator has been ejected by outlier detection, the time at which the ejection expires
+	// Format: date-time
+	EjectedUntil *strfmt.DateTime `json:"ejectedUntil,omitempty"`
+
 	// host Id
 	// Required: true
 	HostID *string `json:"hostId"`
@@ -108,6 +112,8 @@ func (m *TerminatorDetail) UnmarshalJSON(raw []byte) error {
 
 		DynamicCost *TerminatorCost `json:"dynamicCost"`
 
+		EjectedUntil *strfmt.DateTime `json:"ejectedUntil,omitempty"`
+
 		HostID *string `json:"hostId"`
 
 		InstanceID *string `json:"instanceId"`
@@ -134,6 +140,8 @@ func (m *TerminatorDetail) UnmarshalJSON(raw []byte) error {
 
 	m.DynamicCost = dataAO1.DynamicCost
 
+	m.EjectedUntil = dataAO1.EjectedUntil
+
 	m.HostID = dataAO1.HostID
 
 	m.InstanceID = dataAO1.InstanceID
@@ -169,6 +177,8 @@ func (m TerminatorDetail) MarshalJSON() ([]byte, error) {
 
 		DynamicCost *TerminatorCost `json:"dynamicCost"`
 
+		EjectedUntil *strfmt.DateTime `json:"ejectedUntil,omitempty"`
+
 		HostID *string `json:"hostId"`
 
 		InstanceID *string `json:"instanceId"`
@@ -192,6 +202,8 @@ func (m TerminatorDetail) MarshalJSON() ([]byte, error) {
 
 	dataAO1.DynamicCost = m.DynamicCost
 
+	dataAO1.EjectedUntil = m.EjectedUntil
+
 	dataAO1.HostID = m.HostID
 
 	dataAO1.InstanceID = m.InstanceID
@@ -239,6 +251,10 @@ func (m *TerminatorDetail) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateEjectedUntil(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHostID(formats); err != nil {
 		res = append(res, err)
 	}
@@ -339,6 +355,19 @@ func (m *TerminatorDetail) validateDynamicCost(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TerminatorDetail) validateEjectedUntil(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.EjectedUntil) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("ejectedUntil", "body", "date-time", m.EjectedUntil.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *TerminatorDetail) validateHostID(formats strfmt.Registry) error {
 
 	if err := validate.Required("hostId", "body", m.HostID); err != nil {
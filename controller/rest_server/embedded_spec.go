@@ -1941,9 +1941,21 @@ func init() {
         "cost": {
           "type": "integer"
         },
+        "destAvailableBandwidth": {
+          "description": "The available bandwidth estimated by the destination router, in bits per second. Zero if no estimate is available",
+          "type": "integer"
+        },
+        "destJitter": {
+          "description": "The link jitter reported by the destination router, in nanoseconds",
+          "type": "integer"
+        },
         "destLatency": {
           "type": "integer"
         },
+        "destLoss": {
+          "description": "The percentage of link probes lost, as reported by the destination router",
+          "type": "number"
+        },
         "destRouter": {
           "$ref": "#/definitions/entityRef"
         },
@@ -1959,9 +1971,21 @@ func init() {
         "protocol": {
           "type": "string"
         },
+        "sourceAvailableBandwidth": {
+          "description": "The available bandwidth estimated by the source router, in bits per second. Zero if no estimate is available",
+          "type": "integer"
+        },
+        "sourceJitter": {
+          "description": "The link jitter reported by the source router, in nanoseconds",
+          "type": "integer"
+        },
         "sourceLatency": {
           "type": "integer"
         },
+        "sourceLoss": {
+          "description": "The percentage of link probes lost, as reported by the source router",
+          "type": "number"
+        },
         "sourceRouter": {
           "$ref": "#/definitions/entityRef"
         },
@@ -2142,6 +2166,10 @@ func init() {
         "noTraversal"
       ],
       "properties": {
+        "configId": {
+          "type": "string",
+          "x-nullable": true
+        },
         "cost": {
           "type": "integer",
           "maximum": 65535
@@ -2182,9 +2210,54 @@ func init() {
             "connected",
             "cost",
             "noTraversal",
-            "disabled"
+            "disabled",
+            "draining",
+            "drained"
           ],
           "properties": {
+            "certExpiresAt": {
+              "description": "The time at which the first of the router's certificates expires",
+              "type": "string",
+              "format": "date-time"
+            },
+            "certRenewalError": {
+              "description": "The error the router reported, if its last certificate renewal failed",
+              "type": "string"
+            },
+            "certSigner": {
+              "description": "The enrollment signer which issued the router's certificates: current, next or unknown",
+              "type": "string"
+            },
+            "configAppliedVersion": {
+              "description": "The version of the managed config the router last reported as applied",
+              "type": "string"
+            },
+            "configChanges": {
+              "description": "The managed config settings which changed, mapped to whether the router must be restarted for them to take effect",
+              "type": "object",
+              "additionalProperties": {
+                "type": "boolean"
+              }
+            },
+            "configError": {
+              "description": "The error the router reported, if it failed to apply the managed config",
+              "type": "string"
+            },
+            "configId": {
+              "description": "The router.v1 config which manages part of the router's configuration",
+              "type": "string"
+            },
+            "configRestartRequired": {
+              "description": "The managed config settings which only take effect once the router is restarted",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "configVersion": {
+              "description": "The version of the managed config most recently sent to the router",
+              "type": "string"
+            },
             "connected": {
               "type": "boolean"
             },
@@ -2195,6 +2268,12 @@ func init() {
             "disabled": {
               "type": "boolean"
             },
+            "drained": {
+              "type": "boolean"
+            },
+            "draining": {
+              "type": "boolean"
+            },
             "fingerprint": {
               "type": "string"
             },
@@ -2247,6 +2326,10 @@ func init() {
     "routerPatch": {
       "type": "object",
       "properties": {
+        "configId": {
+          "type": "string",
+          "x-nullable": true
+        },
         "cost": {
           "type": "integer",
           "maximum": 65535,
@@ -2256,6 +2339,10 @@ func init() {
           "type": "boolean",
           "x-nullable": true
         },
+        "draining": {
+          "type": "boolean",
+          "x-nullable": true
+        },
         "fingerprint": {
           "type": "string",
           "x-nullable": true
@@ -2281,6 +2368,10 @@ func init() {
         "noTraversal"
       ],
       "properties": {
+        "configId": {
+          "type": "string",
+          "x-nullable": true
+        },
         "cost": {
           "type": "integer",
           "maximum": 65535
@@ -2289,6 +2380,10 @@ func init() {
           "type": "boolean",
           "x-nullable": true
         },
+        "draining": {
+          "type": "boolean",
+          "x-nullable": true
+        },
         "fingerprint": {
           "type": "string"
         },
@@ -2312,9 +2407,17 @@ func init() {
         "maxIdleTimeMillis": {
           "type": "integer"
         },
+        "multipath": {
+          "description": "Set to duplicate or stripe to route circuits over two disjoint paths. Duplicate sends every payload over both paths, for reliability. Stripe alternates payloads between the paths, for throughput.",
+          "type": "string"
+        },
         "name": {
           "type": "string"
         },
+        "priority": {
+          "description": "The priority class of the service's circuits, one of interactive, bulk or background. Interactive payloads are sent ahead of other traffic on router links, background payloads after it. Defaults to bulk.",
+          "type": "string"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
@@ -2340,9 +2443,17 @@ func init() {
             "maxIdleTimeMillis": {
               "type": "integer"
             },
+            "multipath": {
+              "description": "Set to duplicate or stripe to route circuits over two disjoint paths. Duplicate sends every payload over both paths, for reliability. Stripe alternates payloads between the paths, for throughput.",
+              "type": "string"
+            },
             "name": {
               "type": "string"
             },
+            "priority": {
+              "description": "The priority class of the service's circuits, one of interactive, bulk or background. Interactive payloads are sent ahead of other traffic on router links, background payloads after it. Defaults to bulk.",
+              "type": "string"
+            },
             "terminatorStrategy": {
               "type": "string"
             }
@@ -2362,9 +2473,17 @@ func init() {
         "maxIdleTimeMillis": {
           "type": "integer"
         },
+        "multipath": {
+          "description": "Set to duplicate or stripe to route circuits over two disjoint paths. Duplicate sends every payload over both paths, for reliability. Stripe alternates payloads between the paths, for throughput.",
+          "type": "string"
+        },
         "name": {
           "type": "string"
         },
+        "priority": {
+          "description": "The priority class of the service's circuits, one of interactive, bulk or background. Interactive payloads are sent ahead of other traffic on router links, background payloads after it. Defaults to bulk.",
+          "type": "string"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
@@ -2382,9 +2501,17 @@ func init() {
         "maxIdleTimeMillis": {
           "type": "integer"
         },
+        "multipath": {
+          "description": "Set to duplicate or stripe to route circuits over two disjoint paths. Duplicate sends every payload over both paths, for reliability. Stripe alternates payloads between the paths, for throughput.",
+          "type": "string"
+        },
         "name": {
           "type": "string"
         },
+        "priority": {
+          "description": "The priority class of the service's circuits, one of interactive, bulk or background. Interactive payloads are sent ahead of other traffic on router links, background payloads after it. Defaults to bulk.",
+          "type": "string"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
@@ -2494,6 +2621,12 @@ func init() {
             "dynamicCost": {
               "$ref": "#/definitions/terminatorCost"
             },
+            "ejectedUntil": {
+              "description": "If the terminator has been ejected by outlier detection, the time at which the ejection expires",
+              "type": "string",
+              "format": "date-time",
+              "x-nullable": true
+            },
             "hostId": {
               "type": "string"
             },
@@ -7743,9 +7876,21 @@ func init() {
         "cost": {
           "type": "integer"
         },
+        "destAvailableBandwidth": {
+          "description": "The available bandwidth estimated by the destination router, in bits per second. Zero if no estimate is available",
+          "type": "integer"
+        },
+        "destJitter": {
+          "description": "The link jitter reported by the destination router, in nanoseconds",
+          "type": "integer"
+        },
         "destLatency": {
           "type": "integer"
         },
+        "destLoss": {
+          "description": "The percentage of link probes lost, as reported by the destination router",
+          "type": "number"
+        },
         "destRouter": {
           "$ref": "#/definitions/entityRef"
         },
@@ -7761,9 +7906,21 @@ func init() {
         "protocol": {
           "type": "string"
         },
+        "sourceAvailableBandwidth": {
+          "description": "The available bandwidth estimated by the source router, in bits per second. Zero if no estimate is available",
+          "type": "integer"
+        },
+        "sourceJitter": {
+          "description": "The link jitter reported by the source router, in nanoseconds",
+          "type": "integer"
+        },
         "sourceLatency": {
           "type": "integer"
         },
+        "sourceLoss": {
+          "description": "The percentage of link probes lost, as reported by the source router",
+          "type": "number"
+        },
         "sourceRouter": {
           "$ref": "#/definitions/entityRef"
         },
@@ -7944,6 +8101,10 @@ func init() {
         "noTraversal"
       ],
       "properties": {
+        "configId": {
+          "type": "string",
+          "x-nullable": true
+        },
         "cost": {
           "type": "integer",
           "maximum": 65535,
@@ -7985,9 +8146,54 @@ func init() {
             "connected",
             "cost",
             "noTraversal",
-            "disabled"
+            "disabled",
+            "draining",
+            "drained"
           ],
           "properties": {
+            "certExpiresAt": {
+              "description": "The time at which the first of the router's certificates expires",
+              "type": "string",
+              "format": "date-time"
+            },
+            "certRenewalError": {
+              "description": "The error the router reported, if its last certificate renewal failed",
+              "type": "string"
+            },
+            "certSigner": {
+              "description": "The enrollment signer which issued the router's certificates: current, next or unknown",
+              "type": "string"
+            },
+            "configAppliedVersion": {
+              "description": "The version of the managed config the router last reported as applied",
+              "type": "string"
+            },
+            "configChanges": {
+              "description": "The managed config settings which changed, mapped to whether the router must be restarted for them to take effect",
+              "type": "object",
+              "additionalProperties": {
+                "type": "boolean"
+              }
+            },
+            "configError": {
+              "description": "The error the router reported, if it failed to apply the managed config",
+              "type": "string"
+            },
+            "configId": {
+              "description": "The router.v1 config which manages part of the router's configuration",
+              "type": "string"
+            },
+            "configRestartRequired": {
+              "description": "The managed config settings which only take effect once the router is restarted",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "configVersion": {
+              "description": "The version of the managed config most recently sent to the router",
+              "type": "string"
+            },
             "connected": {
               "type": "boolean"
             },
@@ -7999,6 +8205,12 @@ func init() {
             "disabled": {
               "type": "boolean"
             },
+            "drained": {
+              "type": "boolean"
+            },
+            "draining": {
+              "type": "boolean"
+            },
             "fingerprint": {
               "type": "string"
             },
@@ -8051,6 +8263,10 @@ func init() {
     "routerPatch": {
       "type": "object",
       "properties": {
+        "configId": {
+          "type": "string",
+          "x-nullable": true
+        },
         "cost": {
           "type": "integer",
           "maximum": 65535,
@@ -8061,6 +8277,10 @@ func init() {
           "type": "boolean",
           "x-nullable": true
         },
+        "draining": {
+          "type": "boolean",
+          "x-nullable": true
+        },
         "fingerprint": {
           "type": "string",
           "x-nullable": true
@@ -8086,6 +8306,10 @@ func init() {
         "noTraversal"
       ],
       "properties": {
+        "configId": {
+          "type": "string",
+          "x-nullable": true
+        },
         "cost": {
           "type": "integer",
           "maximum": 65535,
@@ -8095,6 +8319,10 @@ func init() {
           "type": "boolean",
           "x-nullable": true
         },
+        "draining": {
+          "type": "boolean",
+          "x-nullable": true
+        },
         "fingerprint": {
           "type": "string"
         },
@@ -8118,9 +8346,17 @@ func init() {
         "maxIdleTimeMillis": {
           "type": "integer"
         },
+        "multipath": {
+          "description": "Set to duplicate or stripe to route circuits over two disjoint paths. Duplicate sends every payload over both paths, for reliability. Stripe alternates payloads between the paths, for throughput.",
+          "type": "string"
+        },
         "name": {
           "type": "string"
         },
+        "priority": {
+          "description": "The priority class of the service's circuits, one of interactive, bulk or background. Interactive payloads are sent ahead of other traffic on router links, background payloads after it. Defaults to bulk.",
+          "type": "string"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
@@ -8146,9 +8382,17 @@ func init() {
             "maxIdleTimeMillis": {
               "type": "integer"
             },
+            "multipath": {
+              "description": "Set to duplicate or stripe to route circuits over two disjoint paths. Duplicate sends every payload over both paths, for reliability. Stripe alternates payloads between the paths, for throughput.",
+              "type": "string"
+            },
             "name": {
               "type": "string"
             },
+            "priority": {
+              "description": "The priority class of the service's circuits, one of interactive, bulk or background. Interactive payloads are sent ahead of other traffic on router links, background payloads after it. Defaults to bulk.",
+              "type": "string"
+            },
             "terminatorStrategy": {
               "type": "string"
             }
@@ -8168,9 +8412,17 @@ func init() {
         "maxIdleTimeMillis": {
           "type": "integer"
         },
+        "multipath": {
+          "description": "Set to duplicate or stripe to route circuits over two disjoint paths. Duplicate sends every payload over both paths, for reliability. Stripe alternates payloads between the paths, for throughput.",
+          "type": "string"
+        },
         "name": {
           "type": "string"
         },
+        "priority": {
+          "description": "The priority class of the service's circuits, one of interactive, bulk or background. Interactive payloads are sent ahead of other traffic on router links, background payloads after it. Defaults to bulk.",
+          "type": "string"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
@@ -8188,9 +8440,17 @@ func init() {
         "maxIdleTimeMillis": {
           "type": "integer"
         },
+        "multipath": {
+          "description": "Set to duplicate or stripe to route circuits over two disjoint paths. Duplicate sends every payload over both paths, for reliability. Stripe alternates payloads between the paths, for throughput.",
+          "type": "string"
+        },
         "name": {
           "type": "string"
         },
+        "priority": {
+          "description": "The priority class of the service's circuits, one of interactive, bulk or background. Interactive payloads are sent ahead of other traffic on router links, background payloads after it. Defaults to bulk.",
+          "type": "string"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
@@ -8301,6 +8561,12 @@ func init() {
             "dynamicCost": {
               "$ref": "#/definitions/terminatorCost"
             },
+            "ejectedUntil": {
+              "description": "If the terminator has been ejected by outlier detection, the time at which the ejection expires",
+              "type": "string",
+              "format": "date-time",
+              "x-nullable": true
+            },
             "hostId": {
               "type": "string"
             },
@@ -51,6 +51,9 @@ const (
 	policyMaxFreq     = 1 * time.Hour
 	policyAppWanFreq  = 1 * time.Second
 	policySessionFreq = 5 * time.Second
+
+	// schedules have minute granularity, so this bounds how long sessions outlive a closed policy window
+	policyScheduleFreq = 30 * time.Second
 )
 
 func NewController(host env.HostController) (*Controller, error) {
@@ -202,6 +205,14 @@ func (c *Controller) Initialize() {
 
 	}
 
+	policyScheduleEnforcer := policy.NewPolicyScheduleEnforcer(c.AppEnv, policyScheduleFreq)
+	if err := c.policyEngine.AddOperation(policyScheduleEnforcer); err != nil {
+		log.WithField("cause", err).
+			WithField("enforcerName", policyScheduleEnforcer.GetName()).
+			WithField("enforcerId", policyScheduleEnforcer.GetId()).
+			Errorf("could not add policy schedule enforcer")
+	}
+
 	if err := c.AppEnv.GetStores().EventualEventer.Start(c.AppEnv.GetHostController().GetCloseNotifyChannel()); err != nil {
 		log.WithError(err).Panic("could not start EventualEventer")
 	}
@@ -4,9 +4,13 @@ import (
 	"github.com/michaelquigley/pfxlog"
 	"github.com/hanzozt/channel/v4"
 	"github.com/hanzozt/channel/v4/protobufs"
+	"github.com/hanzozt/storage/boltz"
 	"github.com/hanzozt/zt/v2/common/pb/ctrl_pb"
+	"github.com/hanzozt/zt/v2/common/routerconfig"
 	config2 "github.com/hanzozt/zt/v2/controller/config"
+	"github.com/hanzozt/zt/v2/controller/db"
 	"github.com/hanzozt/zt/v2/controller/model"
+	"github.com/hanzozt/zt/v2/controller/network"
 	"github.com/hanzozt/zt/v2/controller/raft"
 	"google.golang.org/protobuf/proto"
 )
@@ -35,7 +39,7 @@ func (o OnConnectSettingsHandler) RouterConnected(r *model.Router) {
 
 		if body, err := proto.Marshal(settingsMsg); err == nil {
 			msg := channel.NewMessage(int32(ctrl_pb.ContentType_SettingsType), body)
-			if err := r.Control.GetDefaultSender().Send(msg); err == nil {
+			if err := r.Control.GetDefaultSender().Send(msg); err != nil {
 				pfxlog.Logger().WithError(err).WithFields(map[string]interface{}{
 					"routerId": r.Id,
 					"channel":  r.Control.GetChannel().LogicalName(),
@@ -93,3 +97,127 @@ func (o OnConnectCtrlAddressesUpdateHandler) RouterConnected(r *model.Router) {
 		log.WithError(err).Error("error sending UpdateCtrlAddresses on router connect")
 	}
 }
+
+// RouterDrainSettingsHandler tells routers whether they're draining, when they connect and whenever their drain
+// state changes
+type RouterDrainSettingsHandler struct{}
+
+func (o *RouterDrainSettingsHandler) RouterDisconnected(*model.Router) {
+	//do nothing, satisfy interface
+}
+
+func (o *RouterDrainSettingsHandler) RouterConnected(r *model.Router) {
+	o.sendDrainSetting(r)
+}
+
+func (o *RouterDrainSettingsHandler) RouterDrainChanged(r *model.Router) {
+	o.sendDrainSetting(r)
+}
+
+func (o *RouterDrainSettingsHandler) sendDrainSetting(r *model.Router) {
+	ctrl := r.Control
+	if ctrl == nil {
+		return
+	}
+
+	log := pfxlog.Logger().WithField("routerId", r.Id).WithField("draining", r.Draining)
+
+	value := byte(0)
+	if r.Draining {
+		value = 1
+	}
+
+	settingsMsg := &ctrl_pb.Settings{
+		Data: map[int32][]byte{
+			int32(ctrl_pb.SettingTypes_RouterDraining): {value},
+		},
+	}
+
+	body, err := proto.Marshal(settingsMsg)
+	if err != nil {
+		log.WithError(err).Error("error marshalling router drain setting")
+		return
+	}
+
+	msg := channel.NewMessage(int32(ctrl_pb.ContentType_SettingsType), body)
+	if err = ctrl.GetDefaultSender().Send(msg); err != nil {
+		log.WithError(err).Error("error sending router drain setting")
+	}
+}
+
+// RouterManagedConfigHandler pushes the managed config assigned to a router when the router connects, when it's
+// assigned a different config and when its config is updated. Routers report back the version they applied, which is
+// recorded on the connected router
+type RouterManagedConfigHandler struct {
+	network *network.Network
+}
+
+func NewRouterManagedConfigHandler(n *network.Network) *RouterManagedConfigHandler {
+	result := &RouterManagedConfigHandler{
+		network: n,
+	}
+	n.GetStores().Config.AddEntityEventListenerF(result.configUpdated, boltz.EntityUpdatedAsync)
+	return result
+}
+
+func (o *RouterManagedConfigHandler) RouterDisconnected(*model.Router) {
+	//do nothing, satisfy interface
+}
+
+func (o *RouterManagedConfigHandler) RouterConnected(r *model.Router) {
+	o.sendManagedConfig(r)
+}
+
+func (o *RouterManagedConfigHandler) RouterConfigChanged(r *model.Router) {
+	o.sendManagedConfig(r)
+}
+
+func (o *RouterManagedConfigHandler) configUpdated(config *db.Config) {
+	if config.TypeId != routerconfig.ConfigTypeV1 {
+		return
+	}
+
+	for _, r := range o.network.AllConnectedRouters() {
+		if r.ConfigId == config.Id {
+			o.sendManagedConfig(r)
+		}
+	}
+}
+
+func (o *RouterManagedConfigHandler) sendManagedConfig(r *model.Router) {
+	ctrl := r.Control
+	if ctrl == nil || !r.HasCapability(ctrl_pb.RouterCapability_ManagedConfig) {
+		return
+	}
+
+	log := pfxlog.Logger().WithField("routerId", r.Id).WithField("configId", r.ConfigId)
+
+	msg := &ctrl_pb.UpdateManagedConfig{
+		ConfigId: r.ConfigId,
+	}
+
+	if r.ConfigId != "" {
+		config, err := o.network.Config.Read(r.ConfigId)
+		if err != nil {
+			log.WithError(err).Error("unable to read router managed config")
+			return
+		}
+
+		managedConfig, err := routerconfig.ParseConfig(config.Data)
+		if err != nil {
+			log.WithError(err).Error("invalid router managed config")
+			return
+		}
+
+		msg.Version = routerconfig.Version(config.Data)
+		msg.Config = managedConfig.Encode()
+	}
+
+	r.SetConfigPushed(msg.Version)
+
+	if err := protobufs.MarshalTyped(msg).Send(ctrl.GetDefaultSender()); err != nil {
+		log.WithError(err).Error("error sending router managed config")
+	} else {
+		log.WithField("version", msg.Version).Debug("sent router managed config")
+	}
+}
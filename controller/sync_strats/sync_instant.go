@@ -1121,6 +1121,22 @@ func (strategy *InstantStrategy) ValidatePostureChecks(tx *bbolt.Tx, rdm *common
 			} else {
 				result = append(result, fmt.Errorf("for posture check %s, sub type not mac address, rather: %T", t.Id, v.Subtype))
 			}
+		case *db.PostureCheckGeoIp:
+			if rdmSubType, ok := v.Subtype.(*edge_ctrl_pb.DataState_PostureCheck_GeoIp_); ok && rdmSubType.GeoIp != nil {
+				result = diffJson("posture check", t.Id, "geo ip allowed cidrs", subType.AllowedCidrs, rdmSubType.GeoIp.AllowedCidrs, result)
+				result = diffJson("posture check", t.Id, "geo ip denied cidrs", subType.DeniedCidrs, rdmSubType.GeoIp.DeniedCidrs, result)
+				result = diffJson("posture check", t.Id, "geo ip allowed countries", subType.AllowedCountries, rdmSubType.GeoIp.AllowedCountries, result)
+				result = diffJson("posture check", t.Id, "geo ip denied countries", subType.DeniedCountries, rdmSubType.GeoIp.DeniedCountries, result)
+			} else {
+				result = append(result, fmt.Errorf("for posture check %s, sub type not geo ip, rather: %T", t.Id, v.Subtype))
+			}
+		case *db.PostureCheckAttestation:
+			if rdmSubType, ok := v.Subtype.(*edge_ctrl_pb.DataState_PostureCheck_Attestation_); ok && rdmSubType.Attestation != nil {
+				result = diffVals("posture check", t.Id, "attestation trusted ca pem", subType.TrustedCaPem, rdmSubType.Attestation.TrustedCaPem, result)
+				result = diffVals("posture check", t.Id, "attestation timeout seconds", subType.TimeoutSeconds, rdmSubType.Attestation.TimeoutSeconds, result)
+			} else {
+				result = append(result, fmt.Errorf("for posture check %s, sub type not attestation, rather: %T", t.Id, v.Subtype))
+			}
 		case *db.PostureCheckMfa:
 			if rdmSubType, ok := v.Subtype.(*edge_ctrl_pb.DataState_PostureCheck_Mfa_); ok && rdmSubType.Mfa != nil {
 				result = diffVals("posture check", t.Id, "mfa ignore legacy endpoints", subType.IgnoreLegacyEndpoints, rdmSubType.Mfa.IgnoreLegacyEndpoints, result)
@@ -1476,6 +1492,7 @@ func newServicePolicy(storeModel *db.ServicePolicy) *edge_ctrl_pb.DataState_Serv
 		Id:         storeModel.Id,
 		Name:       storeModel.Name,
 		PolicyType: edge_ctrl_pb.PolicyType(storeModel.PolicyType.Id()),
+		Schedule:   edge_ctrl_pb.NewPolicySchedule(storeModel.Schedule),
 	}
 
 	return servicePolicy
@@ -1617,6 +1634,22 @@ func newPostureCheck(postureModel *db.PostureCheck) *edge_ctrl_pb.DataState_Post
 				MacAddresses: subType.MacAddresses,
 			},
 		}
+	case *db.PostureCheckGeoIp:
+		newVal.Subtype = &edge_ctrl_pb.DataState_PostureCheck_GeoIp_{
+			GeoIp: &edge_ctrl_pb.DataState_PostureCheck_GeoIp{
+				AllowedCidrs:     subType.AllowedCidrs,
+				DeniedCidrs:      subType.DeniedCidrs,
+				AllowedCountries: subType.AllowedCountries,
+				DeniedCountries:  subType.DeniedCountries,
+			},
+		}
+	case *db.PostureCheckAttestation:
+		newVal.Subtype = &edge_ctrl_pb.DataState_PostureCheck_Attestation_{
+			Attestation: &edge_ctrl_pb.DataState_PostureCheck_Attestation{
+				TrustedCaPem:   subType.TrustedCaPem,
+				TimeoutSeconds: subType.TimeoutSeconds,
+			},
+		}
 	case *db.PostureCheckOperatingSystem:
 
 		osList := &edge_ctrl_pb.DataState_PostureCheck_OsList{}
@@ -25,10 +25,13 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/michaelquigley/pfxlog"
 	"github.com/hanzozt/identity"
 	"github.com/hanzozt/xweb/v3"
+	"github.com/hanzozt/zt/v2/controller/event"
+	"github.com/hanzozt/zt/v2/controller/events"
 	"github.com/hanzozt/zt/v2/controller/network"
 )
 
@@ -105,12 +108,71 @@ func NewMetricsApiHandler(n *network.Network, options map[interface{}]interface{
 		}
 	}
 
-	metricsApi.modelMapper = NewMetricsModelMapper(n, "prometheus", includeTimestamps)
+	format := "prometheus"
+	if value, found := options["format"]; found {
+		if f, ok := value.(string); ok && (f == "prometheus" || f == "openmetrics") {
+			format = f
+		} else {
+			return nil, fmt.Errorf("invalid metrics api format %v, must be one of prometheus or openmetrics", value)
+		}
+	}
+
+	if format == "prometheus" {
+		metricsApi.modelMapper = NewMetricsModelMapper(n, "prometheus", includeTimestamps)
+	} else {
+		usageCollector, err := newMetricsApiUsageCollector(n, options)
+		if err != nil {
+			return nil, err
+		}
+
+		metricsApi.modelMapper = NewOpenMetricsModelMapper(n, includeTimestamps, false, usageCollector)
+		metricsApi.openMetricsMapper = NewOpenMetricsModelMapper(n, includeTimestamps, true, usageCollector)
+	}
+
 	metricsApi.handler = metricsApi.newHandler()
 
 	return metricsApi, nil
 }
 
+// newMetricsApiUsageCollector creates the collector used to expose usage and service event counters with the
+// openmetrics format, unless disabled with includeUsage. Series which aren't updated within usageSeriesExpiry
+// are dropped, and at most usageMaxSeries series are tracked.
+func newMetricsApiUsageCollector(n *network.Network, options map[interface{}]interface{}) (*events.PrometheusUsageCollector, error) {
+	if value, found := options["includeUsage"]; found {
+		if t, ok := value.(bool); ok && !t {
+			return nil, nil
+		}
+	}
+
+	maxSeries := events.DefaultUsageMaxSeries
+	if value, found := options["usageMaxSeries"]; found {
+		if v, ok := value.(int); ok && v > 0 {
+			maxSeries = v
+		} else {
+			return nil, fmt.Errorf("invalid metrics api usageMaxSeries %v, must be a positive integer", value)
+		}
+	}
+
+	expiry := events.DefaultUsageSeriesExpiry
+	if value, found := options["usageSeriesExpiry"]; found {
+		d, err := time.ParseDuration(fmt.Sprintf("%v", value))
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid metrics api usageSeriesExpiry %v, must be a positive duration", value)
+		}
+		expiry = d
+	}
+
+	usageCollector := events.NewPrometheusUsageCollector(maxSeries, expiry)
+	err := n.GetEventDispatcher().ProcessSubscriptions(usageCollector, []*event.Subscription{
+		{Type: event.UsageEventNS, Options: map[string]interface{}{"version": 3}},
+		{Type: event.ServiceEventNS},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return usageCollector, nil
+}
+
 type MetricsApiHandler struct {
 	inspectMgr  *network.InspectionsManager
 	handler     http.Handler
@@ -118,6 +180,8 @@ type MetricsApiHandler struct {
 	scrapeCert  *x509.Certificate
 	modelMapper MetricsModelMapper
 	options     map[interface{}]interface{}
+
+	openMetricsMapper MetricsModelMapper
 }
 
 func (metricsApi *MetricsApiHandler) Binding() string {
@@ -142,9 +206,6 @@ func (metricsApi *MetricsApiHandler) ServeHTTP(writer http.ResponseWriter, reque
 
 func (metricsApi *MetricsApiHandler) newHandler() http.Handler {
 	handler := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
-		// Set Content-Type, see https://github.com/hanzozt/zt/v2/issues/2608
-		rw.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
-
 		if nil != metricsApi.scrapeCert {
 			certOk := false
 			for _, r := range r.TLS.PeerCertificates {
@@ -159,16 +220,27 @@ func (metricsApi *MetricsApiHandler) newHandler() http.Handler {
 			}
 		}
 
+		// with the openmetrics format, scrapers which prefer the OpenMetrics syntax advertise it in the Accept header
+		modelMapper := metricsApi.modelMapper
+		contentType := events.PrometheusContentType
+		if metricsApi.openMetricsMapper != nil && strings.Contains(r.Header.Get("Accept"), "application/openmetrics-text") {
+			modelMapper = metricsApi.openMetricsMapper
+			contentType = events.OpenMetricsContentType
+		}
+
 		inspection := metricsApi.inspectMgr.Inspect(".*", []string{"metrics:prometheus"})
 
-		metricsResult, err := metricsApi.modelMapper.MapInspectResultToMetricsResult(inspection)
+		metricsResult, err := modelMapper.MapInspectResultToMetricsResult(inspection)
 
 		if err != nil {
-			_, _ = fmt.Fprintf(rw, "Failed to convert metrics to prometheus format %s:%s", metricsApi.network.GetAppId(), err.Error())
+			rw.Header().Set("Content-Type", "text/plain; charset=utf-8")
 			rw.WriteHeader(http.StatusInternalServerError)
+			_, _ = fmt.Fprintf(rw, "Failed to convert metrics to prometheus format %s:%s", metricsApi.network.GetAppId(), err.Error())
 		} else {
+			// Set Content-Type, see https://github.com/hanzozt/zt/v2/issues/2608
+			rw.Header().Set("Content-Type", contentType)
 			if _, err = rw.Write([]byte(*metricsResult)); err != nil {
-				rw.WriteHeader(http.StatusInternalServerError)
+				pfxlog.Logger().WithError(err).Debug("failed to write metrics response")
 			}
 		}
 	})
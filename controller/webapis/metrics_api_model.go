@@ -25,6 +25,7 @@ import (
 	"github.com/hanzozt/zt/v2/controller/event"
 	"github.com/hanzozt/zt/v2/controller/events"
 	"github.com/hanzozt/zt/v2/controller/network"
+	"github.com/michaelquigley/pfxlog"
 	"github.com/pkg/errors"
 )
 
@@ -37,6 +38,8 @@ type metricsResultMapper struct {
 	network           *network.Network
 	format            string
 	includeTimestamps bool
+	openMetricsSyntax bool
+	usageCollector    *events.PrometheusUsageCollector
 }
 
 func NewMetricsModelMapper(n *network.Network, format string, includeTimestamps bool) MetricsModelMapper {
@@ -44,66 +47,106 @@ func NewMetricsModelMapper(n *network.Network, format string, includeTimestamps
 		network:           n,
 		format:            format,
 		includeTimestamps: includeTimestamps,
+		openMetricsSyntax: format == "openmetrics",
 	}
 }
 
-func (self *metricsResultMapper) MapInspectResultValueToMetricsResult(inspectResultValue *network.InspectResultValue) (any, error) {
-	var result any
-
-	msg := &metrics_pb.MetricsMessage{}
-	if err := json.Unmarshal([]byte(inspectResultValue.Value), msg); err == nil {
+// NewOpenMetricsModelMapper returns a mapper which renders inspected metrics as labeled metric families. If
+// openMetricsSyntax is false, the families are written using the prometheus 0.0.4 text syntax instead of the
+// OpenMetrics 1.0 syntax. If a usage collector is provided, its counters are included as well.
+func NewOpenMetricsModelMapper(n *network.Network, includeTimestamps bool, openMetricsSyntax bool, usageCollector *events.PrometheusUsageCollector) MetricsModelMapper {
+	return &metricsResultMapper{
+		network:           n,
+		format:            "openmetrics",
+		includeTimestamps: includeTimestamps,
+		openMetricsSyntax: openMetricsSyntax,
+		usageCollector:    usageCollector,
+	}
+}
 
-		var metricEvents []event.MetricsEvent
+func (self *metricsResultMapper) newExposition() *events.PrometheusExposition {
+	return events.NewPrometheusExposition(events.PrometheusExpositionConfig{
+		OpenMetrics:       self.openMetricsSyntax,
+		IncludeTimestamps: self.includeTimestamps,
+		IsRouter: func(id string) bool {
+			return self.network.GetConnectedRouter(id) != nil
+		},
+	})
+}
 
-		adapter := self.network.GetEventDispatcher().NewFilteredMetricsAdapter(nil, nil, event.MetricsEventHandlerF(func(event *event.MetricsEvent) {
-			metricEvents = append(metricEvents, *event)
-		}))
+func (self *metricsResultMapper) toMetricsEvents(inspectResultValue *network.InspectResultValue) ([]event.MetricsEvent, error) {
+	msg := &metrics_pb.MetricsMessage{}
+	if err := json.Unmarshal([]byte(inspectResultValue.Value), msg); err != nil {
+		return nil, err
+	}
 
-		adapter.AcceptMetricsMsg(msg)
+	var metricEvents []event.MetricsEvent
 
-		switch self.format {
-		case "json":
-			result = metricEvents
-		case "prometheus":
-			var promMsgs []string
+	adapter := self.network.GetEventDispatcher().NewFilteredMetricsAdapter(nil, nil, event.MetricsEventHandlerF(func(event *event.MetricsEvent) {
+		metricEvents = append(metricEvents, *event)
+	}))
 
-			for _, msg := range metricEvents {
-				event := (events.PrometheusMetricsEvent)(msg)
-				o, err := event.Marshal(self.includeTimestamps)
+	adapter.AcceptMetricsMsg(msg)
+	return metricEvents, nil
+}
 
-				if err == nil {
-					promMsgs = append(promMsgs, string(o))
-				} else {
-					promMsgs = append(promMsgs, fmt.Sprint(err))
-				}
-			}
-			result = promMsgs
-		default:
-			return nil, errors.New(fmt.Sprintf("Unsupported metrics format %s requested", self.format))
+func (self *metricsResultMapper) addToExposition(exposition *events.PrometheusExposition, metricEvents []event.MetricsEvent) {
+	for idx := range metricEvents {
+		if err := exposition.AddMetricsEvent(&metricEvents[idx]); err != nil {
+			pfxlog.Logger().WithError(err).WithField("metric", metricEvents[idx].Metric).Debug("skipping metric")
 		}
-	} else {
-		return nil, err
 	}
-	return result, nil
 }
 
-func (self *metricsResultMapper) MapInspectResultToMetricsResult(inspectResult *network.InspectResult) (*string, error) {
+func (self *metricsResultMapper) writeExposition(exposition *events.PrometheusExposition) (string, error) {
+	sb := &strings.Builder{}
+	if _, err := exposition.WriteTo(sb); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
 
-	var emit string
+func (self *metricsResultMapper) MapInspectResultValueToMetricsResult(inspectResultValue *network.InspectResultValue) (any, error) {
+	metricEvents, err := self.toMetricsEvents(inspectResultValue)
+	if err != nil {
+		return nil, err
+	}
 
-	var r []any
+	switch self.format {
+	case "json":
+		return metricEvents, nil
+	case "prometheus":
+		var promMsgs []string
 
-	for _, val := range inspectResult.Results {
-		s, _ := self.MapInspectResultValueToMetricsResult(val)
+		for _, msg := range metricEvents {
+			event := (events.PrometheusMetricsEvent)(msg)
+			o, err := event.Marshal(self.includeTimestamps)
 
-		r = append(r, s)
+			if err == nil {
+				promMsgs = append(promMsgs, string(o))
+			} else {
+				promMsgs = append(promMsgs, fmt.Sprint(err))
+			}
+		}
+		return promMsgs, nil
+	case "openmetrics":
+		exposition := self.newExposition()
+		self.addToExposition(exposition, metricEvents)
+		return self.writeExposition(exposition)
+	default:
+		return nil, errors.New(fmt.Sprintf("Unsupported metrics format %s requested", self.format))
 	}
+}
+
+func (self *metricsResultMapper) MapInspectResultToMetricsResult(inspectResult *network.InspectResult) (*string, error) {
+	var emit string
 
 	switch self.format {
 	case "json":
 		var js []any
-		for _, mg := range r {
-			for _, m := range mg.([]event.MetricsEvent) {
+		for _, val := range inspectResult.Results {
+			metricEvents, _ := self.toMetricsEvents(val)
+			for _, m := range metricEvents {
 				js = append(js, m)
 			}
 		}
@@ -115,10 +158,31 @@ func (self *metricsResultMapper) MapInspectResultToMetricsResult(inspectResult *
 	case "prometheus":
 		var prom string
 
-		for _, m := range r {
-			prom += strings.Join(m.([]string), "")
+		for _, val := range inspectResult.Results {
+			m, _ := self.MapInspectResultValueToMetricsResult(val)
+			if promMsgs, ok := m.([]string); ok {
+				prom += strings.Join(promMsgs, "")
+			}
 		}
 		emit = prom
+	case "openmetrics":
+		exposition := self.newExposition()
+		for _, val := range inspectResult.Results {
+			metricEvents, _ := self.toMetricsEvents(val)
+			self.addToExposition(exposition, metricEvents)
+		}
+
+		if self.usageCollector != nil {
+			self.usageCollector.Collect(exposition)
+		}
+
+		result, err := self.writeExposition(exposition)
+		if err != nil {
+			return nil, err
+		}
+		emit = result
+	default:
+		return nil, errors.New(fmt.Sprintf("Unsupported metrics format %s requested", self.format))
 	}
 
 	return &emit, nil
@@ -16,6 +16,8 @@
 
 package xt
 
+import "time"
+
 func NewStrategyChangeEvent(serviceId string, current, added, changed, removed []Terminator) StrategyChangeEvent {
 	return &strategyChangeEvent{
 		serviceId: serviceId,
@@ -72,6 +74,16 @@ func NewDialSucceeded(terminator Terminator) TerminatorEvent {
 	}
 }
 
+// NewTimedDialSucceeded creates a dial succeeded event which also reports how long the terminating router took to
+// dial the terminator. Path setup isn't included
+func NewTimedDialSucceeded(terminator Terminator, dialDuration time.Duration) TerminatorEvent {
+	return &defaultEvent{
+		terminator:   terminator,
+		eventType:    eventTypeSucceeded,
+		dialDuration: dialDuration,
+	}
+}
+
 func NewCircuitRemoved(terminator Terminator) TerminatorEvent {
 	return &defaultEvent{
 		terminator: terminator,
@@ -88,14 +100,19 @@ const (
 )
 
 type defaultEvent struct {
-	terminator Terminator
-	eventType  eventType
+	terminator   Terminator
+	eventType    eventType
+	dialDuration time.Duration
 }
 
 func (event *defaultEvent) GetTerminator() Terminator {
 	return event.terminator
 }
 
+func (event *defaultEvent) GetDialDuration() time.Duration {
+	return event.dialDuration
+}
+
 func (event *defaultEvent) Accept(visitor EventVisitor) {
 	if event.eventType == eventTypeFailed {
 		visitor.VisitDialFailed(event)
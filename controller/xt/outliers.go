@@ -0,0 +1,356 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+)
+
+const (
+	DefaultOutlierConsecutiveFailures  = 5
+	DefaultOutlierFailureRateThreshold = 0.5
+	DefaultOutlierFailureRateMinDials  = 10
+	DefaultOutlierFailureRateWindow    = time.Minute
+	DefaultOutlierBaseEjectionDuration = 30 * time.Second
+	DefaultOutlierMaxEjectionDuration  = 5 * time.Minute
+	DefaultOutlierMaxEjectedPercent    = 50
+)
+
+// OutlierDetectionConfig controls when terminators are ejected. A terminator is ejected if it has
+// ConsecutiveFailures dial failures in a row, or if at least FailureRateMinDials dials have been made in the current
+// FailureRateWindow and the fraction which failed is at least FailureRateThreshold. Setting ConsecutiveFailures
+// or FailureRateThreshold to zero disables the corresponding check.
+//
+// An ejected terminator isn't offered to terminator strategies until the ejection expires. The first ejection
+// lasts for BaseEjectionDuration, and each subsequent ejection doubles, up to MaxEjectionDuration. Once a
+// terminator has gone MaxEjectionDuration without being ejected, the backoff is reset.
+//
+// At most MaxEjectedPercent of a service's candidate terminators will be withheld from a strategy, and at least one
+// terminator is always left, so that a widespread failure doesn't take down a service entirely.
+type OutlierDetectionConfig struct {
+	Enabled              bool
+	ConsecutiveFailures  uint32
+	FailureRateThreshold float64
+	FailureRateMinDials  uint32
+	FailureRateWindow    time.Duration
+	BaseEjectionDuration time.Duration
+	MaxEjectionDuration  time.Duration
+	MaxEjectedPercent    uint32
+}
+
+func DefaultOutlierDetectionConfig() OutlierDetectionConfig {
+	return OutlierDetectionConfig{
+		ConsecutiveFailures:  DefaultOutlierConsecutiveFailures,
+		FailureRateThreshold: DefaultOutlierFailureRateThreshold,
+		FailureRateMinDials:  DefaultOutlierFailureRateMinDials,
+		FailureRateWindow:    DefaultOutlierFailureRateWindow,
+		BaseEjectionDuration: DefaultOutlierBaseEjectionDuration,
+		MaxEjectionDuration:  DefaultOutlierMaxEjectionDuration,
+		MaxEjectedPercent:    DefaultOutlierMaxEjectedPercent,
+	}
+}
+
+// OutlierEventHandler is notified when terminators are ejected and when their ejection expires
+type OutlierEventHandler interface {
+	TerminatorEjected(terminator Terminator, until time.Time)
+	TerminatorRestored(terminator Terminator)
+}
+
+type OutlierDetector interface {
+	SetConfig(config OutlierDetectionConfig)
+	GetConfig() OutlierDetectionConfig
+	AddHandler(handler OutlierEventHandler)
+	NotifyEvent(event TerminatorEvent)
+	GetEjectedUntil(terminatorId string) (time.Time, bool)
+	FilterEjected(terminators []CostedTerminator) []CostedTerminator
+	Remove(terminatorId string)
+}
+
+var globalOutlierDetector = newOutlierDetector(DefaultOutlierDetectionConfig())
+
+// GlobalOutlierDetector returns the outlier detector which is applied to all strategies returned from the
+// GlobalRegistry
+func GlobalOutlierDetector() OutlierDetector {
+	return globalOutlierDetector
+}
+
+type outlierState struct {
+	terminator          Terminator
+	consecutiveFailures uint32
+	windowStart         time.Time
+	dials               uint32
+	failures            uint32
+	ejections           uint32
+	ejectedUntil        time.Time
+}
+
+func (self *outlierState) isEjected(now time.Time) bool {
+	return now.Before(self.ejectedUntil)
+}
+
+func newOutlierDetector(config OutlierDetectionConfig) *outlierDetector {
+	return &outlierDetector{
+		config: config,
+		states: map[string]*outlierState{},
+	}
+}
+
+type outlierDetector struct {
+	lock     sync.Mutex
+	config   OutlierDetectionConfig
+	states   map[string]*outlierState
+	handlers []OutlierEventHandler
+}
+
+func (self *outlierDetector) SetConfig(config OutlierDetectionConfig) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.config = config
+	if !config.Enabled {
+		self.states = map[string]*outlierState{}
+	}
+}
+
+func (self *outlierDetector) GetConfig() OutlierDetectionConfig {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return self.config
+}
+
+func (self *outlierDetector) AddHandler(handler OutlierEventHandler) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.handlers = append(append([]OutlierEventHandler(nil), self.handlers...), handler)
+}
+
+func (self *outlierDetector) NotifyEvent(event TerminatorEvent) {
+	event.Accept(self)
+}
+
+func (self *outlierDetector) VisitDialFailed(event TerminatorEvent) {
+	self.recordDial(event.GetTerminator(), true, time.Now())
+}
+
+func (self *outlierDetector) VisitDialSucceeded(event TerminatorEvent) {
+	self.recordDial(event.GetTerminator(), false, time.Now())
+}
+
+func (self *outlierDetector) VisitCircuitRemoved(TerminatorEvent) {}
+
+func (self *outlierDetector) recordDial(terminator Terminator, failed bool, now time.Time) {
+	self.lock.Lock()
+
+	if !self.config.Enabled {
+		self.lock.Unlock()
+		return
+	}
+
+	state, found := self.states[terminator.GetId()]
+	if !found {
+		state = &outlierState{
+			windowStart: now,
+		}
+		self.states[terminator.GetId()] = state
+	}
+	state.terminator = terminator
+
+	if now.Sub(state.windowStart) > self.config.FailureRateWindow {
+		state.windowStart = now
+		state.dials = 0
+		state.failures = 0
+	}
+
+	state.dials++
+	if failed {
+		state.failures++
+		state.consecutiveFailures++
+	} else {
+		state.consecutiveFailures = 0
+		if state.ejections > 0 && now.Sub(state.ejectedUntil) > self.config.MaxEjectionDuration {
+			state.ejections = 0
+		}
+	}
+
+	// dials may still go to ejected terminators if too many terminators are ejected. Don't extend the ejection
+	if !failed || state.isEjected(now) || !self.isOutlier(state) {
+		self.lock.Unlock()
+		return
+	}
+
+	duration := self.config.BaseEjectionDuration
+	for i := uint32(0); i < state.ejections && duration < self.config.MaxEjectionDuration; i++ {
+		duration *= 2
+	}
+	if duration > self.config.MaxEjectionDuration {
+		duration = self.config.MaxEjectionDuration
+	}
+
+	until := now.Add(duration)
+	state.ejections++
+	state.ejectedUntil = until
+	state.consecutiveFailures = 0
+	state.windowStart = now
+	state.dials = 0
+	state.failures = 0
+
+	handlers := self.handlers
+	self.lock.Unlock()
+
+	pfxlog.Logger().WithField("terminatorId", terminator.GetId()).
+		WithField("serviceId", terminator.GetServiceId()).
+		WithField("ejectedUntil", until).
+		Info("terminator ejected after repeated dial failures")
+
+	for _, handler := range handlers {
+		handler.TerminatorEjected(terminator, until)
+	}
+
+	time.AfterFunc(duration, func() {
+		self.restore(terminator.GetId(), until)
+	})
+}
+
+// isOutlier must be called with the lock held
+func (self *outlierDetector) isOutlier(state *outlierState) bool {
+	if self.config.ConsecutiveFailures > 0 && state.consecutiveFailures >= self.config.ConsecutiveFailures {
+		return true
+	}
+
+	if self.config.FailureRateThreshold > 0 && state.dials > 0 && state.dials >= self.config.FailureRateMinDials {
+		return float64(state.failures)/float64(state.dials) >= self.config.FailureRateThreshold
+	}
+
+	return false
+}
+
+func (self *outlierDetector) restore(terminatorId string, until time.Time) {
+	self.lock.Lock()
+	state, found := self.states[terminatorId]
+	if !found || !state.ejectedUntil.Equal(until) {
+		self.lock.Unlock()
+		return
+	}
+	terminator := state.terminator
+	handlers := self.handlers
+	self.lock.Unlock()
+
+	pfxlog.Logger().WithField("terminatorId", terminatorId).Info("terminator ejection expired")
+
+	for _, handler := range handlers {
+		handler.TerminatorRestored(terminator)
+	}
+}
+
+func (self *outlierDetector) GetEjectedUntil(terminatorId string) (time.Time, bool) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if state, found := self.states[terminatorId]; found && state.isEjected(time.Now()) {
+		return state.ejectedUntil, true
+	}
+	return time.Time{}, false
+}
+
+func (self *outlierDetector) FilterEjected(terminators []CostedTerminator) []CostedTerminator {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if !self.config.Enabled || len(self.states) == 0 || len(terminators) < 2 {
+		return terminators
+	}
+
+	now := time.Now()
+
+	type ejected struct {
+		idx   int
+		until time.Time
+	}
+
+	var ejectedList []ejected
+	for idx, terminator := range terminators {
+		if state, found := self.states[terminator.GetId()]; found && state.isEjected(now) {
+			ejectedList = append(ejectedList, ejected{idx: idx, until: state.ejectedUntil})
+		}
+	}
+
+	if len(ejectedList) == 0 {
+		return terminators
+	}
+
+	maxEjected := len(terminators) * int(self.config.MaxEjectedPercent) / 100
+	if maxEjected >= len(terminators) {
+		maxEjected = len(terminators) - 1
+	}
+
+	// if too many terminators are ejected, let through the ones whose ejection expires soonest
+	if len(ejectedList) > maxEjected {
+		sort.Slice(ejectedList, func(i, j int) bool {
+			return ejectedList[i].until.After(ejectedList[j].until)
+		})
+		ejectedList = ejectedList[:maxEjected]
+	}
+
+	if len(ejectedList) == 0 {
+		return terminators
+	}
+
+	skip := map[int]struct{}{}
+	for _, e := range ejectedList {
+		skip[e.idx] = struct{}{}
+	}
+
+	result := make([]CostedTerminator, 0, len(terminators)-len(skip))
+	for idx, terminator := range terminators {
+		if _, found := skip[idx]; !found {
+			result = append(result, terminator)
+		}
+	}
+	return result
+}
+
+func (self *outlierDetector) Remove(terminatorId string) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	delete(self.states, terminatorId)
+}
+
+// outlierDetectingStrategy applies outlier detection to a strategy. Ejected terminators are removed before
+// the wrapped strategy is asked to select a terminator.
+type outlierDetectingStrategy struct {
+	wrapped  Strategy
+	detector OutlierDetector
+}
+
+func (self *outlierDetectingStrategy) Select(params CreateCircuitParams, terminators []CostedTerminator) (CostedTerminator, PeerData, error) {
+	return self.wrapped.Select(params, self.detector.FilterEjected(terminators))
+}
+
+func (self *outlierDetectingStrategy) HandleTerminatorChange(event StrategyChangeEvent) error {
+	for _, t := range event.GetRemoved() {
+		self.detector.Remove(t.GetId())
+	}
+	return self.wrapped.HandleTerminatorChange(event)
+}
+
+func (self *outlierDetectingStrategy) NotifyEvent(event TerminatorEvent) {
+	self.detector.NotifyEvent(event)
+	self.wrapped.NotifyEvent(event)
+}
@@ -0,0 +1,222 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testTerminator struct {
+	id string
+}
+
+func (t *testTerminator) GetId() string             { return t.id }
+func (t *testTerminator) GetPrecedence() Precedence { return Precedences.Default }
+func (t *testTerminator) GetCost() uint16           { return 0 }
+func (t *testTerminator) GetServiceId() string      { return "svc" }
+func (t *testTerminator) GetInstanceId() string     { return "" }
+func (t *testTerminator) GetRouterId() string       { return "router" }
+func (t *testTerminator) GetBinding() string        { return "edge" }
+func (t *testTerminator) GetAddress() string        { return "" }
+func (t *testTerminator) GetPeerData() PeerData     { return nil }
+func (t *testTerminator) GetCreatedAt() time.Time   { return time.Time{} }
+func (t *testTerminator) GetHostId() string         { return "" }
+func (t *testTerminator) GetSourceCtrl() string     { return "" }
+func (t *testTerminator) GetRouteCost() uint32      { return Precedences.Default.GetBiasedCost(0) }
+
+type testOutlierHandler struct {
+	sync.Mutex
+	ejected  []string
+	restored []string
+}
+
+func (self *testOutlierHandler) TerminatorEjected(terminator Terminator, _ time.Time) {
+	self.Lock()
+	defer self.Unlock()
+	self.ejected = append(self.ejected, terminator.GetId())
+}
+
+func (self *testOutlierHandler) TerminatorRestored(terminator Terminator) {
+	self.Lock()
+	defer self.Unlock()
+	self.restored = append(self.restored, terminator.GetId())
+}
+
+func (self *testOutlierHandler) getRestored() []string {
+	self.Lock()
+	defer self.Unlock()
+	return append([]string(nil), self.restored...)
+}
+
+func newTestOutlierDetector() *outlierDetector {
+	config := DefaultOutlierDetectionConfig()
+	config.Enabled = true
+	config.ConsecutiveFailures = 3
+	config.FailureRateMinDials = 10
+	return newOutlierDetector(config)
+}
+
+func TestOutlierConsecutiveFailures(t *testing.T) {
+	req := require.New(t)
+
+	detector := newTestOutlierDetector()
+	handler := &testOutlierHandler{}
+	detector.AddHandler(handler)
+
+	t1 := &testTerminator{id: "t1"}
+	t2 := &testTerminator{id: "t2"}
+	now := time.Now()
+
+	detector.recordDial(t1, true, now)
+	detector.recordDial(t1, true, now)
+	detector.recordDial(t1, false, now)
+	detector.recordDial(t1, true, now)
+	detector.recordDial(t1, true, now)
+	_, ejected := detector.GetEjectedUntil(t1.id)
+	req.False(ejected)
+
+	detector.recordDial(t1, true, now)
+	until, ejected := detector.GetEjectedUntil(t1.id)
+	req.True(ejected)
+	req.Equal(now.Add(DefaultOutlierBaseEjectionDuration), until)
+	req.Equal([]string{"t1"}, handler.ejected)
+
+	filtered := detector.FilterEjected([]CostedTerminator{t1, t2})
+	req.Len(filtered, 1)
+	req.Equal("t2", filtered[0].GetId())
+
+	// the next ejection should back off
+	state := detector.states[t1.id]
+	state.ejectedUntil = now
+	for i := 0; i < 3; i++ {
+		detector.recordDial(t1, true, now)
+	}
+	req.Equal(now.Add(2*DefaultOutlierBaseEjectionDuration), state.ejectedUntil)
+	req.Equal(uint32(2), state.ejections)
+
+	// after a long healthy period the backoff resets
+	later := state.ejectedUntil.Add(DefaultOutlierMaxEjectionDuration + time.Second)
+	detector.recordDial(t1, false, later)
+	req.Equal(uint32(0), state.ejections)
+}
+
+func TestOutlierFailureRate(t *testing.T) {
+	req := require.New(t)
+
+	detector := newTestOutlierDetector()
+	detector.config.ConsecutiveFailures = 0
+
+	t1 := &testTerminator{id: "t1"}
+	now := time.Now()
+
+	for i := 0; i < 9; i++ {
+		detector.recordDial(t1, i%2 == 0, now)
+	}
+	_, ejected := detector.GetEjectedUntil(t1.id)
+	req.False(ejected)
+
+	detector.recordDial(t1, true, now)
+	_, ejected = detector.GetEjectedUntil(t1.id)
+	req.True(ejected)
+
+	// failures outside of the window are forgotten
+	t2 := &testTerminator{id: "t2"}
+	for i := 0; i < 9; i++ {
+		detector.recordDial(t2, true, now)
+	}
+	detector.recordDial(t2, true, now.Add(2*DefaultOutlierFailureRateWindow))
+	_, ejected = detector.GetEjectedUntil(t2.id)
+	req.False(ejected)
+}
+
+func TestOutlierMaxEjectedPercent(t *testing.T) {
+	req := require.New(t)
+
+	detector := newTestOutlierDetector()
+	now := time.Now()
+
+	var terminators []CostedTerminator
+	for _, id := range []string{"t1", "t2", "t3", "t4"} {
+		terminators = append(terminators, &testTerminator{id: id})
+	}
+
+	for idx, terminator := range terminators[:3] {
+		for i := 0; i < 3; i++ {
+			detector.recordDial(terminator, true, now.Add(time.Duration(idx)*time.Second))
+		}
+	}
+
+	// only 50% may be ejected, so the terminator whose ejection expires first is let through
+	filtered := detector.FilterEjected(terminators)
+	req.Len(filtered, 2)
+	req.Equal("t1", filtered[0].GetId())
+	req.Equal("t4", filtered[1].GetId())
+
+	// at least one terminator is always left
+	detector.config.MaxEjectedPercent = 100
+	filtered = detector.FilterEjected(terminators[:3])
+	req.Len(filtered, 1)
+	req.Equal("t1", filtered[0].GetId())
+
+	detector.SetConfig(OutlierDetectionConfig{})
+	req.Len(detector.FilterEjected(terminators), 4)
+}
+
+func TestOutlierRestore(t *testing.T) {
+	req := require.New(t)
+
+	detector := newTestOutlierDetector()
+	detector.config.BaseEjectionDuration = 10 * time.Millisecond
+	handler := &testOutlierHandler{}
+	detector.AddHandler(handler)
+
+	t1 := &testTerminator{id: "t1"}
+	strategy := &outlierDetectingStrategy{
+		wrapped:  &testStrategy{},
+		detector: detector,
+	}
+
+	for i := 0; i < 3; i++ {
+		strategy.NotifyEvent(NewDialFailedEvent(t1))
+	}
+
+	req.Eventually(func() bool {
+		return len(handler.getRestored()) == 1
+	}, time.Second, 5*time.Millisecond)
+
+	_, ejected := detector.GetEjectedUntil(t1.id)
+	req.False(ejected)
+
+	req.NoError(strategy.HandleTerminatorChange(NewStrategyChangeEvent("svc", nil, nil, nil, TList(t1))))
+	req.Empty(detector.states)
+}
+
+type testStrategy struct{}
+
+func (self *testStrategy) Select(_ CreateCircuitParams, terminators []CostedTerminator) (CostedTerminator, PeerData, error) {
+	return terminators[0], nil, nil
+}
+
+func (self *testStrategy) HandleTerminatorChange(StrategyChangeEvent) error {
+	return nil
+}
+
+func (self *testStrategy) NotifyEvent(TerminatorEvent) {}
@@ -71,7 +71,10 @@ func (registry *defaultRegistry) GetStrategy(name string) (Strategy, error) {
 			return nil, boltz.NewNotFoundError("terminatorStrategy", "name", name)
 		}
 
-		result = factory.NewStrategy()
+		result = &outlierDetectingStrategy{
+			wrapped:  factory.NewStrategy(),
+			detector: globalOutlierDetector,
+		}
 		registry.strategies.put(factory.GetStrategyName(), result)
 	}
 
@@ -91,6 +91,13 @@ type TerminatorEvent interface {
 	Accept(visitor EventVisitor)
 }
 
+// TimedTerminatorEvent is implemented by terminator events which may report dial latency. If the dial duration
+// isn't known, GetDialDuration will return 0
+type TimedTerminatorEvent interface {
+	TerminatorEvent
+	GetDialDuration() time.Duration
+}
+
 type EventVisitor interface {
 	VisitDialFailed(event TerminatorEvent)
 	VisitDialSucceeded(event TerminatorEvent)
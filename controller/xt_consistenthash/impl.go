@@ -0,0 +1,169 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_consistenthash
+
+import (
+	"encoding/json"
+	"hash/fnv"
+
+	"github.com/hanzozt/sdk-golang/zt/edge"
+	"github.com/hanzozt/zt/v2/common/ctrl_msg"
+	"github.com/hanzozt/zt/v2/controller/xt"
+)
+
+const (
+	Name = "consistent-hash"
+
+	// AppDataHashKey is the app data field which may be used to provide the hash key at dial time. The app data
+	// must be a json object for the key to be found, for example: {"hashKey": "shard-12"}
+	AppDataHashKey = "hashKey"
+)
+
+/**
+The consistent-hash strategy maps each client to a terminator using rendezvous (highest random weight) hashing.
+Every terminator is scored by hashing the client key together with the terminator's backend key, and the highest
+scoring terminator is selected. When terminators are added or removed only the clients mapped to those terminators
+move, so stateful backends keep their clients across reconnects.
+
+The client key is, in order of preference:
+  1. the hashKey field from the dial app data, if the app data is a json object containing one
+  2. the dialing identity id
+  3. the client token, which is the session id for sdk dials or the router id for router embedded tunnelers
+
+The backend key is the terminator instance id if it has one, otherwise the host id, falling back to the terminator
+id. Terminators for the same backend (for example, the same sdk application bound via multiple edge routers) share
+a backend key, and the lowest cost terminator for the selected backend is used.
+
+Only terminators with the best precedence are considered, so a client whose terminator is marked failed will
+temporarily be moved and then return once the terminator recovers.
+*/
+
+func NewFactory() xt.Factory {
+	return &factory{}
+}
+
+type factory struct{}
+
+func (self *factory) GetStrategyName() string {
+	return Name
+}
+
+func (self *factory) NewStrategy() xt.Strategy {
+	return &strategy{}
+}
+
+type strategy struct{}
+
+func (self *strategy) Select(params xt.CreateCircuitParams, terminators []xt.CostedTerminator) (xt.CostedTerminator, xt.PeerData, error) {
+	terminators = xt.GetRelatedTerminators(terminators)
+	if len(terminators) == 1 {
+		return terminators[0], nil, nil
+	}
+
+	clientKey := GetClientKey(params)
+	if clientKey == "" {
+		return terminators[0], nil, nil
+	}
+
+	// terminators are sorted by cost, so the first terminator found for the winning backend is the cheapest
+	var result xt.CostedTerminator
+	var resultBackend string
+	var resultWeight uint64
+
+	for _, terminator := range terminators {
+		backend := GetBackendKey(terminator)
+		if result != nil && backend == resultBackend {
+			continue
+		}
+		weight := RendezvousWeight(clientKey, backend)
+		if result == nil || weight > resultWeight || (weight == resultWeight && backend < resultBackend) {
+			result = terminator
+			resultBackend = backend
+			resultWeight = weight
+		}
+	}
+
+	return result, nil, nil
+}
+
+func (self *strategy) NotifyEvent(xt.TerminatorEvent) {}
+
+func (self *strategy) HandleTerminatorChange(xt.StrategyChangeEvent) error {
+	return nil
+}
+
+// GetClientKey returns the key used to map a dial to a terminator, or an empty string if no key is available
+func GetClientKey(params xt.CreateCircuitParams) string {
+	if params == nil {
+		return ""
+	}
+
+	clientId := params.GetClientId()
+	if clientId == nil {
+		return ""
+	}
+
+	if appData, ok := clientId.Data[uint32(edge.AppDataHeader)]; ok && len(appData) > 0 && appData[0] == '{' {
+		fields := map[string]any{}
+		if err := json.Unmarshal(appData, &fields); err == nil {
+			if key, ok := fields[AppDataHashKey].(string); ok && key != "" {
+				return "key:" + key
+			}
+		}
+	}
+
+	if identityId, ok := clientId.Data[ctrl_msg.DialerIdentityIdHeader]; ok && len(identityId) > 0 {
+		return "identity:" + string(identityId)
+	}
+
+	if clientId.Token != "" {
+		return "token:" + clientId.Token
+	}
+
+	return ""
+}
+
+// GetBackendKey returns the key identifying the backend a terminator connects to
+func GetBackendKey(terminator xt.Terminator) string {
+	if instanceId := terminator.GetInstanceId(); instanceId != "" {
+		return "instance:" + terminator.GetHostId() + "/" + instanceId
+	}
+	if hostId := terminator.GetHostId(); hostId != "" {
+		return "host:" + hostId
+	}
+	return "terminator:" + terminator.GetId()
+}
+
+// RendezvousWeight returns the weight for the given client and backend. The fnv hash is passed through a
+// finalizer, as fnv on its own doesn't distribute similar inputs well enough for this purpose.
+func RendezvousWeight(clientKey, backendKey string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(clientKey))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(backendKey))
+	return mix64(h.Sum64())
+}
+
+// mix64 is the splitmix64 finalizer
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -0,0 +1,172 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_consistenthash
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hanzozt/identity"
+	"github.com/hanzozt/sdk-golang/zt/edge"
+	"github.com/hanzozt/zt/v2/common/ctrl_msg"
+	"github.com/hanzozt/zt/v2/common/logcontext"
+	"github.com/hanzozt/zt/v2/controller/xt"
+	"github.com/stretchr/testify/require"
+)
+
+type mockTerminator struct {
+	id         string
+	hostId     string
+	instanceId string
+	routeCost  uint32
+}
+
+func (m *mockTerminator) GetId() string                { return m.id }
+func (m *mockTerminator) GetPrecedence() xt.Precedence { return xt.Precedences.Default }
+func (m *mockTerminator) GetCost() uint16              { return 0 }
+func (m *mockTerminator) GetServiceId() string         { return "svc" }
+func (m *mockTerminator) GetInstanceId() string        { return m.instanceId }
+func (m *mockTerminator) GetRouterId() string          { return "router" }
+func (m *mockTerminator) GetBinding() string           { return "edge" }
+func (m *mockTerminator) GetAddress() string           { return "" }
+func (m *mockTerminator) GetPeerData() xt.PeerData     { return nil }
+func (m *mockTerminator) GetCreatedAt() time.Time      { return time.Time{} }
+func (m *mockTerminator) GetHostId() string            { return m.hostId }
+func (m *mockTerminator) GetSourceCtrl() string        { return "" }
+func (m *mockTerminator) GetRouteCost() uint32 {
+	return xt.Precedences.Default.GetBiasedCost(m.routeCost)
+}
+
+type mockParams struct {
+	clientId *identity.TokenId
+}
+
+func (m *mockParams) GetServiceId() string              { return "svc" }
+func (m *mockParams) GetClientId() *identity.TokenId    { return m.clientId }
+func (m *mockParams) GetLogContext() logcontext.Context { return logcontext.NewContext() }
+
+func identityParams(identityId string) *mockParams {
+	return &mockParams{
+		clientId: &identity.TokenId{
+			Token: "session-" + identityId,
+			Data:  map[uint32][]byte{ctrl_msg.DialerIdentityIdHeader: []byte(identityId)},
+		},
+	}
+}
+
+func newTerminators(count int) []xt.CostedTerminator {
+	var result []xt.CostedTerminator
+	for i := 0; i < count; i++ {
+		result = append(result, &mockTerminator{id: fmt.Sprintf("t%d", i), hostId: fmt.Sprintf("host%d", i), routeCost: 10})
+	}
+	return result
+}
+
+func selectAll(t *testing.T, s xt.Strategy, clients int, terminators []xt.CostedTerminator) map[string]string {
+	result := map[string]string{}
+	for i := 0; i < clients; i++ {
+		identityId := fmt.Sprintf("identity%d", i)
+		selected, _, err := s.Select(identityParams(identityId), terminators)
+		require.NoError(t, err)
+		result[identityId] = selected.GetHostId()
+	}
+	return result
+}
+
+func TestConsistentHashMinimalRemapping(t *testing.T) {
+	req := require.New(t)
+
+	s := NewFactory().NewStrategy()
+	terminators := newTerminators(5)
+
+	before := selectAll(t, s, 1000, terminators)
+
+	counts := map[string]int{}
+	for _, host := range before {
+		counts[host]++
+	}
+	req.Len(counts, 5)
+	for host, count := range counts {
+		req.InDelta(200, count, 60, "host %s has %d clients", host, count)
+	}
+
+	// selection is stable, regardless of terminator order
+	reversed := make([]xt.CostedTerminator, len(terminators))
+	for i, terminator := range terminators {
+		reversed[len(terminators)-1-i] = terminator
+	}
+	req.Equal(before, selectAll(t, s, 1000, reversed))
+
+	// removing a terminator should only move the clients which were using it
+	removed := terminators[2].GetHostId()
+	after := selectAll(t, s, 1000, append(append([]xt.CostedTerminator{}, terminators[:2]...), terminators[3:]...))
+	for client, host := range before {
+		if host != removed {
+			req.Equal(host, after[client])
+		} else {
+			req.NotEqual(removed, after[client])
+		}
+	}
+
+	// adding a terminator should only move clients to the new terminator
+	added := &mockTerminator{id: "t5", hostId: "host5", routeCost: 10}
+	after = selectAll(t, s, 1000, append(terminators, added))
+	moved := 0
+	for client, host := range before {
+		if after[client] != host {
+			req.Equal("host5", after[client])
+			moved++
+		}
+	}
+	req.InDelta(1000/6, moved, 60)
+}
+
+func TestConsistentHashClientKeys(t *testing.T) {
+	req := require.New(t)
+
+	req.Equal("", GetClientKey(nil))
+	req.Equal("", GetClientKey(&mockParams{}))
+	req.Equal("token:abc", GetClientKey(&mockParams{clientId: &identity.TokenId{Token: "abc"}}))
+	req.Equal("identity:i1", GetClientKey(identityParams("i1")))
+
+	params := identityParams("i1")
+	params.clientId.Data[uint32(edge.AppDataHeader)] = []byte(`{"hashKey": "shard-12", "dst_port": "443"}`)
+	req.Equal("key:shard-12", GetClientKey(params))
+
+	params.clientId.Data[uint32(edge.AppDataHeader)] = []byte(`not json`)
+	req.Equal("identity:i1", GetClientKey(params))
+}
+
+func TestConsistentHashPrefersCheapestTerminatorForBackend(t *testing.T) {
+	req := require.New(t)
+
+	s := NewFactory().NewStrategy()
+
+	// the same backend, bound via two routers
+	terminators := []xt.CostedTerminator{
+		&mockTerminator{id: "near", hostId: "host1", routeCost: 5},
+		&mockTerminator{id: "other", hostId: "host2", routeCost: 10},
+		&mockTerminator{id: "far", hostId: "host1", routeCost: 20},
+	}
+
+	for i := 0; i < 100; i++ {
+		selected, _, err := s.Select(identityParams(fmt.Sprintf("identity%d", i)), terminators)
+		req.NoError(err)
+		req.NotEqual("far", selected.GetId())
+	}
+}
@@ -0,0 +1,218 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_leastloaded
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/hanzozt/zt/v2/controller/xt"
+	cmap "github.com/orcaman/concurrent-map/v2"
+)
+
+const (
+	Name = "least-loaded"
+
+	// weight given to the most recent dial latency sample
+	latencyAlpha = 0.2
+
+	// weight given to the most recent dial outcome
+	failureAlpha = 0.2
+
+	// the failure rate halves every failureHalfLife if there are no new dials. Without this a terminator
+	// which failed repeatedly would never be selected again, and so would never get the chance to recover
+	failureHalfLife = time.Minute
+
+	maxFailureRate = 0.99
+
+	// terminators without any latency samples are treated as if they had this latency, so they get tried
+	minLatencyMs = 1.0
+)
+
+/**
+The least-loaded strategy tracks the number of active circuits for each terminator, along with an exponentially
+weighted moving average of dial latency and dial failure rate. Terminators are scored by their path cost plus
+their expected load, which is the dial latency multiplied by the number of active circuits plus one, scaled up by
+the failure rate. Rather than always picking the terminator with the best score, which causes newly started or
+recently recovered terminators to be swamped, two terminators are picked at random and the one with the better
+score is used (power of two choices).
+
+Only terminators with the best precedence are considered, so required and failed precedences still work as they
+do for other strategies.
+*/
+
+func NewFactory() xt.Factory {
+	return &factory{}
+}
+
+type factory struct{}
+
+func (self *factory) GetStrategyName() string {
+	return Name
+}
+
+func (self *factory) NewStrategy() xt.Strategy {
+	return &strategy{
+		stats: cmap.New[*terminatorStats](),
+	}
+}
+
+type terminatorStats struct {
+	sync.Mutex
+	activeCircuits uint32
+	latencyMs      float64
+	failureRate    float64
+	lastDial       time.Time
+}
+
+func (self *terminatorStats) recordDial(failed bool, dialDuration time.Duration, now time.Time) {
+	self.Lock()
+	defer self.Unlock()
+
+	outcome := 0.0
+	if failed {
+		outcome = 1
+	}
+	self.failureRate = self.decayedFailureRate(now)*(1-failureAlpha) + outcome*failureAlpha
+	self.lastDial = now
+
+	if failed {
+		return
+	}
+
+	if self.activeCircuits < math.MaxUint32 {
+		self.activeCircuits++
+	}
+
+	if dialDuration > 0 {
+		sample := float64(dialDuration) / float64(time.Millisecond)
+		if self.latencyMs == 0 {
+			self.latencyMs = sample
+		} else {
+			self.latencyMs = self.latencyMs*(1-latencyAlpha) + sample*latencyAlpha
+		}
+	}
+}
+
+func (self *terminatorStats) circuitRemoved() {
+	self.Lock()
+	defer self.Unlock()
+
+	if self.activeCircuits > 0 {
+		self.activeCircuits--
+	}
+}
+
+// decayedFailureRate must be called with the lock held
+func (self *terminatorStats) decayedFailureRate(now time.Time) float64 {
+	if self.failureRate == 0 || self.lastDial.IsZero() {
+		return self.failureRate
+	}
+	halfLives := float64(now.Sub(self.lastDial)) / float64(failureHalfLife)
+	return self.failureRate * math.Pow(0.5, halfLives)
+}
+
+func (self *terminatorStats) score(pathCost uint32, now time.Time) float64 {
+	self.Lock()
+	defer self.Unlock()
+
+	latency := math.Max(self.latencyMs, minLatencyMs)
+	failureRate := math.Min(self.decayedFailureRate(now), maxFailureRate)
+	load := latency * float64(self.activeCircuits+1) / (1 - failureRate)
+	return float64(pathCost) + load
+}
+
+type strategy struct {
+	xt.DefaultEventVisitor
+	stats cmap.ConcurrentMap[string, *terminatorStats]
+}
+
+func (self *strategy) getStats(terminatorId string) *terminatorStats {
+	return self.stats.Upsert(terminatorId, nil, func(exist bool, valueInMap *terminatorStats, _ *terminatorStats) *terminatorStats {
+		if exist {
+			return valueInMap
+		}
+		return &terminatorStats{}
+	})
+}
+
+func (self *strategy) getScore(terminator xt.CostedTerminator, now time.Time) float64 {
+	pathCost := terminator.GetPrecedence().Unbias(terminator.GetRouteCost())
+	if stats, found := self.stats.Get(terminator.GetId()); found {
+		return stats.score(pathCost, now)
+	}
+	return float64(pathCost) + minLatencyMs
+}
+
+func (self *strategy) Select(_ xt.CreateCircuitParams, terminators []xt.CostedTerminator) (xt.CostedTerminator, xt.PeerData, error) {
+	if len(terminators) == 0 {
+		return nil, nil, errors.New("no terminators available")
+	}
+	terminators = xt.GetRelatedTerminators(terminators)
+	if len(terminators) == 1 {
+		return terminators[0], nil, nil
+	}
+
+	first := rand.Intn(len(terminators))
+	second := rand.Intn(len(terminators) - 1)
+	if second >= first {
+		second++
+	}
+
+	// terminators are sorted by cost, so on a tie prefer the lower index
+	if first > second {
+		first, second = second, first
+	}
+
+	now := time.Now()
+	if self.getScore(terminators[second], now) < self.getScore(terminators[first], now) {
+		return terminators[second], nil, nil
+	}
+	return terminators[first], nil, nil
+}
+
+func (self *strategy) VisitDialFailed(event xt.TerminatorEvent) {
+	self.getStats(event.GetTerminator().GetId()).recordDial(true, 0, time.Now())
+}
+
+func (self *strategy) VisitDialSucceeded(event xt.TerminatorEvent) {
+	var dialDuration time.Duration
+	if timedEvent, ok := event.(xt.TimedTerminatorEvent); ok {
+		dialDuration = timedEvent.GetDialDuration()
+	}
+	self.getStats(event.GetTerminator().GetId()).recordDial(false, dialDuration, time.Now())
+}
+
+func (self *strategy) VisitCircuitRemoved(event xt.TerminatorEvent) {
+	if stats, found := self.stats.Get(event.GetTerminator().GetId()); found {
+		stats.circuitRemoved()
+	}
+}
+
+func (self *strategy) NotifyEvent(event xt.TerminatorEvent) {
+	event.Accept(self)
+}
+
+func (self *strategy) HandleTerminatorChange(event xt.StrategyChangeEvent) error {
+	for _, t := range event.GetRemoved() {
+		self.stats.Remove(t.GetId())
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_leastloaded
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hanzozt/zt/v2/controller/xt"
+	"github.com/stretchr/testify/require"
+)
+
+type mockTerminator struct {
+	id        string
+	routeCost uint32
+}
+
+func (m *mockTerminator) GetId() string                { return m.id }
+func (m *mockTerminator) GetPrecedence() xt.Precedence { return xt.Precedences.Default }
+func (m *mockTerminator) GetCost() uint16              { return 0 }
+func (m *mockTerminator) GetServiceId() string         { return "svc" }
+func (m *mockTerminator) GetInstanceId() string        { return "" }
+func (m *mockTerminator) GetRouterId() string          { return "r-" + m.id }
+func (m *mockTerminator) GetBinding() string           { return "edge" }
+func (m *mockTerminator) GetAddress() string           { return "" }
+func (m *mockTerminator) GetPeerData() xt.PeerData     { return nil }
+func (m *mockTerminator) GetCreatedAt() time.Time      { return time.Time{} }
+func (m *mockTerminator) GetHostId() string            { return "" }
+func (m *mockTerminator) GetSourceCtrl() string        { return "" }
+func (m *mockTerminator) GetRouteCost() uint32 {
+	return xt.Precedences.Default.GetBiasedCost(m.routeCost)
+}
+
+func selectCounts(strategy xt.Strategy, terminators []xt.CostedTerminator, n int) map[string]int {
+	result := map[string]int{}
+	for i := 0; i < n; i++ {
+		t, _, err := strategy.Select(nil, terminators)
+		if err != nil {
+			panic(err)
+		}
+		result[t.GetId()]++
+	}
+	return result
+}
+
+func TestLeastLoadedPrefersIdleTerminators(t *testing.T) {
+	req := require.New(t)
+
+	strategy := NewFactory().NewStrategy()
+	cheap := &mockTerminator{id: "cheap", routeCost: 10}
+	expensive := &mockTerminator{id: "expensive", routeCost: 30}
+	terminators := []xt.CostedTerminator{cheap, expensive}
+
+	// with no load, the cheaper path wins
+	counts := selectCounts(strategy, terminators, 100)
+	req.Equal(100, counts["cheap"])
+
+	// once the cheap terminator is carrying circuits, the more expensive one should be preferred
+	for i := 0; i < 20; i++ {
+		strategy.NotifyEvent(xt.NewTimedDialSucceeded(cheap, 10*time.Millisecond))
+	}
+	counts = selectCounts(strategy, terminators, 100)
+	req.Equal(100, counts["expensive"])
+
+	for i := 0; i < 20; i++ {
+		strategy.NotifyEvent(xt.NewCircuitRemoved(cheap))
+	}
+	counts = selectCounts(strategy, terminators, 100)
+	req.Equal(100, counts["cheap"])
+}
+
+func TestLeastLoadedAvoidsFailingTerminators(t *testing.T) {
+	req := require.New(t)
+
+	s := NewFactory().NewStrategy().(*strategy)
+	failing := &mockTerminator{id: "failing", routeCost: 10}
+	healthy := &mockTerminator{id: "healthy", routeCost: 10}
+	terminators := []xt.CostedTerminator{failing, healthy}
+
+	s.NotifyEvent(xt.NewTimedDialSucceeded(failing, 20*time.Millisecond))
+	s.NotifyEvent(xt.NewTimedDialSucceeded(healthy, 20*time.Millisecond))
+	for i := 0; i < 10; i++ {
+		s.NotifyEvent(xt.NewDialFailedEvent(failing))
+	}
+
+	counts := selectCounts(s, terminators, 100)
+	req.Equal(100, counts["healthy"])
+
+	// failure rates decay over time, so the failing terminator will eventually be retried
+	stats, _ := s.stats.Get(failing.id)
+	now := time.Now()
+	req.Less(stats.score(10, now.Add(10*failureHalfLife)), stats.score(10, now))
+
+	s.HandleTerminatorChange(xt.NewStrategyChangeEvent("svc", nil, nil, nil, xt.TList(failing)))
+	req.False(s.stats.Has(failing.id))
+}
+
+func TestLeastLoadedSpreadsAcrossEqualTerminators(t *testing.T) {
+	req := require.New(t)
+
+	s := NewFactory().NewStrategy().(*strategy)
+	var terminators []xt.CostedTerminator
+	for _, id := range []string{"a", "b", "c", "d"} {
+		terminators = append(terminators, &mockTerminator{id: id, routeCost: 10})
+	}
+
+	for i := 0; i < 400; i++ {
+		selected, _, err := s.Select(nil, terminators)
+		req.NoError(err)
+		s.NotifyEvent(xt.NewTimedDialSucceeded(selected, 5*time.Millisecond))
+	}
+
+	counts := map[string]int{}
+	for _, t := range terminators {
+		stats, found := s.stats.Get(t.GetId())
+		req.True(found)
+		counts[t.GetId()] = int(stats.activeCircuits)
+	}
+
+	for id, count := range counts {
+		req.InDelta(100, count, 10, "terminator %s has %d circuits", id, count)
+	}
+}
+
+func TestLeastLoadedNoTerminators(t *testing.T) {
+	req := require.New(t)
+
+	s := NewFactory().NewStrategy().(*strategy)
+	selected, _, err := s.Select(nil, nil)
+	req.Error(err)
+	req.Nil(selected)
+}
@@ -93,6 +93,8 @@ const (
 	MinConnectEventsFullSyncInterval     = time.Second
 	MaxConnectEventsFullSyncInterval     = 24 * time.Hour
 
+	DefaultMetricsReportInterval = time.Minute
+
 	InterfaceDiscoveryMapKey = "interfaceDiscovery"
 )
 
@@ -156,6 +158,7 @@ type Config struct {
 		Listeners  []map[interface{}]interface{}
 		Dialers    []map[interface{}]interface{}
 		Heartbeats channel.HeartbeatOptions
+		Probes     LinkProbeOptions
 	}
 	Dialers   map[string]xgress.OptionsData
 	Listeners []ListenerBinding
@@ -184,8 +187,12 @@ type Config struct {
 	Plugins        []string
 	Edge           *EdgeConfig
 	IfaceDiscovery InterfaceDiscoveryConfig
-	Src            map[interface{}]interface{}
-	path           string
+	// ManagedConfigFile is where configuration pushed by the controllers is saved
+	ManagedConfigFile string
+	Src               map[interface{}]interface{}
+	path              string
+	managed           *managedConfigFile
+	effectiveSrc      map[interface{}]interface{}
 }
 
 func (config *Config) CurrentCtrlAddress() string {
@@ -387,6 +394,9 @@ func LoadConfigWithOptions(path string, loadIdentity bool) (*Config, error) {
 
 	cfg := &Config{Src: cfgmap}
 
+	// configuration pushed by the controllers is overlaid on the configuration file. Src keeps the file contents
+	cfgmap = cfg.loadManagedConfig(cfgmap)
+
 	identityConfig, err := LoadIdentityConfigFromMap(cfgmap)
 
 	if err != nil {
@@ -559,6 +569,7 @@ func LoadConfigWithOptions(path string, loadIdentity bool) (*Config, error) {
 	cfg.Link.Heartbeats = *channel.DefaultHeartbeatOptions()
 	cfg.Link.Heartbeats.SendInterval = DefaultLinkHeartbeatSendInterval
 	cfg.Link.Heartbeats.CloseUnresponsiveTimeout = DefaultLinkUnresponsiveTimeout
+	cfg.Link.Probes = *DefaultLinkProbeOptions()
 
 	if value, found := cfgmap["link"]; found {
 		if submap, ok := value.(map[interface{}]interface{}); ok {
@@ -614,6 +625,18 @@ func LoadConfigWithOptions(path string, loadIdentity bool) (*Config, error) {
 					cfg.Link.Heartbeats = *options
 				}
 			}
+
+			if value, found := submap["probes"]; found {
+				if submap, ok := value.(map[interface{}]interface{}); ok {
+					options, err := LoadLinkProbeOptions(submap)
+					if err != nil {
+						return nil, err
+					}
+					cfg.Link.Probes = *options
+				} else {
+					return nil, errors.New("invalid value for 'link.probes', must be a map")
+				}
+			}
 		}
 	}
 
@@ -655,7 +678,7 @@ func LoadConfigWithOptions(path string, loadIdentity bool) (*Config, error) {
 		}
 	}
 
-	cfg.Metrics.ReportInterval = time.Minute
+	cfg.Metrics.ReportInterval = DefaultMetricsReportInterval
 	cfg.Metrics.MessageQueueSize = 10
 	cfg.Metrics.EventQueueSize = 256
 
@@ -893,9 +916,9 @@ func LoadConfigWithOptions(path string, loadIdentity bool) (*Config, error) {
 		for _, c := range cfg.Link.Listeners {
 			a := c["advertise"]
 			if a != nil {
-				// should start with tls: or dtls:
+				// should start with tls:, dtls: or quic:
 				parts := strings.Split(a.(string), ":")
-				if parts[0] == "tls" || parts[0] == "dtls" {
+				if parts[0] == "tls" || parts[0] == "dtls" || parts[0] == "quic" {
 					addy := parts[1]
 					e := cfg.Id.ValidFor(addy)
 					if e != nil {
@@ -40,6 +40,7 @@ const (
 	DefaultSessionValidateChunkSize   = 1000
 	DefaultSessionValidateMinInterval = "250ms"
 	DefaultSessionValidateMaxInterval = "1500ms"
+	DefaultCertRenewalLeadTime        = 7 * 24 * time.Hour
 )
 
 type EdgeConfig struct {
@@ -54,10 +55,17 @@ type EdgeConfig struct {
 	Tcfg                       transport.Configuration
 	ForceExtendEnrollment      bool
 
+	// CertRenewalLeadTime is how long before the router's certificates expire that they're renewed
+	CertRenewalLeadTime time.Duration
+
 	RouterConfig *Config
 
 	Db             string
 	DbSaveInterval time.Duration
+
+	// GeoIpDatabase is the path to an optional offline GeoIP database, used to resolve client source addresses to
+	// countries for geo ip posture checks
+	GeoIpDatabase string
 }
 
 type Csr struct {
@@ -116,6 +124,10 @@ func (config *EdgeConfig) LoadEdgeConfigFromMap(configMap map[interface{}]interf
 		return err
 	}
 
+	if err = config.loadEnrollment(configMap); err != nil {
+		return err
+	}
+
 	var edgeConfigMap map[interface{}]interface{}
 
 	if val, ok := configMap["edge"]; ok && val != nil {
@@ -151,6 +163,14 @@ func (config *EdgeConfig) LoadEdgeConfigFromMap(configMap map[interface{}]interf
 		config.DbSaveInterval = 30 * time.Second
 	}
 
+	if val, found := edgeConfigMap["geoIpDatabase"]; found {
+		strVal, ok := val.(string)
+		if !ok {
+			return errors.Errorf("invalid type for geoIpDatabase, expected string, got %T", val)
+		}
+		config.GeoIpDatabase = strings.TrimSpace(strVal)
+	}
+
 	if val, found := edgeConfigMap["heartbeatIntervalSeconds"]; found {
 		config.HeartbeatIntervalSeconds = val.(int)
 	}
@@ -352,6 +372,35 @@ func parseEdgeListenerOptions(index int, address string, edgeListenerMap map[int
 	}
 }
 
+// loadEnrollment loads the root `enrollment` section, which controls how the router renews its certificates
+func (config *EdgeConfig) loadEnrollment(rootConfigMap map[interface{}]interface{}) error {
+	config.CertRenewalLeadTime = DefaultCertRenewalLeadTime
+
+	val, found := rootConfigMap["enrollment"]
+	if !found || val == nil {
+		return nil
+	}
+
+	enrollmentMap, ok := val.(map[interface{}]interface{})
+	if !ok {
+		return errors.New("[enrollment] must be a map")
+	}
+
+	if val, found := enrollmentMap["renewalLeadTime"]; found {
+		strVal, ok := val.(string)
+		if !ok {
+			return errors.Errorf("invalid type for [enrollment.renewalLeadTime], expected duration string, got %T", val)
+		}
+		leadTime, err := time.ParseDuration(strVal)
+		if err != nil || leadTime <= 0 {
+			return errors.Errorf("invalid value '%s' for [enrollment.renewalLeadTime], must be a positive duration (e.g. 336h)", strVal)
+		}
+		config.CertRenewalLeadTime = leadTime
+	}
+
+	return nil
+}
+
 // loadCsr search for a root `csr` path or an `edge.csr` path for a CSR definition. The root path is preferred.
 func (config *EdgeConfig) loadCsr(rootConfigMap map[interface{}]interface{}) error {
 	csrI, ok := rootConfigMap["csr"]
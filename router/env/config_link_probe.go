@@ -0,0 +1,128 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package env
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultLinkProbeInterval           = 2 * time.Second
+	DefaultLinkProbeTimeout            = 2 * time.Second
+	DefaultLinkProbeWindow             = 30
+	DefaultLinkProbeBandwidthProbeSize = 64 * 1024
+	DefaultLinkProbeBandwidthEvery     = 5
+
+	MinLinkProbeInterval           = 100 * time.Millisecond
+	MinLinkProbeWindow             = 2
+	MaxLinkProbeWindow             = 1000
+	MaxLinkProbeBandwidthProbeSize = 1024 * 1024
+)
+
+// LinkProbeOptions configures the active probes sent over each link, which are used to measure packet loss, jitter
+// and available bandwidth in addition to the latency reported by link heartbeats
+type LinkProbeOptions struct {
+	// Enabled turns link probing on or off
+	Enabled bool
+	// Interval is how often a probe is sent over each link
+	Interval time.Duration
+	// Timeout is how long to wait for a probe response before counting the probe as lost
+	Timeout time.Duration
+	// Window is the number of probes loss and bandwidth figures are calculated over
+	Window uint32
+	// BandwidthProbeSize is the size, in bytes, of the padded probes used to estimate link capacity
+	BandwidthProbeSize uint32
+	// BandwidthEvery is how many regular probes are sent for each padded bandwidth probe. Zero disables bandwidth
+	// estimation.
+	BandwidthEvery uint32
+}
+
+func DefaultLinkProbeOptions() *LinkProbeOptions {
+	return &LinkProbeOptions{
+		Enabled:            true,
+		Interval:           DefaultLinkProbeInterval,
+		Timeout:            DefaultLinkProbeTimeout,
+		Window:             DefaultLinkProbeWindow,
+		BandwidthProbeSize: DefaultLinkProbeBandwidthProbeSize,
+		BandwidthEvery:     DefaultLinkProbeBandwidthEvery,
+	}
+}
+
+func LoadLinkProbeOptions(src map[interface{}]interface{}) (*LinkProbeOptions, error) {
+	options := DefaultLinkProbeOptions()
+
+	if value, found := src["enabled"]; found {
+		if val, ok := value.(bool); ok {
+			options.Enabled = val
+		} else {
+			return nil, errors.New("invalid value for 'link.probes.enabled', must be a boolean")
+		}
+	}
+
+	durations := []struct {
+		name  string
+		field *time.Duration
+	}{
+		{name: "interval", field: &options.Interval},
+		{name: "timeout", field: &options.Timeout},
+	}
+
+	for _, duration := range durations {
+		if value, found := src[duration.name]; found {
+			strVal, ok := value.(string)
+			if !ok {
+				return nil, errors.Errorf("invalid value for 'link.probes.%s', must be a duration", duration.name)
+			}
+			val, err := time.ParseDuration(strVal)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid value for 'link.probes.%s'", duration.name)
+			}
+			if val < MinLinkProbeInterval {
+				return nil, errors.Errorf("invalid value for 'link.probes.%s', must be at least %v", duration.name, MinLinkProbeInterval)
+			}
+			*duration.field = val
+		}
+	}
+
+	if value, found := src["window"]; found {
+		if val, ok := value.(int); ok && val >= MinLinkProbeWindow && val <= MaxLinkProbeWindow {
+			options.Window = uint32(val)
+		} else {
+			return nil, errors.Errorf("invalid value for 'link.probes.window', must be an integer between %v and %v", MinLinkProbeWindow, MaxLinkProbeWindow)
+		}
+	}
+
+	if value, found := src["bandwidthProbeSize"]; found {
+		if val, ok := value.(int); ok && val > 0 && val <= MaxLinkProbeBandwidthProbeSize {
+			options.BandwidthProbeSize = uint32(val)
+		} else {
+			return nil, errors.Errorf("invalid value for 'link.probes.bandwidthProbeSize', must be an integer between 1 and %v", MaxLinkProbeBandwidthProbeSize)
+		}
+	}
+
+	if value, found := src["bandwidthEvery"]; found {
+		if val, ok := value.(int); ok && val >= 0 {
+			options.BandwidthEvery = uint32(val)
+		} else {
+			return nil, errors.New("invalid value for 'link.probes.bandwidthEvery', must be an integer greater than or equal to 0")
+		}
+	}
+
+	return options, nil
+}
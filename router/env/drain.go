@@ -0,0 +1,57 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package env
+
+import (
+	"sync/atomic"
+
+	"github.com/hanzozt/foundation/v2/concurrenz"
+)
+
+// DrainListener is notified when the router starts or stops draining
+type DrainListener interface {
+	DrainStateChanged(draining bool)
+}
+
+// DrainState tracks whether the controllers have marked this router as draining. While draining, the controllers
+// route new circuits elsewhere where possible and the router sheds idle client connections, so that it can be
+// restarted once it's no longer carrying any circuits.
+type DrainState struct {
+	draining  atomic.Bool
+	listeners concurrenz.CopyOnWriteSlice[DrainListener]
+}
+
+func NewDrainState() *DrainState {
+	return &DrainState{}
+}
+
+func (self *DrainState) IsDraining() bool {
+	return self.draining.Load()
+}
+
+// SetDraining updates the drain state, notifying listeners if it changed
+func (self *DrainState) SetDraining(draining bool) {
+	if self.draining.CompareAndSwap(!draining, draining) {
+		for _, listener := range self.listeners.Value() {
+			listener.DrainStateChanged(draining)
+		}
+	}
+}
+
+func (self *DrainState) AddListener(listener DrainListener) {
+	self.listeners.Append(listener)
+}
@@ -57,8 +57,11 @@ type RouterEnv interface {
 	GetForwarder() Forwarder
 	GetXgressMetrics() XgressMetrics
 	NotifyCertsUpdated()
+	SigningCaRollover(caPem []byte) error
 	GetAlerter() Alerter
 	GetXgressRegistry() *Registry
+	GetDrainState() *DrainState
+	GetManagedConfig() *ManagedConfig
 
 	UpdateCtrlEndpoints(endpoints []string)
 	UpdateLeader(leaderId string)
@@ -92,7 +95,15 @@ type Destination interface {
 	GetDestinationType() string
 }
 
+const (
+	UsageTypeIngressShapedRx = "ingress.shaped.rx"
+	UsageTypeIngressShapedTx = "ingress.shaped.tx"
+)
+
 type XgressMetrics interface {
 	Rx(source metrics.UsageSource, originator xgress.Originator, payload *xgress.Payload)
 	Tx(source metrics.UsageSource, originator xgress.Originator, payload *xgress.Payload)
+	// Shaped records data on an ingress connection which was delayed by rate limiting. The usage type is either
+	// UsageTypeIngressShapedRx or UsageTypeIngressShapedTx
+	Shaped(source metrics.UsageSource, usageType string, size int64)
 }
@@ -0,0 +1,340 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package env
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/hanzozt/foundation/v2/concurrenz"
+	"github.com/hanzozt/zt/v2/common/routerconfig"
+	"github.com/sirupsen/logrus"
+)
+
+const DefaultManagedConfigFile = "managed-config.json"
+
+// liveForwarderKeys are the forwarder options which are applied without a restart. The worker pools can't be resized
+// once they've been created, so their settings only take effect on restart.
+var liveForwarderKeys = []string{
+	"faultTxInterval",
+	"idleCircuitTimeout",
+	"idleTxInterval",
+	"unresponsiveLinkTimeout",
+	"xgressCloseCheckInterval",
+	"xgressDialDwellTime",
+}
+
+// liveMetricsKeys are the metrics options which are applied without a restart. The interval age threshold is fixed
+// when the metrics registry is created.
+var liveMetricsKeys = []string{
+	"reportInterval",
+}
+
+// ManagedSettings are the settings from a managed config which are applied while the router is running
+type ManagedSettings struct {
+	Forwarder             *ForwarderOptions
+	MetricsReportInterval time.Duration
+}
+
+// ManagedConfigListener is notified when a managed config has been applied, with the resulting settings
+type ManagedConfigListener interface {
+	ManagedConfigApplied(settings *ManagedSettings)
+}
+
+// managedConfigFile is the form in which the managed config is saved, so that it's applied on restart
+type managedConfigFile struct {
+	Version  string          `json:"version"`
+	ConfigId string          `json:"configId"`
+	Config   json.RawMessage `json:"config"`
+	config   *routerconfig.Config
+}
+
+func managedConfigFilePath(cfgmap map[interface{}]interface{}) string {
+	if value, found := cfgmap["ctrl"]; found {
+		if submap, ok := value.(map[interface{}]interface{}); ok {
+			if path, ok := submap["managedConfigFile"].(string); ok && path != "" {
+				return path
+			}
+		}
+	}
+	path, _ := cfgmap[PathMapKey].(string)
+	return filepath.Join(filepath.Dir(path), DefaultManagedConfigFile)
+}
+
+// loadManagedConfig overlays the saved managed config, if there is one, on the given config map. If the saved config
+// can't be read or no longer fits the configuration file, it's ignored, so the router can still start
+func (config *Config) loadManagedConfig(cfgmap map[interface{}]interface{}) map[interface{}]interface{} {
+	config.ManagedConfigFile = managedConfigFilePath(cfgmap)
+	config.effectiveSrc = cfgmap
+
+	log := pfxlog.Logger().WithField("file", config.ManagedConfigFile)
+
+	data, err := os.ReadFile(config.ManagedConfigFile)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.WithError(err).Warn("unable to read managed config, ignoring")
+		}
+		return cfgmap
+	}
+
+	managed := &managedConfigFile{}
+	if err = json.Unmarshal(data, managed); err != nil {
+		log.WithError(err).Warn("invalid managed config, ignoring")
+		return cfgmap
+	}
+
+	if managed.config, err = routerconfig.Decode(managed.Config); err != nil {
+		log.WithError(err).Warn("invalid managed config, ignoring")
+		return cfgmap
+	}
+
+	result, err := managed.config.ApplyToMap(cfgmap)
+	if err != nil {
+		log.WithError(err).Warn("managed config doesn't match router configuration, ignoring")
+		return cfgmap
+	}
+
+	log.WithField("version", managed.Version).WithField("configId", managed.ConfigId).Info("applying managed config")
+	config.managed = managed
+	config.effectiveSrc = result
+	return result
+}
+
+// ManagedConfig applies the configuration pushed by the controllers. Log levels, metrics reporting and most forwarder
+// options are changed immediately. Everything else is saved and takes effect when the router is next restarted.
+type ManagedConfig struct {
+	lock            sync.Mutex
+	config          *Config
+	defaultLogLevel logrus.Level
+	version         string
+	current         map[interface{}]interface{}
+	changes         map[string]bool
+	listeners       concurrenz.CopyOnWriteSlice[ManagedConfigListener]
+}
+
+func NewManagedConfig(config *Config) *ManagedConfig {
+	result := &ManagedConfig{
+		config:          config,
+		defaultLogLevel: logrus.GetLevel(),
+		current:         config.effectiveSrc,
+	}
+
+	if config.managed != nil {
+		result.version = config.managed.Version
+		result.applyLogLevel(config.managed.config)
+	}
+
+	return result
+}
+
+func (self *ManagedConfig) AddListener(listener ManagedConfigListener) {
+	self.listeners.Append(listener)
+}
+
+// GetVersion returns the version of the managed config which was most recently applied
+func (self *ManagedConfig) GetVersion() string {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return self.version
+}
+
+// Apply validates and applies a managed config. An empty config clears the managed config. On success, the settings
+// which changed are returned, mapped to whether the router must be restarted for them to take effect. Settings which
+// were applied live are reported when they change, those which need a restart for as long as they differ from the
+// settings the router was started with.
+func (self *ManagedConfig) Apply(version string, configId string, data []byte) (map[string]bool, error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if version == self.version {
+		return self.changes, nil
+	}
+
+	var managedConfig *routerconfig.Config
+	effective := self.config.Src
+
+	if len(data) > 0 {
+		var err error
+		if managedConfig, err = routerconfig.Decode(data); err != nil {
+			return nil, err
+		}
+		if effective, err = managedConfig.ApplyToMap(self.config.Src); err != nil {
+			return nil, err
+		}
+	}
+
+	forwarderOptions, err := loadForwarderOptionsFromMap(effective)
+	if err != nil {
+		return nil, err
+	}
+
+	reportInterval, err := loadMetricsReportIntervalFromMap(effective)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = self.save(version, configId, data); err != nil {
+		return nil, err
+	}
+
+	changes := self.getChanges(effective)
+	if self.applyLogLevel(managedConfig) {
+		changes["log.level"] = false
+	}
+
+	self.version = version
+	self.current = effective
+	self.changes = changes
+
+	settings := &ManagedSettings{
+		Forwarder:             forwarderOptions,
+		MetricsReportInterval: reportInterval,
+	}
+
+	for _, listener := range self.listeners.Value() {
+		listener.ManagedConfigApplied(settings)
+	}
+
+	return self.changes, nil
+}
+
+// applyLogLevel sets the log level from the given config, or the level the router was started with if the config
+// doesn't set one. It returns true if the log level changed
+func (self *ManagedConfig) applyLogLevel(config *routerconfig.Config) bool {
+	level := self.defaultLogLevel
+	if config != nil && config.Log != nil && config.Log.Level != "" {
+		level, _ = logrus.ParseLevel(config.Log.Level)
+	}
+
+	if logrus.GetLevel() != level {
+		pfxlog.Logger().WithField("level", level.String()).Info("setting log level from managed config")
+		logrus.SetLevel(level)
+		return true
+	}
+	return false
+}
+
+// getChanges compares the given config map with the one the router is running with, returning the names of the
+// settings which differ, mapped to whether they can only be changed by restarting the router
+func (self *ManagedConfig) getChanges(effective map[interface{}]interface{}) map[string]bool {
+	result := map[string]bool{}
+
+	self.compareSection(result, effective, routerconfig.SectionForwarder, routerconfig.ForwarderKeys, liveForwarderKeys)
+	self.compareSection(result, effective, routerconfig.SectionMetrics, routerconfig.MetricsKeys, liveMetricsKeys)
+
+	started := self.config.effectiveSrc
+	startedListeners := listenersByBinding(started)
+	for binding, listener := range listenersByBinding(effective) {
+		if !reflect.DeepEqual(startedListeners[binding], listener) {
+			result[routerconfig.SectionListeners+"."+binding] = true
+		}
+	}
+
+	if !reflect.DeepEqual(started["link"], effective["link"]) {
+		result[routerconfig.SectionLinkGroups] = true
+	}
+
+	return result
+}
+
+// compareSection adds the keys of the given section which changed to the result. Live keys are compared with the
+// settings currently applied, the others with the settings the router was started with
+func (self *ManagedConfig) compareSection(result map[string]bool, effective map[interface{}]interface{}, section string, keys []string, liveKeys []string) {
+	startedSection, _ := self.config.effectiveSrc[section].(map[interface{}]interface{})
+	currentSection, _ := self.current[section].(map[interface{}]interface{})
+	newSection, _ := effective[section].(map[interface{}]interface{})
+
+	for _, key := range keys {
+		if slices.Contains(liveKeys, key) {
+			if !reflect.DeepEqual(currentSection[key], newSection[key]) {
+				result[section+"."+key] = false
+			}
+		} else if !reflect.DeepEqual(startedSection[key], newSection[key]) {
+			result[section+"."+key] = true
+		}
+	}
+}
+
+func (self *ManagedConfig) save(version string, configId string, data []byte) error {
+	path := self.config.ManagedConfigFile
+
+	if len(data) == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("unable to remove managed config file '%s' (%w)", path, err)
+		}
+		return nil
+	}
+
+	encoded, err := json.MarshalIndent(&managedConfigFile{
+		Version:  version,
+		ConfigId: configId,
+		Config:   data,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("unable to marshal managed config (%w)", err)
+	}
+
+	if err = os.WriteFile(path, encoded, 0600); err != nil {
+		return fmt.Errorf("unable to write managed config to file '%s' (%w)", path, err)
+	}
+	return nil
+}
+
+func loadForwarderOptionsFromMap(cfgmap map[interface{}]interface{}) (*ForwarderOptions, error) {
+	if submap, ok := cfgmap[routerconfig.SectionForwarder].(map[interface{}]interface{}); ok {
+		options, err := LoadForwarderOptions(submap)
+		if err != nil {
+			return nil, fmt.Errorf("invalid forwarder options (%w)", err)
+		}
+		return options, nil
+	}
+	return DefaultForwarderOptions(), nil
+}
+
+func loadMetricsReportIntervalFromMap(cfgmap map[interface{}]interface{}) (time.Duration, error) {
+	if submap, ok := cfgmap[routerconfig.SectionMetrics].(map[interface{}]interface{}); ok {
+		if value, found := submap["reportInterval"]; found {
+			interval, err := time.ParseDuration(fmt.Sprintf("%v", value))
+			if err != nil {
+				return 0, fmt.Errorf("invalid value for metrics.reportInterval (%w)", err)
+			}
+			return interval, nil
+		}
+	}
+	return DefaultMetricsReportInterval, nil
+}
+
+func listenersByBinding(cfgmap map[interface{}]interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	if listeners, ok := cfgmap[routerconfig.SectionListeners].([]interface{}); ok {
+		for _, listener := range listeners {
+			if submap, ok := listener.(map[interface{}]interface{}); ok {
+				if binding, ok := submap["binding"].(string); ok {
+					result[binding] = submap
+				}
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,139 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package env
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+type testManagedConfigListener struct {
+	settings *ManagedSettings
+}
+
+func (self *testManagedConfigListener) ManagedConfigApplied(settings *ManagedSettings) {
+	self.settings = settings
+}
+
+func TestManagedConfigApply(t *testing.T) {
+	req := require.New(t)
+
+	defaultLevel := logrus.GetLevel()
+	defer logrus.SetLevel(defaultLevel)
+
+	dir := t.TempDir()
+	src := map[interface{}]interface{}{
+		PathMapKey: filepath.Join(dir, "router.yml"),
+		"forwarder": map[interface{}]interface{}{
+			"idleTxInterval": 60000,
+		},
+		"listeners": []interface{}{
+			map[interface{}]interface{}{"binding": "edge"},
+		},
+	}
+
+	config := &Config{Src: src}
+	config.loadManagedConfig(src)
+	req.Nil(config.managed)
+
+	managedConfig := NewManagedConfig(config)
+	listener := &testManagedConfigListener{}
+	managedConfig.AddListener(listener)
+
+	changes, err := managedConfig.Apply("v1", "cfg", []byte(`{
+		"log": { "level": "trace" },
+		"forwarder": { "idleTxInterval": 5000, "linkDialWorkerCount": 64 },
+		"metrics": { "reportInterval": "15s", "intervalAgeThreshold": "2m" },
+		"listeners": { "edge": { "getSessionTimeout": 30 } }
+	}`))
+	req.NoError(err)
+	req.Equal(map[string]bool{
+		"log.level":                     false,
+		"forwarder.idleTxInterval":      false,
+		"forwarder.linkDialWorkerCount": true,
+		"metrics.reportInterval":        false,
+		"metrics.intervalAgeThreshold":  true,
+		"listeners.edge":                true,
+	}, changes)
+	req.Equal("v1", managedConfig.GetVersion())
+	req.Equal(logrus.TraceLevel, logrus.GetLevel())
+	req.Equal(5*time.Second, listener.settings.Forwarder.IdleTxInterval)
+	req.Equal(uint16(64), listener.settings.Forwarder.LinkDial.WorkerCount)
+	req.Equal(15*time.Second, listener.settings.MetricsReportInterval)
+	req.FileExists(config.ManagedConfigFile)
+
+	// live settings are reported when they change, settings needing a restart until the router is restarted
+	changes, err = managedConfig.Apply("v2", "cfg", []byte(`{
+		"log": { "level": "trace" },
+		"forwarder": { "idleTxInterval": 5000, "linkDialWorkerCount": 64, "faultTxInterval": 1000 },
+		"metrics": { "reportInterval": "15s", "intervalAgeThreshold": "2m" },
+		"listeners": { "edge": { "getSessionTimeout": 30 } }
+	}`))
+	req.NoError(err)
+	req.Equal(map[string]bool{
+		"forwarder.faultTxInterval":     false,
+		"forwarder.linkDialWorkerCount": true,
+		"metrics.intervalAgeThreshold":  true,
+		"listeners.edge":                true,
+	}, changes)
+	req.Equal(time.Second, listener.settings.Forwarder.FaultTxInterval)
+
+	// invalid configs are rejected and leave the current config in place
+	_, err = managedConfig.Apply("v3", "cfg", []byte(`{ "listeners": { "tunnel": { "mode": "tproxy" } } }`))
+	req.ErrorContains(err, "no listener with binding 'tunnel'")
+	_, err = managedConfig.Apply("v3", "cfg", []byte(`{ "forwarder": { "linkDialWorkerCount": 100000 } }`))
+	req.ErrorContains(err, "linkDialWorkerCount")
+	req.Equal("v2", managedConfig.GetVersion())
+
+	// on restart, the saved config is overlaid on the configuration file
+	restarted := &Config{Src: src}
+	effective := restarted.loadManagedConfig(src)
+	req.NotNil(restarted.managed)
+	req.Equal("v2", restarted.managed.Version)
+	forwarderOptions, err := loadForwarderOptionsFromMap(effective)
+	req.NoError(err)
+	req.Equal(uint16(64), forwarderOptions.LinkDial.WorkerCount)
+
+	restartedManagedConfig := NewManagedConfig(restarted)
+	req.Equal("v2", restartedManagedConfig.GetVersion())
+	changes, err = restartedManagedConfig.Apply("v2", "cfg", nil)
+	req.NoError(err)
+	req.Empty(changes)
+
+	// clearing the config removes the saved config and reverts the log level
+	logrus.SetLevel(defaultLevel)
+	restartedManagedConfig = NewManagedConfig(restarted)
+	changes, err = restartedManagedConfig.Apply("", "", nil)
+	req.NoError(err)
+	req.Equal(map[string]bool{
+		"log.level":                     false,
+		"forwarder.faultTxInterval":     false,
+		"forwarder.idleTxInterval":      false,
+		"forwarder.linkDialWorkerCount": true,
+		"metrics.reportInterval":        false,
+		"metrics.intervalAgeThreshold":  true,
+		"listeners.edge":                true,
+	}, changes)
+	req.Equal(defaultLevel, logrus.GetLevel())
+	_, err = os.Stat(restarted.ManagedConfigFile)
+	req.True(os.IsNotExist(err))
+}
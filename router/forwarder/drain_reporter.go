@@ -0,0 +1,109 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/hanzozt/channel/v4"
+	"github.com/hanzozt/channel/v4/protobufs"
+	"github.com/hanzozt/zt/v2/common/pb/ctrl_pb"
+	"github.com/hanzozt/zt/v2/router/env"
+)
+
+const (
+	drainCheckInterval  = 5 * time.Second
+	drainReportInterval = 30 * time.Second
+)
+
+// DrainReporter tells the controllers how many circuits the router is carrying while it's draining, so they can
+// report when it's empty and safe to restart
+type DrainReporter struct {
+	ctrls       env.NetworkControllers
+	drainState  *env.DrainState
+	forwarder   *Forwarder
+	notify      chan struct{}
+	closeNotify <-chan struct{}
+}
+
+func NewDrainReporter(routerEnv env.RouterEnv, forwarder *Forwarder) *DrainReporter {
+	result := &DrainReporter{
+		ctrls:       routerEnv.GetNetworkControllers(),
+		drainState:  routerEnv.GetDrainState(),
+		forwarder:   forwarder,
+		notify:      make(chan struct{}, 1),
+		closeNotify: routerEnv.GetCloseNotify(),
+	}
+	result.drainState.AddListener(result)
+	go result.run()
+	return result
+}
+
+func (self *DrainReporter) DrainStateChanged(bool) {
+	select {
+	case self.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (self *DrainReporter) run() {
+	ticker := time.NewTicker(drainCheckInterval)
+	defer ticker.Stop()
+
+	var last *ctrl_pb.RouterDrainStatus
+	var lastSent time.Time
+
+	for {
+		select {
+		case <-self.notify:
+		case <-ticker.C:
+		case <-self.closeNotify:
+			return
+		}
+
+		status := &ctrl_pb.RouterDrainStatus{
+			Draining: self.drainState.IsDraining(),
+			Circuits: uint32(self.forwarder.CircuitCount()),
+		}
+
+		// once the router has reported that it's no longer draining, there's nothing further to report
+		if !status.Draining && (last == nil || !last.Draining) {
+			continue
+		}
+
+		// controllers which connect after the router starts draining are caught up by the periodic report
+		changed := last == nil || last.Draining != status.Draining || last.Circuits != status.Circuits
+		if changed || time.Since(lastSent) >= drainReportInterval {
+			self.report(status)
+			last = status
+			lastSent = time.Now()
+		}
+	}
+}
+
+func (self *DrainReporter) report(status *ctrl_pb.RouterDrainStatus) {
+	self.ctrls.ForEach(func(ctrlId string, ch channel.Channel) {
+		if err := protobufs.MarshalTyped(status).Send(ch); err != nil {
+			pfxlog.Logger().WithError(err).
+				WithField("ctrlId", ctrlId).
+				WithField("draining", status.Draining).
+				WithField("circuits", status.Circuits).
+				Error("failed to send router drain status")
+		}
+	})
+}
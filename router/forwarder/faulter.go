@@ -18,6 +18,7 @@ package forwarder
 
 import (
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/michaelquigley/pfxlog"
@@ -32,7 +33,8 @@ import (
 
 type Faulter struct {
 	ctrls         env.NetworkControllers
-	interval      time.Duration
+	interval      atomic.Int64
+	running       atomic.Bool
 	circuitIds    cmap.ConcurrentMap[string, string]
 	closeNotify   <-chan struct{}
 	linkFaults    metrics.Meter
@@ -47,23 +49,34 @@ type FaultReceiver interface {
 func NewFaulter(routerEnv env.RouterEnv, interval time.Duration) *Faulter {
 	f := &Faulter{
 		ctrls:         routerEnv.GetNetworkControllers(),
-		interval:      interval,
 		circuitIds:    cmap.New[string](),
 		closeNotify:   routerEnv.GetCloseNotify(),
 		linkFaults:    routerEnv.GetMetricsRegistry().Meter("faults.link"),
 		circuitFaults: routerEnv.GetMetricsRegistry().Meter("faults.circuit"),
 	}
 
-	if interval > 0 {
-		go f.run()
-	}
+	f.interval.Store(int64(interval))
+	f.start()
 
 	return f
 }
 
+// ManagedConfigApplied updates the fault reporting interval from a managed config, starting fault reporting if it's
+// now enabled
+func (self *Faulter) ManagedConfigApplied(settings *env.ManagedSettings) {
+	self.interval.Store(int64(settings.Forwarder.FaultTxInterval))
+	self.start()
+}
+
+func (self *Faulter) start() {
+	if self.interval.Load() > 0 && self.running.CompareAndSwap(false, true) {
+		go self.run()
+	}
+}
+
 func (self *Faulter) Report(circuitId string, ctrlId string) {
 	self.circuitFaults.Mark(1)
-	if self.interval > 0 {
+	if self.interval.Load() > 0 {
 		self.circuitIds.Set(circuitId, ctrlId)
 	}
 }
@@ -87,8 +100,14 @@ func (self *Faulter) run() {
 	defer logrus.Errorf("exited")
 
 	for {
+		interval := time.Duration(self.interval.Load())
+		if interval <= 0 {
+			self.running.Store(false)
+			return
+		}
+
 		select {
-		case <-time.After(self.interval):
+		case <-time.After(interval):
 			workloadByCtrl := map[string][]string{}
 			self.circuitIds.IterCb(func(circuitId, ctrlId string) {
 				workloadByCtrl[ctrlId] = append(workloadByCtrl[ctrlId], circuitId)
@@ -40,7 +40,8 @@ type Forwarder struct {
 	faulter         FaultReceiver
 	metricsRegistry metrics.UsageRegistry
 	traceController trace.Controller
-	Options         *env.ForwarderOptions
+	scanner         *Scanner
+	options         atomic.Pointer[env.ForwarderOptions]
 	CloseNotify     <-chan struct{}
 }
 
@@ -57,20 +58,30 @@ func NewForwarder(metricsRegistry metrics.UsageRegistry, faulter FaultReceiver,
 		faulter:         faulter,
 		metricsRegistry: metricsRegistry,
 		traceController: trace.NewController(closeNotify),
-		Options:         options,
 		CloseNotify:     closeNotify,
 	}
+	f.options.Store(options)
 	return f
 }
 
 func (forwarder *Forwarder) StartScanner(ctrls env.NetworkControllers) {
-	scanner := newScanner(ctrls, forwarder.Options, forwarder.CloseNotify)
-	scanner.setCircuitTable(forwarder.circuits)
+	forwarder.scanner = newScanner(ctrls, forwarder.GetOptions(), forwarder.CloseNotify)
+	forwarder.scanner.setCircuitTable(forwarder.circuits)
+	forwarder.scanner.start()
+}
 
-	if scanner.interval > 0 {
-		go scanner.run()
-	} else {
-		logrus.Warnf("scanner disabled")
+// GetOptions returns the forwarder options currently in effect. They may be replaced by a managed config while the
+// router is running, so they should be read when they're used, rather than cached
+func (forwarder *Forwarder) GetOptions() *env.ForwarderOptions {
+	return forwarder.options.Load()
+}
+
+// ManagedConfigApplied replaces the forwarder options with those from a managed config, and updates the idle circuit
+// scanner with the new idle timeout and scan interval
+func (forwarder *Forwarder) ManagedConfigApplied(settings *env.ManagedSettings) {
+	forwarder.options.Store(settings.Forwarder)
+	if forwarder.scanner != nil {
+		forwarder.scanner.setOptions(settings.Forwarder)
 	}
 }
 
@@ -105,6 +116,11 @@ func (forwarder *Forwarder) UnregisterDestinations(circuitId string) {
 	}
 }
 
+// CircuitCount returns the number of circuits currently routed through this router
+func (forwarder *Forwarder) CircuitCount() int {
+	return forwarder.circuits.circuits.Count()
+}
+
 func (forwarder *Forwarder) HasDestination(address xgress.Address) bool {
 	_, found := forwarder.destinations.getDestination(address)
 	return found
@@ -127,6 +143,11 @@ func (forwarder *Forwarder) Route(ctrlId string, route *ctrl_pb.Route) error {
 	} else {
 		circuitFt = newForwardTable(ctrlId)
 	}
+	circuitFt.setMultipath(route.Multipath)
+	circuitFt.setPriority(route.Priority)
+	// multipath routes list the forwards for the first path before those for the alternate path, so a repeated source
+	// address is the alternate destination for that source
+	seen := map[string]struct{}{}
 	for _, forward := range route.Forwards {
 		if !forwarder.HasDestination(xgress.Address(forward.DstAddress)) {
 			if forward.DstType == ctrl_pb.DestType_Link {
@@ -138,7 +159,12 @@ func (forwarder *Forwarder) Route(ctrlId string, route *ctrl_pb.Route) error {
 			}
 			// It's an ingress destination, which isn't established until after routing has completed
 		}
-		circuitFt.setForwardAddress(xgress.Address(forward.SrcAddress), xgress.Address(forward.DstAddress))
+		if _, found := seen[forward.SrcAddress]; found && route.Multipath != ctrl_pb.MultipathMode_MultipathNone {
+			circuitFt.setAlternateForwardAddress(xgress.Address(forward.SrcAddress), xgress.Address(forward.DstAddress))
+		} else {
+			circuitFt.setForwardAddress(xgress.Address(forward.SrcAddress), xgress.Address(forward.DstAddress))
+		}
+		seen[forward.SrcAddress] = struct{}{}
 		pfxlog.Logger().WithFields(logrus.Fields{
 			"circuitId":   circuitId,
 			"source":      forward.SrcAddress,
@@ -155,7 +181,7 @@ func (forwarder *Forwarder) Unroute(circuitId string, now bool) {
 		forwarder.EndCircuit(circuitId)
 		pfxlog.Logger().WithField("circuitId", circuitId).Info("circuit unrouted")
 	} else {
-		go forwarder.unrouteTimeout(circuitId, forwarder.Options.XgressCloseCheckInterval)
+		go forwarder.unrouteTimeout(circuitId, forwarder.GetOptions().XgressCloseCheckInterval)
 	}
 }
 
@@ -172,26 +198,19 @@ func (forwarder *Forwarder) RetransmitPayload(srcAddr xgress.Address, payload *x
 }
 
 func (forwarder *Forwarder) forwardPayload(srcAddr xgress.Address, payload *xgress.Payload, markActive bool, timeout time.Duration) error {
-	log := pfxlog.ContextLogger(string(srcAddr))
-
 	circuitId := payload.GetCircuitId()
 	if forwardTable, found := forwarder.circuits.getForwardTable(circuitId, markActive); found {
 		if dstAddr, found := forwardTable.getForwardAddress(srcAddr); found {
-			if dst, found := forwarder.destinations.getDestination(dstAddr); found {
-				payloadType := xgress.PayloadTypeXg
-				if !markActive {
-					payloadType = xgress.PayloadTypeRtx
-				} else if timeout == 0 {
-					payloadType = xgress.PayloadTypeFwd
-				}
-				if err := dst.SendPayload(payload, timeout, payloadType); err != nil {
-					return err
-				}
-				log.WithFields(payload.GetLoggerFields()).Debugf("=> %s", string(dstAddr))
-				return nil
-			} else {
-				return fmt.Errorf("cannot forward payload, no destination for circuit=%v src=%v dst=%v", circuitId, srcAddr, dstAddr)
+			payloadType := xgress.PayloadTypeXg
+			if !markActive {
+				payloadType = xgress.PayloadTypeRtx
+			} else if timeout == 0 {
+				payloadType = xgress.PayloadTypeFwd
+			}
+			if altAddr, found := forwardTable.getAlternateForwardAddress(srcAddr); found {
+				return forwarder.forwardMultipathPayload(forwardTable, srcAddr, dstAddr, altAddr, payload, timeout, payloadType)
 			}
+			return forwarder.sendPayload(srcAddr, dstAddr, payload, timeout, payloadType, forwardTable.getPriority())
 		} else {
 			return fmt.Errorf("cannot forward payload, no destination address for circuit=%v src=%v", circuitId, srcAddr)
 		}
@@ -200,12 +219,63 @@ func (forwarder *Forwarder) forwardPayload(srcAddr xgress.Address, payload *xgre
 	}
 }
 
+// forwardMultipathPayload forwards a payload from a source with an alternate destination. In duplicate mode the payload
+// is sent to both destinations, while in stripe mode it's sent to each in turn. Either way, the payload is only
+// dropped if it can't be sent to either destination, so that the circuit keeps working while one path is rerouted.
+func (forwarder *Forwarder) forwardMultipathPayload(ft *forwardTable, srcAddr, dstAddr, altAddr xgress.Address, payload *xgress.Payload, timeout time.Duration, payloadType xgress.PayloadType) error {
+	stripe := ft.getMultipath() == ctrl_pb.MultipathMode_MultipathStripe
+	if stripe && ft.nextStripe() {
+		dstAddr, altAddr = altAddr, dstAddr
+	}
+
+	err := forwarder.sendPayload(srcAddr, dstAddr, payload, timeout, payloadType, ft.getPriority())
+	if err == nil && stripe {
+		return nil
+	}
+
+	altErr := forwarder.sendPayload(srcAddr, altAddr, payload, timeout, payloadType, ft.getPriority())
+	if err == nil || altErr == nil {
+		return nil
+	}
+	return errors.Join(err, altErr)
+}
+
+// sendPayload sends a payload to the given destination. Links which support it are given the priority class of the
+// circuit, so they can schedule the payload ahead of or behind the payloads of other circuits.
+func (forwarder *Forwarder) sendPayload(srcAddr, dstAddr xgress.Address, payload *xgress.Payload, timeout time.Duration, payloadType xgress.PayloadType, priority ctrl_pb.PriorityClass) error {
+	if dst, found := forwarder.destinations.getDestination(dstAddr); found {
+		var err error
+		if priorityDst, ok := dst.(xlink.PriorityLinkDestination); ok {
+			err = priorityDst.SendPriorityPayload(payload, timeout, payloadType, priority)
+		} else {
+			err = dst.SendPayload(payload, timeout, payloadType)
+		}
+		if err != nil {
+			return err
+		}
+		pfxlog.ContextLogger(string(srcAddr)).WithFields(payload.GetLoggerFields()).Debugf("=> %s", string(dstAddr))
+		return nil
+	}
+	return fmt.Errorf("cannot forward payload, no destination for circuit=%v src=%v dst=%v", payload.GetCircuitId(), srcAddr, dstAddr)
+}
+
+// availableForwardAddress returns the alternate forward address for a source if the destination of the given forward
+// address has gone away. Acknowledgements and control messages for multipath circuits are sent to a single
+// destination, and this keeps them flowing while one of the paths is being rerouted.
+func (forwarder *Forwarder) availableForwardAddress(ft *forwardTable, srcAddr, dstAddr xgress.Address) xgress.Address {
+	if altAddr, found := ft.getAlternateForwardAddress(srcAddr); found && !forwarder.HasDestination(dstAddr) {
+		return altAddr
+	}
+	return dstAddr
+}
+
 func (forwarder *Forwarder) ForwardAcknowledgement(srcAddr xgress.Address, acknowledgement *xgress.Acknowledgement) error {
 	log := pfxlog.ContextLogger(string(srcAddr))
 
 	circuitId := acknowledgement.CircuitId
 	if forwardTable, found := forwarder.circuits.getForwardTable(circuitId, true); found {
 		if dstAddr, found := forwardTable.getForwardAddress(srcAddr); found {
+			dstAddr = forwarder.availableForwardAddress(forwardTable, srcAddr, dstAddr)
 			if dst, found := forwarder.destinations.getDestination(dstAddr); found {
 				if err := dst.SendAcknowledgement(acknowledgement); err != nil {
 					return err
@@ -234,6 +304,7 @@ func (forwarder *Forwarder) ForwardControl(srcAddr xgress.Address, control *xgre
 
 	if forwardTable, found := forwarder.circuits.getForwardTable(circuitId, true); found {
 		if dstAddr, found := forwardTable.getForwardAddress(srcAddr); found {
+			dstAddr = forwarder.availableForwardAddress(forwardTable, srcAddr, dstAddr)
 			if dst, found := forwarder.destinations.getDestination(dstAddr); found {
 				if control.IsTypeTraceRoute() {
 					hops := control.DecrementAndGetHop()
@@ -350,6 +421,18 @@ func (forwarder *Forwarder) InspectCircuits() *inspect.ForwarderCircuits {
 			forwarder.InspectDestination(v, detail)
 		}
 
+		if priority := ft.getPriority(); priority != ctrl_pb.PriorityClass_PriorityBulk {
+			detail.Priority = priority.String()
+		}
+
+		if alternates := ft.alternates.Items(); len(alternates) > 0 {
+			detail.Multipath = ft.getMultipath().String()
+			detail.AlternateRoutes = alternates
+			for _, v := range alternates {
+				forwarder.InspectDestination(v, detail)
+			}
+		}
+
 		result.Circuits[key] = detail
 	})
 	return result
@@ -382,6 +465,12 @@ func (forwarder *Forwarder) InspectCircuit(circuitId string, getRelatedGoroutine
 				dest.InspectCircuit(result)
 			}
 		}
+
+		ft.alternates.IterCb(func(_ string, addr string) {
+			if dest, _ := forwarder.destinations.getDestination(xgress.Address(addr)); dest != nil {
+				dest.InspectCircuit(result)
+			}
+		})
 		return result
 	}
 	return nil
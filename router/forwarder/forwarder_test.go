@@ -0,0 +1,174 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hanzozt/sdk-golang/xgress"
+	"github.com/hanzozt/zt/v2/common/pb/ctrl_pb"
+	"github.com/hanzozt/zt/v2/router/env"
+	"github.com/stretchr/testify/require"
+)
+
+type testDestination struct {
+	payloads []int32
+	acks     int
+}
+
+func (self *testDestination) SendPayload(payload *xgress.Payload, _ time.Duration, _ xgress.PayloadType) error {
+	self.payloads = append(self.payloads, payload.Sequence)
+	return nil
+}
+
+func (self *testDestination) SendAcknowledgement(*xgress.Acknowledgement) error {
+	self.acks++
+	return nil
+}
+
+func (self *testDestination) SendControl(*xgress.Control) error {
+	return nil
+}
+
+func (self *testDestination) InspectCircuit(*xgress.CircuitInspectDetail) {}
+
+func (self *testDestination) GetDestinationType() string {
+	return "test"
+}
+
+func newMultipathTestForwarder(t *testing.T, mode ctrl_pb.MultipathMode) (*Forwarder, *testDestination, *testDestination) {
+	forwarder := NewForwarder(nil, nil, env.DefaultForwarderOptions(), nil)
+
+	linkA := &testDestination{}
+	linkB := &testDestination{}
+	forwarder.destinations.addDestination("link-a", linkA)
+	forwarder.destinations.addDestination("link-b", linkB)
+
+	err := forwarder.Route("ctrl", &ctrl_pb.Route{
+		CircuitId: "circuit",
+		Multipath: mode,
+		Forwards: []*ctrl_pb.Route_Forward{
+			{SrcAddress: "ingress", DstAddress: "link-a", DstType: ctrl_pb.DestType_Link},
+			{SrcAddress: "link-a", DstAddress: "ingress", DstType: ctrl_pb.DestType_Start},
+			{SrcAddress: "ingress", DstAddress: "link-b", DstType: ctrl_pb.DestType_Link},
+			{SrcAddress: "link-b", DstAddress: "ingress", DstType: ctrl_pb.DestType_Start},
+		},
+	})
+	require.NoError(t, err)
+
+	return forwarder, linkA, linkB
+}
+
+func forwardTestPayloads(t *testing.T, forwarder *Forwarder, count int) {
+	for i := 0; i < count; i++ {
+		payload := &xgress.Payload{CircuitId: "circuit", Sequence: int32(i)}
+		require.NoError(t, forwarder.ForwardPayload("ingress", payload, 0))
+	}
+}
+
+func TestForwarderMultipathDuplicate(t *testing.T) {
+	req := require.New(t)
+	forwarder, linkA, linkB := newMultipathTestForwarder(t, ctrl_pb.MultipathMode_MultipathDuplicate)
+
+	forwardTestPayloads(t, forwarder, 3)
+	req.Equal([]int32{0, 1, 2}, linkA.payloads)
+	req.Equal([]int32{0, 1, 2}, linkB.payloads)
+
+	// acks go to a single destination
+	req.NoError(forwarder.ForwardAcknowledgement("ingress", &xgress.Acknowledgement{CircuitId: "circuit"}))
+	req.Equal(1, linkA.acks)
+	req.Equal(0, linkB.acks)
+
+	// losing a path doesn't lose payloads or acks
+	forwarder.destinations.removeDestination("link-a")
+	forwardTestPayloads(t, forwarder, 1)
+	req.Equal([]int32{0, 1, 2, 0}, linkB.payloads)
+	req.NoError(forwarder.ForwardAcknowledgement("ingress", &xgress.Acknowledgement{CircuitId: "circuit"}))
+	req.Equal(1, linkB.acks)
+}
+
+func TestForwarderMultipathStripe(t *testing.T) {
+	req := require.New(t)
+	forwarder, linkA, linkB := newMultipathTestForwarder(t, ctrl_pb.MultipathMode_MultipathStripe)
+
+	forwardTestPayloads(t, forwarder, 4)
+	req.Equal([]int32{0, 2}, linkA.payloads)
+	req.Equal([]int32{1, 3}, linkB.payloads)
+
+	// payloads striped to a lost path are sent over the remaining one
+	forwarder.destinations.removeDestination("link-b")
+	forwardTestPayloads(t, forwarder, 2)
+	req.Equal([]int32{0, 2, 0, 1}, linkA.payloads)
+}
+
+func TestForwarderMultipathCleared(t *testing.T) {
+	req := require.New(t)
+	forwarder, linkA, linkB := newMultipathTestForwarder(t, ctrl_pb.MultipathMode_MultipathDuplicate)
+
+	// a single path route replaces the alternate destinations
+	err := forwarder.Route("ctrl", &ctrl_pb.Route{
+		CircuitId: "circuit",
+		Forwards: []*ctrl_pb.Route_Forward{
+			{SrcAddress: "ingress", DstAddress: "link-b", DstType: ctrl_pb.DestType_Link},
+			{SrcAddress: "link-b", DstAddress: "ingress", DstType: ctrl_pb.DestType_Start},
+		},
+	})
+	req.NoError(err)
+
+	forwardTestPayloads(t, forwarder, 2)
+	req.Empty(linkA.payloads)
+	req.Equal([]int32{0, 1}, linkB.payloads)
+}
+
+type testPriorityDestination struct {
+	testDestination
+	priorities []ctrl_pb.PriorityClass
+}
+
+func (self *testPriorityDestination) SendPriorityPayload(payload *xgress.Payload, timeout time.Duration, payloadType xgress.PayloadType, priority ctrl_pb.PriorityClass) error {
+	self.priorities = append(self.priorities, priority)
+	return self.SendPayload(payload, timeout, payloadType)
+}
+
+func TestForwarderPriority(t *testing.T) {
+	req := require.New(t)
+	forwarder := NewForwarder(nil, nil, env.DefaultForwarderOptions(), nil)
+
+	link := &testPriorityDestination{}
+	forwarder.destinations.addDestination("link", link)
+
+	route := &ctrl_pb.Route{
+		CircuitId: "circuit",
+		Priority:  ctrl_pb.PriorityClass_PriorityInteractive,
+		Forwards: []*ctrl_pb.Route_Forward{
+			{SrcAddress: "ingress", DstAddress: "link", DstType: ctrl_pb.DestType_Link},
+			{SrcAddress: "link", DstAddress: "ingress", DstType: ctrl_pb.DestType_Start},
+		},
+	}
+	req.NoError(forwarder.Route("ctrl", route))
+
+	forwardTestPayloads(t, forwarder, 2)
+	req.Equal([]int32{0, 1}, link.payloads)
+	req.Equal([]ctrl_pb.PriorityClass{ctrl_pb.PriorityClass_PriorityInteractive, ctrl_pb.PriorityClass_PriorityInteractive}, link.priorities)
+
+	// reroutes carry the priority class as well
+	route.Priority = ctrl_pb.PriorityClass_PriorityBackground
+	req.NoError(forwarder.Route("ctrl", route))
+	forwardTestPayloads(t, forwarder, 1)
+	req.Equal(ctrl_pb.PriorityClass_PriorityBackground, link.priorities[2])
+}
@@ -30,21 +30,39 @@ import (
 type Scanner struct {
 	ctrls       env.NetworkControllers
 	circuits    *circuitTable
-	interval    time.Duration
-	timeout     time.Duration
+	interval    atomic.Int64
+	timeout     atomic.Int64
+	running     atomic.Bool
 	closeNotify <-chan struct{}
 }
 
 func newScanner(ctrls env.NetworkControllers, options *env.ForwarderOptions, closeNotify <-chan struct{}) *Scanner {
 	s := &Scanner{
 		ctrls:       ctrls,
-		interval:    options.IdleTxInterval,
-		timeout:     options.IdleCircuitTimeout,
 		closeNotify: closeNotify,
 	}
+	s.interval.Store(int64(options.IdleTxInterval))
+	s.timeout.Store(int64(options.IdleCircuitTimeout))
 	return s
 }
 
+// setOptions updates the scan interval and idle timeout, starting the scanner if it's now enabled
+func (self *Scanner) setOptions(options *env.ForwarderOptions) {
+	self.interval.Store(int64(options.IdleTxInterval))
+	self.timeout.Store(int64(options.IdleCircuitTimeout))
+	self.start()
+}
+
+func (self *Scanner) start() {
+	if self.interval.Load() > 0 {
+		if self.running.CompareAndSwap(false, true) {
+			go self.run()
+		}
+	} else {
+		logrus.Warnf("scanner disabled")
+	}
+}
+
 func (self *Scanner) setCircuitTable(circuits *circuitTable) {
 	self.circuits = circuits
 }
@@ -54,8 +72,14 @@ func (self *Scanner) run() {
 	defer logrus.Warn("exited")
 
 	for {
+		interval := time.Duration(self.interval.Load())
+		if interval <= 0 {
+			self.running.Store(false)
+			return
+		}
+
 		select {
-		case <-time.After(self.interval):
+		case <-time.After(interval):
 			self.scan()
 
 		case <-self.closeNotify:
@@ -69,10 +93,11 @@ func (self *Scanner) scan() {
 	logrus.Debugf("scanning [%d] circuits", len(circuits))
 
 	now := time.Now().UnixMilli()
+	timeout := time.Duration(self.timeout.Load())
 	idleCircuits := map[string]map[string]int64{}
 	for circuitId, ft := range circuits {
 		idleTime := time.Duration(now-atomic.LoadInt64(&ft.last)) * time.Millisecond
-		if idleTime > self.timeout {
+		if idleTime > timeout {
 			ctrlMap := idleCircuits[ft.ctrlId]
 			if ctrlMap == nil {
 				ctrlMap = map[string]int64{}
@@ -82,7 +107,7 @@ func (self *Scanner) scan() {
 			logrus.WithField("circuitId", circuitId).
 				WithField("ctrlId", ft.ctrlId).
 				WithField("idleTime", idleTime).
-				WithField("idleThreshold", self.timeout).
+				WithField("idleThreshold", timeout).
 				Warn("circuit exceeds idle threshold")
 		}
 	}
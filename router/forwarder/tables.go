@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/hanzozt/sdk-golang/xgress"
+	"github.com/hanzozt/zt/v2/common/pb/ctrl_pb"
 	"github.com/hanzozt/zt/v2/router/env"
 	"github.com/orcaman/concurrent-map/v2"
 )
@@ -67,20 +68,61 @@ func (st *circuitTable) debug() string {
 	return out
 }
 
-// forwardTable implements a directory of destinations, keyed by source address.
+// forwardTable implements a directory of destinations, keyed by source address. Multipath circuits have a second,
+// alternate destination for the sources at either end of the circuit.
 type forwardTable struct {
 	ctrlId       string
 	last         int64
 	destinations cmap.ConcurrentMap[string, string]
+	alternates   cmap.ConcurrentMap[string, string]
+	multipath    atomic.Int32
+	stripe       atomic.Uint32
+	priority     atomic.Int32
 }
 
 func newForwardTable(ctrlId string) *forwardTable {
 	return &forwardTable{
 		ctrlId:       ctrlId,
 		destinations: cmap.New[string](),
+		alternates:   cmap.New[string](),
 	}
 }
 
+// setMultipath sets the multipath mode from a route. Each route carries the complete set of alternate destinations,
+// so any previous alternates are cleared.
+func (ft *forwardTable) setMultipath(mode ctrl_pb.MultipathMode) {
+	ft.multipath.Store(int32(mode))
+	ft.alternates.Clear()
+}
+
+func (ft *forwardTable) getMultipath() ctrl_pb.MultipathMode {
+	return ctrl_pb.MultipathMode(ft.multipath.Load())
+}
+
+func (ft *forwardTable) setPriority(priority ctrl_pb.PriorityClass) {
+	ft.priority.Store(int32(priority))
+}
+
+func (ft *forwardTable) getPriority() ctrl_pb.PriorityClass {
+	return ctrl_pb.PriorityClass(ft.priority.Load())
+}
+
+// nextStripe returns true for every other call, and is used to alternate payloads between destinations
+func (ft *forwardTable) nextStripe() bool {
+	return ft.stripe.Add(1)%2 == 0
+}
+
+func (ft *forwardTable) setAlternateForwardAddress(src, dst xgress.Address) {
+	ft.alternates.Set(string(src), string(dst))
+}
+
+func (ft *forwardTable) getAlternateForwardAddress(src xgress.Address) (xgress.Address, bool) {
+	if dst, found := ft.alternates.Get(string(src)); found {
+		return xgress.Address(dst), true
+	}
+	return "", false
+}
+
 func (ft *forwardTable) setForwardAddress(src, dst xgress.Address) {
 	ft.destinations.Set(string(src), string(dst))
 }
@@ -97,6 +139,9 @@ func (ft *forwardTable) debug() string {
 	for i := range ft.destinations.IterBuffered() {
 		out += fmt.Sprintf("\t\t@/%s -> @/%s\n", i.Key, i.Val)
 	}
+	for i := range ft.alternates.IterBuffered() {
+		out += fmt.Sprintf("\t\t@/%s -> @/%s (%s)\n", i.Key, i.Val, ft.getMultipath())
+	}
 	return out
 }
 
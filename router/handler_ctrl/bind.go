@@ -53,9 +53,9 @@ func XgressDialerWorker(_ uint32, f func()) {
 
 func NewBindHandler(routerEnv InspectRouterEnv, forwarder *forwarder.Forwarder) (channel.BindHandler, error) {
 	xgDialerPoolConfig := goroutines.PoolConfig{
-		QueueSize:   uint32(forwarder.Options.XgressDial.QueueLength),
+		QueueSize:   uint32(forwarder.GetOptions().XgressDial.QueueLength),
 		MinWorkers:  0,
-		MaxWorkers:  uint32(forwarder.Options.XgressDial.WorkerCount),
+		MaxWorkers:  uint32(forwarder.GetOptions().XgressDial.WorkerCount),
 		IdleTime:    30 * time.Second,
 		CloseNotify: routerEnv.GetCloseNotify(),
 		PanicHandler: func(err interface{}) {
@@ -114,6 +114,7 @@ func (self *bindHandler) BindChannel(binding channel.Binding) error {
 	binding.AddTypedReceiveHandler(newTraceHandler(self.env.GetRouterId(), self.forwarder.TraceController(), binding.GetChannel()))
 	binding.AddTypedReceiveHandler(newInspectHandler(self.env, self.forwarder))
 	binding.AddTypedReceiveHandler(newSettingsHandler(self.env))
+	binding.AddTypedReceiveHandler(newUpdateManagedConfigHandler(self.env))
 	binding.AddTypedReceiveHandler(newFaultHandler(self.env.GetXlinkRegistry()))
 	binding.AddTypedReceiveHandler(self.ctrlAddrChangeHandler)
 
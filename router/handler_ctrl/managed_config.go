@@ -0,0 +1,75 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_ctrl
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/hanzozt/channel/v4"
+	"github.com/hanzozt/channel/v4/protobufs"
+	"github.com/hanzozt/zt/v2/common/pb/ctrl_pb"
+	"github.com/hanzozt/zt/v2/router/env"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+// updateManagedConfigHandler applies managed configs pushed by the controllers and reports the outcome back to the
+// controller which sent them
+type updateManagedConfigHandler struct {
+	env env.RouterEnv
+}
+
+func newUpdateManagedConfigHandler(env env.RouterEnv) channel.TypedReceiveHandler {
+	return &updateManagedConfigHandler{
+		env: env,
+	}
+}
+
+func (handler *updateManagedConfigHandler) ContentType() int32 {
+	return int32(ctrl_pb.ContentType_UpdateManagedConfigType)
+}
+
+func (handler *updateManagedConfigHandler) HandleReceive(msg *channel.Message, ch channel.Channel) {
+	log := pfxlog.ContextLogger(ch.Label()).Entry
+	upd := &ctrl_pb.UpdateManagedConfig{}
+	if err := proto.Unmarshal(msg.Body, upd); err != nil {
+		log.WithError(err).Error("error unmarshalling")
+		return
+	}
+
+	log = log.WithFields(logrus.Fields{
+		"version":  upd.Version,
+		"configId": upd.ConfigId,
+		"ctrlId":   ch.Id(),
+	})
+
+	status := &ctrl_pb.ManagedConfigStatus{
+		Version: upd.Version,
+	}
+
+	changes, err := handler.env.GetManagedConfig().Apply(upd.Version, upd.ConfigId, upd.Config)
+	if err != nil {
+		log.WithError(err).Error("unable to apply managed config")
+		status.Error = err.Error()
+	} else {
+		log.WithField("changes", changes).Info("managed config applied")
+		status.Changes = changes
+	}
+
+	if err = protobufs.MarshalTyped(status).WithTimeout(handler.env.DefaultRequestTimeout()).Send(ch); err != nil {
+		log.WithError(err).Error("error sending managed config status")
+	}
+}
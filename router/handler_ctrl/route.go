@@ -94,14 +94,14 @@ func (rh *routeHandler) HandleReceive(msg *channel.Message, ch channel.Channel)
 		if route.Egress != nil {
 			if rh.forwarder.HasDestination(xgress.Address(route.Egress.Address)) {
 				log.Warnf("destination exists for [%s]", route.Egress.Address)
-				rh.completeRoute(msg, int(route.Attempt), route, nil, log)
+				rh.completeRoute(msg, int(route.Attempt), route, nil, 0, log)
 				return
 			} else {
 				rh.connectEgress(msg, int(route.Attempt), ch, route, ctx, time.Now().Add(time.Duration(route.Timeout)))
 				return
 			}
 		} else {
-			rh.completeRoute(msg, int(route.Attempt), route, nil, log)
+			rh.completeRoute(msg, int(route.Attempt), route, nil, 0, log)
 		}
 	}
 
@@ -113,7 +113,7 @@ func (rh *routeHandler) HandleReceive(msg *channel.Message, ch channel.Channel)
 	}
 }
 
-func (rh *routeHandler) completeRoute(msg *channel.Message, attempt int, route *ctrl_pb.Route, peerData xt.PeerData, log *logrus.Entry) {
+func (rh *routeHandler) completeRoute(msg *channel.Message, attempt int, route *ctrl_pb.Route, peerData xt.PeerData, dialDuration time.Duration, log *logrus.Entry) {
 	if err := rh.forwarder.Route(rh.ch.PeerId(), route); err != nil {
 		rh.fail(msg, attempt, route, err, ctrl_msg.ErrorTypeGeneric, log)
 		return
@@ -125,6 +125,9 @@ func (rh *routeHandler) completeRoute(msg *channel.Message, attempt int, route *
 	for k, v := range peerData {
 		response.Headers[int32(k)] = v
 	}
+	if dialDuration > 0 {
+		response.PutUint64Header(ctrl_msg.RouteResultDialTimeHeader, uint64(dialDuration))
+	}
 
 	response.ReplyTo(msg)
 
@@ -160,14 +163,15 @@ func (rh *routeHandler) connectEgress(msg *channel.Message, attempt int, ch chan
 
 	if factory, err := rh.env.GetXgressRegistry().Factory(route.Egress.Binding); err == nil {
 		if dialer, err := factory.CreateDialer(rh.dialerCfg[route.Egress.Binding]); err == nil {
-			if rh.forwarder.Options.XgressDialDwellTime > 0 {
-				log.Infof("dwelling [%s] on dial", rh.forwarder.Options.XgressDialDwellTime)
-				time.Sleep(rh.forwarder.Options.XgressDialDwellTime)
+			if dwellTime := rh.forwarder.GetOptions().XgressDialDwellTime; dwellTime > 0 {
+				log.Infof("dwelling [%s] on dial", dwellTime)
+				time.Sleep(dwellTime)
 			}
 
 			params := newDialParams(rh.ch.PeerId(), route, rh.env.GetXgressBindHandler(), ctx, deadline)
+			dialStart := time.Now()
 			if peerData, err := dialer.Dial(params); err == nil {
-				rh.completeRoute(msg, attempt, route, peerData, log)
+				rh.completeRoute(msg, attempt, route, peerData, time.Since(dialStart), log)
 			} else {
 				var errCode byte
 
@@ -24,7 +24,8 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-// settingsHandler is a catch-all handler for all settings message (currently only sent on router connect).
+// settingsHandler is a catch-all handler for all settings message (sent on router connect, and when the router's
+// drain state changes).
 type settingsHandler struct {
 	env env.RouterEnv
 }
@@ -46,6 +47,16 @@ func (handler *settingsHandler) HandleReceive(msg *channel.Message, ch channel.C
 			case int32(ctrl_pb.SettingTypes_NewCtrlAddress):
 				newAddress := string(settingValue)
 				handler.env.UpdateCtrlEndpoints([]string{newAddress})
+			case int32(ctrl_pb.SettingTypes_RouterDraining):
+				draining := len(settingValue) > 0 && settingValue[0] == 1
+				if drainState := handler.env.GetDrainState(); drainState.IsDraining() != draining {
+					log.WithField("draining", draining).Info("router drain state changed by controller")
+					drainState.SetDraining(draining)
+				}
+			case int32(ctrl_pb.SettingTypes_SigningCaRollover):
+				if err := handler.env.SigningCaRollover(settingValue); err != nil {
+					log.WithError(err).Error("unable to apply signing CA rollover")
+				}
 			default:
 				log.Error("unknown setting type, ignored")
 			}
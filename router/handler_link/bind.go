@@ -21,13 +21,14 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-func NewBindHandlerFactory(c env.NetworkControllers, f *forwarder.Forwarder, hbo *channel.HeartbeatOptions, mr metrics.Registry, registry xlink.Registry) *bindHandlerFactory {
+func NewBindHandlerFactory(c env.NetworkControllers, f *forwarder.Forwarder, hbo *channel.HeartbeatOptions, probeOptions *env.LinkProbeOptions, mr metrics.Registry, registry xlink.Registry) *bindHandlerFactory {
 	return &bindHandlerFactory{
 		ctrl:             c,
 		forwarder:        f,
 		metricsRegistry:  mr,
 		xlinkRegistry:    registry,
 		heartbeatOptions: hbo,
+		probeOptions:     probeOptions,
 	}
 }
 
@@ -37,6 +38,7 @@ type bindHandlerFactory struct {
 	metricsRegistry  metrics.Registry
 	xlinkRegistry    xlink.Registry
 	heartbeatOptions *channel.HeartbeatOptions
+	probeOptions     *env.LinkProbeOptions
 }
 
 func (self *bindHandlerFactory) NewBindHandler(link xlink.Xlink, latency bool, listenerSide bool) channel.BindHandler {
@@ -106,6 +108,12 @@ func (self *bindHandler) BindChannel(binding channel.Binding) error {
 	}
 	channel.ConfigureHeartbeat(binding, 10*time.Second, time.Second, cb)
 
+	if self.trackLatency && self.probeOptions != nil && self.probeOptions.Enabled {
+		prober := newLinkProber(self.xlink.Id(), binding.GetChannel(), self.probeOptions, self.metricsRegistry)
+		binding.AddCloseHandler(prober)
+		go prober.run()
+	}
+
 	return nil
 }
 
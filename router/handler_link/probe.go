@@ -0,0 +1,238 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_link
+
+import (
+	"crypto/rand"
+	"math"
+	"time"
+
+	"github.com/hanzozt/channel/v4"
+	"github.com/hanzozt/metrics"
+	"github.com/hanzozt/zt/v2/router/env"
+	"github.com/michaelquigley/pfxlog"
+)
+
+// linkProber sends probes over a link to measure packet loss, jitter and available bandwidth. Probes are latency
+// requests, which every router answers, so probing works regardless of the version of the router on the other end.
+//
+// Loss is the fraction of probes in the window which weren't answered within the probe timeout. Jitter is the
+// smoothed variation between consecutive round trip times, calculated as in RFC 3550. Capacity is estimated by
+// comparing the minimum round trip times of regular and padded probes over the window, the difference being the time
+// taken to serialize the padding onto the slowest hop. Available bandwidth is that capacity less the current transmit
+// rate of the link.
+type linkProber struct {
+	linkId         string
+	ch             channel.Channel
+	options        *env.LinkProbeOptions
+	stats          *linkProbeStats
+	padding        []byte
+	txRateMeter    metrics.Meter
+	lossGauge      metrics.Gauge
+	jitterGauge    metrics.Gauge
+	bandwidthGauge metrics.Gauge
+	closeNotify    chan struct{}
+}
+
+func newLinkProber(linkId string, ch channel.Channel, options *env.LinkProbeOptions, registry metrics.Registry) *linkProber {
+	result := &linkProber{
+		linkId:         linkId,
+		ch:             ch,
+		options:        options,
+		stats:          newLinkProbeStats(int(options.Window)),
+		txRateMeter:    registry.Meter("link." + linkId + ".tx.bytesrate"),
+		lossGauge:      registry.Gauge("link." + linkId + ".loss"),
+		jitterGauge:    registry.Gauge("link." + linkId + ".jitter"),
+		bandwidthGauge: registry.Gauge("link." + linkId + ".bandwidth"),
+		closeNotify:    make(chan struct{}),
+	}
+
+	if options.BandwidthEvery > 0 {
+		// random padding, so that compression anywhere along the path doesn't shrink the probe
+		result.padding = make([]byte, options.BandwidthProbeSize)
+		if _, err := rand.Read(result.padding); err != nil {
+			pfxlog.Logger().WithField("linkId", linkId).WithError(err).Error("unable to create bandwidth probe padding, disabling bandwidth probes")
+			result.padding = nil
+		}
+	}
+
+	return result
+}
+
+func (self *linkProber) HandleClose(channel.Channel) {
+	close(self.closeNotify)
+	// the meter is shared with the link's peek handler, the registry reference counts it
+	self.txRateMeter.Dispose()
+	self.lossGauge.Dispose()
+	self.jitterGauge.Dispose()
+	self.bandwidthGauge.Dispose()
+}
+
+func (self *linkProber) run() {
+	log := pfxlog.Logger().WithField("linkId", self.linkId)
+
+	ticker := time.NewTicker(self.options.Interval)
+	defer ticker.Stop()
+
+	var count uint32
+	for {
+		select {
+		case <-ticker.C:
+		case <-self.closeNotify:
+			return
+		}
+
+		count++
+		padded := self.padding != nil && count%self.options.BandwidthEvery == 0
+
+		rtt, err := self.probe(padded)
+		if err != nil {
+			if self.ch.IsClosed() {
+				return
+			}
+			if !channel.IsTimeout(err) {
+				log.WithError(err).Debug("unable to send link probe")
+				continue
+			}
+			self.stats.recordLoss()
+		} else {
+			self.stats.recordRtt(rtt, padded)
+		}
+
+		self.lossGauge.Update(self.stats.lossBasisPoints())
+		self.jitterGauge.Update(self.stats.jitter().Nanoseconds())
+		self.bandwidthGauge.Update(self.stats.availableBandwidth(len(self.padding), int64(self.txRateMeter.Rate1()*8)))
+	}
+}
+
+func (self *linkProber) probe(padded bool) (time.Duration, error) {
+	var body []byte
+	if padded {
+		body = self.padding
+	}
+
+	request := channel.NewMessage(channel.ContentTypeLatencyType, body)
+	start := time.Now()
+	if _, err := request.WithPriority(channel.High).WithTimeout(self.options.Timeout).SendForReply(self.ch); err != nil {
+		return 0, err
+	}
+	return time.Since(start), nil
+}
+
+// linkProbeStats holds the results of the probes in the current window
+type linkProbeStats struct {
+	lost      []bool
+	lostCount int
+	next      int
+	count     int
+
+	lastRtt       time.Duration
+	jitterNanos   float64
+	rtts          []time.Duration
+	paddedRtts    []time.Duration
+	nextRtt       int
+	nextPaddedRtt int
+}
+
+func newLinkProbeStats(window int) *linkProbeStats {
+	return &linkProbeStats{
+		lost:       make([]bool, window),
+		rtts:       make([]time.Duration, 0, window),
+		paddedRtts: make([]time.Duration, 0, window),
+	}
+}
+
+func (self *linkProbeStats) record(lost bool) {
+	if self.count == len(self.lost) {
+		if self.lost[self.next] {
+			self.lostCount--
+		}
+	} else {
+		self.count++
+	}
+
+	self.lost[self.next] = lost
+	if lost {
+		self.lostCount++
+	}
+	self.next = (self.next + 1) % len(self.lost)
+}
+
+func (self *linkProbeStats) recordLoss() {
+	self.record(true)
+}
+
+func (self *linkProbeStats) recordRtt(rtt time.Duration, padded bool) {
+	self.record(false)
+
+	if padded {
+		self.paddedRtts, self.nextPaddedRtt = addSample(self.paddedRtts, self.nextPaddedRtt, rtt)
+		return
+	}
+
+	if self.lastRtt != 0 {
+		delta := math.Abs(float64(rtt - self.lastRtt))
+		self.jitterNanos += (delta - self.jitterNanos) / 16
+	}
+	self.lastRtt = rtt
+	self.rtts, self.nextRtt = addSample(self.rtts, self.nextRtt, rtt)
+}
+
+func addSample(samples []time.Duration, next int, sample time.Duration) ([]time.Duration, int) {
+	if len(samples) < cap(samples) {
+		return append(samples, sample), 0
+	}
+	samples[next] = sample
+	return samples, (next + 1) % len(samples)
+}
+
+// lossBasisPoints returns the fraction of probes lost in the current window, in hundredths of a percent
+func (self *linkProbeStats) lossBasisPoints() int64 {
+	if self.count == 0 {
+		return 0
+	}
+	return int64(self.lostCount * 10_000 / self.count)
+}
+
+func (self *linkProbeStats) jitter() time.Duration {
+	return time.Duration(self.jitterNanos)
+}
+
+// availableBandwidth returns the estimated available bandwidth in bits per second, given the padding size of the
+// bandwidth probes in bytes and the current transmit rate in bits per second. Zero is returned if there isn't enough
+// data for an estimate, so a known estimate is never less than one.
+func (self *linkProbeStats) availableBandwidth(paddingSize int, txRate int64) int64 {
+	if paddingSize == 0 || len(self.rtts) == 0 || len(self.paddedRtts) == 0 {
+		return 0
+	}
+
+	serializationTime := minSample(self.paddedRtts) - minSample(self.rtts)
+	if serializationTime <= 0 {
+		return 0
+	}
+
+	capacity := int64(float64(paddingSize*8) / serializationTime.Seconds())
+	return max(capacity-txRate, 1)
+}
+
+func minSample(samples []time.Duration) time.Duration {
+	result := samples[0]
+	for _, sample := range samples[1:] {
+		result = min(result, sample)
+	}
+	return result
+}
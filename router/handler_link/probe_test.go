@@ -0,0 +1,98 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_link
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hanzozt/metrics"
+	"github.com/hanzozt/zt/v2/router/env"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkProbeStatsLoss(t *testing.T) {
+	req := require.New(t)
+	stats := newLinkProbeStats(4)
+
+	req.Equal(int64(0), stats.lossBasisPoints())
+
+	stats.recordLoss()
+	stats.recordRtt(time.Millisecond, false)
+	req.Equal(int64(5000), stats.lossBasisPoints())
+
+	stats.recordRtt(time.Millisecond, false)
+	stats.recordRtt(time.Millisecond, false)
+	req.Equal(int64(2500), stats.lossBasisPoints())
+
+	// the lost probe drops out of the window
+	stats.recordRtt(time.Millisecond, false)
+	req.Equal(int64(0), stats.lossBasisPoints())
+}
+
+func TestLinkProbeStatsJitter(t *testing.T) {
+	req := require.New(t)
+	stats := newLinkProbeStats(10)
+
+	stats.recordRtt(10*time.Millisecond, false)
+	req.Equal(time.Duration(0), stats.jitter())
+
+	stats.recordRtt(26*time.Millisecond, false)
+	req.Equal(time.Millisecond, stats.jitter())
+
+	// padded probes don't count towards jitter
+	stats.recordRtt(100*time.Millisecond, true)
+	req.Equal(time.Millisecond, stats.jitter())
+}
+
+func TestLinkProbeStatsAvailableBandwidth(t *testing.T) {
+	req := require.New(t)
+	stats := newLinkProbeStats(10)
+
+	req.Equal(int64(0), stats.availableBandwidth(1000, 0))
+
+	stats.recordRtt(12*time.Millisecond, false)
+	stats.recordRtt(10*time.Millisecond, false)
+	req.Equal(int64(0), stats.availableBandwidth(1000, 0))
+
+	// 1000 bytes taking an extra 8ms is 1Mbps
+	stats.recordRtt(18*time.Millisecond, true)
+	stats.recordRtt(25*time.Millisecond, true)
+	req.Equal(int64(1_000_000), stats.availableBandwidth(1000, 0))
+	req.Equal(int64(750_000), stats.availableBandwidth(1000, 250_000))
+	req.Equal(int64(1), stats.availableBandwidth(1000, 2_000_000))
+	req.Equal(int64(0), stats.availableBandwidth(0, 0))
+}
+
+func TestLinkProberDisposesMetricsOnClose(t *testing.T) {
+	req := require.New(t)
+	registry := metrics.NewRegistry("test", nil)
+
+	// the peek handler holds its own reference to the link's transmit meter
+	peekTxMeter := registry.Meter("link.test.tx.bytesrate")
+
+	prober := newLinkProber("test", nil, &env.LinkProbeOptions{Window: 4}, registry)
+	prober.HandleClose(nil)
+
+	req.True(registry.IsValidMetric("link.test.tx.bytesrate"))
+	req.False(registry.IsValidMetric("link.test.loss"))
+	req.False(registry.IsValidMetric("link.test.jitter"))
+	req.False(registry.IsValidMetric("link.test.bandwidth"))
+
+	peekTxMeter.Dispose()
+	req.False(registry.IsValidMetric("link.test.tx.bytesrate"))
+}
@@ -33,6 +33,7 @@ func NewXgressMetrics(registry metrics.UsageRegistry) *XgressMetrics {
 	egressTxMsgMeter := registry.Meter("egress.tx.msgrate")
 	egressRxBytesMeter := registry.Meter("egress.rx.bytesrate")
 	egressRxMsgMeter := registry.Meter("egress.rx.msgrate")
+	ingressShapedBytesMeter := registry.Meter("ingress.shaped.bytesrate")
 
 	ingressTxMsgSizeHistogram := registry.Histogram("ingress.tx.msgsize")
 	ingressRxMsgSizeHistogram := registry.Histogram("ingress.rx.msgsize")
@@ -49,6 +50,8 @@ func NewXgressMetrics(registry metrics.UsageRegistry) *XgressMetrics {
 		egressRxBytesMeter:  egressRxBytesMeter,
 		egressRxMsgMeter:    egressRxMsgMeter,
 
+		ingressShapedBytesMeter: ingressShapedBytesMeter,
+
 		ingressTxMsgSizeHistogram: ingressTxMsgSizeHistogram,
 		ingressRxMsgSizeHistogram: ingressRxMsgSizeHistogram,
 		egressTxMsgSizeHistogram:  egressTxMsgSizeHistogram,
@@ -68,6 +71,8 @@ type XgressMetrics struct {
 	egressRxBytesMeter  metrics.Meter
 	egressRxMsgMeter    metrics.Meter
 
+	ingressShapedBytesMeter metrics.Meter
+
 	ingressTxMsgSizeHistogram metrics.Histogram
 	ingressRxMsgSizeHistogram metrics.Histogram
 	egressTxMsgSizeHistogram  metrics.Histogram
@@ -106,3 +111,8 @@ func (handler *XgressMetrics) Tx(source metrics.UsageSource, originator xgress.O
 		handler.egressTxMsgSizeHistogram.Update(msgSize)
 	}
 }
+
+func (handler *XgressMetrics) Shaped(source metrics.UsageSource, usageType string, size int64) {
+	handler.usageCounter.Update(source, usageType, time.Now(), uint64(size))
+	handler.ingressShapedBytesMeter.Mark(size)
+}
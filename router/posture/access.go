@@ -2,6 +2,7 @@ package posture
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/michaelquigley/pfxlog"
 	"github.com/hanzozt/zt/v2/common"
@@ -23,6 +24,11 @@ func HasAccess(rdm *common.RouterDataModel, identityId string, serviceId string,
 		return nil, &NoPoliciesError{}
 	}
 
+	if err = FilterInactivePolicies(accessPolicies, time.Now()); err != nil {
+		log.WithError(err).Debug("policies provided access but none are currently scheduled")
+		return nil, err
+	}
+
 	grantingPolicy, errs := IsPassing(accessPolicies, data)
 
 	if errs != nil && len(*errs) > 0 {
@@ -34,6 +40,28 @@ func HasAccess(rdm *common.RouterDataModel, identityId string, serviceId string,
 	return grantingPolicy, nil
 }
 
+// FilterInactivePolicies removes policies which are outside their scheduled time windows. If no policies remain,
+// a PolicyScheduleError is returned.
+func FilterInactivePolicies(accessPolicies *common.AccessPolicies, now time.Time) error {
+	var active []*common.ServicePolicy
+	var inactiveIds []string
+
+	for _, policy := range accessPolicies.Policies {
+		if policy.IsActive(now) {
+			active = append(active, policy)
+		} else {
+			inactiveIds = append(inactiveIds, policy.Id)
+		}
+	}
+
+	if len(active) == 0 {
+		return &PolicyScheduleError{PolicyIds: inactiveIds}
+	}
+
+	accessPolicies.Policies = active
+	return nil
+}
+
 func IsPassing(accessPolicies *common.AccessPolicies, data *InstanceData) (*common.ServicePolicy, *PolicyAccessErrors) {
 	errs := &PolicyAccessErrors{}
 
@@ -0,0 +1,74 @@
+package posture
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hanzozt/zt/v2/common/attestation"
+	"github.com/hanzozt/zt/v2/common/pb/edge_ctrl_pb"
+	"github.com/pkg/errors"
+)
+
+type AttestationCheck struct {
+	*edge_ctrl_pb.DataState_PostureCheck
+	*edge_ctrl_pb.DataState_PostureCheck_Attestation
+}
+
+func (m *AttestationCheck) Evaluate(state *InstanceData) *CheckError {
+	now := time.Now()
+
+	if state == nil {
+		return &CheckError{
+			Id:    m.Id,
+			Name:  m.Name,
+			Cause: NilStateError,
+		}
+	}
+
+	if state.AttestedAt == nil {
+		return &CheckError{
+			Id:    m.Id,
+			Name:  m.Name,
+			Cause: errors.New("device has not been attested"),
+		}
+	}
+
+	if m.TimeoutSeconds > 0 {
+		timeout := time.Duration(m.TimeoutSeconds) * time.Second
+		if state.AttestedAt.Add(timeout).Before(now) {
+			return &CheckError{
+				Id:    m.Id,
+				Name:  m.Name,
+				Cause: fmt.Errorf("last device attestation exceeded timeout of %s, last attested at %s, checked at %s", timeout.String(), state.AttestedAt.String(), now.String()),
+			}
+		}
+	}
+
+	if err := m.verifyChain(state.DeviceCertificates, now); err != nil {
+		return &CheckError{
+			Id:    m.Id,
+			Name:  m.Name,
+			Cause: err,
+		}
+	}
+
+	return nil
+}
+
+func (m *AttestationCheck) verifyChain(deviceCertificates [][]byte, now time.Time) error {
+	roots, err := attestation.NewCertPool(m.TrustedCaPem)
+	if err != nil {
+		return errors.Wrap(err, "invalid trusted CA bundle")
+	}
+
+	chain, err := attestation.ParseDerCertificates(deviceCertificates)
+	if err != nil {
+		return errors.Wrap(err, "invalid device certificate")
+	}
+
+	if err = attestation.VerifyChain(roots, chain, now); err != nil {
+		return errors.Wrap(err, "device certificate is not trusted")
+	}
+
+	return nil
+}
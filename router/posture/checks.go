@@ -2,10 +2,12 @@ package posture
 
 import (
 	"bytes"
+	"net/netip"
 	"sync"
 	"time"
 
 	"github.com/michaelquigley/pfxlog"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/hanzozt/foundation/v2/stringz"
 	"github.com/hanzozt/sdk-golang/pb/edge_client_pb"
 	"github.com/hanzozt/zt/v2/common"
@@ -19,7 +21,7 @@ type Cache struct {
 	apiSessionInstances       cmap.ConcurrentMap[string, *Instance]
 	apiSessionInstanceHistory cmap.ConcurrentMap[string, []*InstanceData]
 	updateListeners           []func(data *InstanceData)
-	totpParser                TotpTokenParser
+	tokenParser               TokenParser
 }
 
 // NewCache creates a new posture data cache for managing device state information
@@ -27,15 +29,15 @@ type Cache struct {
 // snapshots to support policy evaluation and audit requirements.
 //
 // Parameters:
-//   - parser: A TOTP token parser implementation, used to verify ToTP tokens on posture response
+//   - parser: A token parser implementation, used to verify TOTP and attestation tokens on posture response
 //
 // Returns:
 //   - *Cache: A new cache instance ready for storing posture responses
-func NewCache(parser TotpTokenParser) *Cache {
+func NewCache(parser TokenParser) *Cache {
 	return &Cache{
 		apiSessionInstances:       cmap.New[*Instance](),
 		apiSessionInstanceHistory: cmap.New[[]*InstanceData](),
-		totpParser:                parser,
+		tokenParser:               parser,
 	}
 }
 
@@ -80,7 +82,7 @@ func (cache *Cache) AddResponses(identityId, apiSessionId string, responses *edg
 
 	updated := false
 	for _, response := range responses.Responses {
-		next := instance.Apply(response, cache.totpParser)
+		next := instance.Apply(response, cache.tokenParser)
 		updated = updated || next
 	}
 
@@ -105,6 +107,31 @@ func (cache *Cache) AddUpdateListener(listener func(data *InstanceData)) {
 	cache.updateListeners = append(cache.updateListeners, listener)
 }
 
+// SetSourceAddress records the address an SDK connected from for an API session, along with the country the address
+// resolves to. If the address changes, registered listeners are notified so that access can be re-evaluated.
+//
+// Parameters:
+//   - identityId: The identity associated with the API session
+//   - apiSessionId: The API session ID the connection was authenticated with
+//   - addr: The source address of the connection
+//   - country: The country the source address resolves to, or an empty string if unknown
+func (cache *Cache) SetSourceAddress(identityId, apiSessionId string, addr netip.Addr, country string) {
+	instance := cache.apiSessionInstances.Upsert(apiSessionId, nil, func(exist bool, valueInMap *Instance, newValue *Instance) *Instance {
+		if !exist {
+			valueInMap = newInstance()
+			valueInMap.ApiSessionId = apiSessionId
+			valueInMap.IdentityId = identityId
+			valueInMap.updatedListeners = []func(data *InstanceData){cache.onUpdate}
+		}
+
+		return valueInMap
+	})
+
+	if instance.setSourceAddress(addr, country) {
+		instance.emitUpdated()
+	}
+}
+
 func (cache *Cache) GetInstance(apiSessionId string) *Instance {
 	result, _ := cache.apiSessionInstances.Get(apiSessionId)
 	return result
@@ -132,6 +159,16 @@ type InstanceData struct {
 	Woken        *edge_client_pb.PostureResponse_Woken
 	ProcessList  *edge_client_pb.PostureResponse_ProcessList
 	PassedMfaAt  *time.Time
+
+	// AttestedAt is the time the controller verified a device attestation for the API session, and
+	// DeviceCertificates the attested device certificate chain, DER encoded and leaf first
+	AttestedAt         *time.Time
+	DeviceCertificates [][]byte
+
+	// SourceAddress is the address the most recent SDK connection for the API session was made from, and
+	// SourceCountry the country it resolves to, if known
+	SourceAddress netip.Addr
+	SourceCountry string
 }
 
 func newInstance() *Instance {
@@ -146,6 +183,15 @@ type TotpTokenParser interface {
 	ParseTotpToken(string) (*common.TotpClaims, error)
 }
 
+type AttestationTokenParser interface {
+	ParseAttestationToken(string) (*common.AttestationClaims, error)
+}
+
+type TokenParser interface {
+	TotpTokenParser
+	AttestationTokenParser
+}
+
 // Apply updates the posture instance with new device state information from a posture response.
 // This function merges the incoming posture data with existing data, only updating fields
 // that are present in the response. Changes are detected by comparing new values with
@@ -156,11 +202,11 @@ type TotpTokenParser interface {
 //
 // Parameters:
 //   - response: The posture response containing updated device state information
-//   - parser: A TOTP token parser implementation, used to verify ToTP tokens on posture response
+//   - parser: A token parser implementation, used to verify TOTP and attestation tokens on posture response
 //
 // Returns:
 //   - bool: True if the posture instance was updated, false if no changes were detected
-func (instance *Instance) Apply(response *edge_client_pb.PostureResponse, parser TotpTokenParser) bool {
+func (instance *Instance) Apply(response *edge_client_pb.PostureResponse, parser TokenParser) bool {
 	instance.lock.Lock()
 	defer instance.lock.Unlock()
 
@@ -197,6 +243,9 @@ func (instance *Instance) Apply(response *edge_client_pb.PostureResponse, parser
 	} else if processList := response.GetProcessList(); isProcessListDifferent(instance.ProcessList, processList) {
 		instance.ProcessList = processList
 		updated = true
+	} else if totpToken := response.GetTotpToken(); totpToken != nil && isAttestationToken(totpToken.Token) {
+		// attestation tokens are controller issued posture tokens, like TOTP tokens, and are delivered the same way
+		updated = instance.applyAttestationToken(totpToken.Token, parser)
 	} else if totpToken := response.GetTotpToken(); totpToken != nil {
 		if totpToken.Token == "" {
 			pfxlog.Logger().Error("received empty totp token for posture response")
@@ -227,6 +276,56 @@ func (instance *Instance) Apply(response *edge_client_pb.PostureResponse, parser
 	return updated
 }
 
+func (instance *Instance) applyAttestationToken(token string, parser AttestationTokenParser) bool {
+	attestationClaims, err := parser.ParseAttestationToken(token)
+	if err != nil {
+		pfxlog.Logger().WithError(err).Error("error parsing attestation token")
+		return false
+	}
+
+	if attestationClaims.IssuedAt == nil {
+		pfxlog.Logger().Error("received attestation token with no issued at time")
+		return false
+	}
+
+	if attestationClaims.ApiSessionId != instance.ApiSessionId {
+		pfxlog.Logger().Errorf("received attestation token for api session %s, but instance is for %s", attestationClaims.ApiSessionId, instance.ApiSessionId)
+		return false
+	}
+
+	attestedAt := attestationClaims.IssuedAt.Time
+	if instance.AttestedAt != nil && !instance.AttestedAt.Before(attestedAt) {
+		return false
+	}
+
+	instance.AttestedAt = &attestedAt
+	instance.DeviceCertificates = attestationClaims.DeviceCertificates
+	return true
+}
+
+// isAttestationToken returns true if the given token claims to be an attestation token. The token is verified
+// when it is parsed.
+func isAttestationToken(token string) bool {
+	claims := &common.AttestationClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		return false
+	}
+	return claims.Type == common.TokenTypeAttestation
+}
+
+func (instance *Instance) setSourceAddress(addr netip.Addr, country string) bool {
+	instance.lock.Lock()
+	defer instance.lock.Unlock()
+
+	if instance.SourceAddress == addr && instance.SourceCountry == country {
+		return false
+	}
+
+	instance.SourceAddress = addr
+	instance.SourceCountry = country
+	return true
+}
+
 func isOsDifferent(old *edge_client_pb.PostureResponse_Os, new *edge_client_pb.PostureResponse_OperatingSystem) bool {
 	if old == nil || old.Os == nil {
 		return true
@@ -303,6 +402,16 @@ func CtrlCheckToLogic(postureCheck *edge_ctrl_pb.DataState_PostureCheck) Checker
 			DataState_PostureCheck:     postureCheck,
 			DataState_PostureCheck_Mfa: subCheck.Mfa,
 		}
+	case *edge_ctrl_pb.DataState_PostureCheck_GeoIp_:
+		return &GeoIpCheck{
+			DataState_PostureCheck:       postureCheck,
+			DataState_PostureCheck_GeoIp: subCheck.GeoIp,
+		}
+	case *edge_ctrl_pb.DataState_PostureCheck_Attestation_:
+		return &AttestationCheck{
+			DataState_PostureCheck:             postureCheck,
+			DataState_PostureCheck_Attestation: subCheck.Attestation,
+		}
 	}
 
 	return nil
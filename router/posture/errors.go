@@ -24,6 +24,16 @@ func (e *NoPoliciesError) Error() string {
 	return "no policies provide access"
 }
 
+// PolicyScheduleError is returned when policies would grant access, but none of them are currently within
+// their scheduled time windows
+type PolicyScheduleError struct {
+	PolicyIds []string
+}
+
+func (e *PolicyScheduleError) Error() string {
+	return fmt.Sprintf("no policies provide access at this time, policies outside of their schedules: %v", e.PolicyIds)
+}
+
 type PolicyAccessError struct {
 	Id     string
 	Name   string
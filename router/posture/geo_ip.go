@@ -0,0 +1,38 @@
+package posture
+
+import (
+	"github.com/hanzozt/zt/v2/common/geoip"
+	"github.com/hanzozt/zt/v2/common/pb/edge_ctrl_pb"
+)
+
+type GeoIpCheck struct {
+	*edge_ctrl_pb.DataState_PostureCheck
+	*edge_ctrl_pb.DataState_PostureCheck_GeoIp
+}
+
+func (m *GeoIpCheck) Evaluate(state *InstanceData) *CheckError {
+	if state == nil {
+		return &CheckError{
+			Id:    m.Id,
+			Name:  m.Name,
+			Cause: NilStateError,
+		}
+	}
+
+	rules := &geoip.Rules{
+		AllowedCidrs:     m.AllowedCidrs,
+		DeniedCidrs:      m.DeniedCidrs,
+		AllowedCountries: m.AllowedCountries,
+		DeniedCountries:  m.DeniedCountries,
+	}
+
+	if err := rules.Evaluate(state.SourceAddress, state.SourceCountry); err != nil {
+		return &CheckError{
+			Id:    m.Id,
+			Name:  m.Name,
+			Cause: err,
+		}
+	}
+
+	return nil
+}
@@ -103,7 +103,7 @@ type Router struct {
 	shutdownC           chan struct{}
 	shutdownDoneC       chan struct{}
 	isShutdown          atomic.Bool
-	metricsReporter     metrics.Handler
+	metricsReporter     *fabricMetrics.IntervalReporter
 	versionProvider     versions.VersionProvider
 	debugOperations     map[byte]func(c *bufio.ReadWriter) error
 	stateManager        state.Manager
@@ -118,6 +118,8 @@ type Router struct {
 	xgMetrics           *routerMetrics.XgressMetrics
 	healthChecker       gosundheit.Health
 	alertReporter       *alert.Reporter
+	drainState          *env.DrainState
+	managedConfig       *env.ManagedConfig
 }
 
 func (self *Router) NotifyOfReconnect(ch ctrlchan.CtrlChannel) {
@@ -170,6 +172,19 @@ func (self *Router) GetXgressRegistry() *env.Registry {
 	return self.xgRegistry
 }
 
+func (self *Router) GetDrainState() *env.DrainState {
+	return self.drainState
+}
+
+func (self *Router) GetManagedConfig() *env.ManagedConfig {
+	return self.managedConfig
+}
+
+// ManagedConfigApplied updates the metrics report interval from a managed config
+func (self *Router) ManagedConfigApplied(settings *env.ManagedSettings) {
+	self.metricsReporter.SetReportInterval(settings.MetricsReportInterval)
+}
+
 func (self *Router) RenderJsonConfig() (string, error) {
 	jsonMap, err := config.ToJsonCompatibleMap(self.config.Src)
 
@@ -258,6 +273,7 @@ func (self *Router) GetDialHeaders() (channel.Headers, error) {
 	routerMeta := &ctrl_pb.RouterMetadata{
 		Capabilities: []ctrl_pb.RouterCapability{
 			ctrl_pb.RouterCapability_LinkManagement,
+			ctrl_pb.RouterCapability_ManagedConfig,
 		},
 	}
 
@@ -302,9 +318,12 @@ func Create(cfg *env.Config, versionProvider versions.VersionProvider) *Router {
 		ctrlRateLimiter:     command.NewAdaptiveRateLimitTracker(cfg.Ctrl.RateLimit, metricsRegistry, closeNotify),
 		indexWatchers:       env.NewIndexWatchers(),
 		xgMetrics:           routerMetrics.NewXgressMetrics(metricsRegistry),
+		drainState:          env.NewDrainState(),
+		managedConfig:       env.NewManagedConfig(cfg),
 	}
 
 	router.ctrls = env.NewNetworkControllers(router, &cfg.Ctrl.Heartbeats)
+	router.metricsReporter = fabricMetrics.NewIntervalReporter(fabricMetrics.NewControllersReporter(router.ctrls), cfg.Metrics.ReportInterval)
 	router.stateManager = state.NewManager(router)
 	router.certManager = state.NewCertExpirationChecker(router)
 	router.alertReporter = alert.NewAlertReporter(router.ctrls, cfg.Id.Token, 1000, 10)
@@ -313,6 +332,10 @@ func Create(cfg *env.Config, versionProvider versions.VersionProvider) *Router {
 	router.faulter = forwarder.NewFaulter(router, cfg.Forwarder.FaultTxInterval)
 	router.forwarder = forwarder.NewForwarder(metricsRegistry, router.faulter, cfg.Forwarder, closeNotify)
 	router.forwarder.StartScanner(router.ctrls)
+	router.managedConfig.AddListener(router.forwarder)
+	router.managedConfig.AddListener(router.faulter)
+	router.managedConfig.AddListener(router)
+	forwarder.NewDrainReporter(router, router.forwarder)
 
 	var err error
 	router.ctrlBindhandler, err = handler_ctrl.NewBindHandler(router, router.forwarder)
@@ -597,9 +620,9 @@ func linkDialerWorker(_ uint32, f func()) {
 
 func (self *Router) initRateLimiterPool() error {
 	rateLimiterPoolConfig := goroutines.PoolConfig{
-		QueueSize:   uint32(self.forwarder.Options.RateLimiter.QueueLength),
+		QueueSize:   uint32(self.forwarder.GetOptions().RateLimiter.QueueLength),
 		MinWorkers:  0,
-		MaxWorkers:  uint32(self.forwarder.Options.RateLimiter.WorkerCount),
+		MaxWorkers:  uint32(self.forwarder.GetOptions().RateLimiter.WorkerCount),
 		IdleTime:    30 * time.Second,
 		CloseNotify: self.GetCloseNotify(),
 		PanicHandler: func(err interface{}) {
@@ -655,6 +678,10 @@ func (self *Router) NotifyCertsUpdated() {
 	self.certManager.CertsUpdated()
 }
 
+func (self *Router) SigningCaRollover(caPem []byte) error {
+	return self.certManager.SigningCaRollover(caPem)
+}
+
 func (self *Router) registerComponents() error {
 	self.xlinkFactories = make(map[string]xlink.Factory)
 	acceptor := newXlinkAccepter(self.forwarder)
@@ -662,6 +689,7 @@ func (self *Router) registerComponents() error {
 		self.ctrls,
 		self.forwarder,
 		&self.config.Link.Heartbeats,
+		&self.config.Link.Probes,
 		self.metricsRegistry,
 		self.xlinkRegistry,
 	)
@@ -838,8 +866,7 @@ func (self *Router) startControlPlane() error {
 
 	self.ctrls.UpdateControllerEndpoints(endpoints)
 
-	self.metricsReporter = fabricMetrics.NewControllersReporter(self.ctrls)
-	self.metricsRegistry.StartReporting(self.metricsReporter, self.config.Metrics.ReportInterval, self.config.Metrics.MessageQueueSize)
+	self.metricsRegistry.StartReporting(self.metricsReporter, self.metricsReporter.ReportTick(), self.config.Metrics.MessageQueueSize)
 
 	if self.config.Ctrl.StartupTimeout > 0 {
 		time.AfterFunc(self.config.Ctrl.StartupTimeout, func() {
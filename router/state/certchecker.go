@@ -24,6 +24,7 @@ import (
 
 	"github.com/michaelquigley/pfxlog"
 	"github.com/hanzozt/channel/v4"
+	"github.com/hanzozt/foundation/v2/concurrenz"
 	"github.com/hanzozt/identity"
 	"github.com/hanzozt/zt/v2/common/pb/edge_ctrl_pb"
 	controllerEnv "github.com/hanzozt/zt/v2/controller/env"
@@ -56,6 +57,13 @@ type CertExpirationChecker struct {
 	edgeConfig   *routerEnv.EdgeConfig
 	certsUpdated chan struct{}
 
+	// nextSigner is the signer published by the controller during a signing CA rollover. Certificates it didn't sign
+	// are renewed straight away, once
+	nextSigner        atomic.Pointer[x509.Certificate]
+	signerUpdated     chan struct{}
+	rolloverRequested atomic.Bool
+	renewalError      concurrenz.AtomicValue[string]
+
 	isRunning atomic.Bool
 
 	isRequesting    atomic.Bool
@@ -72,11 +80,18 @@ func NewCertExpirationChecker(env CertEnv) *CertExpirationChecker {
 		ctrls:           env.GetNetworkControllers(),
 		edgeConfig:      env.GetConfig().Edge,
 		certsUpdated:    make(chan struct{}, 1),
+		signerUpdated:   make(chan struct{}, 1),
 		timeoutDuration: DefaultTimeoutDuration,
 	}
 
 	ret.extender = ret
 
+	ret.ctrls.AddChangeListener(routerEnv.CtrlEventListenerFunc(func(event routerEnv.CtrlEvent) {
+		if event.Type == routerEnv.ControllerAdded || event.Type == routerEnv.ControllerReconnected {
+			ret.reportStateTo(event.Controller.Channel())
+		}
+	}))
+
 	go func() {
 		if err := ret.Run(); err != nil {
 			pfxlog.Logger().WithError(err).Error("error while running certchecker")
@@ -114,8 +129,10 @@ func (self *CertExpirationChecker) Run() error {
 			select {
 			case <-self.certsUpdated:
 				self.extender.SetIsRequesting(false)
+				self.setRenewalError(nil)
 			case <-time.After(self.timeoutDuration):
 				self.extender.SetIsRequesting(false)
+				self.setRenewalError(errors.New("timed out waiting for renewed certificates"))
 			case <-self.closeNotify:
 				self.isRunning.Store(false)
 				return nil
@@ -139,9 +156,14 @@ func (self *CertExpirationChecker) Run() error {
 		select {
 		case <-self.certsUpdated:
 			self.extender.SetIsRequesting(false)
+			self.setRenewalError(nil)
+		case <-self.signerUpdated:
+			// the wait time is recalculated, as the certificates may need to be renewed by the new signer
 		case <-time.After(durationToWait):
+			self.markRolloverRequested()
 			if err := self.extender.ExtendEnrollment(); err != nil {
 				pfxlog.Logger().Errorf("could not extend enrollment: %v", err)
+				self.setRenewalError(err)
 			}
 		case <-self.closeNotify:
 			return nil
@@ -244,13 +266,22 @@ func (self *CertExpirationChecker) getWaitTime() (time.Duration, error) {
 		return 0, fmt.Errorf("client certificate has expired")
 	}
 
-	clientExpirationDuration := self.id.Cert().Leaf.NotAfter.Add(-7 * 24 * time.Hour).Sub(now) //1 week before client cert expires
+	if self.needsRolloverRenewal() {
+		return 0, nil
+	}
+
+	leadTime := self.edgeConfig.CertRenewalLeadTime
+	if leadTime <= 0 {
+		leadTime = routerEnv.DefaultCertRenewalLeadTime
+	}
+
+	clientExpirationDuration := self.id.Cert().Leaf.NotAfter.Add(-leadTime).Sub(now)
 
 	if clientExpirationDuration < 0 {
 		return 0, nil
 	}
 
-	serverExpirationDuration := self.id.ServerCert()[0].Leaf.NotAfter.Add(-7 * 24 * time.Hour).Sub(now) // 1 week before server cert expires
+	serverExpirationDuration := self.id.ServerCert()[0].Leaf.NotAfter.Add(-leadTime).Sub(now)
 
 	if serverExpirationDuration < 0 {
 		return 0, nil
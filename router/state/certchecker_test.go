@@ -9,11 +9,14 @@ import (
 	"crypto/x509/pkix"
 	"math/big"
 	"net"
+	"os"
+	"path/filepath"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/hanzozt/channel/v4"
+	nfpem "github.com/hanzozt/foundation/v2/pem"
 	"github.com/hanzozt/foundation/v2/tlz"
 	"github.com/hanzozt/foundation/v2/versions"
 	"github.com/hanzozt/identity"
@@ -235,6 +238,90 @@ func Test_CertExpirationChecker(t *testing.T) {
 			req.GreaterOrEqual(waitTime, 20*time.Second)
 			req.LessOrEqual(waitTime, 30*time.Second)
 		})
+
+		t.Run("both 60d out with 30d lead time is 30d", func(t *testing.T) {
+			req := require.New(t)
+			certChecker, _ := newCertChecker()
+			certChecker.edgeConfig.CertRenewalLeadTime = 30 * 24 * time.Hour
+
+			notAfter := time.Now().Add(60 * 24 * time.Hour).Add(30 * time.Second)
+
+			certChecker.id.Cert().Leaf.NotAfter = notAfter
+			certChecker.id.ServerCert()[0].Leaf.NotAfter = notAfter
+
+			waitTime, err := certChecker.getWaitTime()
+
+			req.NoError(err)
+			req.GreaterOrEqual(waitTime, 30*24*time.Hour)
+			req.LessOrEqual(waitTime, 30*24*time.Hour+30*time.Second)
+		})
+
+		t.Run("certs not signed by next signer is 0, once", func(t *testing.T) {
+			req := require.New(t)
+			certChecker, _ := newCertChecker()
+
+			caCert, _ := newTestCa()
+			certChecker.nextSigner.Store(caCert)
+
+			waitTime, err := certChecker.getWaitTime()
+			req.NoError(err)
+			req.Equal(0*time.Second, waitTime)
+
+			certChecker.markRolloverRequested()
+
+			waitTime, err = certChecker.getWaitTime()
+			req.NoError(err)
+			req.Greater(waitTime, 300*24*time.Hour)
+		})
+	})
+
+	t.Run("SigningCaRollover", func(t *testing.T) {
+		t.Run("adds CA to bundle and requests renewal", func(t *testing.T) {
+			req := require.New(t)
+			certChecker, closeF := newCertChecker()
+			defer closeF()
+
+			caFile := filepath.Join(t.TempDir(), "ca.pem")
+			existingCa, _ := newTestCa()
+			req.NoError(os.WriteFile(caFile, nfpem.EncodeToBytes(existingCa), 0644))
+
+			testIdentity := certChecker.id.Identity.(*SimpleTestIdentity)
+			testIdentity.Config = &identity.Config{CA: caFile}
+
+			caCert, caPem := newTestCa()
+			req.NoError(certChecker.SigningCaRollover(caPem))
+			req.True(testIdentity.reloadCalled)
+
+			bundle, err := os.ReadFile(caFile)
+			req.NoError(err)
+			certs := nfpem.PemBytesToCertificates(bundle)
+			req.Len(certs, 2)
+			req.True(certs[0].Equal(existingCa))
+			req.True(certs[1].Equal(caCert))
+
+			req.Eventually(func() bool {
+				return certChecker.IsRequesting()
+			}, time.Second, 10*time.Millisecond)
+			req.True(certChecker.rolloverRequested.Load())
+
+			// publishing the same signer again doesn't change the bundle or request another renewal
+			testIdentity.reloadCalled = false
+			req.NoError(certChecker.SigningCaRollover(caPem))
+			req.False(testIdentity.reloadCalled)
+			req.True(certChecker.rolloverRequested.Load())
+		})
+
+		t.Run("errors if CA bundle isn't a file", func(t *testing.T) {
+			req := require.New(t)
+			certChecker, closeF := newCertChecker()
+			defer closeF()
+
+			certChecker.id.Identity.(*SimpleTestIdentity).Config = &identity.Config{CA: "pem:"}
+
+			_, caPem := newTestCa()
+			req.Error(certChecker.SigningCaRollover(caPem))
+			req.Nil(certChecker.nextSigner.Load())
+		})
 	})
 
 	t.Run("Run", func(t *testing.T) {
@@ -377,6 +464,7 @@ type SimpleTestIdentity struct {
 	TlsCert             *tls.Certificate
 	TlsServerCert       []*tls.Certificate
 	CertPool            *x509.CertPool
+	Config              *identity.Config
 	reloadCalled        bool
 	setCertCalled       bool
 	setServerCertCalled bool
@@ -470,7 +558,32 @@ func (s *SimpleTestIdentity) SetServerCert(string) error {
 }
 
 func (s *SimpleTestIdentity) GetConfig() *identity.Config {
-	return nil
+	return s.Config
+}
+
+func newTestCa() (*x509.Certificate, []byte) {
+	privateKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().AddDate(5, 0, 0),
+		SerialNumber:          big.NewInt(987654321),
+		Subject:               pkix.Name{CommonName: "test_ca_" + eid.New()},
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	raw, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		panic(err)
+	}
+
+	caCert, err := x509.ParseCertificate(raw)
+	if err != nil {
+		panic(err)
+	}
+
+	return caCert, nfpem.EncodeToBytes(caCert)
 }
 
 func newCertChecker() (*CertExpirationChecker, func()) {
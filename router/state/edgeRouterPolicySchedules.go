@@ -0,0 +1,102 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package state
+
+import (
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/hanzozt/channel/v4"
+	"github.com/hanzozt/zt/v2/common/pb/edge_ctrl_pb"
+	"github.com/hanzozt/zt/v2/common/schedule"
+	"github.com/hanzozt/zt/v2/router/posture"
+	"google.golang.org/protobuf/proto"
+)
+
+// EdgeRouterPolicySchedules holds the schedules of the edge router policies which give identities access to this
+// router, for identities which only have access through policies with schedules. Identities which aren't present
+// aren't restricted by schedules.
+type EdgeRouterPolicySchedules map[string][]*EdgeRouterPolicySchedule
+
+type EdgeRouterPolicySchedule struct {
+	PolicyId string
+	Schedule *schedule.Schedule
+}
+
+func NewEdgeRouterPolicySchedules(msg *edge_ctrl_pb.EdgeRouterPolicySchedules) EdgeRouterPolicySchedules {
+	result := EdgeRouterPolicySchedules{}
+	for identityId, identityPolicies := range msg.Identities {
+		for _, policy := range identityPolicies.Policies {
+			s, err := schedule.Parse(policy.Schedule.ToSpec())
+			if err != nil {
+				pfxlog.Logger().WithError(err).WithField("policyId", policy.Id).
+					Error("invalid edge router policy schedule, policy will not grant access")
+			}
+			result[identityId] = append(result[identityId], &EdgeRouterPolicySchedule{
+				PolicyId: policy.Id,
+				Schedule: s,
+			})
+		}
+	}
+	return result
+}
+
+// CheckAccess returns a PolicyScheduleError if the identity only has access to this router through edge router
+// policies, and none of them are currently in force
+func (self EdgeRouterPolicySchedules) CheckAccess(identityId string, now time.Time) error {
+	policies, found := self[identityId]
+	if !found {
+		return nil
+	}
+
+	var inactiveIds []string
+	for _, policy := range policies {
+		if policy.Schedule.IsActive(now) {
+			return nil
+		}
+		inactiveIds = append(inactiveIds, policy.PolicyId)
+	}
+
+	return &posture.PolicyScheduleError{PolicyIds: inactiveIds}
+}
+
+type edgeRouterPolicySchedulesHandler struct {
+	sm Manager
+}
+
+func NewEdgeRouterPolicySchedulesHandler(sm Manager) *edgeRouterPolicySchedulesHandler {
+	return &edgeRouterPolicySchedulesHandler{
+		sm: sm,
+	}
+}
+
+func (h *edgeRouterPolicySchedulesHandler) ContentType() int32 {
+	return int32(edge_ctrl_pb.ContentType_EdgeRouterPolicySchedulesType)
+}
+
+func (h *edgeRouterPolicySchedulesHandler) HandleReceive(msg *channel.Message, ch channel.Channel) {
+	req := &edge_ctrl_pb.EdgeRouterPolicySchedules{}
+	if err := proto.Unmarshal(msg.Body, req); err != nil {
+		pfxlog.Logger().WithError(err).WithField("ctrlId", ch.Id()).Error("could not unmarshal edge router policy schedules")
+		return
+	}
+
+	pfxlog.Logger().WithField("ctrlId", ch.Id()).WithField("identities", len(req.Identities)).
+		Debug("received edge router policy schedules")
+
+	h.sm.SetEdgeRouterPolicySchedules(NewEdgeRouterPolicySchedules(req))
+}
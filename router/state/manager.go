@@ -22,6 +22,7 @@ import (
 	"crypto/x509"
 	"fmt"
 	"math/rand"
+	"net"
 	"os"
 	"runtime/debug"
 	"strings"
@@ -40,6 +41,7 @@ import (
 	"github.com/hanzozt/sdk-golang/zt/edge"
 	"github.com/hanzozt/zt/v2/common"
 	"github.com/hanzozt/zt/v2/common/eid"
+	"github.com/hanzozt/zt/v2/common/geoip"
 	"github.com/hanzozt/zt/v2/common/metrics"
 	"github.com/hanzozt/zt/v2/common/pb/edge_ctrl_pb"
 	"github.com/hanzozt/zt/v2/common/runner"
@@ -242,6 +244,10 @@ type Manager interface {
 	// the router's posture cache.
 	ProcessPostureResponses(ch channel.Channel, response *edge_client_pb.PostureResponses)
 
+	// ProcessSourceAddress records the source address of an SDK connection in the router's
+	// posture cache, for use by geo ip posture checks.
+	ProcessSourceAddress(apiSessionToken *ApiSessionToken, remoteAddr net.Addr)
+
 	// GetEnv returns the router environment instance.
 	GetEnv() env.RouterEnv
 
@@ -265,7 +271,12 @@ type Manager interface {
 	// HasBindAccess evaluates service binding authorization for an identity.
 	HasBindAccess(identityId, apiSessionId, serviceId string) (*common.ServicePolicy, error)
 
+	// SetEdgeRouterPolicySchedules replaces the edge router policy schedules which restrict the identities that
+	// may use this router.
+	SetEdgeRouterPolicySchedules(schedules EdgeRouterPolicySchedules)
+
 	ParseTotpToken(token string) (*common.TotpClaims, error)
+	ParseAttestationToken(token string) (*common.AttestationClaims, error)
 }
 
 // ConnectionTracker provides visibility into active channel connections,
@@ -318,6 +329,17 @@ func NewManager(stateEnv env.RouterEnv) Manager {
 
 	result.postureCache.AddUpdateListener(result.onPostureDataUpdate)
 	cfg := stateEnv.GetConfig()
+
+	if path := cfg.Edge.GeoIpDatabase; path != "" {
+		geoIpDb, err := geoip.Load(path)
+		if err != nil {
+			pfxlog.Logger().WithError(err).Error("unable to load geoip database, geo ip posture checks will not match countries")
+		} else {
+			pfxlog.Logger().WithField("path", path).WithField("ranges", geoIpDb.Len()).Info("loaded geoip database")
+			result.geoIpDb = geoIpDb
+		}
+	}
+
 	result.LoadRouterModel(stateEnv.GetConfig().Edge.Db)
 
 	stateEnv.GetNetworkControllers().AddChangeListener(env.CtrlEventListenerFunc(func(event env.CtrlEvent) {
@@ -391,9 +413,17 @@ func (self *ManagerImpl) HasAccess(identityId, apiSessionId, serviceId string, p
 		data = &instance.InstanceData
 	}
 
+	if err := self.edgeRouterPolicySchedules.Load().CheckAccess(identityId, time.Now()); err != nil {
+		return nil, err
+	}
+
 	return posture.HasAccess(rdm, identityId, serviceId, data, policyType)
 }
 
+func (self *ManagerImpl) SetEdgeRouterPolicySchedules(schedules EdgeRouterPolicySchedules) {
+	self.edgeRouterPolicySchedules.Store(schedules)
+}
+
 func routerDataModelWorker(_ uint32, f func()) {
 	f()
 }
@@ -446,8 +476,14 @@ type ManagerImpl struct {
 	dataModelSubTimeout   time.Time
 
 	postureCache *posture.Cache
+	geoIpDb      *geoip.Database
 
 	connectionTracker ConnectionTracker
+
+	edgeRouterPolicySchedules concurrenz.AtomicValue[EdgeRouterPolicySchedules]
+
+	// lastScheduleCheck is the minute at which connections were last checked against policy schedules
+	lastScheduleCheck time.Time
 }
 
 func (self *ManagerImpl) ParseTotpToken(jwtStr string) (*common.TotpClaims, error) {
@@ -473,6 +509,29 @@ func (self *ManagerImpl) ParseTotpToken(jwtStr string) (*common.TotpClaims, erro
 	return totpClaims, nil
 }
 
+func (self *ManagerImpl) ParseAttestationToken(jwtStr string) (*common.AttestationClaims, error) {
+	attestationClaims := &common.AttestationClaims{}
+	token, err := jwt.ParseWithClaims(jwtStr, attestationClaims, self.pubKeyLookup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !attestationClaims.HasAudience(common.ClaimAudienceHanzo ZT) && !attestationClaims.HasAudience(common.ClaimLegacyNative) {
+		return nil, fmt.Errorf("provided an attestation token with invalid audience '%s', expected: %s or %s", attestationClaims.Audience, common.ClaimAudienceHanzo ZT, common.ClaimLegacyNative)
+	}
+
+	if attestationClaims.Type != common.TokenTypeAttestation {
+		return nil, fmt.Errorf("provided an attestation token with invalid type '%s' expected '%s'", attestationClaims.Type, common.TokenTypeAttestation)
+	}
+
+	if !token.Valid {
+		return nil, fmt.Errorf("provided attestation token that is not valid")
+	}
+
+	return attestationClaims, nil
+}
+
 // SetConnectionTracker registers the connection tracking implementation with the state manager.
 // The connection tracker provides the state manager with visibility into active network
 // connections, enabling policy enforcement and posture checking operations. This dependency
@@ -641,6 +700,26 @@ func (self *ManagerImpl) ProcessPostureResponses(ch channel.Channel, responses *
 	self.postureCache.AddResponses(apiSessionToken.IdentityId, apiSessionToken.Id, responses)
 }
 
+// ProcessSourceAddress records the address an SDK connected from, along with the country it resolves to, in the
+// router's posture cache. Geo ip posture checks are evaluated against the most recent address seen for an API session.
+//
+// Parameters:
+//   - apiSessionToken: The API session the connection was authenticated with
+//   - remoteAddr: The remote address of the connection
+func (self *ManagerImpl) ProcessSourceAddress(apiSessionToken *ApiSessionToken, remoteAddr net.Addr) {
+	if apiSessionToken == nil || remoteAddr == nil {
+		return
+	}
+
+	addr, err := geoip.ParseAddr(remoteAddr.String())
+	if err != nil {
+		pfxlog.Logger().WithError(err).WithField("remoteAddr", remoteAddr.String()).Debug("unable to parse sdk connection source address")
+		return
+	}
+
+	self.postureCache.SetSourceAddress(apiSessionToken.IdentityId, apiSessionToken.Id, addr, self.geoIpDb.Lookup(addr))
+}
+
 // HandleClientApiSessionTokenUpdate propagates JWT token updates to active client
 // connections, ensuring all channels associated with an identity receive the
 // refreshed authentication credentials during token rotation.
@@ -1477,6 +1556,7 @@ func (self *ManagerImpl) BindChannel(binding channel.Binding) error {
 	binding.AddTypedReceiveHandler(NewApiSessionAddedHandler(self, binding))
 	binding.AddTypedReceiveHandler(NewApiSessionRemovedHandler(self))
 	binding.AddTypedReceiveHandler(NewApiSessionUpdatedHandler(self))
+	binding.AddTypedReceiveHandler(NewEdgeRouterPolicySchedulesHandler(self))
 	binding.AddTypedReceiveHandler(NewDataStateHandler(self))
 	binding.AddTypedReceiveHandler(NewDataStateEventHandler(self))
 	binding.AddTypedReceiveHandler(NewValidateDataStateRequestHandler(self, self.env))
@@ -1562,6 +1642,59 @@ func (sm *ManagerImpl) CheckConnections() {
 			}
 		}
 	}
+
+	sm.checkPolicySchedules(now)
+}
+
+// checkPolicySchedules closes connections which are no longer permitted because the schedules of the policies
+// granting them access have closed. Schedules have minute granularity, so this runs at most once a minute, and only if
+// there are scheduled policies. Only connections backed by JWTs are checked, as legacy sessions are removed by the
+// controller.
+func (sm *ManagerImpl) checkPolicySchedules(now time.Time) {
+	minute := now.Truncate(time.Minute)
+	if !minute.After(sm.lastScheduleCheck) {
+		return
+	}
+	sm.lastScheduleCheck = minute
+
+	rdm := sm.routerDataModel.Load()
+	hasServicePolicySchedules := rdm != nil && rdm.HasScheduledServicePolicies()
+	if !hasServicePolicySchedules && len(sm.edgeRouterPolicySchedules.Load()) == 0 {
+		return
+	}
+
+	for _, channels := range sm.connectionTracker.GetChannels() {
+		for _, ch := range channels {
+			if ch.IsClosed() {
+				continue
+			}
+
+			edgeConn, connIdToSink := GetConnProviderAndSinksFromCh(ch)
+
+			for connId, sink := range connIdToSink {
+				connState := sink.GetData()
+
+				if connState == nil || connState.ApiSessionToken == nil || connState.ServiceSessionToken == nil ||
+					connState.ApiSessionToken.Type != ApiSessionTokenJwt {
+					continue
+				}
+
+				_, err := sm.HasAccess(connState.ApiSessionToken.IdentityId, connState.ApiSessionToken.Id, connState.ServiceSessionToken.ServiceId, connState.PolicyType)
+
+				var scheduleErr *posture.PolicyScheduleError
+				if errors.As(err, &scheduleErr) {
+					pfxlog.Logger().WithField("identityId", connState.ApiSessionToken.IdentityId).
+						WithField("serviceId", connState.ServiceSessionToken.ServiceId).
+						WithField("connId", connId).
+						Info("closing connection, policy schedules no longer grant access")
+
+					if closeErr := edgeConn.CloseConn(connId, err.Error()); closeErr != nil {
+						pfxlog.Logger().WithError(closeErr).Error("error closing connection during policy schedule check")
+					}
+				}
+			}
+		}
+	}
 }
 
 // GetConnProviderAndSinksFromCh extracts connection provider and sinks
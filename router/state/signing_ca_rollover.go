@@ -0,0 +1,174 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package state
+
+import (
+	"bytes"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/hanzozt/channel/v4"
+	"github.com/hanzozt/channel/v4/protobufs"
+	nfpem "github.com/hanzozt/foundation/v2/pem"
+	"github.com/hanzozt/identity"
+	"github.com/hanzozt/zt/v2/common/pb/ctrl_pb"
+	"github.com/pkg/errors"
+)
+
+// SigningCaRollover is called when the controller publishes the chain of the signer which will issue router
+// certificates going forward. The chain is added to the router's trusted CAs, so that certificates issued by either
+// signer are accepted during the rollover, and the router's own certificates are renewed if the new signer didn't
+// issue them.
+func (self *CertExpirationChecker) SigningCaRollover(caPem []byte) error {
+	certs := nfpem.PemBytesToCertificates(caPem)
+	if len(certs) == 0 {
+		return errors.New("no certificates found in signing CA rollover")
+	}
+
+	if err := self.trustCas(certs); err != nil {
+		return err
+	}
+
+	signer := certs[0]
+	if current := self.nextSigner.Load(); current != nil && current.Equal(signer) {
+		return nil
+	}
+
+	pfxlog.Logger().WithField("signer", signer.Subject.String()).Info("controller published next signing CA")
+
+	self.nextSigner.Store(signer)
+	self.rolloverRequested.Store(false)
+
+	select {
+	case self.signerUpdated <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// needsRolloverRenewal returns true if a next signer has been published and didn't issue the router's certificates.
+// Renewal is only requested once per signer, so a controller which doesn't sign with the next signer doesn't cause
+// continual renewals.
+func (self *CertExpirationChecker) needsRolloverRenewal() bool {
+	signer := self.nextSigner.Load()
+	if signer == nil || self.rolloverRequested.Load() {
+		return false
+	}
+
+	return self.id.Cert().Leaf.CheckSignatureFrom(signer) != nil ||
+		self.id.ServerCert()[0].Leaf.CheckSignatureFrom(signer) != nil
+}
+
+// markRolloverRequested records that the renewal about to be requested is for the next signer, if it is
+func (self *CertExpirationChecker) markRolloverRequested() {
+	if self.needsRolloverRenewal() {
+		pfxlog.Logger().WithField("signer", self.nextSigner.Load().Subject.String()).
+			Info("certificates not issued by next signing CA, renewing")
+		self.rolloverRequested.Store(true)
+	}
+}
+
+// trustCas adds the given certificates to the router's CA bundle, if they aren't already in it, and reloads the
+// router identity
+func (self *CertExpirationChecker) trustCas(certs []*x509.Certificate) error {
+	path, ok := identity.IsFile(self.id.GetConfig().CA)
+	if !ok {
+		return fmt.Errorf("unable to add signing CA to router CA bundle, the bundle isn't stored in a file (%s)", self.id.GetConfig().CA)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("unable to read router CA bundle '%s' (%w)", path, err)
+	}
+
+	current, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("unable to read router CA bundle '%s' (%w)", path, err)
+	}
+
+	existing := nfpem.PemBytesToCertificates(current)
+
+	updated := bytes.NewBuffer(current)
+	added := 0
+	for _, cert := range certs {
+		found := false
+		for _, existingCert := range existing {
+			if existingCert.Equal(cert) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			if updated.Len() > 0 && !bytes.HasSuffix(updated.Bytes(), []byte("\n")) {
+				updated.WriteString("\n")
+			}
+			updated.Write(nfpem.EncodeToBytes(cert))
+			added++
+		}
+	}
+
+	if added == 0 {
+		return nil
+	}
+
+	if err = os.WriteFile(path, updated.Bytes(), info.Mode().Perm()); err != nil {
+		return fmt.Errorf("unable to write router CA bundle '%s' (%w)", path, err)
+	}
+
+	pfxlog.Logger().WithField("path", path).WithField("added", added).Info("added signing CA to router CA bundle")
+
+	return self.id.Reload()
+}
+
+func (self *CertExpirationChecker) setRenewalError(err error) {
+	msg := ""
+	if err != nil {
+		msg = err.Error()
+	}
+	self.renewalError.Store(msg)
+	self.ReportState()
+}
+
+// ReportState sends the router's certificates and the outcome of the last renewal to all connected controllers
+func (self *CertExpirationChecker) ReportState() {
+	self.ctrls.ForEach(func(_ string, ch channel.Channel) {
+		self.reportStateTo(ch)
+	})
+}
+
+func (self *CertExpirationChecker) reportStateTo(ch channel.Channel) {
+	if ch == nil || ch.IsClosed() {
+		return
+	}
+
+	status := &ctrl_pb.CertStatus{
+		ClientCert:   self.id.Cert().Leaf.Raw,
+		RenewalError: self.renewalError.Load(),
+	}
+
+	if serverCerts := self.id.ServerCert(); len(serverCerts) > 0 && serverCerts[0].Leaf != nil {
+		status.ServerCert = serverCerts[0].Leaf.Raw
+	}
+
+	if err := protobufs.MarshalTyped(status).WithTimeout(DefaultTimeoutDuration).Send(ch); err != nil {
+		pfxlog.Logger().WithError(err).WithField("ctrlId", ch.Id()).Debug("unable to send cert status")
+	}
+}
@@ -0,0 +1,300 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress_common
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hanzozt/metrics"
+	"github.com/hanzozt/sdk-golang/xgress"
+	"github.com/hanzozt/zt/v2/common/pb/edge_ctrl_pb"
+	"github.com/hanzozt/zt/v2/common/ratelimit"
+	"github.com/hanzozt/zt/v2/router/env"
+	"github.com/michaelquigley/pfxlog"
+)
+
+var rateLimiters = &rateLimiterRegistry{
+	limiters: map[string]*rateLimiter{},
+}
+
+// WrapRateLimited shapes an ingress connection according to the circuit's rate limit, if there is one.
+//
+// Reads from the client wait on the up bucket after reading, which pushes back on the client. Writes to the client
+// wait on the down bucket before writing, which holds up acks and so closes the xgress window of the sender.
+func WrapRateLimited(conn xgress.Connection, circuitId string, tags map[string]string, rateLimit *edge_ctrl_pb.RateLimit, metrics env.XgressMetrics) xgress.Connection {
+	config, err := ratelimit.FromProto(rateLimit)
+	if err != nil {
+		pfxlog.Logger().WithField("circuitId", circuitId).WithError(err).Error("invalid rate limit, circuit will not be rate limited")
+		return conn
+	}
+
+	if config == nil || config.IsEmpty() {
+		return conn
+	}
+
+	key := rateLimitKey(config, tags)
+	return &rateLimitedConn{
+		Connection:  conn,
+		circuitId:   circuitId,
+		tags:        tags,
+		limiter:     rateLimiters.acquire(key, config),
+		key:         key,
+		metrics:     metrics,
+		closeNotify: make(chan struct{}),
+	}
+}
+
+// rateLimitKey returns the key of the buckets shared by circuits in the config's scope. The config is part of the
+// key, so circuits with different limits never share buckets or change each other's limits. An empty key means the
+// circuit gets its own buckets.
+func rateLimitKey(config *ratelimit.Config, tags map[string]string) string {
+	switch config.GetScope() {
+	case ratelimit.ScopeIdentity:
+		return "identity:" + tags["clientId"] + ":" + tags["serviceId"] + ":" + config.Key()
+	case ratelimit.ScopeService:
+		return "service:" + tags["serviceId"] + ":" + config.Key()
+	}
+	return ""
+}
+
+type rateLimitedConn struct {
+	xgress.Connection
+	circuitId   string
+	tags        map[string]string
+	limiter     *rateLimiter
+	key         string
+	metrics     env.XgressMetrics
+	closed      atomic.Bool
+	closeNotify chan struct{}
+}
+
+func (self *rateLimitedConn) GetIntervalId() string {
+	return self.circuitId
+}
+
+func (self *rateLimitedConn) GetTags() map[string]string {
+	return self.tags
+}
+
+func (self *rateLimitedConn) ReadPayload() ([]byte, map[uint8][]byte, error) {
+	data, headers, err := self.Connection.ReadPayload()
+	if len(data) > 0 {
+		self.wait(self.limiter.up, len(data), env.UsageTypeIngressShapedRx)
+	}
+	return data, headers, err
+}
+
+func (self *rateLimitedConn) WritePayload(data []byte, headers map[uint8][]byte) (int, error) {
+	if len(data) > 0 {
+		self.wait(self.limiter.down, len(data), env.UsageTypeIngressShapedTx)
+	}
+	return self.Connection.WritePayload(data, headers)
+}
+
+func (self *rateLimitedConn) wait(bucket *ratelimit.Bucket, size int, usageType string) {
+	if bucket == nil {
+		return
+	}
+
+	delay := bucket.Reserve(size, time.Now())
+	if delay <= 0 {
+		return
+	}
+
+	if self.metrics != nil {
+		self.metrics.Shaped(self, usageType, int64(size))
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-self.closeNotify:
+	}
+}
+
+func (self *rateLimitedConn) FlowFromFabricToXgressClosed() {
+	if signalConn, ok := self.Connection.(xgress.SignalConnection); ok {
+		signalConn.FlowFromFabricToXgressClosed()
+	}
+}
+
+func (self *rateLimitedConn) Close() error {
+	if self.closed.CompareAndSwap(false, true) {
+		close(self.closeNotify)
+		rateLimiters.release(self.key, self.limiter)
+	}
+	return self.Connection.Close()
+}
+
+// AckRateLimiter shapes circuits where the SDK runs the xgress, so there's no ingress connection on the router to
+// wrap. Payloads reserve capacity as they pass through the router and the acks for them are held back until the
+// reservation is due, which closes the xgress window of the sender without blocking the shared edge connection.
+type AckRateLimiter struct {
+	source    metrics.UsageSource
+	limiter   *rateLimiter
+	key       string
+	metrics   env.XgressMetrics
+	upReady   atomic.Int64
+	downReady atomic.Int64
+	closed    atomic.Bool
+}
+
+// NewAckRateLimiter returns a rate limiter for an sdk flow-control circuit, or nil if the circuit isn't rate limited
+func NewAckRateLimiter(source metrics.UsageSource, rateLimit *edge_ctrl_pb.RateLimit, xgressMetrics env.XgressMetrics) *AckRateLimiter {
+	config, err := ratelimit.FromProto(rateLimit)
+	if err != nil {
+		pfxlog.Logger().WithField("circuitId", source.GetIntervalId()).WithError(err).Error("invalid rate limit, circuit will not be rate limited")
+		return nil
+	}
+
+	if config == nil || config.IsEmpty() {
+		return nil
+	}
+
+	key := rateLimitKey(config, source.GetTags())
+	return &AckRateLimiter{
+		source:  source,
+		limiter: rateLimiters.acquire(key, config),
+		key:     key,
+		metrics: xgressMetrics,
+	}
+}
+
+// PayloadFromClient reserves up capacity for a payload received from the sdk
+func (self *AckRateLimiter) PayloadFromClient(size int) {
+	self.reserve(self.limiter.up, &self.upReady, size, env.UsageTypeIngressShapedRx)
+}
+
+// PayloadToClient reserves down capacity for a payload sent to the sdk
+func (self *AckRateLimiter) PayloadToClient(size int) {
+	self.reserve(self.limiter.down, &self.downReady, size, env.UsageTypeIngressShapedTx)
+}
+
+// AckToClient calls send once the payloads received from the sdk so far are within the up limit
+func (self *AckRateLimiter) AckToClient(send func()) {
+	self.delay(&self.upReady, send)
+}
+
+// AckFromClient calls send once the payloads sent to the sdk so far are within the down limit
+func (self *AckRateLimiter) AckFromClient(send func()) {
+	self.delay(&self.downReady, send)
+}
+
+func (self *AckRateLimiter) reserve(bucket *ratelimit.Bucket, ready *atomic.Int64, size int, usageType string) {
+	if bucket == nil || size == 0 {
+		return
+	}
+
+	now := time.Now()
+	delay := bucket.Reserve(size, now)
+	if delay <= 0 {
+		return
+	}
+
+	if self.metrics != nil {
+		self.metrics.Shaped(self.source, usageType, int64(size))
+	}
+
+	readyAt := now.Add(delay).UnixNano()
+	for {
+		current := ready.Load()
+		if current >= readyAt || ready.CompareAndSwap(current, readyAt) {
+			return
+		}
+	}
+}
+
+func (self *AckRateLimiter) delay(ready *atomic.Int64, send func()) {
+	delay := time.Until(time.Unix(0, ready.Load()))
+	if delay <= 0 || self.closed.Load() {
+		send()
+		return
+	}
+	time.AfterFunc(delay, func() {
+		if !self.closed.Load() {
+			send()
+		}
+	})
+}
+
+// Close releases the limiter's buckets. Acks still being held back are dropped.
+func (self *AckRateLimiter) Close() {
+	if self.closed.CompareAndSwap(false, true) {
+		rateLimiters.release(self.key, self.limiter)
+	}
+}
+
+// rateLimiter holds the buckets for a circuit, or for the circuits sharing a limit. The buckets are fixed when the
+// limiter is created, since circuits only share a limiter if they have the same config.
+type rateLimiter struct {
+	up       *ratelimit.Bucket
+	down     *ratelimit.Bucket
+	refCount int
+}
+
+func newRateLimiter(config *ratelimit.Config) *rateLimiter {
+	result := &rateLimiter{}
+	if config.Up != nil {
+		result.up = ratelimit.NewBucket(config.Up)
+	}
+	if config.Down != nil {
+		result.down = ratelimit.NewBucket(config.Down)
+	}
+	return result
+}
+
+// rateLimiterRegistry tracks shared rate limiters by key. When a config changes, new circuits get a new key and so
+// new buckets, while existing circuits keep the limits they were created with.
+type rateLimiterRegistry struct {
+	lock     sync.Mutex
+	limiters map[string]*rateLimiter
+}
+
+func (self *rateLimiterRegistry) acquire(key string, config *ratelimit.Config) *rateLimiter {
+	if key == "" {
+		return newRateLimiter(config)
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	result, found := self.limiters[key]
+	if !found {
+		result = newRateLimiter(config)
+		self.limiters[key] = result
+	}
+	result.refCount++
+	return result
+}
+
+func (self *rateLimiterRegistry) release(key string, limiter *rateLimiter) {
+	if key == "" {
+		return
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	limiter.refCount--
+	if limiter.refCount <= 0 && self.limiters[key] == limiter {
+		delete(self.limiters, key)
+	}
+}
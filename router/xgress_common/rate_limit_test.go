@@ -0,0 +1,54 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress_common
+
+import (
+	"testing"
+
+	"github.com/hanzozt/zt/v2/common/ratelimit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiterRegistryKeysByConfig(t *testing.T) {
+	req := require.New(t)
+
+	registry := &rateLimiterRegistry{
+		limiters: map[string]*rateLimiter{},
+	}
+	tags := map[string]string{"clientId": "c1", "serviceId": "s1"}
+
+	slow := &ratelimit.Config{Up: &ratelimit.Limit{BytesPerSecond: 1000}}
+	fast := &ratelimit.Config{Up: &ratelimit.Limit{BytesPerSecond: 1_000_000}}
+
+	slowKey := rateLimitKey(slow, tags)
+	fastKey := rateLimitKey(fast, tags)
+	req.NotEqual(slowKey, fastKey)
+
+	first := registry.acquire(slowKey, slow)
+	second := registry.acquire(slowKey, &ratelimit.Config{Up: &ratelimit.Limit{BytesPerSecond: 1000}})
+	req.Same(first, second)
+
+	other := registry.acquire(fastKey, fast)
+	req.NotSame(first, other)
+
+	registry.release(slowKey, first)
+	registry.release(slowKey, second)
+	registry.release(fastKey, other)
+	req.Empty(registry.limiters)
+
+	req.Empty(rateLimitKey(&ratelimit.Config{Scope: ratelimit.ScopeCircuit, Up: slow.Up}, tags))
+}
@@ -153,6 +153,7 @@ func (self *Acceptor) BindChannel(binding channel.Binding) error {
 
 	identityId := conn.apiSessionToken.ApiSession.IdentityId
 	self.connStateTracker.markConnected(identityId, conn.ch.GetChannel())
+	self.listener.factory.stateManager.ProcessSourceAddress(conn.apiSessionToken, binding.GetChannel().Underlay().GetRemoteAddr())
 
 	binding.AddCloseHandler(channel.CloseHandlerF(func(ch channel.Channel) {
 		self.connectionCount.Add(-1)
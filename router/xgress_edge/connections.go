@@ -168,6 +168,8 @@ type connectionTracker struct {
 	lastFullSync       time.Time
 	queuedEventCounter atomic.Int64
 	stateManager       state.Manager
+	drainState         *env.DrainState
+	idleWhileDraining  map[*edgeClientConn]struct{}
 }
 
 func newConnectionTracker(env env.RouterEnv, stateManager state.Manager) *connectionTracker {
@@ -181,6 +183,7 @@ func newConnectionTracker(env env.RouterEnv, stateManager state.Manager) *connec
 		fullSyncInterval: env.GetConnectEventsConfig().FullSyncInterval,
 		maxQueuedEvents:  env.GetConnectEventsConfig().MaxQueuedEvents,
 		stateManager:     stateManager,
+		drainState:       env.GetDrainState(),
 	}
 
 	go result.runLoop(env.GetCloseNotify())
@@ -198,6 +201,9 @@ func (self *connectionTracker) runLoop(closeNotify <-chan struct{}) {
 	circuitPostDialAccessCheckTicker := time.NewTicker(time.Minute)
 	defer circuitPostDialAccessCheckTicker.Stop()
 
+	drainTicker := time.NewTicker(10 * time.Second)
+	defer drainTicker.Stop()
+
 	for {
 		select {
 		case <-reportTicker.C:
@@ -209,6 +215,8 @@ func (self *connectionTracker) runLoop(closeNotify <-chan struct{}) {
 			self.scanForInactiveStateListeners()
 		case <-circuitPostDialAccessCheckTicker.C:
 			self.scanForCircuitsNeedingPolicyCheck()
+		case <-drainTicker.C:
+			self.closeIdleConnectionsWhileDraining()
 		case <-closeNotify:
 			return
 		}
@@ -228,6 +236,39 @@ func (self *connectionTracker) scanForInactiveStateListeners() {
 	}
 }
 
+// closeIdleConnectionsWhileDraining closes client connections which haven't had any circuits for two consecutive
+// scans while the router is draining, so the clients move to another edge router. Connections with circuits are
+// left open until their circuits finish.
+func (self *connectionTracker) closeIdleConnectionsWhileDraining() {
+	if !self.drainState.IsDraining() {
+		self.idleWhileDraining = nil
+		return
+	}
+
+	idle := map[*edgeClientConn]struct{}{}
+	self.iterateClientConns(func(conn *edgeClientConn) {
+		hasCircuits := false
+		conn.IterateCircuits(func(edgeCircuit) {
+			hasCircuits = true
+		})
+		if !hasCircuits {
+			idle[conn] = struct{}{}
+		}
+	})
+
+	for conn := range idle {
+		if _, wasIdle := self.idleWhileDraining[conn]; wasIdle {
+			pfxlog.Logger().WithField("identityId", conn.getIdentityId()).
+				WithField("channel", conn.ch.GetChannel().Label()).
+				Info("router is draining, closing idle client connection")
+			_ = conn.ch.GetChannel().Close()
+			delete(idle, conn)
+		}
+	}
+
+	self.idleWhileDraining = idle
+}
+
 func (self *connectionTracker) scanForCircuitsNeedingPolicyCheck() {
 	var toCheck []edgeCircuit
 	self.iterateCircuits(func(conn edgeCircuit) {
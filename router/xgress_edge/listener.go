@@ -484,6 +484,7 @@ func (self *edgeClientConn) cleanupXgressCircuit(edgeForwarder *xgEdgeForwarder)
 
 	self.forwarder.EndCircuit(circuitId)
 	self.xgCircuits.Remove(circuitId)
+	edgeForwarder.closeRateLimiter()
 
 	// Notify the controller of the xgress fault
 	fault := &ctrl_pb.Fault{Id: circuitId}
@@ -1291,6 +1292,10 @@ func (self *edgeClientConn) handleXgPayload(msg *channel.Message, _ channel.Chan
 		return
 	}
 
+	if edgeFwd.rateLimiter != nil && !payload.IsRetransmitFlagSet() {
+		edgeFwd.rateLimiter.PayloadFromClient(len(payload.Data))
+	}
+
 	if err = self.forwarder.ForwardPayload(edgeFwd.address, payload, 0); err != nil {
 		if !payload.IsCircuitEndFlagSet() && !payload.IsFlagEOFSet() {
 			pfxlog.Logger().WithFields(payload.GetLoggerFields()).WithError(err).Debug("failed to forward payload")
@@ -1326,7 +1331,18 @@ func (self *edgeClientConn) handleXgAcknowledgement(req *channel.Message, _ chan
 		return
 	}
 
-	if err = self.forwarder.ForwardAcknowledgement(edgeFwd.address, ack); err != nil {
+	if edgeFwd.rateLimiter != nil {
+		edgeFwd.rateLimiter.AckFromClient(func() {
+			self.forwardXgAcknowledgement(edgeFwd, ack)
+		})
+		return
+	}
+
+	self.forwardXgAcknowledgement(edgeFwd, ack)
+}
+
+func (self *edgeClientConn) forwardXgAcknowledgement(edgeFwd *xgEdgeForwarder, ack *xgress.Acknowledgement) {
+	if err := self.forwarder.ForwardAcknowledgement(edgeFwd.address, ack); err != nil {
 		pfxlog.Logger().WithFields(ack.GetLoggerFields()).WithError(err).Error("failed to forward acknowledgement")
 	}
 }
@@ -1391,7 +1407,8 @@ func (self *nonXgConnectHandler) FinishConnect(ctx *connectContext, response *ct
 	ctx.SdkConn.mapResponsePeerData(response.PeerData)
 
 	xgOptions := &ctx.SdkConn.listener.options.Options
-	x := xgress.NewXgress(response.CircuitId, ctx.CtrlId, xgress.Address(response.Address), self.conn, xgress.Initiator, xgOptions, response.Tags)
+	xgPeer := xgress_common.WrapRateLimited(self.conn, response.CircuitId, response.Tags, response.RateLimit, ctx.SdkConn.listener.factory.env.GetXgressMetrics())
+	x := xgress.NewXgress(response.CircuitId, ctx.CtrlId, xgress.Address(response.Address), xgPeer, xgress.Initiator, xgOptions, response.Tags)
 	ctx.SdkConn.listener.bindHandler.HandleXgressBind(x)
 	self.conn.x.Store(x)
 
@@ -1413,6 +1430,7 @@ type xgEdgeForwarder struct {
 	metrics         env.XgressMetrics
 	tags            map[string]string
 	accessCheckDone atomic.Bool
+	rateLimiter     *xgress_common.AckRateLimiter
 }
 
 func (self *xgEdgeForwarder) GetDestinationType() string {
@@ -1455,6 +1473,10 @@ func (self *xgEdgeForwarder) SetPostCreateAccessCheckDone() {
 }
 
 func (self *xgEdgeForwarder) SendPayload(payload *xgress.Payload, timeout time.Duration, _ xgress.PayloadType) error {
+	if self.rateLimiter != nil && !payload.IsRetransmitFlagSet() {
+		self.rateLimiter.PayloadToClient(len(payload.Data))
+	}
+
 	msg := payload.Marshall()
 	msg.PutUint32Header(sdkedge.ConnIdHeader, self.connId)
 	if timeout == 0 {
@@ -1487,6 +1509,18 @@ func (self *xgEdgeForwarder) SendPayload(payload *xgress.Payload, timeout time.D
 }
 
 func (self *xgEdgeForwarder) SendAcknowledgement(ack *xgress.Acknowledgement) error {
+	if self.rateLimiter != nil {
+		self.rateLimiter.AckToClient(func() {
+			if err := self.sendAcknowledgement(ack); err != nil {
+				pfxlog.Logger().WithFields(ack.GetLoggerFields()).WithError(err).Error("failed to send acknowledgement")
+			}
+		})
+		return nil
+	}
+	return self.sendAcknowledgement(ack)
+}
+
+func (self *xgEdgeForwarder) sendAcknowledgement(ack *xgress.Acknowledgement) error {
 	msg := ack.Marshall()
 	msg.PutUint32Header(sdkedge.ConnIdHeader, self.connId)
 	sent, err := self.ch.GetDefaultSender().TrySend(msg)
@@ -1536,7 +1570,11 @@ func (self *xgEdgeForwarder) FinishConnect(ctx *connectContext, response *ctrl_m
 
 	self.circuitId = response.CircuitId
 	self.address = xgress.Address(response.Address)
+
 	self.tags = response.Tags
+
+	// the SDK runs the xgress for these circuits, so there's no ingress connection here to shape
+	self.rateLimiter = xgress_common.NewAckRateLimiter(self, response.RateLimit, self.metrics)
 	self.RegisterRouting()
 
 	connId, _ := ctx.Req.GetUint32Header(sdkedge.ConnIdHeader)
@@ -1569,6 +1607,7 @@ func (self *xgEdgeForwarder) Unrouted() {
 	pfxlog.Logger().WithField("circuitId", self.circuitId).Debug("unroute: start")
 	defer pfxlog.Logger().WithField("circuitId", self.circuitId).Debug("unroute: complete")
 	self.xgCircuits.Remove(self.circuitId)
+	self.closeRateLimiter()
 
 	msg := sdkedge.NewStateClosedMsg(self.connId, "xgress unrouted")
 	err := msg.WithPriority(channel.High).WithTimeout(5 * time.Second).SendAndWaitForWire(self.ch.GetDefaultSender())
@@ -1578,6 +1617,12 @@ func (self *xgEdgeForwarder) Unrouted() {
 	}
 }
 
+func (self *xgEdgeForwarder) closeRateLimiter() {
+	if self.rateLimiter != nil {
+		self.rateLimiter.Close()
+	}
+}
+
 func (self *xgEdgeForwarder) GetTimeOfLastRxFromLink() int64 {
 	return self.lastRx.Load()
 }
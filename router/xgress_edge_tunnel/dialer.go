@@ -30,6 +30,7 @@ import (
 	"github.com/hanzozt/zt/v2/router/xgress_router"
 	"github.com/hanzozt/zt/v2/tunnel"
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 )
 
 func (self *tunneler) IsTerminatorValid(id string, destination string) bool {
@@ -58,6 +59,7 @@ func (self *tunneler) Dial(params xgress_router.DialParams) (xt.PeerData, error)
 	if err != nil {
 		return nil, err
 	}
+	tunnel.SetDialerIdentity(options, getDialerIdentity(circuitId.Data, log))
 
 	//TODO: Figure out timeout
 	conn, halfClose, err := terminator.context.Dial(options)
@@ -87,6 +89,25 @@ func (self *tunneler) Dial(params xgress_router.DialParams) (xt.PeerData, error)
 	return peerData, nil
 }
 
+// getDialerIdentity returns the identity which dialed the circuit, as provided by the controller, or nil if the
+// circuit wasn't dialed by an identity
+func getDialerIdentity(data map[uint32][]byte, log *logrus.Entry) *tunnel.DialerIdentity {
+	dialer, err := ctrl_msg.GetDialerIdentity(data)
+	if err != nil {
+		log.WithError(err).Error("invalid dialer identity")
+	}
+	if dialer == nil {
+		return nil
+	}
+
+	return &tunnel.DialerIdentity{
+		Id:             dialer.Id,
+		Name:           dialer.Name,
+		RoleAttributes: dialer.RoleAttributes,
+		Token:          dialer.Token,
+	}
+}
+
 func (self *tunneler) Inspect(key string, _ time.Duration) any {
 	if key == inspect.ErtTerminatorsKey {
 		return self.hostedServices.Inspect()
@@ -0,0 +1,61 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress_edge_tunnel
+
+import (
+	"testing"
+
+	"github.com/hanzozt/zt/v2/common/ctrl_msg"
+	"github.com/hanzozt/zt/v2/tunnel"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDialerIdentityForTunnelCircuit(t *testing.T) {
+	req := require.New(t)
+	log := logrus.WithField("test", t.Name())
+
+	// peer data as sent by the initiating router's tunneler, which doesn't identify the dialer
+	newPeerData := func() map[uint32][]byte {
+		return map[uint32][]byte{
+			ctrl_msg.InitiatorLocalAddressHeader:  []byte("127.0.0.1:8080"),
+			ctrl_msg.InitiatorRemoteAddressHeader: []byte("127.0.0.1:54321"),
+		}
+	}
+	req.Nil(getDialerIdentity(newPeerData(), log))
+
+	// the controller adds the router's identity, with role attributes and token if the service identifies callers
+	caller := &ctrl_msg.DialerIdentity{
+		Id:             "router1",
+		Name:           "Router 1",
+		RoleAttributes: []string{"east", "edge"},
+		Token:          "token",
+	}
+	peerData := caller.AddToPeerData(newPeerData())
+	req.Equal(&tunnel.DialerIdentity{
+		Id:             "router1",
+		Name:           "Router 1",
+		RoleAttributes: []string{"east", "edge"},
+		Token:          "token",
+	}, getDialerIdentity(peerData, log))
+
+	// otherwise only the id and name are passed on, and anything else in the peer data is dropped
+	peerData[ctrl_msg.DialerIdentityTokenHeader] = []byte("forged")
+	caller = &ctrl_msg.DialerIdentity{Id: "router1", Name: "Router 1", RoleAttributes: []string{"east"}}
+	peerData = caller.AddToPeerData(peerData)
+	req.Equal(&tunnel.DialerIdentity{Id: "router1", Name: "Router 1"}, getDialerIdentity(peerData, log))
+}
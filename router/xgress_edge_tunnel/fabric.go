@@ -70,6 +70,12 @@ func (self *fabricProvider) GetCurrentIdentityWithBackoff() (*rest_model.Identit
 	return self.currentIdentity.Load(), nil
 }
 
+// ProvidesCallerIdentity returns true, since the controller adds the role attributes and a token for the dialing
+// identity to circuits which are hosted by this router
+func (self *fabricProvider) ProvidesCallerIdentity() bool {
+	return true
+}
+
 func (self *fabricProvider) UpdateIdentity(i *rest_model.IdentityDetail) {
 	self.currentIdentity.Store(i)
 }
@@ -140,7 +146,8 @@ func (self *fabricProvider) TunnelService(service tunnel.Service, terminatorInst
 		}
 	}
 
-	x := xgress.NewXgress(response.CircuitId, ctrlCh.PeerId(), xgress.Address(response.Address), xgConn, xgress.Initiator, self.options, response.Tags)
+	xgPeer := xgress_common.WrapRateLimited(xgConn, response.CircuitId, response.Tags, response.RateLimit, self.env.GetXgressMetrics())
+	x := xgress.NewXgress(response.CircuitId, ctrlCh.PeerId(), xgress.Address(response.Address), xgPeer, xgress.Initiator, self.options, response.Tags)
 	self.bindHandler.HandleXgressBind(x)
 	x.Start()
 
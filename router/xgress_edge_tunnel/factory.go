@@ -167,6 +167,7 @@ type Options struct {
 	dnsUpstream      string
 	dnsUnanswerable  string
 	lanIf            string
+	tproxyBackend    string
 	services         []string
 	udpIdleTimeout   time.Duration
 	udpCheckInterval time.Duration
@@ -264,6 +265,14 @@ func (options *Options) load(data xgress.OptionsData) error {
 			}
 		}
 
+		if value, found := data["tproxyBackend"]; found {
+			if strVal, ok := value.(string); ok {
+				options.tproxyBackend = strVal
+			} else {
+				return errors.Errorf(`invalid value '%v' for tproxyBackend, must be a string value`, value)
+			}
+		}
+
 		if value, found := data["udpIdleTimeout"]; found {
 			if strVal, ok := value.(string); ok {
 				dur, err := time.ParseDuration(strVal)
@@ -92,6 +92,7 @@ func (self *tunneler) Start() error {
 
 		tproxyConfig := tproxy.Config{
 			LanIf:            self.listenOptions.lanIf,
+			Backend:          self.listenOptions.tproxyBackend,
 			UDPIdleTimeout:   self.listenOptions.udpIdleTimeout,
 			UDPCheckInterval: self.listenOptions.udpCheckInterval,
 		}
@@ -125,6 +125,11 @@ type LinkDestination interface {
 	GetDestinationType() string
 }
 
+// PriorityLinkDestination is implemented by links which schedule payloads by the priority class of their circuit
+type PriorityLinkDestination interface {
+	SendPriorityPayload(payload *xgress.Payload, timeout time.Duration, payloadType xgress.PayloadType, priority ctrl_pb.PriorityClass) error
+}
+
 type Xlink interface {
 	LinkDestination
 	Key() string
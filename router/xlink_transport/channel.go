@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/hanzozt/channel/v4"
+	"github.com/hanzozt/zt/v2/common/pb/ctrl_pb"
 	"github.com/hanzozt/zt/v2/router/env"
 )
 
@@ -17,7 +18,7 @@ const (
 	ChannelTypeDefault string = "link.default"
 )
 
-func NewBaseLinkChannel(underlay channel.Underlay) *BaseLinkChannel {
+func NewBaseLinkChannel(underlay channel.Underlay, qos *qosConfig) *BaseLinkChannel {
 	senderContext := channel.NewSenderContext()
 
 	defaultMsgChan := make(chan channel.Sendable, 64)
@@ -32,19 +33,37 @@ func NewBaseLinkChannel(underlay channel.Underlay) *BaseLinkChannel {
 		defaultMsgChan: defaultMsgChan,
 		retryMsgChan:   retryMsgChan,
 	}
+
+	// the default queue only carries control messages, so they never wait behind payloads. each priority class gets
+	// its own payload queue
+	var payloadMsgChans [priorityClassCount]chan channel.Sendable
+	for _, class := range strictPriorityOrder {
+		payloadMsgChans[class] = make(chan channel.Sendable, 64)
+		result.payloadSenders[class] = channel.NewSingleChSender(senderContext, payloadMsgChans[class])
+	}
+	result.interactiveMsgChan = payloadMsgChans[ctrl_pb.PriorityClass_PriorityInteractive]
+	result.bulkMsgChan = payloadMsgChans[ctrl_pb.PriorityClass_PriorityBulk]
+	result.backgroundMsgChan = payloadMsgChans[ctrl_pb.PriorityClass_PriorityBackground]
+	result.scheduler = newPayloadScheduler(qos, payloadMsgChans)
+
 	return result
 }
 
 type BaseLinkChannel struct {
 	ch channel.MultiChannel
 	channel.SenderContext
-	ackSender     channel.Sender
-	defaultSender channel.Sender
+	ackSender      channel.Sender
+	defaultSender  channel.Sender
+	payloadSenders [priorityClassCount]channel.Sender
 
-	ackMsgChan     chan channel.Sendable
-	defaultMsgChan chan channel.Sendable
-	retryMsgChan   chan channel.Sendable
-	connIteration  atomic.Uint32
+	ackMsgChan         chan channel.Sendable
+	defaultMsgChan     chan channel.Sendable
+	interactiveMsgChan chan channel.Sendable
+	bulkMsgChan        chan channel.Sendable
+	backgroundMsgChan  chan channel.Sendable
+	retryMsgChan       chan channel.Sendable
+	scheduler          *payloadScheduler
+	connIteration      atomic.Uint32
 }
 
 func (self *BaseLinkChannel) ChannelCreated(ch channel.MultiChannel) {
@@ -59,14 +78,51 @@ func (self *BaseLinkChannel) GetDefaultSender() channel.Sender {
 	return self.defaultSender
 }
 
+func (self *BaseLinkChannel) GetPayloadSender(priority ctrl_pb.PriorityClass) channel.Sender {
+	if priority < 0 || int(priority) >= priorityClassCount {
+		return self.payloadSenders[ctrl_pb.PriorityClass_PriorityBulk]
+	}
+	return self.payloadSenders[priority]
+}
+
 func (self *BaseLinkChannel) GetAckSender() channel.Sender {
 	return self.ackSender
 }
 
+// GetNextMsgDefault returns the next message for a default underlay. Acks and retries are small and time sensitive,
+// so they go first, followed by control messages. Payloads are then taken from the priority class queues by the
+// link's scheduler. If nothing is queued, it waits for the first message to arrive.
 func (self *BaseLinkChannel) GetNextMsgDefault(notifier *channel.CloseNotifier) (channel.Sendable, error) {
+	select {
+	case msg := <-self.ackMsgChan:
+		return msg, nil
+	case msg := <-self.retryMsgChan:
+		return msg, nil
+	default:
+	}
+
+	select {
+	case msg := <-self.defaultMsgChan:
+		return msg, nil
+	default:
+	}
+
+	if msg := self.scheduler.next(); msg != nil {
+		return msg, nil
+	}
+
 	select {
 	case msg := <-self.defaultMsgChan:
 		return msg, nil
+	case msg := <-self.interactiveMsgChan:
+		self.scheduler.sent(ctrl_pb.PriorityClass_PriorityInteractive, msg)
+		return msg, nil
+	case msg := <-self.bulkMsgChan:
+		self.scheduler.sent(ctrl_pb.PriorityClass_PriorityBulk, msg)
+		return msg, nil
+	case msg := <-self.backgroundMsgChan:
+		self.scheduler.sent(ctrl_pb.PriorityClass_PriorityBackground, msg)
+		return msg, nil
 	case msg := <-self.ackMsgChan:
 		return msg, nil
 	case msg := <-self.retryMsgChan:
@@ -124,17 +180,20 @@ func (self *BaseLinkChannel) HandleUnderlayAccepted(ch channel.MultiChannel, und
 
 type DialLinkChannelConfig struct {
 	Dialer                 channel.DialUnderlayFactory
+	AckDialer              channel.DialUnderlayFactory
 	Underlay               channel.Underlay
 	MaxDefaultChannels     int
 	MaxAckChannel          int
 	StartupDelay           time.Duration
+	Qos                    *qosConfig
 	UnderlayChangeCallback func(ch *DialLinkChannel)
 }
 
 func NewDialLinkChannel(config DialLinkChannelConfig) UnderlayHandlerLinkChannel {
 	result := &DialLinkChannel{
-		BaseLinkChannel: *NewBaseLinkChannel(config.Underlay),
+		BaseLinkChannel: *NewBaseLinkChannel(config.Underlay, config.Qos),
 		dialer:          config.Dialer,
+		ackDialer:       config.AckDialer,
 		changeCallback:  config.UnderlayChangeCallback,
 		syncRequired:    map[string]struct{}{},
 		startupDelay:    config.StartupDelay,
@@ -154,6 +213,7 @@ type UnderlayHandlerLinkChannel interface {
 type LinkChannel interface {
 	GetChannel() channel.Channel
 	GetDefaultSender() channel.Sender
+	GetPayloadSender(priority ctrl_pb.PriorityClass) channel.Sender
 	GetAckSender() channel.Sender
 	GetConnStateIteration() uint32
 }
@@ -168,6 +228,7 @@ type StateTrackingLinkChannel interface {
 type DialLinkChannel struct {
 	BaseLinkChannel
 	dialer         channel.DialUnderlayFactory
+	ackDialer      channel.DialUnderlayFactory
 	constraints    channel.UnderlayConstraints
 	changeCallback func(ch *DialLinkChannel)
 	startupDelay   time.Duration
@@ -258,7 +319,11 @@ func (self *DialLinkChannel) DialFailed(ch channel.MultiChannel, _ string, attem
 }
 
 func (self *DialLinkChannel) CreateGroupedUnderlay(groupId string, groupSecret []byte, underlayType string, timeout time.Duration) (channel.Underlay, error) {
-	return self.dialer.CreateWithHeaders(timeout, map[int32][]byte{
+	dialer := self.dialer
+	if underlayType == ChannelTypeAck && self.ackDialer != nil {
+		dialer = self.ackDialer
+	}
+	return dialer.CreateWithHeaders(timeout, map[int32][]byte{
 		channel.TypeHeader:         []byte(underlayType),
 		channel.ConnectionIdHeader: []byte(groupId),
 		channel.GroupSecretHeader:  groupSecret,
@@ -266,9 +331,9 @@ func (self *DialLinkChannel) CreateGroupedUnderlay(groupId string, groupSecret [
 	})
 }
 
-func NewListenerLinkChannel(underlay channel.Underlay) UnderlayHandlerLinkChannel {
+func NewListenerLinkChannel(underlay channel.Underlay, qos *qosConfig) UnderlayHandlerLinkChannel {
 	result := &ListenerLinkChannel{
-		BaseLinkChannel: *NewBaseLinkChannel(underlay),
+		BaseLinkChannel: *NewBaseLinkChannel(underlay, qos),
 	}
 
 	result.constraints.AddConstraint(ChannelTypeDefault, 1, 1)
@@ -299,14 +364,40 @@ func (self *ListenerLinkChannel) MarkLinkStateSynced(string) {
 	// no action required
 }
 
+// singleLinkPayloadPriorities maps payload priority classes onto channel message priorities for links with a single
+// underlay, and for the payload channel of split links. Interactive payloads share the standard priority of control messages, while bulk and background payloads
+// queue behind them
+var singleLinkPayloadPriorities = [priorityClassCount]channel.Priority{
+	ctrl_pb.PriorityClass_PriorityInteractive: channel.Standard,
+	ctrl_pb.PriorityClass_PriorityBulk:        (channel.Standard + channel.Low) / 2,
+	ctrl_pb.PriorityClass_PriorityBackground:  channel.Low,
+}
+
 func NewSingleLinkChannel(ch channel.Channel) LinkChannel {
-	return &SingleLinkChannel{
+	result := &SingleLinkChannel{
 		ch: ch,
 	}
+	for class := range singleLinkPayloadPriorities {
+		result.payloadSenders[class] = newPayloadSender(ch, ctrl_pb.PriorityClass(class))
+	}
+	return result
+}
+
+// newPayloadSender returns a sender which sends payloads on the given channel with the channel priority mapped from
+// the given priority class. Unknown classes are treated as bulk
+func newPayloadSender(ch channel.Sender, priority ctrl_pb.PriorityClass) channel.Sender {
+	if priority < 0 || int(priority) >= priorityClassCount {
+		priority = ctrl_pb.PriorityClass_PriorityBulk
+	}
+	return &prioritySender{
+		Sender:   ch,
+		priority: singleLinkPayloadPriorities[priority],
+	}
 }
 
 type SingleLinkChannel struct {
-	ch channel.Channel
+	ch             channel.Channel
+	payloadSenders [priorityClassCount]channel.Sender
 }
 
 func (self *SingleLinkChannel) InitChannel(channel.MultiChannel) {
@@ -320,6 +411,13 @@ func (self *SingleLinkChannel) GetDefaultSender() channel.Sender {
 	return self.ch
 }
 
+func (self *SingleLinkChannel) GetPayloadSender(priority ctrl_pb.PriorityClass) channel.Sender {
+	if priority < 0 || int(priority) >= priorityClassCount {
+		return self.payloadSenders[ctrl_pb.PriorityClass_PriorityBulk]
+	}
+	return self.payloadSenders[priority]
+}
+
 func (self *SingleLinkChannel) GetAckSender() channel.Sender {
 	return self.ch
 }
@@ -331,3 +429,27 @@ func (self *SingleLinkChannel) MarkLinkStateSynced(string) {
 func (self *SingleLinkChannel) GetConnStateIteration() uint32 {
 	return 1
 }
+
+// prioritySender sends messages with the given channel priority, so the channel's outgoing priority queue orders
+// them by the priority class of their circuit
+type prioritySender struct {
+	channel.Sender
+	priority channel.Priority
+}
+
+func (self *prioritySender) Send(s channel.Sendable) error {
+	return self.Sender.Send(&prioritySendable{Sendable: s, priority: self.priority})
+}
+
+func (self *prioritySender) TrySend(s channel.Sendable) (bool, error) {
+	return self.Sender.TrySend(&prioritySendable{Sendable: s, priority: self.priority})
+}
+
+type prioritySendable struct {
+	channel.Sendable
+	priority channel.Priority
+}
+
+func (self *prioritySendable) Priority() channel.Priority {
+	return self.priority
+}
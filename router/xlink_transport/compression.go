@@ -0,0 +1,425 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xlink_transport
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hanzozt/channel/v4"
+	"github.com/hanzozt/metrics"
+	"github.com/hanzozt/sdk-golang/xgress"
+	"github.com/klauspost/compress/zstd"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/pierrec/lz4/v4"
+	"github.com/pkg/errors"
+)
+
+const (
+	CompressionNone    = "none"
+	CompressionDeflate = "deflate"
+	CompressionZstd    = "zstd"
+	CompressionLz4     = "lz4"
+
+	// PayloadCompressionHeader marks a link payload message as compressed. The value is the id of the codec used. It
+	// sits above the range of xgress payload header keys, so it's never mistaken for one.
+	PayloadCompressionHeader = 2300
+
+	// MinCompressiblePayloadSize is the smallest payload worth compressing. Anything smaller rarely shrinks enough to
+	// cover the cost.
+	MinCompressiblePayloadSize = 256
+
+	// MaxDecompressedPayloadSize bounds the size a compressed payload may expand to
+	MaxDecompressedPayloadSize = 16 * 1024 * 1024
+
+	// compressionFailedContentType is given to payloads which can't be decompressed. No handler is registered for it,
+	// so the payload is dropped rather than being forwarded corrupted, and is recovered by xgress retransmission.
+	compressionFailedContentType = -1100
+)
+
+type compressionCodec interface {
+	Id() byte
+	Name() string
+	Compress(data []byte) ([]byte, error)
+	Decompress(data []byte) ([]byte, error)
+}
+
+var compressionCodecs = map[string]compressionCodec{
+	CompressionDeflate: newDeflateCodec(1),
+	CompressionZstd:    newZstdCodec(),
+	CompressionLz4:     newLz4Codec(),
+}
+
+func supportedCompressionAlgorithms() string {
+	var result []string
+	for algorithm := range compressionCodecs {
+		result = append(result, algorithm)
+	}
+	sort.Strings(result)
+	return strings.Join(result, ", ")
+}
+
+func getCompressionCodecById(id byte) compressionCodec {
+	for _, codec := range compressionCodecs {
+		if codec.Id() == id {
+			return codec
+		}
+	}
+	return nil
+}
+
+// loadCompressionConfig parses the 'compression' setting of a link listener or dialer, which may be a single
+// algorithm or a list of algorithms in order of preference
+func loadCompressionConfig(value interface{}, configType string) ([]string, error) {
+	var algorithms []string
+	if algorithm, ok := value.(string); ok {
+		algorithms = append(algorithms, algorithm)
+	} else if list, ok := value.([]interface{}); ok {
+		for _, algorithm := range list {
+			algorithms = append(algorithms, fmt.Sprint(algorithm))
+		}
+	} else {
+		return nil, fmt.Errorf("invalid 'compression' value in %s config (%s)", configType, reflect.TypeOf(value))
+	}
+
+	var result []string
+	for _, algorithm := range algorithms {
+		algorithm = strings.ToLower(strings.TrimSpace(algorithm))
+		if algorithm == CompressionNone {
+			continue
+		}
+		if _, found := compressionCodecs[algorithm]; !found {
+			return nil, fmt.Errorf("invalid 'compression' value in %s config, unknown algorithm '%s', supported algorithms: %s",
+				configType, algorithm, supportedCompressionAlgorithms())
+		}
+		result = append(result, algorithm)
+	}
+	return result, nil
+}
+
+func putCompressionHeader(headers channel.Headers, algorithms []string) {
+	if len(algorithms) > 0 {
+		headers.PutStringHeader(LinkHeaderCompression, strings.Join(algorithms, ","))
+	}
+}
+
+func getCompressionHeader(headers channel.Headers) []string {
+	if headers == nil {
+		return nil
+	}
+	if val, ok := headers.GetStringHeader(LinkHeaderCompression); ok && val != "" {
+		return strings.Split(val, ",")
+	}
+	return nil
+}
+
+// negotiateCompression picks the first of the dialer's algorithms which the listener also supports. Both ends of a
+// link run this with the same inputs, so they agree without another round trip. Routers which don't support
+// compression don't send the header, so links to them are uncompressed.
+func negotiateCompression(dialerAlgorithms, listenerAlgorithms []string) string {
+	for _, algorithm := range dialerAlgorithms {
+		for _, supported := range listenerAlgorithms {
+			if algorithm == supported {
+				if _, found := compressionCodecs[algorithm]; found {
+					return algorithm
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// bindCompression sets up compression of payloads sent over the given link channel, if an algorithm was negotiated
+func bindCompression(binding channel.Binding, linkId string, algorithm string, registry metrics.Registry) {
+	codec, found := compressionCodecs[algorithm]
+	if !found {
+		return
+	}
+
+	transformer := newCompressionTransformer(linkId, codec, registry)
+	binding.AddTransformHandler(transformer)
+	binding.AddCloseHandler(transformer)
+}
+
+// compressionTransformer compresses xgress payloads as they're written to a link and decompresses them as they're
+// read. Payloads which don't shrink are sent as-is, as are all other messages.
+//
+// The ratio metric records uncompressed size / compressed size, in hundredths. The compress and decompress timers
+// record the time spent compressing and decompressing.
+type compressionTransformer struct {
+	linkId          string
+	codec           compressionCodec
+	ratio           metrics.Histogram
+	compressTimer   metrics.Timer
+	decompressTimer metrics.Timer
+}
+
+func newCompressionTransformer(linkId string, codec compressionCodec, registry metrics.Registry) *compressionTransformer {
+	return &compressionTransformer{
+		linkId:          linkId,
+		codec:           codec,
+		ratio:           registry.Histogram("link." + linkId + ".compression.ratio"),
+		compressTimer:   registry.Timer("link." + linkId + ".compression.compress_time"),
+		decompressTimer: registry.Timer("link." + linkId + ".compression.decompress_time"),
+	}
+}
+
+func (self *compressionTransformer) Tx(m *channel.Message, _ channel.Channel) {
+	if m.ContentType != xgress.ContentTypePayloadType || len(m.Body) < MinCompressiblePayloadSize {
+		return
+	}
+
+	// a message may be retried on another underlay after a failed write, in which case it's already compressed
+	if _, compressed := m.Headers[PayloadCompressionHeader]; compressed {
+		return
+	}
+
+	start := time.Now()
+	compressed, err := self.codec.Compress(m.Body)
+	self.compressTimer.UpdateSince(start)
+
+	if err != nil {
+		pfxlog.Logger().WithField("linkId", self.linkId).WithError(err).Error("unable to compress payload, sending uncompressed")
+		return
+	}
+
+	if len(compressed) >= len(m.Body) {
+		self.ratio.Update(100)
+		return
+	}
+
+	self.ratio.Update(int64(len(m.Body) * 100 / len(compressed)))
+	m.Body = compressed
+	m.PutByteHeader(PayloadCompressionHeader, self.codec.Id())
+}
+
+func (self *compressionTransformer) Rx(m *channel.Message, _ channel.Channel) {
+	id, ok := m.GetByteHeader(PayloadCompressionHeader)
+	if !ok {
+		return
+	}
+	delete(m.Headers, PayloadCompressionHeader)
+
+	log := pfxlog.Logger().WithField("linkId", self.linkId)
+
+	codec := getCompressionCodecById(id)
+	if codec == nil {
+		log.WithField("codecId", id).Error("received payload with unknown compression, dropping")
+		m.ContentType = compressionFailedContentType
+		return
+	}
+
+	start := time.Now()
+	data, err := codec.Decompress(m.Body)
+	self.decompressTimer.UpdateSince(start)
+
+	if err != nil {
+		log.WithError(err).Error("unable to decompress payload, dropping")
+		m.ContentType = compressionFailedContentType
+		return
+	}
+
+	m.Body = data
+}
+
+func (self *compressionTransformer) HandleClose(channel.Channel) {
+	self.ratio.Dispose()
+	self.compressTimer.Dispose()
+	self.decompressTimer.Dispose()
+}
+
+// deflateCodec uses DEFLATE from the standard library. Writers and readers are pooled, since links compress from
+// multiple goroutines and both are expensive to allocate.
+type deflateCodec struct {
+	level   int
+	writers sync.Pool
+	readers sync.Pool
+}
+
+func newDeflateCodec(level int) *deflateCodec {
+	return &deflateCodec{
+		level: level,
+	}
+}
+
+func (self *deflateCodec) Id() byte {
+	return 1
+}
+
+func (self *deflateCodec) Name() string {
+	return CompressionDeflate
+}
+
+func (self *deflateCodec) Compress(data []byte) ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, len(data)/2))
+
+	writer, _ := self.writers.Get().(*flate.Writer)
+	if writer == nil {
+		var err error
+		if writer, err = flate.NewWriter(buf, self.level); err != nil {
+			return nil, err
+		}
+	} else {
+		writer.Reset(buf)
+	}
+	defer self.writers.Put(writer)
+
+	if _, err := writer.Write(data); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (self *deflateCodec) Decompress(data []byte) ([]byte, error) {
+	src := bytes.NewReader(data)
+
+	reader, _ := self.readers.Get().(io.ReadCloser)
+	if reader == nil {
+		reader = flate.NewReader(src)
+	} else if err := reader.(flate.Resetter).Reset(src, nil); err != nil {
+		return nil, err
+	}
+	defer self.readers.Put(reader)
+
+	result, err := io.ReadAll(io.LimitReader(reader, MaxDecompressedPayloadSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(result) > MaxDecompressedPayloadSize {
+		return nil, errors.Errorf("decompressed payload exceeds maximum size of %d bytes", MaxDecompressedPayloadSize)
+	}
+	return result, nil
+}
+
+// zstdCodec uses Zstandard at its fastest level. A single encoder and decoder are shared, since EncodeAll and
+// DecodeAll may be called concurrently.
+type zstdCodec struct {
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+func newZstdCodec() *zstdCodec {
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedFastest), zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		panic(err)
+	}
+	decoder, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(0), zstd.WithDecoderMaxMemory(MaxDecompressedPayloadSize))
+	if err != nil {
+		panic(err)
+	}
+	return &zstdCodec{
+		encoder: encoder,
+		decoder: decoder,
+	}
+}
+
+func (self *zstdCodec) Id() byte {
+	return 2
+}
+
+func (self *zstdCodec) Name() string {
+	return CompressionZstd
+}
+
+func (self *zstdCodec) Compress(data []byte) ([]byte, error) {
+	return self.encoder.EncodeAll(data, make([]byte, 0, len(data)/2)), nil
+}
+
+func (self *zstdCodec) Decompress(data []byte) ([]byte, error) {
+	result, err := self.decoder.DecodeAll(data, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) > MaxDecompressedPayloadSize {
+		return nil, errors.Errorf("decompressed payload exceeds maximum size of %d bytes", MaxDecompressedPayloadSize)
+	}
+	return result, nil
+}
+
+// lz4Codec uses the LZ4 block format, which is cheaper than the frame format for small payloads. The block format
+// doesn't record the uncompressed size, so it's prefixed to the block as a uvarint. Compressors aren't safe for
+// concurrent use, so they're pooled.
+type lz4Codec struct {
+	compressors sync.Pool
+}
+
+func newLz4Codec() *lz4Codec {
+	return &lz4Codec{
+		compressors: sync.Pool{
+			New: func() any {
+				return &lz4.Compressor{}
+			},
+		},
+	}
+}
+
+func (self *lz4Codec) Id() byte {
+	return 3
+}
+
+func (self *lz4Codec) Name() string {
+	return CompressionLz4
+}
+
+func (self *lz4Codec) Compress(data []byte) ([]byte, error) {
+	result := make([]byte, binary.MaxVarintLen64+lz4.CompressBlockBound(len(data)))
+	n := binary.PutUvarint(result, uint64(len(data)))
+
+	compressor := self.compressors.Get().(*lz4.Compressor)
+	defer self.compressors.Put(compressor)
+
+	size, err := compressor.CompressBlock(data, result[n:])
+	if err != nil {
+		return nil, err
+	}
+	if size == 0 {
+		// incompressible, returning the input lets the caller send it as-is
+		return data, nil
+	}
+	return result[:n+size], nil
+}
+
+func (self *lz4Codec) Decompress(data []byte) ([]byte, error) {
+	size, n := binary.Uvarint(data)
+	if n <= 0 {
+		return nil, errors.New("invalid lz4 payload, missing uncompressed size")
+	}
+	if size > MaxDecompressedPayloadSize {
+		return nil, errors.Errorf("decompressed payload exceeds maximum size of %d bytes", MaxDecompressedPayloadSize)
+	}
+
+	result := make([]byte, size)
+	decompressed, err := lz4.UncompressBlock(data[n:], result)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(decompressed) != size {
+		return nil, errors.Errorf("invalid lz4 payload, expected %d bytes, got %d", size, decompressed)
+	}
+	return result, nil
+}
@@ -0,0 +1,149 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xlink_transport
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/hanzozt/channel/v4"
+	"github.com/hanzozt/metrics"
+	"github.com/hanzozt/sdk-golang/xgress"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadCompressionConfig(t *testing.T) {
+	req := require.New(t)
+
+	algorithms, err := loadCompressionConfig("deflate", "dialer")
+	req.NoError(err)
+	req.Equal([]string{CompressionDeflate}, algorithms)
+
+	algorithms, err = loadCompressionConfig([]interface{}{"none"}, "dialer")
+	req.NoError(err)
+	req.Empty(algorithms)
+
+	algorithms, err = loadCompressionConfig([]interface{}{"zstd", "LZ4", "deflate"}, "listener")
+	req.NoError(err)
+	req.Equal([]string{CompressionZstd, CompressionLz4, CompressionDeflate}, algorithms)
+
+	_, err = loadCompressionConfig("gzip", "listener")
+	req.ErrorContains(err, "unknown algorithm 'gzip', supported algorithms: deflate, lz4, zstd")
+
+	_, err = loadCompressionConfig(1, "listener")
+	req.Error(err)
+
+	config, err := loadDialerConfig(map[interface{}]interface{}{"compression": "deflate"})
+	req.NoError(err)
+	req.Equal([]string{CompressionDeflate}, config.compression)
+}
+
+func TestNegotiateCompression(t *testing.T) {
+	req := require.New(t)
+
+	headers := channel.Headers{}
+	putCompressionHeader(headers, []string{CompressionDeflate})
+	req.Equal(CompressionDeflate, negotiateCompression([]string{CompressionDeflate}, getCompressionHeader(headers)))
+
+	// routers without compression don't send the header
+	req.Equal("", negotiateCompression([]string{CompressionDeflate}, getCompressionHeader(channel.Headers{})))
+	req.Equal("", negotiateCompression(nil, []string{CompressionDeflate}))
+
+	// the dialer's preference wins
+	req.Equal(CompressionZstd, negotiateCompression([]string{CompressionZstd, CompressionDeflate}, []string{CompressionDeflate, CompressionLz4, CompressionZstd}))
+	req.Equal(CompressionLz4, negotiateCompression([]string{CompressionZstd, CompressionLz4}, []string{CompressionDeflate, CompressionLz4}))
+
+	// algorithms this router doesn't have are never picked
+	req.Equal(CompressionDeflate, negotiateCompression([]string{"brotli", CompressionDeflate}, []string{"brotli", CompressionDeflate}))
+}
+
+func TestCompressionCodecs(t *testing.T) {
+	data := bytes.Repeat([]byte(`{"name": "value", "other": "value"}`), 100)
+
+	random := make([]byte, 1024)
+	_, err := rand.Read(random)
+	require.NoError(t, err)
+
+	ids := map[byte]string{}
+	for name, codec := range compressionCodecs {
+		t.Run(name, func(t *testing.T) {
+			req := require.New(t)
+			req.Equal(name, codec.Name())
+			req.NotContains(ids, codec.Id())
+			ids[codec.Id()] = name
+
+			compressed, err := codec.Compress(data)
+			req.NoError(err)
+			req.Less(len(compressed), len(data))
+
+			decompressed, err := codec.Decompress(compressed)
+			req.NoError(err)
+			req.Equal(data, decompressed)
+
+			compressed, err = codec.Compress(random)
+			req.NoError(err)
+			if len(compressed) < len(random) {
+				decompressed, err = codec.Decompress(compressed)
+				req.NoError(err)
+				req.Equal(random, decompressed)
+			}
+
+			_, err = codec.Decompress([]byte("not compressed"))
+			req.Error(err)
+		})
+	}
+}
+
+func TestCompressionTransformer(t *testing.T) {
+	req := require.New(t)
+
+	registry := metrics.NewRegistry("test", nil)
+	transformer := newCompressionTransformer("test", compressionCodecs[CompressionDeflate], registry)
+
+	data := bytes.Repeat([]byte(`{"name": "value", "other": "value"}`), 100)
+	payload := &xgress.Payload{CircuitId: "circuit", Data: data}
+
+	msg := payload.Marshall()
+	transformer.Tx(msg, nil)
+	req.Less(len(msg.Body), len(data))
+	_, compressed := msg.GetByteHeader(PayloadCompressionHeader)
+	req.True(compressed)
+	req.Greater(registry.Histogram("link.test.compression.ratio").Mean(), float64(100))
+
+	transformer.Rx(msg, nil)
+	req.Equal(data, msg.Body)
+	_, compressed = msg.GetByteHeader(PayloadCompressionHeader)
+	req.False(compressed)
+
+	// incompressible payloads are sent as-is
+	random := make([]byte, 1024)
+	_, err := rand.Read(random)
+	req.NoError(err)
+
+	msg = (&xgress.Payload{CircuitId: "circuit", Data: random}).Marshall()
+	transformer.Tx(msg, nil)
+	req.Equal(random, msg.Body)
+	_, compressed = msg.GetByteHeader(PayloadCompressionHeader)
+	req.False(compressed)
+
+	// corrupt payloads are dropped rather than forwarded
+	msg = (&xgress.Payload{CircuitId: "circuit", Data: []byte("not compressed")}).Marshall()
+	msg.PutByteHeader(PayloadCompressionHeader, compressionCodecs[CompressionDeflate].Id())
+	transformer.Rx(msg, nil)
+	req.Equal(int32(compressionFailedContentType), msg.ContentType)
+}
@@ -127,6 +127,22 @@ func loadListenerConfig(data map[interface{}]interface{}) (*listenerConfig, erro
 		config.groups = append(config.groups, link.GroupDefault)
 	}
 
+	if value, found := data["compression"]; found {
+		compression, err := loadCompressionConfig(value, "listener")
+		if err != nil {
+			return nil, err
+		}
+		config.compression = compression
+	}
+
+	if value, found := data["qos"]; found {
+		qos, err := loadQosConfig(value, "listener")
+		if err != nil {
+			return nil, err
+		}
+		config.qos = qos
+	}
+
 	if value, found := data["options"]; found {
 		if submap, ok := value.(map[interface{}]interface{}); ok {
 			options, err := channel.LoadOptions(submap)
@@ -151,6 +167,8 @@ type listenerConfig struct {
 	linkProtocol  string
 	linkCostTags  []string
 	groups        []string
+	compression   []string
+	qos           *qosConfig
 	options       *channel.Options
 }
 
@@ -245,6 +263,22 @@ func loadDialerConfig(data map[interface{}]interface{}) (*dialerConfig, error) {
 		config.groups = append(config.groups, link.GroupDefault)
 	}
 
+	if value, found := data["compression"]; found {
+		compression, err := loadCompressionConfig(value, "dialer")
+		if err != nil {
+			return nil, err
+		}
+		config.compression = compression
+	}
+
+	if value, found := data["qos"]; found {
+		qos, err := loadQosConfig(value, "dialer")
+		if err != nil {
+			return nil, err
+		}
+		config.qos = qos
+	}
+
 	config.healthyBackoffConfig = &backoffConfig{
 		minRetryInterval:   DefaultHealthyMinRetryInterval,
 		maxRetryInterval:   DefaultHealthyMaxRetryInterval,
@@ -380,6 +414,8 @@ type dialerConfig struct {
 	startupDelay           time.Duration
 	localBinding           string
 	groups                 []string
+	compression            []string
+	qos                    *qosConfig
 	options                *channel.Options
 	healthyBackoffConfig   *backoffConfig
 	unhealthyBackoffConfig *backoffConfig
@@ -30,6 +30,7 @@ import (
 	"github.com/hanzozt/transport/v2"
 	"github.com/hanzozt/zt/v2/common/pb/ctrl_pb"
 	"github.com/hanzozt/zt/v2/router/xlink"
+	"github.com/hanzozt/zt/v2/router/xlink_transport/quic"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
@@ -136,6 +137,7 @@ func (self *dialer) dialSplit(linkId *identity.TokenId, address transport.Addres
 		LinkDialedRouterId:      []byte(dial.GetRouterId()),
 	}
 	headers.PutUint32Header(LinkHeaderIteration, dial.GetIteration())
+	putCompressionHeader(headers, self.config.compression)
 
 	channelDialerConfig := channel.DialerConfig{
 		Identity:        linkId,
@@ -172,6 +174,9 @@ func (self *dialer) dialSplit(linkId *identity.TokenId, address transport.Addres
 	log.Info("dialing ack channel")
 
 	headers.PutByteHeader(LinkHeaderType, byte(AckChannel))
+	if ackAddress := quic.DatagramAddress(address); ackAddress != nil {
+		channelDialerConfig.Endpoint = ackAddress
+	}
 	ackDialer := channel.NewClassicDialer(channelDialerConfig)
 
 	_, err = channel.NewChannel("l/"+linkId.Token, ackDialer, channel.BindHandlerF(bindHandler.bindAckChannel), self.config.options)
@@ -199,6 +204,7 @@ func (self *dialer) dialSingle(linkId *identity.TokenId, address transport.Addre
 		LinkDialedRouterId:      []byte(dial.GetRouterId()),
 	}
 	headers.PutUint32Header(LinkHeaderIteration, dial.GetIteration())
+	putCompressionHeader(headers, self.config.compression)
 
 	payloadDialer := channel.NewClassicDialer(channel.DialerConfig{
 		Identity:        linkId,
@@ -247,18 +253,27 @@ func (self *dialer) dialMulti(linkId *identity.TokenId, address transport.Addres
 		LinkDialedRouterId:      []byte(dial.GetRouterId()),
 	}
 	headers.PutUint32Header(LinkHeaderIteration, dial.GetIteration())
+	putCompressionHeader(headers, self.config.compression)
 	headers.PutBoolHeader(channel.IsGroupedHeader, true)
 	headers.PutStringHeader(channel.TypeHeader, ChannelTypeDefault)
 	headers.PutBoolHeader(channel.IsFirstGroupConnection, true)
 
-	linkDialer := channel.NewClassicDialer(channel.DialerConfig{
+	linkDialerConfig := channel.DialerConfig{
 		Identity:        linkId,
 		Endpoint:        address,
 		LocalBinding:    self.config.localBinding,
 		Headers:         headers,
 		TransportConfig: self.transportConfig,
 		MessageStrategy: channel.DatagramMessageStrategy(xgress.UnmarshallPacketPayload),
-	})
+	}
+	linkDialer := channel.NewClassicDialer(linkDialerConfig)
+
+	// over quic, acks are sent as datagrams, so a lost packet holding up payloads doesn't also hold up acks
+	var ackDialer channel.DialUnderlayFactory
+	if ackAddress := quic.DatagramAddress(address); ackAddress != nil {
+		linkDialerConfig.Endpoint = ackAddress
+		ackDialer = channel.NewClassicDialer(linkDialerConfig)
+	}
 
 	bindHandler := &dialBindHandler{
 		dialer: self,
@@ -282,10 +297,12 @@ func (self *dialer) dialMulti(linkId *identity.TokenId, address transport.Addres
 	if isGrouped, _ := channel.Headers(underlay.Headers()).GetBoolHeader(channel.IsGroupedHeader); isGrouped {
 		dialLinkChangeConfig := DialLinkChannelConfig{
 			Dialer:             linkDialer,
+			AckDialer:          ackDialer,
 			Underlay:           underlay,
 			MaxDefaultChannels: int(self.config.maxDefaultConnections),
 			MaxAckChannel:      int(self.config.maxAckConnections),
 			StartupDelay:       self.config.startupDelay,
+			Qos:                self.config.qos,
 			UnderlayChangeCallback: func(ch *DialLinkChannel) {
 				self.notifyOfLinkChange(ch, bindHandler.link)
 			},
@@ -310,6 +327,12 @@ func (self *dialer) dialMulti(linkId *identity.TokenId, address transport.Addres
 	return bindHandler.link, nil
 }
 
+// negotiateCompression returns the compression algorithm to use on a dialed link, based on the algorithms the
+// listener reported in its hello response
+func (self *dialer) negotiateCompression(binding channel.Binding) string {
+	return negotiateCompression(self.config.compression, getCompressionHeader(binding.GetChannel().Underlay().Headers()))
+}
+
 func (self *dialer) notifyOfLinkChange(ch *DialLinkChannel, link xlink.Xlink) {
 	if ch.GetChannel().IsClosed() { // don't send connection changes for closed links. close notification covers everything
 		return
@@ -367,6 +390,9 @@ func (self *dialBindHandler) BindChannel(binding channel.Binding) error {
 		self.link.ch = NewSingleLinkChannel(binding.GetChannel())
 	}
 
+	self.link.compression = self.dialer.negotiateCompression(binding)
+	bindCompression(binding, self.link.id, self.link.compression, self.dialer.env.GetMetricsRegistry())
+
 	bindHandler := self.dialer.bindHandlerFactory.NewBindHandler(self.link, true, false)
 	return bindHandler.BindChannel(binding)
 }
@@ -379,6 +405,8 @@ type splitDialBindHandler struct {
 func (self *splitDialBindHandler) bindPayloadChannel(binding channel.Binding) error {
 	return self.link.syncInit(func() error {
 		self.link.payloadCh = binding.GetChannel()
+		self.link.compression = self.dialer.negotiateCompression(binding)
+		bindCompression(binding, self.link.id, self.link.compression, self.dialer.env.GetMetricsRegistry())
 		bindHandler := self.dialer.bindHandlerFactory.NewBindHandler(self.link, true, false)
 		if err := bindHandler.BindChannel(binding); err != nil {
 			return errors.Wrapf(err, "error accepting outgoing payload channel for [l/%s]", self.link.id)
@@ -38,6 +38,7 @@ const (
 	LinkHeaderBinding                   = 4
 	LinkHeaderIteration                 = 5
 	LinkDialedRouterId                  = 6
+	LinkHeaderCompression               = 7
 	PayloadChannel          channelType = 1
 	AckChannel              channelType = 2
 )
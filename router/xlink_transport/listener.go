@@ -49,6 +49,7 @@ type listener struct {
 func (self *listener) Listen() error {
 	config := channel.ListenerConfig{
 		ConnectOptions:     self.config.options.ConnectOptions,
+		Headers:            self.getHelloHeaders(),
 		TransportConfig:    self.tcfg,
 		PoolConfigurator:   fabricMetrics.GoroutinesPoolMetricsConfigF(self.env.GetMetricsRegistry(), "pool.listener.link"),
 		ConnectionHandlers: []channel.ConnectionHandler{&ConnectionHandler{self.id}},
@@ -66,6 +67,12 @@ func (self *listener) Listen() error {
 	return nil
 }
 
+func (self *listener) getHelloHeaders() channel.Headers {
+	headers := channel.Headers{}
+	putCompressionHeader(headers, self.config.compression)
+	return headers
+}
+
 func (self *listener) GetAdvertisement() string {
 	return self.config.advertise.String()
 }
@@ -91,7 +98,7 @@ func (self *listener) GetLocalBinding() string {
 }
 
 func (self *listener) handleGroupedUnderlay(underlay channel.Underlay, closeCallback func()) (channel.MultiChannel, error) {
-	linkChannel := NewListenerLinkChannel(underlay)
+	linkChannel := NewListenerLinkChannel(underlay, self.config.qos)
 	multiConfig := channel.MultiChannelConfig{
 		LogicalName:     "link/" + underlay.Id(),
 		Options:         self.config.options,
@@ -162,6 +169,11 @@ func (self *listener) BindChannel(binding channel.Binding) error {
 		routerVersion: routerVersion,
 		dialerBinding: dialerBinding,
 		iteration:     iteration,
+		compression:   negotiateCompression(getCompressionHeader(headers), self.config.compression),
+	}
+
+	if linkMeta.compression != "" {
+		log = log.WithField("compression", linkMeta.compression)
 	}
 
 	if chanType != 0 {
@@ -188,6 +200,10 @@ func (self *listener) bindSplitChannel(binding channel.Binding, chanType channel
 		return err
 	}
 
+	if chanType == PayloadChannel {
+		bindCompression(binding, xli.id, linkMeta.compression, self.env.GetMetricsRegistry())
+	}
+
 	latencyPing := chanType == PayloadChannel
 	if err = self.bindHandlerFactory.NewBindHandler(xli, latencyPing, true).BindChannel(binding); err != nil {
 		self.cleanupDeadPartialLink(connId)
@@ -246,6 +262,7 @@ func (self *listener) getOrCreateSplitLink(connId string, linkMeta *linkMetadata
 				linkProtocol:  self.GetLinkProtocol(),
 				dialAddress:   self.GetAdvertisement(),
 				iteration:     linkMeta.iteration,
+				compression:   linkMeta.compression,
 				dialed:        false,
 			},
 			eventTime: time.Now(),
@@ -290,6 +307,7 @@ func (self *listener) bindNonSplitChannel(binding channel.Binding, linkMeta *lin
 		linkProtocol:  self.GetLinkProtocol(),
 		dialAddress:   self.GetAdvertisement(),
 		iteration:     linkMeta.iteration,
+		compression:   linkMeta.compression,
 		dialed:        false,
 	}
 
@@ -303,6 +321,8 @@ func (self *listener) bindNonSplitChannel(binding channel.Binding, linkMeta *lin
 		xli.ch = NewSingleLinkChannel(binding.GetChannel())
 	}
 
+	bindCompression(binding, xli.id, xli.compression, self.env.GetMetricsRegistry())
+
 	bindHandler := self.bindHandlerFactory.NewBindHandler(xli, true, true)
 	if err := bindHandler.BindChannel(binding); err != nil {
 		return errors.Wrapf(err, "error binding channel for link [l/%v]", binding.GetChannel().Id())
@@ -362,4 +382,5 @@ type linkMetadata struct {
 	routerVersion string
 	dialerBinding string
 	iteration     uint32
+	compression   string
 }
@@ -8,7 +8,9 @@ import (
 	"time"
 
 	"github.com/hanzozt/channel/v4"
+	"github.com/hanzozt/identity"
 	"github.com/hanzozt/metrics"
+	"github.com/hanzozt/zt/v2/router/xlink_transport/quic"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -88,6 +90,52 @@ func Test_Throughput(t *testing.T) {
 		underlay: testUnderlay{},
 	}
 
+	measureThroughput(t, underlayFactory, 0, 10*time.Microsecond)
+}
+
+// Test_ThroughputQuic runs the same measurement over a quic link connection to a local listener which discards what
+// it receives. It uses the identity of router 001 from etc, so it doesn't need any other setup.
+func Test_ThroughputQuic(t *testing.T) {
+	t.SkipNow()
+
+	id, err := identity.LoadIdentity(identity.Config{
+		Cert:       "../../etc/ca/intermediate/certs/001-client.cert.pem",
+		ServerCert: "../../etc/ca/intermediate/certs/001-server.cert.pem",
+		Key:        "../../etc/ca/intermediate/private/001.key.pem",
+		CA:         "../../etc/ca/intermediate/certs/ca-chain.cert.pem",
+	})
+	assert.NoError(t, err)
+	tokenId := identity.NewIdentity(id)
+
+	addr, err := quic.AddressParser{}.Parse("quic:127.0.0.1:6299")
+	assert.NoError(t, err)
+
+	listenerConfig := channel.ListenerConfig{
+		ConnectOptions: channel.DefaultConnectOptions(),
+	}
+	listener, err := channel.NewClassicListenerF(tokenId, addr, listenerConfig, func(underlay channel.Underlay) {
+		go func() {
+			for {
+				if _, err := underlay.Rx(); err != nil {
+					return
+				}
+			}
+		}()
+	})
+	assert.NoError(t, err)
+	defer func() { _ = listener.Close() }()
+
+	underlayFactory := channel.NewClassicDialer(channel.DialerConfig{
+		Identity: tokenId,
+		Endpoint: addr,
+	})
+
+	measureThroughput(t, underlayFactory, 16*1024, 0)
+}
+
+// measureThroughput sends messages with bodies of the given size over a channel for a minute, printing the message,
+// byte and drop rates as it goes
+func measureThroughput(t *testing.T, underlayFactory channel.UnderlayFactory, size int, pause time.Duration) {
 	options := channel.DefaultOptions()
 	options.OutQueueSize = 64
 	ch, err := channel.NewChannel("test", underlayFactory, nil, options)
@@ -96,6 +144,7 @@ func Test_Throughput(t *testing.T) {
 	registry := metrics.NewRegistry("test", nil)
 	drops := registry.Meter("drops")
 	msgs := registry.Meter("msgs")
+	bytes := registry.Meter("bytes")
 
 	go func() {
 		ticker := time.NewTicker(5 * time.Second)
@@ -106,19 +155,30 @@ func Test_Throughput(t *testing.T) {
 			fmt.Printf("drops - m1: %v, count: %v\n", v.M1Rate, v.Count)
 			v = registry.Poll().Meters["msgs"]
 			fmt.Printf("msgs  - m1: %v, count: %v\n", v.M1Rate, v.Count)
+			v = registry.Poll().Meters["bytes"]
+			fmt.Printf("bytes - m1: %v, count: %v\n", v.M1Rate, v.Count)
 		}
 	}()
 
+	var body []byte
+	if size > 0 {
+		body = make([]byte, size)
+	}
+
 	go func() {
 		for {
-			m := channel.NewMessage(1, nil)
+			m := channel.NewMessage(1, body)
 			sent, err := ch.TrySend(m)
 			assert.NoError(t, err)
 			if !sent {
 				drops.Mark(1)
+			} else {
+				bytes.Mark(int64(size))
 			}
 			msgs.Mark(1)
-			time.Sleep(10 * time.Microsecond)
+			if pause > 0 {
+				time.Sleep(pause)
+			}
 		}
 	}()
 
@@ -0,0 +1,204 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xlink_transport
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hanzozt/channel/v4"
+	"github.com/hanzozt/zt/v2/common/pb/ctrl_pb"
+)
+
+const (
+	// QosSchedulerStrict always sends payloads of a higher priority class before those of a lower one
+	QosSchedulerStrict = "strict"
+	// QosSchedulerWfq shares link capacity between priority classes by weight, so lower classes aren't starved
+	QosSchedulerWfq = "wfq"
+
+	DefaultQosInteractiveWeight = 8
+	DefaultQosBulkWeight        = 4
+	DefaultQosBackgroundWeight  = 1
+
+	priorityClassCount = 3
+)
+
+// strictPriorityOrder lists the priority classes from highest to lowest
+var strictPriorityOrder = []ctrl_pb.PriorityClass{
+	ctrl_pb.PriorityClass_PriorityInteractive,
+	ctrl_pb.PriorityClass_PriorityBulk,
+	ctrl_pb.PriorityClass_PriorityBackground,
+}
+
+var priorityClassNames = map[string]ctrl_pb.PriorityClass{
+	"interactive": ctrl_pb.PriorityClass_PriorityInteractive,
+	"bulk":        ctrl_pb.PriorityClass_PriorityBulk,
+	"background":  ctrl_pb.PriorityClass_PriorityBackground,
+}
+
+// qosConfig determines how payloads queued for a link are scheduled, by the priority class of their circuit
+type qosConfig struct {
+	scheduler string
+	weights   [priorityClassCount]uint32
+}
+
+func defaultQosConfig() *qosConfig {
+	result := &qosConfig{
+		scheduler: QosSchedulerWfq,
+	}
+	result.weights[ctrl_pb.PriorityClass_PriorityInteractive] = DefaultQosInteractiveWeight
+	result.weights[ctrl_pb.PriorityClass_PriorityBulk] = DefaultQosBulkWeight
+	result.weights[ctrl_pb.PriorityClass_PriorityBackground] = DefaultQosBackgroundWeight
+	return result
+}
+
+// loadQosConfig parses the 'qos' setting of a link listener or dialer. For example:
+//
+//	qos:
+//	  scheduler: wfq
+//	  weights:
+//	    interactive: 8
+//	    bulk: 4
+//	    background: 1
+func loadQosConfig(value interface{}, configType string) (*qosConfig, error) {
+	submap, ok := value.(map[interface{}]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid 'qos' value in %s config (%s)", configType, reflect.TypeOf(value))
+	}
+
+	result := defaultQosConfig()
+
+	if value, found := submap["scheduler"]; found {
+		scheduler := strings.ToLower(fmt.Sprint(value))
+		if scheduler != QosSchedulerStrict && scheduler != QosSchedulerWfq {
+			return nil, fmt.Errorf("invalid 'qos.scheduler' value in %s config, is '%s', must be '%s' or '%s'",
+				configType, scheduler, QosSchedulerStrict, QosSchedulerWfq)
+		}
+		result.scheduler = scheduler
+	}
+
+	if value, found := submap["weights"]; found {
+		weights, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid 'qos.weights' value in %s config (%s)", configType, reflect.TypeOf(value))
+		}
+		for k, v := range weights {
+			class, found := priorityClassNames[fmt.Sprint(k)]
+			if !found {
+				return nil, fmt.Errorf("invalid 'qos.weights' value in %s config, unknown priority class '%v', must be one of 'interactive', 'bulk' or 'background'", configType, k)
+			}
+			weight, ok := v.(int)
+			if !ok || weight < 1 {
+				return nil, fmt.Errorf("invalid 'qos.weights.%v' value in %s config, must be an integer of at least 1", k, configType)
+			}
+			result.weights[class] = uint32(weight)
+		}
+	}
+
+	return result, nil
+}
+
+// payloadScheduler picks the next payload to send on a link from the per priority class queues.
+//
+// With the strict scheduler, payloads are taken from the highest priority class with any queued. With the wfq
+// scheduler, link capacity is shared between the classes with queued payloads in proportion to their weights, using
+// start-time fair queueing. Each class has a virtual finish time, advanced by the size of each payload sent divided by
+// the class weight, and payloads are taken from the class which would start earliest. Classes which were idle start
+// at the current virtual time, so they can't build up credit while they have nothing to send.
+type payloadScheduler struct {
+	config      *qosConfig
+	queues      [priorityClassCount]chan channel.Sendable
+	lock        sync.Mutex
+	virtualTime float64
+	finish      [priorityClassCount]float64
+}
+
+func newPayloadScheduler(config *qosConfig, queues [priorityClassCount]chan channel.Sendable) *payloadScheduler {
+	if config == nil {
+		config = defaultQosConfig()
+	}
+	return &payloadScheduler{
+		config: config,
+		queues: queues,
+	}
+}
+
+// next returns the next payload to send, or nil if none are queued
+func (self *payloadScheduler) next() channel.Sendable {
+	if self.config.scheduler == QosSchedulerStrict {
+		for _, class := range strictPriorityOrder {
+			select {
+			case msg := <-self.queues[class]:
+				return msg
+			default:
+			}
+		}
+		return nil
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	for {
+		next := -1
+		nextStart := float64(0)
+		for class, queue := range self.queues {
+			if len(queue) == 0 {
+				continue
+			}
+			if start := max(self.finish[class], self.virtualTime); next == -1 || start < nextStart {
+				next = class
+				nextStart = start
+			}
+		}
+
+		if next == -1 {
+			return nil
+		}
+
+		select {
+		case msg := <-self.queues[next]:
+			self.account(next, msg)
+			return msg
+		default:
+			// another underlay took the payload while waiting for a message, look again
+		}
+	}
+}
+
+// sent records a payload which was sent without going through next, because the link was idle when it was queued
+func (self *payloadScheduler) sent(class ctrl_pb.PriorityClass, msg channel.Sendable) {
+	if self.config.scheduler == QosSchedulerStrict {
+		return
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.account(int(class), msg)
+}
+
+func (self *payloadScheduler) account(class int, msg channel.Sendable) {
+	size := 1
+	if m := msg.Msg(); m != nil {
+		size += len(m.Body)
+	}
+	start := max(self.finish[class], self.virtualTime)
+	self.finish[class] = start + float64(size)/float64(self.config.weights[class])
+	self.virtualTime = start
+}
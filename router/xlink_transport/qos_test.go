@@ -0,0 +1,253 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xlink_transport
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/hanzozt/channel/v4"
+	"github.com/hanzozt/sdk-golang/xgress"
+	"github.com/hanzozt/zt/v2/common/pb/ctrl_pb"
+	"github.com/hanzozt/zt/v2/router/xlink"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadQosConfig(t *testing.T) {
+	req := require.New(t)
+
+	config, err := loadQosConfig(map[interface{}]interface{}{
+		"scheduler": "strict",
+	}, "dialer")
+	req.NoError(err)
+	req.Equal(QosSchedulerStrict, config.scheduler)
+
+	config, err = loadQosConfig(map[interface{}]interface{}{
+		"weights": map[interface{}]interface{}{
+			"interactive": 10,
+			"background":  2,
+		},
+	}, "listener")
+	req.NoError(err)
+	req.Equal(QosSchedulerWfq, config.scheduler)
+	req.Equal(uint32(10), config.weights[ctrl_pb.PriorityClass_PriorityInteractive])
+	req.Equal(uint32(DefaultQosBulkWeight), config.weights[ctrl_pb.PriorityClass_PriorityBulk])
+	req.Equal(uint32(2), config.weights[ctrl_pb.PriorityClass_PriorityBackground])
+
+	_, err = loadQosConfig(map[interface{}]interface{}{"scheduler": "fifo"}, "dialer")
+	req.ErrorContains(err, "invalid 'qos.scheduler'")
+
+	_, err = loadQosConfig(map[interface{}]interface{}{
+		"weights": map[interface{}]interface{}{"urgent": 1},
+	}, "dialer")
+	req.ErrorContains(err, "unknown priority class 'urgent'")
+
+	_, err = loadQosConfig(map[interface{}]interface{}{
+		"weights": map[interface{}]interface{}{"bulk": 0},
+	}, "dialer")
+	req.ErrorContains(err, "qos.weights.bulk")
+
+	dialerConfig, err := loadDialerConfig(map[interface{}]interface{}{
+		"qos": map[interface{}]interface{}{"scheduler": "strict"},
+	})
+	req.NoError(err)
+	req.Equal(QosSchedulerStrict, dialerConfig.qos.scheduler)
+}
+
+func newTestScheduler(config *qosConfig) (*payloadScheduler, [priorityClassCount]chan channel.Sendable) {
+	var queues [priorityClassCount]chan channel.Sendable
+	for i := range queues {
+		queues[i] = make(chan channel.Sendable, 64)
+	}
+	return newPayloadScheduler(config, queues), queues
+}
+
+func queueTestPayloads(queues [priorityClassCount]chan channel.Sendable, class ctrl_pb.PriorityClass, count int, size int) {
+	for i := 0; i < count; i++ {
+		msg := channel.NewMessage(int32(class), bytes.Repeat([]byte{1}, size))
+		queues[class] <- msg
+	}
+}
+
+// schedulePayloads drains the scheduler, returning the classes of the payloads in the order they were sent
+func schedulePayloads(scheduler *payloadScheduler) []ctrl_pb.PriorityClass {
+	var result []ctrl_pb.PriorityClass
+	for msg := scheduler.next(); msg != nil; msg = scheduler.next() {
+		result = append(result, ctrl_pb.PriorityClass(msg.Msg().ContentType))
+	}
+	return result
+}
+
+func TestPayloadSchedulerStrict(t *testing.T) {
+	req := require.New(t)
+
+	config := defaultQosConfig()
+	config.scheduler = QosSchedulerStrict
+	scheduler, queues := newTestScheduler(config)
+
+	queueTestPayloads(queues, ctrl_pb.PriorityClass_PriorityBackground, 2, 100)
+	queueTestPayloads(queues, ctrl_pb.PriorityClass_PriorityBulk, 2, 100)
+	queueTestPayloads(queues, ctrl_pb.PriorityClass_PriorityInteractive, 2, 100)
+
+	req.Equal([]ctrl_pb.PriorityClass{
+		ctrl_pb.PriorityClass_PriorityInteractive,
+		ctrl_pb.PriorityClass_PriorityInteractive,
+		ctrl_pb.PriorityClass_PriorityBulk,
+		ctrl_pb.PriorityClass_PriorityBulk,
+		ctrl_pb.PriorityClass_PriorityBackground,
+		ctrl_pb.PriorityClass_PriorityBackground,
+	}, schedulePayloads(scheduler))
+}
+
+func TestPayloadSchedulerWfq(t *testing.T) {
+	req := require.New(t)
+
+	scheduler, queues := newTestScheduler(defaultQosConfig())
+
+	// bulk gets half the share of interactive, so interactive payloads of the same size go out twice as often
+	queueTestPayloads(queues, ctrl_pb.PriorityClass_PriorityBulk, 20, 1000)
+	queueTestPayloads(queues, ctrl_pb.PriorityClass_PriorityInteractive, 20, 1000)
+
+	order := schedulePayloads(scheduler)
+	req.Equal(40, len(order))
+
+	interactive := 0
+	for _, class := range order[:15] {
+		if class == ctrl_pb.PriorityClass_PriorityInteractive {
+			interactive++
+		}
+	}
+	req.Equal(10, interactive)
+
+	// small interactive payloads aren't held up behind large bulk payloads
+	queueTestPayloads(queues, ctrl_pb.PriorityClass_PriorityBulk, 10, 16000)
+	queueTestPayloads(queues, ctrl_pb.PriorityClass_PriorityInteractive, 10, 100)
+	order = schedulePayloads(scheduler)
+	req.Equal(ctrl_pb.PriorityClass_PriorityBulk, order[len(order)-1])
+	for _, class := range order[:10] {
+		req.Equal(ctrl_pb.PriorityClass_PriorityInteractive, class)
+	}
+
+}
+
+func TestPayloadSchedulerWfqIdleClass(t *testing.T) {
+	req := require.New(t)
+
+	scheduler, queues := newTestScheduler(defaultQosConfig())
+
+	queueTestPayloads(queues, ctrl_pb.PriorityClass_PriorityBulk, 20, 1000)
+	req.Equal(20, len(schedulePayloads(scheduler)))
+
+	// background was idle, so it doesn't get to catch up on the share it didn't use
+	queueTestPayloads(queues, ctrl_pb.PriorityClass_PriorityBulk, 8, 1000)
+	queueTestPayloads(queues, ctrl_pb.PriorityClass_PriorityBackground, 8, 1000)
+	order := schedulePayloads(scheduler)
+	req.Equal(16, len(order))
+
+	background := 0
+	for _, class := range order[:5] {
+		if class == ctrl_pb.PriorityClass_PriorityBackground {
+			background++
+		}
+	}
+	req.Equal(1, background)
+}
+
+type recordingChannel struct {
+	channel.Channel
+	sent []channel.Sendable
+}
+
+func (self *recordingChannel) Send(s channel.Sendable) error {
+	self.sent = append(self.sent, s)
+	return nil
+}
+
+func TestLinkChannelSendsControlMessagesFirst(t *testing.T) {
+	req := require.New(t)
+
+	config := defaultQosConfig()
+	config.scheduler = QosSchedulerStrict
+	linkChannel := NewBaseLinkChannel(nil, config)
+
+	for _, class := range strictPriorityOrder {
+		msg := channel.NewMessage(int32(class), []byte{1})
+		req.NoError(linkChannel.GetPayloadSender(class).Send(msg))
+	}
+	control := channel.NewMessage(100, nil)
+	req.NoError(linkChannel.GetDefaultSender().Send(control))
+
+	notifier := channel.NewCloseNotifier()
+	var order []int32
+	for i := 0; i < 4; i++ {
+		msg, err := linkChannel.GetNextMsgDefault(notifier)
+		req.NoError(err)
+		order = append(order, msg.Msg().ContentType)
+	}
+
+	req.Equal([]int32{
+		100,
+		int32(ctrl_pb.PriorityClass_PriorityInteractive),
+		int32(ctrl_pb.PriorityClass_PriorityBulk),
+		int32(ctrl_pb.PriorityClass_PriorityBackground),
+	}, order)
+}
+
+func TestSingleLinkChannelPayloadPriority(t *testing.T) {
+	req := require.New(t)
+
+	sender := &recordingChannel{}
+	linkChannel := NewSingleLinkChannel(sender)
+
+	for _, class := range strictPriorityOrder {
+		req.NoError(linkChannel.GetPayloadSender(class).Send(channel.NewMessage(int32(class), nil)))
+	}
+	req.NoError(linkChannel.GetPayloadSender(ctrl_pb.PriorityClass(10)).Send(channel.NewMessage(10, nil)))
+
+	req.Equal(4, len(sender.sent))
+	req.Equal(channel.Priority(channel.Standard), sender.sent[0].Priority())
+	req.Less(sender.sent[0].Priority(), sender.sent[1].Priority())
+	req.Less(sender.sent[1].Priority(), sender.sent[2].Priority())
+	req.Equal(channel.Priority(channel.Low), sender.sent[2].Priority())
+
+	// unknown classes are sent as bulk
+	req.Equal(sender.sent[1].Priority(), sender.sent[3].Priority())
+	req.Equal(int32(10), sender.sent[3].Msg().ContentType)
+}
+
+func TestSplitLinkPayloadPriority(t *testing.T) {
+	req := require.New(t)
+
+	payloadCh := &recordingChannel{}
+	link := &splitImpl{payloadCh: payloadCh}
+	var _ xlink.PriorityLinkDestination = link
+
+	payload := &xgress.Payload{CircuitId: "circuit1", Data: []byte("hello")}
+	for _, class := range strictPriorityOrder {
+		req.NoError(link.SendPriorityPayload(payload, time.Second, xgress.PayloadTypeXg, class))
+	}
+	req.NoError(link.SendPayload(payload, time.Second, xgress.PayloadTypeXg))
+
+	req.Equal(4, len(payloadCh.sent))
+	for i, class := range strictPriorityOrder {
+		req.Equal(singleLinkPayloadPriorities[class], payloadCh.sent[i].Priority())
+	}
+
+	// payloads sent without a priority class are sent as bulk
+	req.Equal(payloadCh.sent[1].Priority(), payloadCh.sent[3].Priority())
+}
@@ -0,0 +1,148 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+// Package quic implements a transport for router links which runs over QUIC. Each connection dialed to an address
+// is a stream on a QUIC connection shared by everything the router dials to that address, so link channels don't
+// block one another when packets are lost. Connections dialed with a datagram address carry messages as QUIC
+// datagrams instead, which suits link acks: they're small, may arrive in any order, and a lost ack is recovered by
+// xgress retransmission.
+package quic
+
+import (
+	"io"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hanzozt/identity"
+	"github.com/hanzozt/transport/v2"
+	"github.com/pkg/errors"
+)
+
+var _ transport.Address = &address{} // enforce that address implements transport.Address
+
+const Type = "quic"
+
+type address struct {
+	net.UDPAddr
+	hostname  string
+	original  string
+	datagrams bool
+	err       error
+}
+
+func (a *address) Dial(name string, i *identity.TokenId, timeout time.Duration, tcfg transport.Configuration) (transport.Conn, error) {
+	return Dial(a, name, i, timeout, tcfg)
+}
+
+func (a *address) DialWithLocalBinding(name string, localBinding string, i *identity.TokenId, timeout time.Duration, tcfg transport.Configuration) (transport.Conn, error) {
+	return DialWithLocalBinding(a, name, localBinding, i, timeout, tcfg)
+}
+
+func (a *address) Listen(name string, i *identity.TokenId, acceptF func(transport.Conn), tcfg transport.Configuration) (io.Closer, error) {
+	return Listen(a, name, i, tcfg, acceptF)
+}
+
+func (a *address) MustListen(name string, i *identity.TokenId, acceptF func(transport.Conn), tcfg transport.Configuration) io.Closer {
+	closer, err := a.Listen(name, i, acceptF, tcfg)
+	if err != nil {
+		panic(err)
+	}
+	return closer
+}
+
+func (a *address) String() string {
+	return a.original
+}
+
+func (a *address) Type() string {
+	return Type
+}
+
+func (a *address) withError(err error) (*address, error) {
+	a.err = err
+	return a, nil
+}
+
+func (a *address) Hostname() string {
+	return a.IP.String()
+}
+
+func (a *address) Port() uint16 {
+	return uint16(a.UDPAddr.Port)
+}
+
+// DatagramAddress returns a copy of the given quic address whose connections send messages as QUIC datagrams rather
+// than on a stream. Each write to such a connection must be a single, complete message. Messages too large for a
+// datagram are sent on the connection's stream, which is also used for the first message in each direction, so
+// channel hellos are never lost. Returns nil if the address isn't a quic address.
+func DatagramAddress(addr transport.Address) transport.Address {
+	quicAddr, ok := addr.(*address)
+	if !ok {
+		return nil
+	}
+	result := *quicAddr
+	result.datagrams = true
+	return &result
+}
+
+type AddressParser struct{}
+
+func (ap AddressParser) Parse(s string) (transport.Address, error) {
+	if !strings.HasPrefix(s, Type+":") {
+		return nil, errors.Errorf("invalid quic address '%v', doesn't start with quic:", s)
+	}
+
+	addr := &address{
+		original: s,
+	}
+	hostPort := s[len(Type+":"):]
+
+	host, portStr, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		return addr.withError(errors.Wrapf(err, "unable to parse addr host and port from %v", s))
+	}
+	addr.hostname = host
+
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return addr.withError(errors.Wrapf(err, "unable to parse port from %v", portStr))
+	}
+
+	if port < 0 || port > math.MaxUint16 {
+		return addr.withError(errors.Errorf("invalid port value %v", portStr))
+	}
+
+	ipAddr := net.ParseIP(host)
+	if ipAddr == nil {
+		ips, err := net.LookupHost(host)
+		if err != nil {
+			return addr.withError(errors.Wrapf(err, "unable to resolve host %v", host))
+		}
+		if len(ips) == 0 {
+			return addr.withError(errors.Errorf("no IPs found when resolving host %v", host))
+		}
+		ipAddr = net.ParseIP(ips[0])
+	}
+
+	addr.UDPAddr = net.UDPAddr{
+		IP:   ipAddr,
+		Port: port,
+	}
+	return addr, nil
+}
@@ -0,0 +1,379 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package quic
+
+import (
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hanzozt/transport/v2"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/quic-go/quic-go"
+)
+
+const (
+	// streamModeStream is sent as the first byte of streams whose connections use the stream for everything
+	streamModeStream byte = 1
+
+	// streamModeDatagram is sent as the first byte of streams whose connections send messages as datagrams
+	streamModeDatagram byte = 2
+
+	// maxFrameSize bounds the size of messages framed on the stream of a datagram connection
+	maxFrameSize = 16 * 1024 * 1024
+
+	// datagramQueueSize is how many received datagrams may wait to be read before further datagrams are dropped
+	datagramQueueSize = 256
+)
+
+// session is a QUIC connection, shared by the streams opened on it. Datagrams are per connection rather than per
+// stream, so each is prefixed with the id of the stream whose connection sent it, and the session dispatches them.
+type session struct {
+	conn     *quic.Conn
+	certs    []*x509.Certificate
+	lock     sync.Mutex
+	streams  int
+	idle     bool
+	receiver map[quic.StreamID]*datagramConn
+	onIdle   func(*session)
+}
+
+func newSession(conn *quic.Conn) *session {
+	result := &session{
+		conn:     conn,
+		certs:    conn.ConnectionState().TLS.PeerCertificates,
+		receiver: map[quic.StreamID]*datagramConn{},
+	}
+	go result.receiveDatagrams()
+	return result
+}
+
+func (self *session) acquire() bool {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	if self.idle || self.conn.Context().Err() != nil {
+		return false
+	}
+	self.streams++
+	return true
+}
+
+// release is called when a connection on the session is closed. Dialed sessions are closed once their last stream
+// is closed, accepted sessions are left for the dialing side to close.
+func (self *session) release() {
+	self.lock.Lock()
+	self.streams--
+	idle := self.streams == 0 && self.onIdle != nil
+	self.idle = self.idle || idle
+	self.lock.Unlock()
+
+	if idle {
+		self.onIdle(self)
+	}
+}
+
+func (self *session) register(conn *datagramConn) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.receiver[conn.stream.StreamID()] = conn
+}
+
+func (self *session) unregister(conn *datagramConn) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	delete(self.receiver, conn.stream.StreamID())
+}
+
+func (self *session) receiveDatagrams() {
+	for {
+		data, err := self.conn.ReceiveDatagram(self.conn.Context())
+		if err != nil {
+			return
+		}
+
+		streamId, n := binary.Uvarint(data)
+		if n <= 0 {
+			pfxlog.Logger().WithField("remoteAddr", self.conn.RemoteAddr()).Debug("dropping datagram with invalid stream id")
+			continue
+		}
+
+		self.lock.Lock()
+		conn := self.receiver[quic.StreamID(streamId)]
+		self.lock.Unlock()
+
+		if conn != nil {
+			conn.receive(data[n:])
+		}
+	}
+}
+
+func (self *session) close(reason string) {
+	_ = self.conn.CloseWithError(0, reason)
+}
+
+// streamConn is a connection which uses its stream for everything
+type streamConn struct {
+	*quic.Stream
+	session *session
+	detail  *transport.ConnectionDetail
+	closed  atomic.Bool
+}
+
+func (self *streamConn) Detail() *transport.ConnectionDetail {
+	return self.detail
+}
+
+func (self *streamConn) PeerCertificates() []*x509.Certificate {
+	return self.session.certs
+}
+
+func (self *streamConn) LocalAddr() net.Addr {
+	return self.session.conn.LocalAddr()
+}
+
+func (self *streamConn) RemoteAddr() net.Addr {
+	return self.session.conn.RemoteAddr()
+}
+
+func (self *streamConn) Close() error {
+	if !self.closed.CompareAndSwap(false, true) {
+		return nil
+	}
+	self.Stream.CancelRead(0)
+	err := self.Stream.Close()
+	self.session.release()
+	return err
+}
+
+// datagramConn is a connection which sends messages as datagrams. Its stream carries the first message in each
+// direction and any message too large for a datagram, each framed with a length prefix.
+type datagramConn struct {
+	stream        *quic.Stream
+	session       *session
+	detail        *transport.ConnectionDetail
+	prefix        []byte
+	writeLock     sync.Mutex
+	sentFirst     atomic.Bool
+	receivedFirst atomic.Bool
+	frames        chan []byte
+	current       []byte
+	readDeadline  atomic.Pointer[time.Time]
+	readErr       atomic.Pointer[error]
+	streamDone    chan struct{}
+	closeNotify   chan struct{}
+	closed        atomic.Bool
+}
+
+func newDatagramConn(stream *quic.Stream, session *session, detail *transport.ConnectionDetail) *datagramConn {
+	result := &datagramConn{
+		stream:      stream,
+		session:     session,
+		detail:      detail,
+		prefix:      binary.AppendUvarint(nil, uint64(stream.StreamID())),
+		frames:      make(chan []byte, datagramQueueSize),
+		streamDone:  make(chan struct{}),
+		closeNotify: make(chan struct{}),
+	}
+	session.register(result)
+	go result.readFrames()
+	return result
+}
+
+func (self *datagramConn) Detail() *transport.ConnectionDetail {
+	return self.detail
+}
+
+func (self *datagramConn) PeerCertificates() []*x509.Certificate {
+	return self.session.certs
+}
+
+func (self *datagramConn) LocalAddr() net.Addr {
+	return self.session.conn.LocalAddr()
+}
+
+func (self *datagramConn) RemoteAddr() net.Addr {
+	return self.session.conn.RemoteAddr()
+}
+
+// receive queues a datagram for reading. Datagrams which overtake the first message on the stream, or arrive when
+// the reader has fallen behind, are dropped, as they would be if they were lost in the network.
+func (self *datagramConn) receive(data []byte) {
+	if !self.receivedFirst.Load() {
+		return
+	}
+	select {
+	case self.frames <- data:
+	default:
+	}
+}
+
+func (self *datagramConn) readFrames() {
+	defer close(self.streamDone)
+
+	var sizeBuf [4]byte
+	for {
+		if _, err := io.ReadFull(self.stream, sizeBuf[:]); err != nil {
+			self.readErr.Store(&err)
+			return
+		}
+
+		size := binary.BigEndian.Uint32(sizeBuf[:])
+		if size > maxFrameSize {
+			err := errors.New("quic stream frame exceeds maximum size")
+			self.readErr.Store(&err)
+			return
+		}
+
+		frame := make([]byte, size)
+		if _, err := io.ReadFull(self.stream, frame); err != nil {
+			self.readErr.Store(&err)
+			return
+		}
+
+		select {
+		case self.frames <- frame:
+		case <-self.closeNotify:
+			return
+		}
+		self.receivedFirst.Store(true)
+	}
+}
+
+// Read returns data from one message at a time, so message boundaries are kept even if datagrams are lost
+func (self *datagramConn) Read(b []byte) (int, error) {
+	if len(self.current) == 0 {
+		frame, err := self.nextFrame()
+		if err != nil {
+			return 0, err
+		}
+		self.current = frame
+	}
+
+	n := copy(b, self.current)
+	self.current = self.current[n:]
+	return n, nil
+}
+
+func (self *datagramConn) nextFrame() ([]byte, error) {
+	select {
+	case frame := <-self.frames:
+		return frame, nil
+	default:
+	}
+
+	var timeout <-chan time.Time
+	if deadline := self.readDeadline.Load(); deadline != nil && !deadline.IsZero() {
+		timer := time.NewTimer(time.Until(*deadline))
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	select {
+	case frame := <-self.frames:
+		return frame, nil
+	case <-self.streamDone:
+		// the stream failing means the peer has gone, so there's no point waiting for further datagrams
+		if err := self.readErr.Load(); err != nil {
+			return nil, *err
+		}
+		return nil, io.EOF
+	case <-self.closeNotify:
+		return nil, net.ErrClosed
+	case <-timeout:
+		return nil, os.ErrDeadlineExceeded
+	}
+}
+
+// Write sends b, which must be a complete message, as a datagram if possible, otherwise on the stream
+func (self *datagramConn) Write(b []byte) (int, error) {
+	if self.closed.Load() {
+		return 0, net.ErrClosed
+	}
+
+	if self.sentFirst.Load() {
+		datagram := make([]byte, 0, len(self.prefix)+len(b))
+		datagram = append(datagram, self.prefix...)
+		datagram = append(datagram, b...)
+
+		err := self.session.conn.SendDatagram(datagram)
+		if err == nil {
+			return len(b), nil
+		}
+
+		var tooLarge *quic.DatagramTooLargeError
+		if !errors.As(err, &tooLarge) {
+			return 0, err
+		}
+	}
+
+	self.writeLock.Lock()
+	defer self.writeLock.Unlock()
+
+	frame := make([]byte, 4, 4+len(b))
+	binary.BigEndian.PutUint32(frame, uint32(len(b)))
+	frame = append(frame, b...)
+	if _, err := self.stream.Write(frame); err != nil {
+		return 0, err
+	}
+	self.sentFirst.Store(true)
+	return len(b), nil
+}
+
+func (self *datagramConn) SetDeadline(t time.Time) error {
+	if err := self.SetReadDeadline(t); err != nil {
+		return err
+	}
+	return self.SetWriteDeadline(t)
+}
+
+func (self *datagramConn) SetReadDeadline(t time.Time) error {
+	self.readDeadline.Store(&t)
+	return nil
+}
+
+func (self *datagramConn) SetWriteDeadline(t time.Time) error {
+	return self.stream.SetWriteDeadline(t)
+}
+
+func (self *datagramConn) Close() error {
+	if !self.closed.CompareAndSwap(false, true) {
+		return nil
+	}
+	close(self.closeNotify)
+	self.session.unregister(self)
+	self.stream.CancelRead(0)
+	err := self.stream.Close()
+	self.session.release()
+	return err
+}
+
+func newConn(mode byte, stream *quic.Stream, session *session, detail *transport.ConnectionDetail) transport.Conn {
+	if mode == streamModeDatagram {
+		return newDatagramConn(stream, session, detail)
+	}
+	return &streamConn{
+		Stream:  stream,
+		session: session,
+		detail:  detail,
+	}
+}
@@ -0,0 +1,227 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package quic
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/hanzozt/identity"
+	"github.com/hanzozt/transport/v2"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/pkg/errors"
+	"github.com/quic-go/quic-go"
+)
+
+const (
+	// Protocol is the ALPN protocol negotiated on QUIC link connections
+	Protocol = "zt-link"
+
+	DefaultConnectTimeout  = 15 * time.Second
+	DefaultKeepAlivePeriod = 10 * time.Second
+	DefaultMaxIdleTimeout  = 30 * time.Second
+
+	// maxStreams limits how many streams a peer may have open on one connection. Each link uses a handful.
+	maxStreams = 1024
+)
+
+func newQuicConfig(handshakeTimeout time.Duration) *quic.Config {
+	return &quic.Config{
+		HandshakeIdleTimeout:  handshakeTimeout,
+		MaxIdleTimeout:        DefaultMaxIdleTimeout,
+		KeepAlivePeriod:       DefaultKeepAlivePeriod,
+		MaxIncomingStreams:    maxStreams,
+		MaxIncomingUniStreams: -1,
+		EnableDatagrams:       true,
+	}
+}
+
+func Dial(addr *address, name string, i *identity.TokenId, timeout time.Duration, tcfg transport.Configuration) (transport.Conn, error) {
+	return DialWithLocalBinding(addr, name, "", i, timeout, tcfg)
+}
+
+func DialWithLocalBinding(addr *address, name, localBinding string, i *identity.TokenId, timeout time.Duration, _ transport.Configuration) (transport.Conn, error) {
+	if addr.err != nil {
+		return nil, addr.err
+	}
+
+	if timeout == 0 {
+		timeout = DefaultConnectTimeout
+	}
+
+	ctx, cancelF := context.WithTimeout(context.Background(), timeout)
+	defer cancelF()
+
+	s, err := sessions.get(ctx, addr, localBinding, i)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := s.conn.OpenStreamSync(ctx)
+	if err != nil {
+		s.release()
+		return nil, errors.Wrapf(err, "unable to open stream to %v", addr.String())
+	}
+
+	mode := streamModeStream
+	if addr.datagrams {
+		mode = streamModeDatagram
+	}
+
+	if _, err = stream.Write([]byte{mode}); err != nil {
+		stream.CancelRead(0)
+		_ = stream.Close()
+		s.release()
+		return nil, errors.Wrapf(err, "unable to open stream to %v", addr.String())
+	}
+
+	detail := &transport.ConnectionDetail{
+		Address: addr.String(),
+		InBound: false,
+		Name:    name,
+	}
+
+	return newConn(mode, stream, s, detail), nil
+}
+
+type sessionKey struct {
+	id           identity.Identity
+	address      string
+	localBinding string
+}
+
+var sessions = &sessionPool{
+	sessions: map[sessionKey]*session{},
+}
+
+// sessionPool tracks the QUIC connections dialed by this process, so connections dialed to the same address with
+// the same identity share one
+type sessionPool struct {
+	lock     sync.Mutex
+	sessions map[sessionKey]*session
+}
+
+func (self *sessionPool) get(ctx context.Context, addr *address, localBinding string, i *identity.TokenId) (*session, error) {
+	key := sessionKey{
+		id:           i.Identity,
+		address:      addr.UDPAddr.String(),
+		localBinding: localBinding,
+	}
+
+	if s := self.acquire(key); s != nil {
+		return s, nil
+	}
+
+	s, err := self.dial(ctx, addr, localBinding, i)
+	if err != nil {
+		return nil, err
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	// another dial to the same address may have completed while this one was in progress
+	if current := self.sessions[key]; current != nil && current.acquire() {
+		s.close("duplicate connection")
+		return current, nil
+	}
+
+	s.onIdle = func(s *session) {
+		self.remove(key, s)
+		s.close("idle")
+	}
+	s.acquire()
+	self.sessions[key] = s
+
+	go func() {
+		<-s.conn.Context().Done()
+		self.remove(key, s)
+	}()
+
+	return s, nil
+}
+
+func (self *sessionPool) acquire(key sessionKey) *session {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if s := self.sessions[key]; s != nil && s.acquire() {
+		return s
+	}
+	return nil
+}
+
+func (self *sessionPool) remove(key sessionKey, s *session) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if self.sessions[key] == s {
+		delete(self.sessions, key)
+	}
+}
+
+func (self *sessionPool) dial(ctx context.Context, addr *address, localBinding string, i *identity.TokenId) (*session, error) {
+	log := pfxlog.Logger().WithField("address", addr.String())
+	log.Debug("dialing")
+
+	ip, err := transport.ResolveLocalBinding(localBinding)
+	if err != nil {
+		return nil, err
+	}
+
+	var localAddr *net.UDPAddr
+	if ip != nil {
+		localAddr = &net.UDPAddr{IP: ip}
+	}
+
+	udpConn, err := net.ListenUDP("udp", localAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	tr := &quic.Transport{Conn: udpConn}
+
+	tlsCfg := i.ClientTLSConfig().Clone()
+	tlsCfg.ServerName = addr.hostname
+	tlsCfg.NextProtos = []string{Protocol}
+
+	conn, err := tr.Dial(ctx, &addr.UDPAddr, tlsCfg, newQuicConfig(0))
+	if err != nil {
+		_ = tr.Close()
+		_ = udpConn.Close()
+		return nil, errors.Wrapf(err, "unable to dial %v", addr.String())
+	}
+
+	if !conn.ConnectionState().SupportsDatagrams.Remote {
+		_ = conn.CloseWithError(0, "datagrams not supported")
+		_ = tr.Close()
+		_ = udpConn.Close()
+		return nil, errors.Errorf("peer at %v doesn't support quic datagrams", addr.String())
+	}
+
+	go func() {
+		<-conn.Context().Done()
+		_ = tr.Close()
+		_ = udpConn.Close()
+	}()
+
+	log.Debugf("server provided [%d] certificates", len(conn.ConnectionState().TLS.PeerCertificates))
+
+	return newSession(conn), nil
+}
@@ -0,0 +1,184 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package quic
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hanzozt/identity"
+	"github.com/hanzozt/transport/v2"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/quic-go/quic-go"
+	"github.com/sirupsen/logrus"
+)
+
+const DefaultHandshakeTimeout = 30 * time.Second
+
+func Listen(addr *address, name string, i *identity.TokenId, tcfg transport.Configuration, acceptF func(transport.Conn)) (io.Closer, error) {
+	if addr.err != nil {
+		return nil, addr.err
+	}
+
+	timeout, err := tcfg.GetHandshakeTimeout()
+	if err != nil {
+		return nil, err
+	}
+
+	if timeout == 0 {
+		timeout = DefaultHandshakeTimeout
+	}
+
+	log := pfxlog.ContextLogger(name + "/" + addr.String()).Entry
+
+	tlsCfg := i.ServerTLSConfig().Clone()
+	tlsCfg.NextProtos = []string{Protocol}
+	if getConfigForClient := tlsCfg.GetConfigForClient; getConfigForClient != nil {
+		// the identity hands back its own config, which doesn't have the ALPN protocol QUIC requires
+		tlsCfg.GetConfigForClient = func(info *tls.ClientHelloInfo) (*tls.Config, error) {
+			cfg, err := getConfigForClient(info)
+			if cfg != nil {
+				cfg = cfg.Clone()
+				cfg.NextProtos = []string{Protocol}
+			}
+			return cfg, err
+		}
+	}
+
+	listener, err := quic.ListenAddr(addr.UDPAddr.String(), tlsCfg, newQuicConfig(timeout))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &acceptor{
+		name:     name,
+		listener: listener,
+		acceptF:  acceptF,
+		timeout:  timeout,
+		sessions: map[*session]struct{}{},
+	}
+
+	go result.acceptLoop(log)
+
+	return result, nil
+}
+
+type acceptor struct {
+	name     string
+	listener *quic.Listener
+	acceptF  func(transport.Conn)
+	closed   atomic.Bool
+	timeout  time.Duration
+	lock     sync.Mutex
+	sessions map[*session]struct{}
+}
+
+func (self *acceptor) Close() error {
+	if !self.closed.CompareAndSwap(false, true) {
+		return nil
+	}
+
+	err := self.listener.Close()
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	for s := range self.sessions {
+		s.close("listener closed")
+	}
+	return err
+}
+
+func (self *acceptor) acceptLoop(log *logrus.Entry) {
+	defer log.Info("exited")
+
+	for !self.closed.Load() {
+		conn, err := self.listener.Accept(context.Background())
+		if err != nil {
+			if self.closed.Load() {
+				log.WithError(err).Info("listener closed, exiting")
+				return
+			}
+			log.WithError(err).Error("accept failed. Failure not recoverable. Exiting listen loop")
+			return
+		}
+
+		s := newSession(conn)
+
+		self.lock.Lock()
+		if self.closed.Load() {
+			self.lock.Unlock()
+			s.close("listener closed")
+			return
+		}
+		self.sessions[s] = struct{}{}
+		self.lock.Unlock()
+
+		go self.acceptStreams(s, log.WithField("remoteAddr", conn.RemoteAddr().String()))
+	}
+}
+
+func (self *acceptor) acceptStreams(s *session, log *logrus.Entry) {
+	defer func() {
+		self.lock.Lock()
+		defer self.lock.Unlock()
+		delete(self.sessions, s)
+	}()
+
+	for {
+		stream, err := s.conn.AcceptStream(s.conn.Context())
+		if err != nil {
+			log.WithError(err).Debug("connection closed")
+			return
+		}
+
+		if !s.acquire() {
+			stream.CancelRead(0)
+			_ = stream.Close()
+			return
+		}
+
+		go self.acceptStream(s, stream, log)
+	}
+}
+
+func (self *acceptor) acceptStream(s *session, stream *quic.Stream, log *logrus.Entry) {
+	mode := []byte{0}
+
+	_ = stream.SetReadDeadline(time.Now().Add(self.timeout))
+	_, err := io.ReadFull(stream, mode)
+	_ = stream.SetReadDeadline(time.Time{})
+
+	if err != nil || (mode[0] != streamModeStream && mode[0] != streamModeDatagram) {
+		log.WithError(err).WithField("mode", mode[0]).Error("unable to read stream mode, closing stream")
+		stream.CancelRead(0)
+		_ = stream.Close()
+		s.release()
+		return
+	}
+
+	detail := &transport.ConnectionDetail{
+		Address: Type + ":" + s.conn.RemoteAddr().String(),
+		InBound: true,
+		Name:    self.name,
+	}
+
+	self.acceptF(newConn(mode[0], stream, s, detail))
+}
@@ -0,0 +1,291 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package quic
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	mathrand "math/rand"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hanzozt/identity"
+	"github.com/hanzozt/transport/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressParser(t *testing.T) {
+	req := require.New(t)
+
+	addr, err := AddressParser{}.Parse("quic:127.0.0.1:6262")
+	req.NoError(err)
+	req.Equal(Type, addr.Type())
+	req.Equal("quic:127.0.0.1:6262", addr.String())
+	req.False(addr.(*address).datagrams)
+
+	datagramAddr := DatagramAddress(addr)
+	req.NotNil(datagramAddr)
+	req.True(datagramAddr.(*address).datagrams)
+	req.False(addr.(*address).datagrams)
+	req.Equal(addr.String(), datagramAddr.String())
+
+	_, err = AddressParser{}.Parse("tls:127.0.0.1:6262")
+	req.Error(err)
+
+	req.Nil(DatagramAddress(nil))
+}
+
+func TestStreamsAndDatagrams(t *testing.T) {
+	req := require.New(t)
+
+	id := newTestIdentity(t)
+	addr := listenEcho(t, id)
+
+	streamConn, err := addr.Dial("test", id, time.Second, nil)
+	req.NoError(err)
+	defer func() { _ = streamConn.Close() }()
+
+	req.Len(streamConn.PeerCertificates(), 1)
+
+	data := make([]byte, 100_000)
+	_, _ = rand.Read(data)
+	_, err = streamConn.Write(data)
+	req.NoError(err)
+
+	result := make([]byte, len(data))
+	_, err = io.ReadFull(streamConn, result)
+	req.NoError(err)
+	req.Equal(data, result)
+
+	datagramConn, err := DatagramAddress(addr).Dial("test", id, time.Second, nil)
+	req.NoError(err)
+
+	// both connections share one quic connection
+	req.Equal(1, countSessions())
+
+	// the first message goes over the stream, later ones as datagrams, too large ones over the stream again
+	for _, msg := range [][]byte{[]byte("hello"), []byte("ack-1"), []byte("ack-2"), bytes.Repeat([]byte("x"), 5000)} {
+		_, err = datagramConn.Write(msg)
+		req.NoError(err)
+
+		buf := make([]byte, 10_000)
+		req.NoError(datagramConn.SetReadDeadline(time.Now().Add(5 * time.Second)))
+		n, err := datagramConn.Read(buf)
+		req.NoError(err)
+		req.Equal(msg, buf[:n])
+	}
+
+	req.NoError(datagramConn.Close())
+	req.Equal(1, countSessions())
+
+	req.NoError(streamConn.Close())
+	req.Eventually(func() bool {
+		return countSessions() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestStreamsWithLoss(t *testing.T) {
+	req := require.New(t)
+
+	id := newTestIdentity(t)
+	listenAddr := listenEcho(t, id)
+	relay := newLossyRelay(t, listenAddr.(*address), 0.05)
+
+	addr, err := AddressParser{}.Parse("quic:" + relay.LocalAddr().String())
+	req.NoError(err)
+
+	conn, err := addr.Dial("test", id, 5*time.Second, nil)
+	req.NoError(err)
+	defer func() { _ = conn.Close() }()
+
+	data := make([]byte, 1_000_000)
+	_, _ = rand.Read(data)
+
+	go func() {
+		_, _ = conn.Write(data)
+	}()
+
+	result := make([]byte, len(data))
+	req.NoError(conn.SetReadDeadline(time.Now().Add(30 * time.Second)))
+	_, err = io.ReadFull(conn, result)
+	req.NoError(err)
+	req.Equal(data, result)
+	req.Greater(relay.dropped.Load(), int64(0))
+}
+
+func countSessions() int {
+	sessions.lock.Lock()
+	defer sessions.lock.Unlock()
+	return len(sessions.sessions)
+}
+
+func listenEcho(t *testing.T, id *identity.TokenId) transport.Address {
+	req := require.New(t)
+
+	port := freeUdpPort(t)
+	addr, err := AddressParser{}.Parse(fmt.Sprintf("quic:127.0.0.1:%d", port))
+	req.NoError(err)
+
+	closer, err := addr.Listen("test", id, func(conn transport.Conn) {
+		go func() {
+			_, _ = io.Copy(conn, conn)
+			_ = conn.Close()
+		}()
+	}, nil)
+	req.NoError(err)
+	t.Cleanup(func() { _ = closer.Close() })
+
+	return addr
+}
+
+func freeUdpPort(t *testing.T) int {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+	return conn.LocalAddr().(*net.UDPAddr).Port
+}
+
+// lossyRelay forwards udp packets between a single client and a server, dropping a fraction of them in each
+// direction
+type lossyRelay struct {
+	*net.UDPConn
+	target  *net.UDPAddr
+	loss    float64
+	dropped atomic.Int64
+}
+
+func newLossyRelay(t *testing.T, target *address, loss float64) *lossyRelay {
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	require.NoError(t, err)
+
+	upstream, err := net.DialUDP("udp", nil, &target.UDPAddr)
+	require.NoError(t, err)
+
+	result := &lossyRelay{
+		UDPConn: conn,
+		target:  &target.UDPAddr,
+		loss:    loss,
+	}
+
+	var client atomic.Pointer[net.UDPAddr]
+
+	go func() {
+		buf := make([]byte, 65536)
+		for {
+			n, from, err := conn.ReadFromUDP(buf)
+			if err != nil {
+				return
+			}
+			client.Store(from)
+			if !result.drop() {
+				_, _ = upstream.Write(buf[:n])
+			}
+		}
+	}()
+
+	go func() {
+		buf := make([]byte, 65536)
+		for {
+			n, err := upstream.Read(buf)
+			if err != nil {
+				return
+			}
+			if to := client.Load(); to != nil && !result.drop() {
+				_, _ = conn.WriteToUDP(buf[:n], to)
+			}
+		}
+	}()
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+		_ = upstream.Close()
+	})
+
+	return result
+}
+
+func (self *lossyRelay) drop() bool {
+	if mathrand.Float64() < self.loss {
+		self.dropped.Add(1)
+		return true
+	}
+	return false
+}
+
+func newTestIdentity(t *testing.T) *identity.TokenId {
+	req := require.New(t)
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	req.NoError(err)
+
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDer, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, caKey.Public(), caKey)
+	req.NoError(err)
+	caCert, err := x509.ParseCertificate(caDer)
+	req.NoError(err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	req.NoError(err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "test-router"},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, key.Public(), caKey)
+	req.NoError(err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	req.NoError(err)
+
+	certPem := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	keyPem := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+	caPem := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDer}))
+
+	id, err := identity.LoadIdentity(identity.Config{
+		Key:        "pem:" + keyPem,
+		Cert:       "pem:" + certPem,
+		ServerCert: "pem:" + certPem,
+		CA:         "pem:" + caPem,
+	})
+	req.NoError(err)
+
+	return identity.NewIdentity(id)
+}
@@ -41,6 +41,7 @@ type impl struct {
 	dialed        bool
 	iteration     uint32
 	dupsRejected  uint32
+	compression   string
 
 	droppedMsgMeter    metrics.Meter
 	droppedXgMsgMeter  metrics.Meter
@@ -75,8 +76,13 @@ func (self *impl) Init(metricsRegistry metrics.Registry) error {
 }
 
 func (self *impl) SendPayload(msg *xgress.Payload, timeout time.Duration, payloadType xgress.PayloadType) error {
+	return self.SendPriorityPayload(msg, timeout, payloadType, ctrl_pb.PriorityClass_PriorityBulk)
+}
+
+func (self *impl) SendPriorityPayload(msg *xgress.Payload, timeout time.Duration, payloadType xgress.PayloadType, priority ctrl_pb.PriorityClass) error {
+	sender := self.ch.GetPayloadSender(priority)
 	if timeout == 0 {
-		sent, err := self.ch.GetDefaultSender().TrySend(msg.Marshall())
+		sent, err := sender.TrySend(msg.Marshall())
 		if err == nil && !sent {
 			self.droppedMsgMeter.Mark(1)
 			if payloadType == xgress.PayloadTypeXg {
@@ -90,7 +96,7 @@ func (self *impl) SendPayload(msg *xgress.Payload, timeout time.Duration, payloa
 		return err
 	}
 
-	return msg.Marshall().WithTimeout(timeout).Send(self.ch.GetDefaultSender())
+	return msg.Marshall().WithTimeout(timeout).Send(sender)
 }
 
 func (self *impl) SendAcknowledgement(msg *xgress.Acknowledgement) error {
@@ -160,6 +166,7 @@ func (self *impl) InspectCircuit(detail *xgress.CircuitInspectDetail) {
 func (self *impl) InspectLink() *inspect.LinkInspectDetail {
 	result := GetLinkInspectDetail(self)
 	result.Split = false
+	result.Compression = self.compression
 	result.Underlays = self.ch.GetChannel().GetUnderlayCountsByType()
 	return result
 }
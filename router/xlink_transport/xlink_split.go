@@ -44,6 +44,7 @@ type splitImpl struct {
 	dialed        bool
 	iteration     uint32
 	dupsRejected  uint32
+	compression   string
 	lock          sync.Mutex
 
 	droppedMsgMeter    metrics.Meter
@@ -85,8 +86,15 @@ func (self *splitImpl) syncInit(f func() error) error {
 }
 
 func (self *splitImpl) SendPayload(msg *xgress.Payload, timeout time.Duration, payloadType xgress.PayloadType) error {
+	return self.SendPriorityPayload(msg, timeout, payloadType, ctrl_pb.PriorityClass_PriorityBulk)
+}
+
+// SendPriorityPayload sends payloads on the payload channel with the channel priority of their circuit's priority
+// class, using the same mapping as single underlay links
+func (self *splitImpl) SendPriorityPayload(msg *xgress.Payload, timeout time.Duration, payloadType xgress.PayloadType, priority ctrl_pb.PriorityClass) error {
+	sender := newPayloadSender(self.payloadCh, priority)
 	if timeout == 0 {
-		sent, err := self.payloadCh.TrySend(msg.Marshall())
+		sent, err := sender.TrySend(msg.Marshall())
 		if err == nil && !sent {
 			pfxlog.Logger().WithField("circuitId", msg.CircuitId).Info("dropped payload")
 			self.droppedMsgMeter.Mark(1)
@@ -101,7 +109,7 @@ func (self *splitImpl) SendPayload(msg *xgress.Payload, timeout time.Duration, p
 		return err
 	}
 
-	return msg.Marshall().WithTimeout(timeout).Send(self.payloadCh)
+	return msg.Marshall().WithTimeout(timeout).Send(sender)
 }
 
 func (self *splitImpl) SendAcknowledgement(msg *xgress.Acknowledgement) error {
@@ -190,6 +198,7 @@ func (self *splitImpl) InspectCircuit(detail *xgress.CircuitInspectDetail) {
 func (self *splitImpl) InspectLink() *inspect.LinkInspectDetail {
 	result := GetLinkInspectDetail(self)
 	result.Split = true
+	result.Compression = self.compression
 	result.Underlays = map[string]int{
 		"ack":     1,
 		"payload": 1,
@@ -3,14 +3,21 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"io"
 	"sync/atomic"
 	"time"
 
+	"github.com/hanzozt/identity"
 	"github.com/hanzozt/metrics"
+	"github.com/hanzozt/transport/v2"
 	"github.com/hanzozt/transport/v2/shaper"
+	"github.com/hanzozt/zt/v2/router/xlink_transport/quic"
 )
 
+var useQuic = flag.Bool("quic", false, "write over a quic link connection to a local listener, using the identity of router 001 from etc")
+
 type metricsWriter struct {
 	m metrics.Meter
 }
@@ -31,10 +38,47 @@ func printRate(rate float64, desc string) {
 	fmt.Printf("%s: %.2f%s\n", desc, rate, units[index])
 }
 
+// quicWriter returns a connection to a local quic listener, which marks the bytes it receives on the given meter
+func quicWriter(m metrics.Meter) io.Writer {
+	id, err := identity.LoadIdentity(identity.Config{
+		Cert:       "etc/ca/intermediate/certs/001-client.cert.pem",
+		ServerCert: "etc/ca/intermediate/certs/001-server.cert.pem",
+		Key:        "etc/ca/intermediate/private/001.key.pem",
+		CA:         "etc/ca/intermediate/certs/ca-chain.cert.pem",
+	})
+	if err != nil {
+		panic(err)
+	}
+	tokenId := identity.NewIdentity(id)
+
+	addr, err := quic.AddressParser{}.Parse("quic:127.0.0.1:6299")
+	if err != nil {
+		panic(err)
+	}
+
+	addr.MustListen("shaper", tokenId, func(conn transport.Conn) {
+		go func() {
+			_, _ = io.Copy(&metricsWriter{m: m}, conn)
+		}()
+	}, nil)
+
+	conn, err := addr.Dial("shaper", tokenId, 5*time.Second, nil)
+	if err != nil {
+		panic(err)
+	}
+	return conn
+}
+
 func main() {
+	flag.Parse()
+
 	r := metrics.NewRegistry("test", nil)
 	meter := r.Meter("writes")
-	w := &metricsWriter{m: meter}
+
+	var w io.Writer = &metricsWriter{m: meter}
+	if *useQuic {
+		w = quicWriter(meter)
+	}
 	f := shaper.LimitWriter(w, time.Second, 500000)
 
 	var written int64
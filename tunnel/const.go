@@ -6,6 +6,7 @@ const (
 	DestinationIpKey       = "dst_ip"
 	DestinationPortKey     = "dst_port"
 	SourceAddrKey          = "source_addr"
+	DialerIdentityKey      = "dialer_identity"
 
 	SourceIpKey   = "src_ip"
 	SourcePortKey = "src_port"
@@ -127,6 +127,8 @@ type HostV1Config struct {
 
 	ListenOptions *HostV1ListenOptions
 	Proxy         *ProxyConfiguration
+	HttpProxy     *HttpProxyConfiguration
+	ProxyProtocol string
 
 	allowedAddrs []allowedAddress
 }
@@ -219,6 +221,29 @@ type ProxyConfiguration struct {
 	Type    string
 }
 
+// HttpProxyConfiguration has the hosting tunneler serve HTTP/1.1 and HTTP/2 and forward the requests to the dialed
+// address with headers identifying the caller. Empty header names select the defaults.
+//
+// The role attributes and signed token of the caller are only available to tunnelers embedded in a router. SDK based
+// tunnelers only learn the id and name of the caller, so they don't send the role attributes and token headers, and
+// rules allowing '#<role attribute>' are rejected when they load the config.
+type HttpProxyConfiguration struct {
+	IdentityIdHeader     string
+	IdentityNameHeader   string
+	RoleAttributesHeader string
+	IdentityTokenHeader  string
+	Rules                []*HttpProxyRule
+}
+
+// HttpProxyRule allows the callers matching any of the Allow entries to make requests whose path starts with
+// PathPrefix and, if Methods is set, which use one of the given methods. Allow entries are '#all', '#<role attribute>'
+// or '@<identity id or name>'.
+type HttpProxyRule struct {
+	PathPrefix string
+	Methods    []string
+	Allow      []string
+}
+
 func (self *HostV1Config) GetDialTimeout(defaultTimeout time.Duration) time.Duration {
 	if self.ListenOptions != nil {
 		if self.ListenOptions.ConnectTimeout != nil {
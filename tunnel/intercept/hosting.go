@@ -43,11 +43,14 @@ type healthChecksProvider interface {
 	GetHttpChecks() []*health.HttpCheckDefinition
 }
 
-func createHostingContexts(service *entities.Service, identity *rest_model.IdentityDetail, tracker AddressTracker) []tunnel.HostingContext {
+// createHostingContexts creates a hosting context per terminator of the service's host.v2 config. fullCallerIdentity
+// indicates whether the fabric provider supplies the role attributes and token of dialing identities, or only their
+// id and name.
+func createHostingContexts(service *entities.Service, identity *rest_model.IdentityDetail, tracker AddressTracker, fullCallerIdentity bool) []tunnel.HostingContext {
 	var result []tunnel.HostingContext
 	pfxlog.Logger().WithField("service", service.Name).WithField("terminatorCount", len(service.HostV2Config.Terminators)).Info("creating hosting contexts")
 	for idx, t := range service.HostV2Config.Terminators {
-		context := newDefaultHostingContext(identity, service, t, tracker, uint32(idx))
+		context := newDefaultHostingContext(identity, service, t, tracker, uint32(idx), fullCallerIdentity)
 		if context == nil {
 			for _, c := range result {
 				c.OnClose()
@@ -59,7 +62,7 @@ func createHostingContexts(service *entities.Service, identity *rest_model.Ident
 	return result
 }
 
-func newDefaultHostingContext(identity *rest_model.IdentityDetail, service *entities.Service, config *entities.HostV1Config, tracker AddressTracker, index uint32) *hostingContext {
+func newDefaultHostingContext(identity *rest_model.IdentityDetail, service *entities.Service, config *entities.HostV1Config, tracker AddressTracker, index uint32, fullCallerIdentity bool) *hostingContext {
 	log := pfxlog.Logger().WithField("service", service.Name)
 
 	if config.ForwardProtocol && len(config.AllowedProtocols) < 1 {
@@ -128,6 +131,29 @@ func newDefaultHostingContext(identity *rest_model.IdentityDetail, service *enti
 		return nil
 	}
 
+	if config.ProxyProtocol != "" {
+		if config.ProxyProtocol != ProxyProtocolV1 && config.ProxyProtocol != ProxyProtocolV2 {
+			log.Errorf("configuration specifies unsupported 'proxyProtocol' version '%s'", config.ProxyProtocol)
+			return nil
+		}
+		if !config.ForwardProtocol && config.Protocol != "tcp" {
+			log.Error("configuration specifies 'proxyProtocol' without dialing 'tcp'")
+			return nil
+		}
+	}
+
+	var httpProxy *httpProxy
+	if config.HttpProxy != nil {
+		if config.ForwardProtocol || config.Protocol != "tcp" {
+			log.Error("configuration specifies 'httpProxy' without dialing 'tcp'")
+			return nil
+		}
+		if httpProxy, err = newHttpProxy(config.HttpProxy, fullCallerIdentity); err != nil {
+			log.WithError(err).Error("invalid http proxy configuration")
+			return nil
+		}
+	}
+
 	var proxyConf *transport.ProxyConfiguration
 	if config.Proxy != nil {
 		proxyConf = &transport.ProxyConfiguration{
@@ -145,6 +171,7 @@ func newDefaultHostingContext(identity *rest_model.IdentityDetail, service *enti
 		config:           config,
 		addrTracker:      tracker,
 		addrTranslations: addrTranslations,
+		httpProxy:        httpProxy,
 	}
 
 }
@@ -166,6 +193,7 @@ type hostingContext struct {
 	addrTracker      AddressTracker
 	addrTranslations []addrTranslation
 	dialWrapper      tunnel.DialWrapper
+	httpProxy        *httpProxy
 }
 
 func (self *hostingContext) GetTerminatorIdCacheKey() string {
@@ -260,6 +288,13 @@ func (self *hostingContext) dialAddress(options map[string]interface{}, protocol
 		conn, err = dialer.Dial(protocol, address)
 	}
 
+	if err == nil && isTcp && self.config.ProxyProtocol != "" {
+		if err = self.writeProxyProtocolHeader(options, conn); err != nil {
+			_ = conn.Close()
+			return nil, false, err
+		}
+	}
+
 	return conn, enableHalfClose, err
 }
 
@@ -413,9 +448,29 @@ func (self *hostingContext) Dial(options map[string]interface{}) (net.Conn, bool
 		return nil, false, err
 	}
 
+	if self.httpProxy != nil {
+		return self.dialHttp(options, protocol, net.JoinHostPort(xAddress, port))
+	}
+
 	return self.dialAddress(options, protocol, xAddress+":"+port)
 }
 
+// dialHttp dials the hosted application and returns a connection which serves the requests of the caller with the
+// http proxy
+func (self *hostingContext) dialHttp(options map[string]interface{}, protocol string, address string) (net.Conn, bool, error) {
+	conn, _, err := self.dialAddress(options, protocol, address)
+	if err != nil {
+		return nil, false, err
+	}
+
+	dial := func() (net.Conn, error) {
+		conn, _, err := self.dialAddress(options, protocol, address)
+		return conn, err
+	}
+
+	return self.httpProxy.serve(conn, address, dial, tunnel.GetDialerIdentity(options)), false, nil
+}
+
 func getDefaultOptions(service *entities.Service, identity *rest_model.IdentityDetail, config *entities.HostV1Config) (*zt.ListenOptions, error) {
 	options := zt.DefaultListenOptions()
 	options.ManualStart = true
@@ -0,0 +1,293 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package intercept
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/hanzozt/zt/v2/tunnel"
+	"github.com/hanzozt/zt/v2/tunnel/entities"
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultHttpIdentityIdHeader     = "X-ZT-Identity-Id"
+	DefaultHttpIdentityNameHeader   = "X-ZT-Identity-Name"
+	DefaultHttpRoleAttributesHeader = "X-ZT-Role-Attributes"
+	DefaultHttpIdentityTokenHeader  = "X-ZT-Identity-Token"
+
+	httpProxyAllowAll = "#all"
+)
+
+// httpProxy serves HTTP/1.1 and HTTP/2 on hosted connections, and forwards the requests allowed by its rules to the
+// hosted application with headers identifying the caller
+type httpProxy struct {
+	identityIdHeader     string
+	identityNameHeader   string
+	roleAttributesHeader string
+	identityTokenHeader  string
+	rules                []*httpProxyRule
+}
+
+type httpProxyRule struct {
+	pathPrefix     string
+	methods        []string
+	allowAll       bool
+	roleAttributes []string
+	identities     []string
+}
+
+// newHttpProxy creates an http proxy from its configuration. Role attribute rules are rejected unless
+// fullCallerIdentity is set, since without the role attributes of callers they would deny everyone.
+func newHttpProxy(config *entities.HttpProxyConfiguration, fullCallerIdentity bool) (*httpProxy, error) {
+	result := &httpProxy{
+		identityIdHeader:     headerOrDefault(config.IdentityIdHeader, DefaultHttpIdentityIdHeader),
+		identityNameHeader:   headerOrDefault(config.IdentityNameHeader, DefaultHttpIdentityNameHeader),
+		roleAttributesHeader: headerOrDefault(config.RoleAttributesHeader, DefaultHttpRoleAttributesHeader),
+		identityTokenHeader:  headerOrDefault(config.IdentityTokenHeader, DefaultHttpIdentityTokenHeader),
+	}
+
+	for _, ruleConfig := range config.Rules {
+		if !strings.HasPrefix(ruleConfig.PathPrefix, "/") {
+			return nil, errors.Errorf("invalid http proxy rule path prefix '%s', must start with '/'", ruleConfig.PathPrefix)
+		}
+
+		rule := &httpProxyRule{
+			pathPrefix: strings.TrimSuffix(path.Clean(ruleConfig.PathPrefix), "/"),
+			methods:    ruleConfig.Methods,
+		}
+
+		for _, allow := range ruleConfig.Allow {
+			if allow == httpProxyAllowAll {
+				rule.allowAll = true
+			} else if len(allow) > 1 && allow[0] == '#' {
+				if !fullCallerIdentity {
+					return nil, errors.Errorf("invalid http proxy rule allow entry '%s', role attributes of callers are only available when hosting from a router", allow)
+				}
+				rule.roleAttributes = append(rule.roleAttributes, allow[1:])
+			} else if len(allow) > 1 && allow[0] == '@' {
+				rule.identities = append(rule.identities, allow[1:])
+			} else {
+				return nil, errors.Errorf("invalid http proxy rule allow entry '%s', must be '#all', '#<role attribute>' or '@<identity>'", allow)
+			}
+		}
+
+		result.rules = append(result.rules, rule)
+	}
+
+	return result, nil
+}
+
+func headerOrDefault(header string, defaultHeader string) string {
+	if header == "" {
+		return defaultHeader
+	}
+	return header
+}
+
+// isAllowed returns true if the first rule matching the request allows the caller. If there are no rules, all
+// requests are allowed.
+func (self *httpProxy) isAllowed(req *http.Request, identity *tunnel.DialerIdentity) bool {
+	if len(self.rules) == 0 {
+		return true
+	}
+
+	// match against the cleaned path, so paths such as '/public/../admin' can't be used to get around a rule
+	reqPath := path.Clean("/" + req.URL.Path)
+	for _, rule := range self.rules {
+		if rule.matches(req.Method, reqPath) {
+			return rule.allows(identity)
+		}
+	}
+	return false
+}
+
+func (self *httpProxyRule) matches(method string, reqPath string) bool {
+	if reqPath != self.pathPrefix && !strings.HasPrefix(reqPath, self.pathPrefix+"/") {
+		return false
+	}
+	if len(self.methods) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(self.methods, func(m string) bool {
+		return strings.EqualFold(m, method)
+	})
+}
+
+func (self *httpProxyRule) allows(identity *tunnel.DialerIdentity) bool {
+	if self.allowAll {
+		return true
+	}
+	if identity == nil {
+		return false
+	}
+	if slices.Contains(self.identities, identity.Id) || (identity.Name != "" && slices.Contains(self.identities, identity.Name)) {
+		return true
+	}
+	return slices.ContainsFunc(identity.RoleAttributes, func(attr string) bool {
+		return slices.Contains(self.roleAttributes, attr)
+	})
+}
+
+// setIdentityHeaders replaces any identity headers sent by the caller with the identity from the circuit
+func (self *httpProxy) setIdentityHeaders(header http.Header, identity *tunnel.DialerIdentity) {
+	header.Del(self.identityIdHeader)
+	header.Del(self.identityNameHeader)
+	header.Del(self.roleAttributesHeader)
+	header.Del(self.identityTokenHeader)
+
+	if identity == nil {
+		return
+	}
+
+	header.Set(self.identityIdHeader, identity.Id)
+	if identity.Name != "" {
+		header.Set(self.identityNameHeader, identity.Name)
+	}
+	if identity.RoleAttributes != nil {
+		header.Set(self.roleAttributesHeader, strings.Join(identity.RoleAttributes, ","))
+	}
+	if identity.Token != "" {
+		header.Set(self.identityTokenHeader, identity.Token)
+	}
+}
+
+// serve returns a connection which the circuit is joined to. Requests read from it are forwarded to the given
+// address, using the already established backend connection first and dialing more as needed.
+func (self *httpProxy) serve(backendConn net.Conn, address string, dial func() (net.Conn, error), identity *tunnel.DialerIdentity) net.Conn {
+	log := pfxlog.Logger().WithField("address", address)
+
+	var connLock sync.Mutex
+	transport := &http.Transport{
+		DialContext: func(context.Context, string, string) (net.Conn, error) {
+			connLock.Lock()
+			conn := backendConn
+			backendConn = nil
+			connLock.Unlock()
+
+			if conn != nil {
+				return conn, nil
+			}
+			return dial()
+		},
+		IdleConnTimeout: 90 * time.Second,
+	}
+
+	target := &url.URL{Scheme: "http", Host: address}
+	proxy := &httputil.ReverseProxy{
+		Rewrite: func(r *httputil.ProxyRequest) {
+			r.SetURL(target)
+			r.Out.Host = r.In.Host
+			self.setIdentityHeaders(r.Out.Header, identity)
+		},
+		Transport: transport,
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			log.WithError(err).Error("failed to proxy http request")
+			w.WriteHeader(http.StatusBadGateway)
+		},
+	}
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !self.isAllowed(r, identity) {
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+		proxy.ServeHTTP(w, r)
+	})
+
+	circuitConn, serverConn := net.Pipe()
+	listener := newSingleConnListener(serverConn)
+
+	protocols := &http.Protocols{}
+	protocols.SetHTTP1(true)
+	protocols.SetUnencryptedHTTP2(true)
+
+	server := &http.Server{
+		Handler:   handler,
+		Protocols: protocols,
+		ConnState: func(_ net.Conn, state http.ConnState) {
+			if state == http.StateClosed || state == http.StateHijacked {
+				_ = listener.Close()
+			}
+		},
+	}
+
+	go func() {
+		_ = server.Serve(listener)
+		transport.CloseIdleConnections()
+
+		connLock.Lock()
+		defer connLock.Unlock()
+		if backendConn != nil {
+			_ = backendConn.Close()
+		}
+	}()
+
+	return circuitConn
+}
+
+// singleConnListener hands out a single connection and then blocks until closed
+type singleConnListener struct {
+	lock      sync.Mutex
+	conn      net.Conn
+	addr      net.Addr
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func newSingleConnListener(conn net.Conn) *singleConnListener {
+	return &singleConnListener{
+		conn:   conn,
+		addr:   conn.LocalAddr(),
+		closed: make(chan struct{}),
+	}
+}
+
+func (self *singleConnListener) Accept() (net.Conn, error) {
+	self.lock.Lock()
+	conn := self.conn
+	self.conn = nil
+	self.lock.Unlock()
+
+	if conn != nil {
+		return conn, nil
+	}
+
+	<-self.closed
+	return nil, net.ErrClosed
+}
+
+func (self *singleConnListener) Close() error {
+	self.closeOnce.Do(func() {
+		close(self.closed)
+	})
+	return nil
+}
+
+func (self *singleConnListener) Addr() net.Addr {
+	return self.addr
+}
@@ -0,0 +1,196 @@
+package intercept
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/hanzozt/zt/v2/tunnel"
+	"github.com/hanzozt/zt/v2/tunnel/entities"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+)
+
+func newHttpHostingContext(t *testing.T, backend *httptest.Server, config *entities.HttpProxyConfiguration) *hostingContext {
+	req := require.New(t)
+
+	host, portStr, err := net.SplitHostPort(backend.Listener.Addr().String())
+	req.NoError(err)
+	port, err := strconv.Atoi(portStr)
+	req.NoError(err)
+
+	identity, err := (&testProvider{}).GetCurrentIdentity()
+	req.NoError(err)
+
+	service := &entities.Service{}
+	id := "http-id"
+	name := "http"
+	service.ID = &id
+	service.Name = &name
+
+	hostCtx := newDefaultHostingContext(identity, service, &entities.HostV1Config{
+		Protocol:  "tcp",
+		Address:   host,
+		Port:      port,
+		HttpProxy: config,
+	}, nil, 0, true)
+	req.NotNil(hostCtx)
+	return hostCtx
+}
+
+func newIdentityEchoBackend(t *testing.T) *httptest.Server {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Id", r.Header.Get(DefaultHttpIdentityIdHeader))
+		w.Header().Set("Name", r.Header.Get(DefaultHttpIdentityNameHeader))
+		w.Header().Set("Roles", r.Header.Get(DefaultHttpRoleAttributesHeader))
+		w.Header().Set("Token", r.Header.Get(DefaultHttpIdentityTokenHeader))
+		_, _ = io.WriteString(w, r.URL.Path)
+	}))
+	t.Cleanup(backend.Close)
+	return backend
+}
+
+func dialHttp(t *testing.T, hostCtx *hostingContext, identity *tunnel.DialerIdentity) net.Conn {
+	options := map[string]interface{}{}
+	tunnel.SetDialerIdentity(options, identity)
+
+	conn, halfClose, err := hostCtx.Dial(options)
+	require.NoError(t, err)
+	require.False(t, halfClose)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func Test_HttpProxyHeaders(t *testing.T) {
+	req := require.New(t)
+	backend := newIdentityEchoBackend(t)
+	hostCtx := newHttpHostingContext(t, backend, &entities.HttpProxyConfiguration{})
+
+	conn := dialHttp(t, hostCtx, &tunnel.DialerIdentity{
+		Id:             "alice-id",
+		Name:           "alice",
+		RoleAttributes: []string{"dev", "ops"},
+		Token:          "token",
+	})
+	reader := bufio.NewReader(conn)
+
+	// identity headers sent by the caller are replaced, and the connection can be used for several requests
+	for _, reqPath := range []string{"/one", "/two"} {
+		httpReq, err := http.NewRequest(http.MethodGet, "http://app.example"+reqPath, nil)
+		req.NoError(err)
+		httpReq.Header.Set(DefaultHttpIdentityIdHeader, "mallory-id")
+		req.NoError(httpReq.Write(conn))
+
+		resp, err := http.ReadResponse(reader, httpReq)
+		req.NoError(err)
+		body, err := io.ReadAll(resp.Body)
+		req.NoError(err)
+		req.NoError(resp.Body.Close())
+
+		req.Equal(http.StatusOK, resp.StatusCode)
+		req.Equal(reqPath, string(body))
+		req.Equal("alice-id", resp.Header.Get("Id"))
+		req.Equal("alice", resp.Header.Get("Name"))
+		req.Equal("dev,ops", resp.Header.Get("Roles"))
+		req.Equal("token", resp.Header.Get("Token"))
+	}
+}
+
+func Test_HttpProxyHttp2(t *testing.T) {
+	req := require.New(t)
+	backend := newIdentityEchoBackend(t)
+	hostCtx := newHttpHostingContext(t, backend, &entities.HttpProxyConfiguration{})
+
+	conn := dialHttp(t, hostCtx, &tunnel.DialerIdentity{Id: "alice-id", Name: "alice"})
+
+	client := &http.Client{
+		Transport: &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(context.Context, string, string, *tls.Config) (net.Conn, error) {
+				return conn, nil
+			},
+		},
+	}
+
+	resp, err := client.Get("http://app.example/h2")
+	req.NoError(err)
+	defer func() { _ = resp.Body.Close() }()
+
+	req.Equal(http.StatusOK, resp.StatusCode)
+	req.Equal(2, resp.ProtoMajor)
+	req.Equal("alice-id", resp.Header.Get("Id"))
+	req.Equal("", resp.Header.Get("Roles"))
+}
+
+func Test_HttpProxyRules(t *testing.T) {
+	backend := newIdentityEchoBackend(t)
+	hostCtx := newHttpHostingContext(t, backend, &entities.HttpProxyConfiguration{
+		Rules: []*entities.HttpProxyRule{
+			{PathPrefix: "/public", Allow: []string{"#all"}},
+			{PathPrefix: "/admin", Methods: []string{"GET"}, Allow: []string{"@bob"}},
+			{PathPrefix: "/admin", Allow: []string{"#admin"}},
+			{PathPrefix: "/", Allow: []string{"#dev", "@carol-id"}},
+		},
+	})
+
+	alice := &tunnel.DialerIdentity{Id: "alice-id", Name: "alice", RoleAttributes: []string{"dev"}}
+	bob := &tunnel.DialerIdentity{Id: "bob-id", Name: "bob"}
+	carol := &tunnel.DialerIdentity{Id: "carol-id", Name: "carol", RoleAttributes: []string{"admin"}}
+
+	check := func(identity *tunnel.DialerIdentity, method string, reqPath string, expected int) {
+		t.Helper()
+		req := require.New(t)
+
+		conn := dialHttp(t, hostCtx, identity)
+		httpReq, err := http.NewRequest(method, "http://app.example/", nil)
+		req.NoError(err)
+		httpReq.URL.Opaque = reqPath
+		req.NoError(httpReq.Write(conn))
+
+		resp, err := http.ReadResponse(bufio.NewReader(conn), httpReq)
+		req.NoError(err)
+		req.NoError(resp.Body.Close())
+		req.Equal(expected, resp.StatusCode, "%s %s", method, reqPath)
+	}
+
+	check(nil, http.MethodGet, "/public/index.html", http.StatusOK)
+	check(nil, http.MethodGet, "/other", http.StatusForbidden)
+	check(bob, http.MethodGet, "/admin/users", http.StatusOK)
+	check(bob, http.MethodPost, "/admin/users", http.StatusForbidden)
+	check(carol, http.MethodPost, "/admin/users", http.StatusOK)
+	check(alice, http.MethodGet, "/admin", http.StatusForbidden)
+	check(alice, http.MethodGet, "/administrators", http.StatusOK)
+	check(alice, http.MethodGet, "/public/../admin", http.StatusForbidden)
+	check(bob, http.MethodGet, "/other", http.StatusForbidden)
+	check(carol, http.MethodGet, "/other", http.StatusOK)
+}
+
+func Test_HttpProxyConfigValidation(t *testing.T) {
+	req := require.New(t)
+
+	_, err := newHttpProxy(&entities.HttpProxyConfiguration{
+		Rules: []*entities.HttpProxyRule{{PathPrefix: "admin", Allow: []string{"#all"}}},
+	}, true)
+	req.Error(err)
+
+	_, err = newHttpProxy(&entities.HttpProxyConfiguration{
+		Rules: []*entities.HttpProxyRule{{PathPrefix: "/", Allow: []string{"admin"}}},
+	}, true)
+	req.Error(err)
+
+	_, err = newHttpProxy(&entities.HttpProxyConfiguration{
+		Rules: []*entities.HttpProxyRule{{PathPrefix: "/", Allow: []string{"#admin"}}},
+	}, false)
+	req.ErrorContains(err, "only available when hosting from a router")
+
+	proxy, err := newHttpProxy(&entities.HttpProxyConfiguration{IdentityIdHeader: "X-User"}, false)
+	req.NoError(err)
+	req.Equal("X-User", proxy.identityIdHeader)
+	req.Equal(DefaultHttpIdentityNameHeader, proxy.identityNameHeader)
+}
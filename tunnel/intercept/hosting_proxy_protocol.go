@@ -0,0 +1,182 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package intercept
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/hanzozt/zt/v2/tunnel"
+	"github.com/pkg/errors"
+)
+
+const (
+	ProxyProtocolV1 = "v1"
+	ProxyProtocolV2 = "v2"
+
+	// ProxyProtocolTlvIdentityId and the other TLV types are taken from the range reserved for custom use by the
+	// PROXY protocol specification
+	ProxyProtocolTlvIdentityId   = 0xE0
+	ProxyProtocolTlvServiceName  = 0xE1
+	ProxyProtocolTlvIdentityName = 0xE2
+)
+
+var proxyProtocolV2Signature = []byte{0x0D, 0x0A, 0x0D, 0x0A, 0x00, 0x0D, 0x0A, 0x51, 0x55, 0x49, 0x54, 0x0A}
+
+type proxyProtocolTlv struct {
+	tlvType byte
+	value   []byte
+}
+
+// getProxyProtocolSource returns the address of the client, as given by the app data of the dial
+func getProxyProtocolSource(options map[string]interface{}) *net.TCPAddr {
+	if ip, found := options[tunnel.SourceIpKey]; found {
+		if port, found := options[tunnel.SourcePortKey]; found {
+			return parseTcpAddr(fmt.Sprint(ip), fmt.Sprint(port))
+		}
+	}
+
+	if val, found := options[tunnel.SourceAddrKey]; found {
+		if host, port, err := net.SplitHostPort(fmt.Sprint(val)); err == nil {
+			return parseTcpAddr(host, port)
+		}
+	}
+
+	return nil
+}
+
+// getProxyProtocolDestination returns the address the client connected to, as given by the app data of the dial,
+// falling back to the address of the hosted application
+func getProxyProtocolDestination(options map[string]interface{}, conn net.Conn) *net.TCPAddr {
+	if ip, found := options[tunnel.DestinationIpKey]; found {
+		if port, found := options[tunnel.DestinationPortKey]; found {
+			if result := parseTcpAddr(fmt.Sprint(ip), fmt.Sprint(port)); result != nil {
+				return result
+			}
+		}
+	}
+
+	result, _ := conn.RemoteAddr().(*net.TCPAddr)
+	return result
+}
+
+func parseTcpAddr(host string, portStr string) *net.TCPAddr {
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return nil
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return nil
+	}
+	return &net.TCPAddr{IP: ip, Port: int(port)}
+}
+
+// newProxyProtocolHeader returns the PROXY protocol header for a connection from src to dst. If either address is
+// unknown, or they aren't of the same address family, the header doesn't carry addresses. TLVs are only supported by
+// version 2.
+func newProxyProtocolHeader(version string, src *net.TCPAddr, dst *net.TCPAddr, tlvs []proxyProtocolTlv) ([]byte, error) {
+	var srcIp, dstIp net.IP
+	if src != nil && dst != nil {
+		if src.IP.To4() != nil && dst.IP.To4() != nil {
+			srcIp, dstIp = src.IP.To4(), dst.IP.To4()
+		} else if src.IP.To4() == nil && dst.IP.To4() == nil {
+			srcIp, dstIp = src.IP.To16(), dst.IP.To16()
+		}
+	}
+
+	switch version {
+	case ProxyProtocolV1:
+		if srcIp == nil {
+			return []byte("PROXY UNKNOWN\r\n"), nil
+		}
+		family := "TCP4"
+		if len(srcIp) == net.IPv6len {
+			family = "TCP6"
+		}
+		return []byte(fmt.Sprintf("PROXY %s %s %s %d %d\r\n", family, srcIp, dstIp, src.Port, dst.Port)), nil
+
+	case ProxyProtocolV2:
+		buf := &bytes.Buffer{}
+		buf.Write(proxyProtocolV2Signature)
+		buf.WriteByte(0x21) // version 2, PROXY command
+
+		var addrs []byte
+		if srcIp == nil {
+			buf.WriteByte(0x00) // AF_UNSPEC, the receiver uses the addresses of the connection
+		} else {
+			if len(srcIp) == net.IPv4len {
+				buf.WriteByte(0x11) // AF_INET, STREAM
+			} else {
+				buf.WriteByte(0x21) // AF_INET6, STREAM
+			}
+			addrs = append(addrs, srcIp...)
+			addrs = append(addrs, dstIp...)
+			addrs = binary.BigEndian.AppendUint16(addrs, uint16(src.Port))
+			addrs = binary.BigEndian.AppendUint16(addrs, uint16(dst.Port))
+		}
+
+		for _, tlv := range tlvs {
+			if len(tlv.value) > 0xFFFF {
+				return nil, errors.Errorf("proxy protocol tlv 0x%x is too long", tlv.tlvType)
+			}
+			addrs = append(addrs, tlv.tlvType)
+			addrs = binary.BigEndian.AppendUint16(addrs, uint16(len(tlv.value)))
+			addrs = append(addrs, tlv.value...)
+		}
+
+		if len(addrs) > 0xFFFF {
+			return nil, errors.New("proxy protocol header is too long")
+		}
+		_ = binary.Write(buf, binary.BigEndian, uint16(len(addrs)))
+		buf.Write(addrs)
+		return buf.Bytes(), nil
+	}
+
+	return nil, errors.Errorf("unsupported proxy protocol version '%s'", version)
+}
+
+// writeProxyProtocolHeader sends the PROXY protocol header to the hosted application, before any data from the
+// client. The identity of the dialer is taken from the circuit, but the client address comes from app data supplied
+// by the dialer.
+func (self *hostingContext) writeProxyProtocolHeader(options map[string]interface{}, conn net.Conn) error {
+	var tlvs []proxyProtocolTlv
+	if identity := tunnel.GetDialerIdentity(options); identity != nil {
+		tlvs = append(tlvs, proxyProtocolTlv{tlvType: ProxyProtocolTlvIdentityId, value: []byte(identity.Id)})
+		if identity.Name != "" {
+			tlvs = append(tlvs, proxyProtocolTlv{tlvType: ProxyProtocolTlvIdentityName, value: []byte(identity.Name)})
+		}
+	}
+	tlvs = append(tlvs, proxyProtocolTlv{tlvType: ProxyProtocolTlvServiceName, value: []byte(*self.service.Name)})
+
+	header, err := newProxyProtocolHeader(self.config.ProxyProtocol, getProxyProtocolSource(options), getProxyProtocolDestination(options, conn), tlvs)
+	if err != nil {
+		return err
+	}
+
+	if err = conn.SetWriteDeadline(time.Now().Add(self.dialTimeout)); err != nil {
+		return err
+	}
+	if _, err = conn.Write(header); err != nil {
+		return errors.Wrap(err, "failed to write proxy protocol header")
+	}
+	return conn.SetWriteDeadline(time.Time{})
+}
@@ -0,0 +1,159 @@
+package intercept
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/hanzozt/zt/v2/tunnel"
+	"github.com/hanzozt/zt/v2/tunnel/entities"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ProxyProtocolV1Header(t *testing.T) {
+	req := require.New(t)
+
+	src := &net.TCPAddr{IP: net.ParseIP("192.168.1.10"), Port: 5432}
+	dst := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 80}
+
+	header, err := newProxyProtocolHeader(ProxyProtocolV1, src, dst, nil)
+	req.NoError(err)
+	req.Equal("PROXY TCP4 192.168.1.10 10.0.0.1 5432 80\r\n", string(header))
+
+	src6 := &net.TCPAddr{IP: net.ParseIP("fd00::1"), Port: 5432}
+	dst6 := &net.TCPAddr{IP: net.ParseIP("fd00::2"), Port: 80}
+	header, err = newProxyProtocolHeader(ProxyProtocolV1, src6, dst6, nil)
+	req.NoError(err)
+	req.Equal("PROXY TCP6 fd00::1 fd00::2 5432 80\r\n", string(header))
+
+	header, err = newProxyProtocolHeader(ProxyProtocolV1, nil, dst, nil)
+	req.NoError(err)
+	req.Equal("PROXY UNKNOWN\r\n", string(header))
+
+	header, err = newProxyProtocolHeader(ProxyProtocolV1, src, dst6, nil)
+	req.NoError(err)
+	req.Equal("PROXY UNKNOWN\r\n", string(header))
+
+	_, err = newProxyProtocolHeader("v3", src, dst, nil)
+	req.Error(err)
+}
+
+func Test_ProxyProtocolV2Header(t *testing.T) {
+	req := require.New(t)
+
+	src := &net.TCPAddr{IP: net.ParseIP("192.168.1.10"), Port: 5432}
+	dst := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 80}
+	tlvs := []proxyProtocolTlv{{tlvType: ProxyProtocolTlvServiceName, value: []byte("db")}}
+
+	header, err := newProxyProtocolHeader(ProxyProtocolV2, src, dst, tlvs)
+	req.NoError(err)
+
+	expected := append([]byte{}, proxyProtocolV2Signature...)
+	expected = append(expected, 0x21, 0x11, 0x00, 17)
+	expected = append(expected, 192, 168, 1, 10, 10, 0, 0, 1)
+	expected = append(expected, 0x15, 0x38, 0x00, 0x50)
+	expected = append(expected, ProxyProtocolTlvServiceName, 0x00, 0x02, 'd', 'b')
+	req.Equal(expected, header)
+
+	header, err = newProxyProtocolHeader(ProxyProtocolV2, nil, dst, tlvs)
+	req.NoError(err)
+
+	expected = append([]byte{}, proxyProtocolV2Signature...)
+	expected = append(expected, 0x21, 0x00, 0x00, 5)
+	expected = append(expected, ProxyProtocolTlvServiceName, 0x00, 0x02, 'd', 'b')
+	req.Equal(expected, header)
+}
+
+func Test_ProxyProtocolDial(t *testing.T) {
+	req := require.New(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	req.NoError(err)
+	defer func() { _ = listener.Close() }()
+
+	identity, err := (&testProvider{}).GetCurrentIdentity()
+	req.NoError(err)
+
+	service := &entities.Service{}
+	id := "db-id"
+	name := "db"
+	service.ID = &id
+	service.Name = &name
+
+	hostCtx := newDefaultHostingContext(identity, service, &entities.HostV1Config{
+		Protocol:      "tcp",
+		Address:       "127.0.0.1",
+		Port:          listener.Addr().(*net.TCPAddr).Port,
+		ProxyProtocol: ProxyProtocolV2,
+	}, nil, 0, false)
+	req.NotNil(hostCtx)
+
+	options := map[string]interface{}{
+		tunnel.SourceIpKey:        "192.168.1.10",
+		tunnel.SourcePortKey:      "5432",
+		tunnel.DestinationIpKey:   "10.0.0.1",
+		tunnel.DestinationPortKey: "80",
+	}
+	tunnel.SetDialerIdentity(options, &tunnel.DialerIdentity{Id: "alice-id", Name: "alice"})
+
+	conn, halfClose, err := hostCtx.Dial(options)
+	req.NoError(err)
+	req.True(halfClose)
+	defer func() { _ = conn.Close() }()
+
+	backendConn, err := listener.Accept()
+	req.NoError(err)
+	defer func() { _ = backendConn.Close() }()
+	req.NoError(backendConn.SetReadDeadline(time.Now().Add(5 * time.Second)))
+
+	header := make([]byte, 16)
+	_, err = io.ReadFull(backendConn, header)
+	req.NoError(err)
+	req.Equal(proxyProtocolV2Signature, header[:12])
+	req.Equal(byte(0x21), header[12])
+	req.Equal(byte(0x11), header[13])
+
+	body := make([]byte, binary.BigEndian.Uint16(header[14:]))
+	_, err = io.ReadFull(backendConn, body)
+	req.NoError(err)
+	req.Equal([]byte{192, 168, 1, 10, 10, 0, 0, 1, 0x15, 0x38, 0x00, 0x50}, body[:12])
+
+	tlvs := map[byte]string{}
+	for rest := body[12:]; len(rest) > 0; {
+		req.GreaterOrEqual(len(rest), 3)
+		l := int(binary.BigEndian.Uint16(rest[1:3]))
+		tlvs[rest[0]] = string(rest[3 : 3+l])
+		rest = rest[3+l:]
+	}
+	req.Equal(map[byte]string{
+		ProxyProtocolTlvIdentityId:   "alice-id",
+		ProxyProtocolTlvIdentityName: "alice",
+		ProxyProtocolTlvServiceName:  "db",
+	}, tlvs)
+
+	// data from the client follows the header
+	_, err = conn.Write([]byte("hello"))
+	req.NoError(err)
+	data := make([]byte, 5)
+	_, err = io.ReadFull(backendConn, data)
+	req.NoError(err)
+	req.Equal("hello", string(data))
+}
+
+func Test_ProxyProtocolSource(t *testing.T) {
+	req := require.New(t)
+
+	req.Nil(getProxyProtocolSource(map[string]interface{}{}))
+	req.Equal("1.2.3.4:99", getProxyProtocolSource(map[string]interface{}{
+		tunnel.SourceIpKey:   "1.2.3.4",
+		tunnel.SourcePortKey: float64(99),
+	}).String())
+	req.Equal("[fd00::1]:99", getProxyProtocolSource(map[string]interface{}{
+		tunnel.SourceAddrKey: "[fd00::1]:99",
+	}).String())
+	req.Nil(getProxyProtocolSource(map[string]interface{}{
+		tunnel.SourceAddrKey: "host.example:99",
+	}))
+}
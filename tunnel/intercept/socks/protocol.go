@@ -0,0 +1,263 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package socks
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/hanzozt/sdk-golang/zt/edge"
+)
+
+const (
+	socksVersion = 5
+
+	socksAuthNone         = 0
+	socksAuthUnacceptable = 0xff
+
+	socksCmdConnect = 1
+
+	socksAddrIPv4   = 1
+	socksAddrDomain = 3
+	socksAddrIPv6   = 4
+
+	socksReplySucceeded           = 0
+	socksReplyNotAllowed          = 2
+	socksReplyHostUnreachable     = 4
+	socksReplyCmdNotSupported     = 7
+	socksReplyAddrTypeUnsupported = 8
+)
+
+type replyStatus int
+
+const (
+	replySucceeded replyStatus = iota
+	// replyNoService is sent when no intercepted service matches the requested address
+	replyNoService
+	// replyDialFailed is sent when the service couldn't be dialed
+	replyDialFailed
+)
+
+// proxyRequest is a SOCKS5 or HTTP CONNECT request for a connection to the given host and port, which may be a
+// hostname or an IP
+type proxyRequest struct {
+	host  string
+	port  uint16
+	reply func(w io.Writer, status replyStatus) error
+}
+
+// readRequest reads a SOCKS5 or HTTP CONNECT request. SOCKS5 requests are distinguished by their first byte, which
+// is the protocol version.
+func readRequest(conn net.Conn, reader *bufio.Reader) (*proxyRequest, error) {
+	first, err := reader.Peek(1)
+	if err != nil {
+		return nil, err
+	}
+	if first[0] == socksVersion {
+		return readSocksRequest(conn, reader)
+	}
+	return readConnectRequest(conn, reader)
+}
+
+func readSocksRequest(conn net.Conn, reader *bufio.Reader) (*proxyRequest, error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return nil, err
+	}
+
+	methods := make([]byte, header[1])
+	if _, err := io.ReadFull(reader, methods); err != nil {
+		return nil, err
+	}
+
+	if !bytes.Contains(methods, []byte{socksAuthNone}) {
+		_, _ = conn.Write([]byte{socksVersion, socksAuthUnacceptable})
+		return nil, fmt.Errorf("client doesn't support unauthenticated socks5, methods: %v", methods)
+	}
+
+	if _, err := conn.Write([]byte{socksVersion, socksAuthNone}); err != nil {
+		return nil, err
+	}
+
+	request := make([]byte, 4)
+	if _, err := io.ReadFull(reader, request); err != nil {
+		return nil, err
+	}
+
+	if request[0] != socksVersion {
+		return nil, fmt.Errorf("unsupported socks version %v", request[0])
+	}
+
+	var host string
+	switch request[3] {
+	case socksAddrIPv4, socksAddrIPv6:
+		addrLen := net.IPv4len
+		if request[3] == socksAddrIPv6 {
+			addrLen = net.IPv6len
+		}
+		addr := make([]byte, addrLen)
+		if _, err := io.ReadFull(reader, addr); err != nil {
+			return nil, err
+		}
+		host = net.IP(addr).String()
+	case socksAddrDomain:
+		nameLen, err := reader.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		name := make([]byte, nameLen)
+		if _, err = io.ReadFull(reader, name); err != nil {
+			return nil, err
+		}
+		host = string(name)
+	default:
+		_ = writeSocksReply(conn, socksReplyAddrTypeUnsupported)
+		return nil, fmt.Errorf("unsupported socks address type %v", request[3])
+	}
+
+	port := make([]byte, 2)
+	if _, err := io.ReadFull(reader, port); err != nil {
+		return nil, err
+	}
+
+	if request[1] != socksCmdConnect {
+		_ = writeSocksReply(conn, socksReplyCmdNotSupported)
+		return nil, fmt.Errorf("unsupported socks command %v", request[1])
+	}
+
+	return &proxyRequest{
+		host:  host,
+		port:  binary.BigEndian.Uint16(port),
+		reply: replySocks,
+	}, nil
+}
+
+func replySocks(w io.Writer, status replyStatus) error {
+	switch status {
+	case replySucceeded:
+		return writeSocksReply(w, socksReplySucceeded)
+	case replyNoService:
+		return writeSocksReply(w, socksReplyNotAllowed)
+	default:
+		return writeSocksReply(w, socksReplyHostUnreachable)
+	}
+}
+
+// writeSocksReply writes a reply with the given code. The bound address is left unspecified, as connections are
+// made over the overlay rather than from a local address.
+func writeSocksReply(w io.Writer, code byte) error {
+	_, err := w.Write([]byte{socksVersion, code, 0, socksAddrIPv4, 0, 0, 0, 0, 0, 0})
+	return err
+}
+
+func readConnectRequest(conn net.Conn, reader *bufio.Reader) (*proxyRequest, error) {
+	req, err := http.ReadRequest(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Method != http.MethodConnect {
+		_ = writeHttpReply(conn, http.StatusMethodNotAllowed)
+		return nil, fmt.Errorf("unsupported http proxy method %v", req.Method)
+	}
+
+	host, portStr, err := net.SplitHostPort(req.Host)
+	if err != nil {
+		_ = writeHttpReply(conn, http.StatusBadRequest)
+		return nil, err
+	}
+
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		_ = writeHttpReply(conn, http.StatusBadRequest)
+		return nil, fmt.Errorf("invalid port in http connect request '%v' (%w)", req.Host, err)
+	}
+
+	return &proxyRequest{
+		host:  host,
+		port:  uint16(port),
+		reply: replyHttp,
+	}, nil
+}
+
+func replyHttp(w io.Writer, status replyStatus) error {
+	switch status {
+	case replySucceeded:
+		_, err := io.WriteString(w, "HTTP/1.1 200 Connection established\r\n\r\n")
+		return err
+	case replyNoService:
+		return writeHttpReply(w, http.StatusForbidden)
+	default:
+		return writeHttpReply(w, http.StatusBadGateway)
+	}
+}
+
+func writeHttpReply(w io.Writer, code int) error {
+	_, err := fmt.Fprintf(w, "HTTP/1.1 %d %s\r\nContent-Length: 0\r\nConnection: close\r\n\r\n", code, http.StatusText(code))
+	return err
+}
+
+// proxyConn is a client connection which has completed its proxy handshake. The reply to the request is deferred
+// until the service has been dialed: success is sent when data is first read or written, which only happens once
+// the dial has succeeded, and failure is sent if the connection is closed before that.
+type proxyConn struct {
+	net.Conn
+	reader    *bufio.Reader
+	request   *proxyRequest
+	replyOnce sync.Once
+	replyErr  error
+}
+
+func (self *proxyConn) sendReply(status replyStatus) error {
+	self.replyOnce.Do(func() {
+		self.replyErr = self.request.reply(self.Conn, status)
+	})
+	return self.replyErr
+}
+
+func (self *proxyConn) Read(b []byte) (int, error) {
+	if err := self.sendReply(replySucceeded); err != nil {
+		return 0, err
+	}
+	return self.reader.Read(b)
+}
+
+func (self *proxyConn) Write(b []byte) (int, error) {
+	if err := self.sendReply(replySucceeded); err != nil {
+		return 0, err
+	}
+	return self.Conn.Write(b)
+}
+
+func (self *proxyConn) CloseWrite() error {
+	if cw, ok := self.Conn.(edge.CloseWriter); ok {
+		return cw.CloseWrite()
+	}
+	return nil
+}
+
+func (self *proxyConn) Close() error {
+	_ = self.sendReply(replyDialFailed)
+	return self.Conn.Close()
+}
@@ -0,0 +1,175 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package socks
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/hanzozt/zt/v2/tunnel/dns"
+)
+
+// resolver tracks the hostnames and wildcard domains of intercepted services, so that hostnames requested by proxy
+// clients map onto the same intercept addresses as hostnames resolved through the tunneler's DNS server. Changes are
+// passed on to the wrapped resolver, if there is one.
+type resolver struct {
+	wrapped dns.Resolver
+	lock    sync.Mutex
+	names   map[string]net.IP
+	ips     map[string]string
+	domains map[string]func(string) (net.IP, error)
+}
+
+func newResolver(wrapped dns.Resolver) *resolver {
+	return &resolver{
+		wrapped: wrapped,
+		names:   map[string]net.IP{},
+		ips:     map[string]string{},
+		domains: map[string]func(string) (net.IP, error){},
+	}
+}
+
+func canonicalName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(name), ".")
+}
+
+func (self *resolver) AddHostname(hostname string, ip net.IP) error {
+	self.lock.Lock()
+	canonical := canonicalName(hostname)
+	if _, found := self.names[canonical]; !found {
+		self.names[canonical] = ip
+		self.ips[ip.String()] = canonical
+	}
+	self.lock.Unlock()
+
+	if self.wrapped != nil {
+		return self.wrapped.AddHostname(hostname, ip)
+	}
+	return nil
+}
+
+func (self *resolver) AddDomain(name string, ipCB func(string) (net.IP, error)) error {
+	if name == "" || name[0] != '*' {
+		return errors.New("invalid wildcard domain")
+	}
+
+	self.lock.Lock()
+	self.domains[canonicalName(name[1:])] = ipCB
+	self.lock.Unlock()
+
+	if self.wrapped != nil {
+		return self.wrapped.AddDomain(name, ipCB)
+	}
+	return nil
+}
+
+func (self *resolver) Lookup(ip net.IP) (string, error) {
+	if ip == nil {
+		return "", errors.New("illegal argument")
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if name, found := self.ips[ip.String()]; found {
+		return name, nil
+	}
+	return "", errors.New("not found")
+}
+
+func (self *resolver) LookupIP(name string) (net.IP, bool) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	ip, found := self.names[canonicalName(name)]
+	return ip, found
+}
+
+func (self *resolver) RemoveHostname(hostname string) net.IP {
+	self.lock.Lock()
+	canonical := canonicalName(hostname)
+	ip, found := self.names[canonical]
+	if found {
+		delete(self.names, canonical)
+		delete(self.ips, ip.String())
+	}
+	self.lock.Unlock()
+
+	if self.wrapped != nil {
+		self.wrapped.RemoveHostname(hostname)
+	}
+	return ip
+}
+
+func (self *resolver) RemoveDomain(name string) {
+	if name == "" || name[0] != '*' {
+		return
+	}
+
+	self.lock.Lock()
+	delete(self.domains, canonicalName(name[1:]))
+	self.lock.Unlock()
+
+	if self.wrapped != nil {
+		self.wrapped.RemoveDomain(name)
+	}
+}
+
+func (self *resolver) Cleanup() error {
+	if self.wrapped != nil {
+		return self.wrapped.Cleanup()
+	}
+	return nil
+}
+
+// resolve returns the intercept IP for the given hostname. Hostnames which match the wildcard domain of an intercepted
+// service are assigned an IP on first use, the same way the DNS server assigns them.
+func (self *resolver) resolve(hostname string) (net.IP, error) {
+	if ip, found := self.LookupIP(hostname); found {
+		return ip, nil
+	}
+
+	canonical := canonicalName(hostname)
+	suffix := canonical
+	for len(suffix) > 1 {
+		idx := strings.IndexByte(suffix[1:], '.')
+		if idx < 0 {
+			break
+		}
+		suffix = suffix[idx+1:]
+
+		self.lock.Lock()
+		ipCB, found := self.domains[suffix]
+		self.lock.Unlock()
+
+		if found {
+			// the callback looks the name up in this resolver, so the lock can't be held while it's called
+			ip, err := ipCB(canonical)
+			if err != nil {
+				return nil, err
+			}
+			if err = self.AddHostname(canonical, ip); err != nil {
+				return nil, err
+			}
+			return ip, nil
+		}
+	}
+
+	return nil, errors.New("not found")
+}
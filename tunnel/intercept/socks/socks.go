@@ -0,0 +1,260 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+// Package socks implements an interceptor which exposes a single SOCKS5 and HTTP CONNECT proxy listener. The host and
+// port requested by a client are matched against the intercept.v1 addresses of the dialable services, so that every
+// authorized service is reachable through one listener, without root privileges or a port per service.
+package socks
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/hanzozt/foundation/v2/stringz"
+	"github.com/hanzozt/zt/v2/tunnel"
+	"github.com/hanzozt/zt/v2/tunnel/dns"
+	"github.com/hanzozt/zt/v2/tunnel/entities"
+	"github.com/hanzozt/zt/v2/tunnel/intercept"
+)
+
+const (
+	DefaultAddress = "127.0.0.1:1080"
+
+	handshakeTimeout = 10 * time.Second
+)
+
+type Config struct {
+	// Address is the address the proxy listens on. Defaults to DefaultAddress
+	Address string
+}
+
+type interceptedService struct {
+	service   *entities.Service
+	lock      sync.Mutex
+	addresses []*intercept.InterceptAddress
+}
+
+// Apply adds an intercept address for the service. Addresses for hostnames matching a wildcard domain are added when
+// the hostname is first resolved.
+func (self *interceptedService) Apply(addr *intercept.InterceptAddress) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.addresses = append(self.addresses, addr)
+}
+
+func (self *interceptedService) getAddresses() []*intercept.InterceptAddress {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return self.addresses
+}
+
+type interceptor struct {
+	listener net.Listener
+	lock     sync.RWMutex
+	resolver *resolver
+	services map[string]*interceptedService
+}
+
+func New(config Config) (intercept.Interceptor, error) {
+	address := config.Address
+	if address == "" {
+		address = DefaultAddress
+	}
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &interceptor{
+		listener: listener,
+		services: map[string]*interceptedService{},
+	}
+
+	pfxlog.Logger().WithField("addr", listener.Addr().String()).Info("socks5/http connect proxy is listening")
+	go result.accept()
+
+	return result, nil
+}
+
+func (self *interceptor) Intercept(service *entities.Service, resolver dns.Resolver, _ intercept.AddressTracker) error {
+	log := pfxlog.Logger().WithField("service", *service.Name)
+
+	if service.InterceptV1Config == nil {
+		log.Debug("service has no intercept config, not available through the proxy")
+		return nil
+	}
+
+	if !stringz.Contains(service.InterceptV1Config.Protocols, "tcp") {
+		log.Info("service doesn't intercept tcp, not available through the proxy")
+		return nil
+	}
+
+	self.lock.Lock()
+	if self.resolver == nil {
+		self.resolver = newResolver(resolver)
+	}
+	serviceResolver := self.resolver
+	self.lock.Unlock()
+
+	target := &interceptedService{service: service}
+	if err := intercept.GetInterceptAddresses(service, []string{"tcp"}, serviceResolver, target); err != nil {
+		return err
+	}
+
+	self.lock.Lock()
+	self.services[*service.Name] = target
+	self.lock.Unlock()
+
+	// pre-fetch network session
+	service.FabricProvider.PrepForUse(*service.ID)
+
+	log.Info("service is available through the proxy")
+	return nil
+}
+
+func (self *interceptor) StopIntercepting(serviceName string, _ intercept.AddressTracker) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	pfxlog.Logger().WithField("service", serviceName).Info("stopping proxy interceptor for service")
+	delete(self.services, serviceName)
+	return nil
+}
+
+func (self *interceptor) Stop() {
+	pfxlog.Logger().Info("stopping socks interceptor")
+	if err := self.listener.Close(); err != nil {
+		pfxlog.Logger().WithError(err).Error("error closing proxy listener")
+	}
+}
+
+func (self *interceptor) getResolver() *resolver {
+	self.lock.RLock()
+	defer self.lock.RUnlock()
+	return self.resolver
+}
+
+// match returns the service which intercepts the given address. If several do, the one with the narrowest address is
+// used, so services for individual hosts take precedence over services for the networks containing them.
+func (self *interceptor) match(ip net.IP, port uint16) *entities.Service {
+	self.lock.RLock()
+	defer self.lock.RUnlock()
+
+	var result *entities.Service
+	var resultAddr *intercept.InterceptAddress
+	for _, svc := range self.services {
+		for _, addr := range svc.getAddresses() {
+			if addr.Contains(ip, port) && (resultAddr == nil || isNarrower(addr, resultAddr)) {
+				result = svc.service
+				resultAddr = addr
+			}
+		}
+	}
+	return result
+}
+
+func isNarrower(addr, other *intercept.InterceptAddress) bool {
+	ones, _ := addr.IpNet().Mask.Size()
+	otherOnes, _ := other.IpNet().Mask.Size()
+	if ones != otherOnes {
+		return ones > otherOnes
+	}
+	return addr.HighPort()-addr.LowPort() < other.HighPort()-other.LowPort()
+}
+
+func (self *interceptor) accept() {
+	log := pfxlog.Logger().WithField("addr", self.listener.Addr().String())
+	for {
+		conn, err := self.listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				log.Info("proxy listener stopped")
+			} else {
+				log.WithError(err).Error("accept failed, proxy listener stopped")
+			}
+			return
+		}
+		go self.handle(conn)
+	}
+}
+
+func (self *interceptor) handle(conn net.Conn) {
+	log := pfxlog.Logger().WithField("src", conn.RemoteAddr().String())
+
+	_ = conn.SetDeadline(time.Now().Add(handshakeTimeout))
+	reader := bufio.NewReader(conn)
+
+	request, err := readRequest(conn, reader)
+	if err != nil {
+		log.WithError(err).Debug("invalid proxy request")
+		_ = conn.Close()
+		return
+	}
+	_ = conn.SetDeadline(time.Time{})
+
+	log = log.WithField("dst", net.JoinHostPort(request.host, strconv.Itoa(int(request.port))))
+
+	service, dstIp, dstHostname := self.lookup(request)
+	if service == nil {
+		log.Debug("no intercepted service matches proxy request")
+		_ = request.reply(conn, replyNoService)
+		_ = conn.Close()
+		return
+	}
+
+	log.WithField("service", *service.Name).Debug("proxying connection to service")
+
+	clientConn := &proxyConn{
+		Conn:    conn,
+		reader:  reader,
+		request: request,
+	}
+
+	destAddr := &net.TCPAddr{IP: dstIp, Port: int(request.port)}
+	sourceAddr := service.GetSourceAddr(conn.RemoteAddr(), destAddr)
+	appInfo := tunnel.GetAppInfo("tcp", dstHostname, dstIp.String(), strconv.Itoa(int(request.port)), sourceAddr)
+	identity := service.GetDialIdentity(conn.RemoteAddr(), destAddr)
+	tunnel.DialAndRun(service.FabricProvider, service, identity, clientConn, appInfo, true)
+}
+
+// lookup returns the service which intercepts the requested address, along with the intercept IP and hostname of the
+// address. Requested hostnames are resolved to the IPs assigned to them for intercepting.
+func (self *interceptor) lookup(request *proxyRequest) (*entities.Service, net.IP, string) {
+	serviceResolver := self.getResolver()
+	if serviceResolver == nil {
+		return nil, nil, ""
+	}
+
+	var dstHostname string
+	dstIp := net.ParseIP(request.host)
+	if dstIp == nil {
+		var err error
+		if dstIp, err = serviceResolver.resolve(request.host); err != nil {
+			return nil, nil, ""
+		}
+		dstHostname = request.host
+	} else {
+		dstHostname, _ = serviceResolver.Lookup(dstIp)
+	}
+
+	return self.match(dstIp, request.port), dstIp, dstHostname
+}
@@ -0,0 +1,199 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package socks
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/hanzozt/edge-api/rest_model"
+	"github.com/hanzozt/zt/v2/tunnel"
+	"github.com/hanzozt/zt/v2/tunnel/entities"
+	"github.com/hanzozt/zt/v2/tunnel/intercept"
+	"github.com/stretchr/testify/require"
+)
+
+// testProvider answers each dial by writing the app info of the dial back to the client
+type testProvider struct{}
+
+func (self *testProvider) PrepForUse(string) {}
+
+func (self *testProvider) GetCurrentIdentity() (*rest_model.IdentityDetail, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (self *testProvider) GetCurrentIdentityWithBackoff() (*rest_model.IdentityDetail, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (self *testProvider) TunnelService(service tunnel.Service, _ string, conn net.Conn, _ bool, appInfo []byte) error {
+	if service.GetName() == "unreachable" {
+		return errors.New("no terminators")
+	}
+	_, err := conn.Write(appInfo)
+	_ = conn.Close()
+	return err
+}
+
+func (self *testProvider) HostService(tunnel.HostingContext) (tunnel.HostControl, error) {
+	return nil, errors.New("not implemented")
+}
+
+func newTestService(name string, addresses ...string) *entities.Service {
+	id := name + "-id"
+	service := &entities.Service{
+		FabricProvider: &testProvider{},
+		InterceptV1Config: &entities.InterceptV1Config{
+			Addresses:  addresses,
+			PortRanges: []*entities.PortRange{{Low: 80, High: 80}},
+			Protocols:  []string{"tcp"},
+		},
+	}
+	service.ID = &id
+	service.Name = &name
+	return service
+}
+
+func socksConnect(t *testing.T, addr string, host string, port uint16) (byte, map[string]string) {
+	req := require.New(t)
+
+	conn, err := net.Dial("tcp", addr)
+	req.NoError(err)
+	defer func() { _ = conn.Close() }()
+
+	_, err = conn.Write([]byte{socksVersion, 1, socksAuthNone})
+	req.NoError(err)
+
+	method := make([]byte, 2)
+	_, err = io.ReadFull(conn, method)
+	req.NoError(err)
+	req.Equal([]byte{socksVersion, socksAuthNone}, method)
+
+	request := []byte{socksVersion, socksCmdConnect, 0}
+	if ip := net.ParseIP(host).To4(); ip != nil {
+		request = append(request, socksAddrIPv4)
+		request = append(request, ip...)
+	} else {
+		request = append(request, socksAddrDomain, byte(len(host)))
+		request = append(request, host...)
+	}
+	request = binary.BigEndian.AppendUint16(request, port)
+	_, err = conn.Write(request)
+	req.NoError(err)
+
+	reply := make([]byte, 10)
+	_, err = io.ReadFull(conn, reply)
+	req.NoError(err)
+
+	if reply[1] != socksReplySucceeded {
+		return reply[1], nil
+	}
+
+	appInfo := map[string]string{}
+	req.NoError(json.NewDecoder(conn).Decode(&appInfo))
+	return reply[1], appInfo
+}
+
+func httpConnect(t *testing.T, addr string, hostPort string) (int, map[string]string) {
+	req := require.New(t)
+
+	conn, err := net.Dial("tcp", addr)
+	req.NoError(err)
+	defer func() { _ = conn.Close() }()
+
+	_, err = conn.Write([]byte("CONNECT " + hostPort + " HTTP/1.1\r\nHost: " + hostPort + "\r\n\r\n"))
+	req.NoError(err)
+
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, &http.Request{Method: http.MethodConnect})
+	req.NoError(err)
+
+	if resp.StatusCode != http.StatusOK {
+		return resp.StatusCode, nil
+	}
+
+	appInfo := map[string]string{}
+	req.NoError(json.NewDecoder(reader).Decode(&appInfo))
+	return resp.StatusCode, appInfo
+}
+
+func TestSocksInterceptor(t *testing.T) {
+	req := require.New(t)
+	req.NoError(intercept.SetDnsInterceptIpRange("100.64.0.1/10"))
+
+	i, err := New(Config{Address: "127.0.0.1:0"})
+	req.NoError(err)
+	defer i.Stop()
+
+	addr := i.(*interceptor).listener.Addr().String()
+
+	req.NoError(i.Intercept(newTestService("echo", "echo.zt", "*.wild.zt", "10.1.0.0/16"), nil, nil))
+	req.NoError(i.Intercept(newTestService("narrow", "10.1.2.0/24"), nil, nil))
+	req.NoError(i.Intercept(newTestService("unreachable", "unreachable.zt"), nil, nil))
+
+	t.Run("socks5 hostname", func(t *testing.T) {
+		code, appInfo := socksConnect(t, addr, "echo.zt", 80)
+		require.Equal(t, byte(socksReplySucceeded), code)
+		require.Equal(t, "echo.zt", appInfo[tunnel.DestinationHostname])
+		require.Equal(t, "80", appInfo[tunnel.DestinationPortKey])
+		require.True(t, intercept.GetDnsInterceptIpRange().Contains(net.ParseIP(appInfo[tunnel.DestinationIpKey])))
+	})
+
+	t.Run("socks5 ip prefers narrowest service", func(t *testing.T) {
+		code, appInfo := socksConnect(t, addr, "10.1.2.3", 80)
+		require.Equal(t, byte(socksReplySucceeded), code)
+		require.Equal(t, "10.1.2.3", appInfo[tunnel.DestinationIpKey])
+
+		service := i.(*interceptor).match(net.ParseIP("10.1.2.3"), 80)
+		require.Equal(t, "narrow", *service.Name)
+		service = i.(*interceptor).match(net.ParseIP("10.1.3.3"), 80)
+		require.Equal(t, "echo", *service.Name)
+	})
+
+	t.Run("socks5 unmatched port", func(t *testing.T) {
+		code, _ := socksConnect(t, addr, "echo.zt", 443)
+		require.Equal(t, byte(socksReplyNotAllowed), code)
+	})
+
+	t.Run("socks5 dial failure", func(t *testing.T) {
+		code, _ := socksConnect(t, addr, "unreachable.zt", 80)
+		require.Equal(t, byte(socksReplyHostUnreachable), code)
+	})
+
+	t.Run("http connect wildcard domain", func(t *testing.T) {
+		code, appInfo := httpConnect(t, addr, "api.wild.zt:80")
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, "api.wild.zt", appInfo[tunnel.DestinationHostname])
+	})
+
+	t.Run("http connect unknown host", func(t *testing.T) {
+		code, _ := httpConnect(t, addr, "example.com:80")
+		require.Equal(t, http.StatusForbidden, code)
+	})
+
+	t.Run("stopped service", func(t *testing.T) {
+		require.NoError(t, i.StopIntercepting("echo", nil))
+		code, _ := socksConnect(t, addr, "echo.zt", 80)
+		require.Equal(t, byte(socksReplyNotAllowed), code)
+	})
+}
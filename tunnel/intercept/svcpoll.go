@@ -294,7 +294,10 @@ func (self *ServiceListener) host(svc *entities.Service, tracker AddressTracker)
 		return
 	}
 
-	hostContexts := createHostingContexts(svc, currentIdentity, tracker)
+	callerIdentityProvider, _ := self.provider.(tunnel.CallerIdentityProvider)
+	fullCallerIdentity := callerIdentityProvider != nil && callerIdentityProvider.ProvidesCallerIdentity()
+
+	hostContexts := createHostingContexts(svc, currentIdentity, tracker, fullCallerIdentity)
 
 	var hostControls []tunnel.HostControl
 
@@ -0,0 +1,302 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package tproxy
+
+import (
+	"fmt"
+	"net"
+	"os/exec"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/hanzozt/zt/v2/tunnel/intercept"
+	"github.com/pkg/errors"
+)
+
+const (
+	nftTable            = "zt_intercept"
+	nftPreroutingChain  = "prerouting"
+	nftInputChain       = "input"
+	nftMaxCommentLength = 128
+)
+
+// nftables manages the intercept rules in a dedicated nftables table, using the nft utility. The intercept addresses
+// of each service are kept in a set per protocol, so the number of rules grows with the number of services rather
+// than the number of addresses. Each change is applied as a single nft transaction, so the ruleset is never left
+// partially updated.
+type nftables struct {
+	lanIf    string
+	lock     sync.Mutex
+	nextId   uint64
+	services []*nftService
+	run      func(script string) error
+}
+
+// nftService holds the nftables state of an intercepted service
+type nftService struct {
+	id          string
+	name        string
+	sourceAddrs []string
+	ports       map[string]int
+}
+
+func (self *nftService) setName(protocol string) string {
+	return self.id + "_" + protocol
+}
+
+func (self *nftService) protocols() []string {
+	var result []string
+	for protocol := range self.ports {
+		result = append(result, protocol)
+	}
+	slices.Sort(result)
+	return result
+}
+
+func newNftables(lanIf string) (*nftables, error) {
+	result := &nftables{
+		lanIf: lanIf,
+		run:   runNft,
+	}
+
+	if _, err := exec.LookPath("nft"); err != nil {
+		return nil, errors.Wrap(err, "nftables backend requires the nft utility")
+	}
+
+	if err := result.run(result.renderTable()); err != nil {
+		return nil, errors.Wrapf(err, "failed to create nftables table '%s'", nftTable)
+	}
+	return result, nil
+}
+
+func runNft(script string) error {
+	pfxlog.Logger().Debugf("applying nftables changes:\n%s", script)
+
+	cmd := exec.Command("nft", "-f", "-")
+	cmd.Stdin = strings.NewReader(script)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return errors.Errorf("nft failed: %v, output: %s", err, out)
+	}
+	return nil
+}
+
+// renderTable replaces any table left behind by a previous run with an empty one
+func (self *nftables) renderTable() string {
+	b := &strings.Builder{}
+	// adding the table before deleting it makes the delete succeed whether or not the table exists
+	fmt.Fprintf(b, "add table ip %s\n", nftTable)
+	fmt.Fprintf(b, "delete table ip %s\n", nftTable)
+	fmt.Fprintf(b, "add table ip %s\n", nftTable)
+	fmt.Fprintf(b, "add chain ip %s %s { type filter hook prerouting priority mangle; policy accept; }\n", nftTable, nftPreroutingChain)
+	if self.lanIf != "" {
+		fmt.Fprintf(b, "add chain ip %s %s { type filter hook input priority filter; policy accept; }\n", nftTable, nftInputChain)
+	}
+	return b.String()
+}
+
+// renderRules replaces the rules in the table's chains with a rule per intercepted service and protocol
+func (self *nftables) renderRules(b *strings.Builder) {
+	fmt.Fprintf(b, "flush chain ip %s %s\n", nftTable, nftPreroutingChain)
+	for _, svc := range self.services {
+		for _, protocol := range svc.protocols() {
+			fmt.Fprintf(b, "add rule ip %s %s ", nftTable, nftPreroutingChain)
+			if len(svc.sourceAddrs) > 0 {
+				fmt.Fprintf(b, "ip saddr { %s } ", strings.Join(svc.sourceAddrs, ", "))
+			}
+			fmt.Fprintf(b, "ip daddr . %s dport @%s meta mark set meta mark or 0x1 tproxy to 127.0.0.1:%d accept comment %s\n",
+				protocol, svc.setName(protocol), svc.ports[protocol], nftComment(svc.name))
+		}
+	}
+
+	if self.lanIf != "" {
+		fmt.Fprintf(b, "flush chain ip %s %s\n", nftTable, nftInputChain)
+		for _, svc := range self.services {
+			for _, protocol := range svc.protocols() {
+				fmt.Fprintf(b, "add rule ip %s %s iifname %q ip daddr . %s dport @%s accept comment %s\n",
+					nftTable, nftInputChain, self.lanIf, protocol, svc.setName(protocol), nftComment(svc.name))
+			}
+		}
+	}
+}
+
+// nftComment quotes a service name for use as a rule comment. Service names are user controlled and end up in an nft
+// script, so anything other than a small set of plain characters is replaced, rather than trying to escape it.
+func nftComment(val string) string {
+	comment := []byte(val)
+	if len(comment) > nftMaxCommentLength {
+		comment = comment[:nftMaxCommentLength]
+	}
+	for i, c := range comment {
+		if !isNftCommentChar(c) {
+			comment[i] = '_'
+		}
+	}
+	return `"` + string(comment) + `"`
+}
+
+func isNftCommentChar(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || strings.IndexByte(" -_.,:/()", c) >= 0
+}
+
+// nftSourceAddrs validates the allowed source addresses of a service, returning them in CIDR form. Only IPv4 is
+// supported, since the rules are in an ip family table.
+func nftSourceAddrs(sourceAddrs []string) ([]string, error) {
+	var result []string
+	for _, sourceAddr := range sourceAddrs {
+		ipNet, err := parseNftAddr(sourceAddr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid allowed source address '%s'", sourceAddr)
+		}
+		result = append(result, ipNet.String())
+	}
+	return result, nil
+}
+
+func parseNftAddr(addr string) (*net.IPNet, error) {
+	var ipNet *net.IPNet
+	if strings.Contains(addr, "/") {
+		var err error
+		if _, ipNet, err = net.ParseCIDR(addr); err != nil {
+			return nil, err
+		}
+	} else if ip := net.ParseIP(addr); ip != nil {
+		ipNet = &net.IPNet{IP: ip, Mask: net.CIDRMask(len(ip)*8, len(ip)*8)}
+	} else {
+		return nil, errors.New("not an IP address or CIDR")
+	}
+
+	if ipNet.IP.To4() == nil {
+		return nil, errors.New("nftables backend doesn't support IPv6")
+	}
+	ipNet.IP = ipNet.IP.To4()
+	ipNet.Mask = ipNet.Mask[len(ipNet.Mask)-net.IPv4len:]
+	return ipNet, nil
+}
+
+// addService creates the sets for a service, along with the rules which send traffic to addresses in the sets to the
+// tproxy listeners on the given ports
+func (self *nftables) addService(name string, ports []IPPortAddr, sourceAddrs []string) (*nftService, error) {
+	sourceAddrs, err := nftSourceAddrs(sourceAddrs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to add nftables rules for service %s", name)
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.nextId++
+	svc := &nftService{
+		id:          fmt.Sprintf("svc%d", self.nextId),
+		name:        name,
+		sourceAddrs: sourceAddrs,
+		ports:       map[string]int{},
+	}
+	for _, port := range ports {
+		svc.ports[port.GetProtocol()] = port.GetPort()
+	}
+
+	b := &strings.Builder{}
+	for _, protocol := range svc.protocols() {
+		fmt.Fprintf(b, "add set ip %s %s { type ipv4_addr . inet_service; flags interval; }\n", nftTable, svc.setName(protocol))
+	}
+
+	self.services = append(self.services, svc)
+	self.renderRules(b)
+
+	if err := self.run(b.String()); err != nil {
+		self.services = self.services[:len(self.services)-1]
+		return nil, errors.Wrapf(err, "failed to add nftables rules for service %s", name)
+	}
+	return svc, nil
+}
+
+// addAddresses adds intercept addresses to the sets of a service. IPv6 addresses are logged and skipped, since the
+// tproxy listeners only accept IPv4 connections, in the same way that the iptables backend skips addresses it can't
+// intercept.
+func (self *nftables) addAddresses(svc *nftService, addrs []*intercept.InterceptAddress) error {
+	elements := map[string][]string{}
+	for _, addr := range addrs {
+		if addr.IpNet().IP.To4() == nil {
+			pfxlog.Logger().WithField("service", svc.name).Warnf("nftables backend doesn't support intercepting IPv6 address %v, skipping", addr.IpNet())
+			continue
+		}
+		if _, found := svc.ports[addr.Proto()]; !found {
+			pfxlog.Logger().WithField("service", svc.name).Errorf("unknown proto[%s] for tproxy", addr.Proto())
+			continue
+		}
+
+		ports := fmt.Sprintf("%d", addr.LowPort())
+		if addr.HighPort() > addr.LowPort() {
+			ports = fmt.Sprintf("%d-%d", addr.LowPort(), addr.HighPort())
+		}
+		elements[addr.Proto()] = append(elements[addr.Proto()], addr.IpNet().String()+" . "+ports)
+	}
+
+	if len(elements) == 0 {
+		return nil
+	}
+
+	b := &strings.Builder{}
+	for _, protocol := range svc.protocols() {
+		if len(elements[protocol]) > 0 {
+			fmt.Fprintf(b, "add element ip %s %s { %s }\n", nftTable, svc.setName(protocol), strings.Join(elements[protocol], ", "))
+		}
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if err := self.run(b.String()); err != nil {
+		return errors.Wrapf(err, "failed to add nftables intercept addresses for service %s", svc.name)
+	}
+	return nil
+}
+
+// removeService removes the rules and sets of a service
+func (self *nftables) removeService(svc *nftService) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	idx := slices.Index(self.services, svc)
+	if idx < 0 {
+		return nil
+	}
+	self.services = slices.Delete(self.services, idx, idx+1)
+
+	b := &strings.Builder{}
+	self.renderRules(b)
+	for _, protocol := range svc.protocols() {
+		fmt.Fprintf(b, "delete set ip %s %s\n", nftTable, svc.setName(protocol))
+	}
+
+	if err := self.run(b.String()); err != nil {
+		return errors.Wrapf(err, "failed to remove nftables rules for service %s", svc.name)
+	}
+	return nil
+}
+
+// stop removes the table, along with any rules and sets which are left in it
+func (self *nftables) stop() error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.services = nil
+	return self.run(fmt.Sprintf("delete table ip %s\n", nftTable))
+}
@@ -0,0 +1,190 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package tproxy
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/hanzozt/zt/v2/tunnel/dns"
+	"github.com/hanzozt/zt/v2/tunnel/entities"
+	"github.com/hanzozt/zt/v2/tunnel/intercept"
+	"github.com/stretchr/testify/require"
+)
+
+type addrCollector []*intercept.InterceptAddress
+
+func (self *addrCollector) Apply(addr *intercept.InterceptAddress) {
+	*self = append(*self, addr)
+}
+
+func TestNftablesScripts(t *testing.T) {
+	req := require.New(t)
+
+	var scripts []string
+	var fail bool
+	nft := &nftables{
+		lanIf: "eth0",
+		run: func(script string) error {
+			if fail {
+				return errors.New("failed")
+			}
+			scripts = append(scripts, script)
+			return nil
+		},
+	}
+
+	req.Equal("add table ip zt_intercept\n"+
+		"delete table ip zt_intercept\n"+
+		"add table ip zt_intercept\n"+
+		"add chain ip zt_intercept prerouting { type filter hook prerouting priority mangle; policy accept; }\n"+
+		"add chain ip zt_intercept input { type filter hook input priority filter; policy accept; }\n",
+		nft.renderTable())
+
+	ports := []IPPortAddr{
+		&UDPIPPortAddr{IP: net.IPv4(127, 0, 0, 1), Port: 2000},
+		&TCPIPPortAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1000},
+	}
+	web, err := nft.addService(`web "prod"`, ports, []string{"192.168.1.7/24"})
+	req.NoError(err)
+	req.Equal("add set ip zt_intercept svc1_tcp { type ipv4_addr . inet_service; flags interval; }\n"+
+		"add set ip zt_intercept svc1_udp { type ipv4_addr . inet_service; flags interval; }\n"+
+		"flush chain ip zt_intercept prerouting\n"+
+		`add rule ip zt_intercept prerouting ip saddr { 192.168.1.0/24 } ip daddr . tcp dport @svc1_tcp meta mark set meta mark or 0x1 tproxy to 127.0.0.1:1000 accept comment "web _prod_"`+"\n"+
+		`add rule ip zt_intercept prerouting ip saddr { 192.168.1.0/24 } ip daddr . udp dport @svc1_udp meta mark set meta mark or 0x1 tproxy to 127.0.0.1:2000 accept comment "web _prod_"`+"\n"+
+		"flush chain ip zt_intercept input\n"+
+		`add rule ip zt_intercept input iifname "eth0" ip daddr . tcp dport @svc1_tcp accept comment "web _prod_"`+"\n"+
+		`add rule ip zt_intercept input iifname "eth0" ip daddr . udp dport @svc1_udp accept comment "web _prod_"`+"\n",
+		scripts[0])
+
+	service := &entities.Service{
+		InterceptV1Config: &entities.InterceptV1Config{
+			Addresses:  []string{"10.0.0.0/24", "10.1.2.3"},
+			PortRanges: []*entities.PortRange{{Low: 80, High: 80}, {Low: 8000, High: 8100}},
+		},
+	}
+	var addrs addrCollector
+	req.NoError(intercept.GetInterceptAddresses(service, []string{"tcp"}, nil, &addrs))
+	req.NoError(nft.addAddresses(web, addrs))
+	req.Equal("add element ip zt_intercept svc1_tcp { 10.0.0.0/24 . 80, 10.0.0.0/24 . 8000-8100, 10.1.2.3/32 . 80, 10.1.2.3/32 . 8000-8100 }\n",
+		scripts[1])
+
+	// IPv6 addresses are skipped, without stopping the other addresses from being intercepted
+	service.InterceptV1Config.Addresses = []string{"fd00::1", "10.2.0.1"}
+	service.InterceptV1Config.PortRanges = []*entities.PortRange{{Low: 443, High: 443}}
+	addrs = nil
+	req.NoError(intercept.GetInterceptAddresses(service, []string{"tcp"}, nil, &addrs))
+	req.NoError(nft.addAddresses(web, addrs))
+	req.Equal("add element ip zt_intercept svc1_tcp { 10.2.0.1/32 . 443 }\n", scripts[2])
+
+	service.InterceptV1Config.Addresses = []string{"fd00::1"}
+	addrs = nil
+	req.NoError(intercept.GetInterceptAddresses(service, []string{"tcp"}, nil, &addrs))
+	req.NoError(nft.addAddresses(web, addrs))
+	req.Len(scripts, 3)
+
+	_, err = nft.addService("db", ports[1:], nil)
+	req.NoError(err)
+	req.Contains(scripts[3], "add rule ip zt_intercept prerouting ip daddr . tcp dport @svc2_tcp meta mark set meta mark or 0x1 tproxy to 127.0.0.1:1000 accept comment \"db\"\n")
+
+	fail = true
+	_, err = nft.addService("broken", ports, nil)
+	req.Error(err)
+	req.Len(nft.services, 2)
+	fail = false
+
+	req.NoError(nft.removeService(web))
+	req.Equal("flush chain ip zt_intercept prerouting\n"+
+		`add rule ip zt_intercept prerouting ip daddr . tcp dport @svc2_tcp meta mark set meta mark or 0x1 tproxy to 127.0.0.1:1000 accept comment "db"`+"\n"+
+		"flush chain ip zt_intercept input\n"+
+		`add rule ip zt_intercept input iifname "eth0" ip daddr . tcp dport @svc2_tcp accept comment "db"`+"\n"+
+		"delete set ip zt_intercept svc1_tcp\n"+
+		"delete set ip zt_intercept svc1_udp\n",
+		scripts[4])
+
+	req.NoError(nft.removeService(web))
+	req.Len(scripts, 5)
+
+	req.NoError(nft.stop())
+	req.Equal("delete table ip zt_intercept\n", scripts[5])
+	req.Empty(nft.services)
+}
+
+func TestNftablesRejectsUnsafeInput(t *testing.T) {
+	req := require.New(t)
+
+	var scripts []string
+	nft := &nftables{
+		run: func(script string) error {
+			scripts = append(scripts, script)
+			return nil
+		},
+	}
+	ports := []IPPortAddr{&TCPIPPortAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1000}}
+
+	_, err := nft.addService("evil\" accept; delete table ip zt_intercept; #\n", ports, nil)
+	req.NoError(err)
+	req.Contains(scripts[0], `comment "evil_ accept_ delete table ip zt_intercept_ __"`+"\n")
+
+	for _, sourceAddr := range []string{"10.0.0.1 } accept; flush ruleset; {", "not-an-ip", "fd00::/64", "::1"} {
+		_, err = nft.addService("web", ports, []string{sourceAddr})
+		req.Error(err, sourceAddr)
+	}
+	req.Len(scripts, 1)
+
+	_, err = nft.addService("web", ports, []string{"10.0.0.1", "192.168.0.0/16"})
+	req.NoError(err)
+	req.Contains(scripts[1], "ip saddr { 10.0.0.1/32, 192.168.0.0/16 } ")
+}
+
+type failingResolver struct {
+	dns.Resolver
+}
+
+func (self failingResolver) AddDomain(string, func(string) (net.IP, error)) error {
+	return errors.New("failed")
+}
+
+func TestNftablesRemovesServiceWhenInterceptFails(t *testing.T) {
+	req := require.New(t)
+
+	var scripts []string
+	nft := &nftables{
+		run: func(script string) error {
+			scripts = append(scripts, script)
+			return nil
+		},
+	}
+	proxy := &tProxy{interceptor: &interceptor{nft: nft}}
+
+	name := "web"
+	service := &entities.Service{
+		InterceptV1Config: &entities.InterceptV1Config{
+			Addresses:  []string{"*.example.com"},
+			PortRanges: []*entities.PortRange{{Low: 80, High: 80}},
+		},
+	}
+	service.Name = &name
+	ports := []IPPortAddr{&TCPIPPortAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1000}}
+
+	req.Error(proxy.interceptWithNftables(service, failingResolver{}, ports, []string{"tcp"}))
+	req.Len(scripts, 2)
+	req.Contains(scripts[1], "delete set ip zt_intercept svc1_tcp\n")
+	req.Empty(nft.services)
+	req.Nil(proxy.nftService)
+}
@@ -18,9 +18,17 @@ package tproxy
 
 import "time"
 
+const (
+	// BackendIptables manages intercept rules with iptables, one rule per intercept address
+	BackendIptables = "iptables"
+	// BackendNftables manages intercept rules with nftables, keeping the intercept addresses of each service in sets
+	BackendNftables = "nftables"
+)
+
 type Config struct {
 	LanIf            string
 	Diverter         string
+	Backend          string
 	UDPIdleTimeout   time.Duration
 	UDPCheckInterval time.Duration
 }
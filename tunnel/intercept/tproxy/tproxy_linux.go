@@ -23,6 +23,7 @@ import (
 	"net"
 	"os/exec"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -84,6 +85,7 @@ func New(config Config, alerter proxy.Alerter) (intercept.Interceptor, error) {
 	self := &interceptor{
 		lanIf:            config.LanIf,
 		diverter:         config.Diverter,
+		backend:          config.Backend,
 		udpIdleTimeout:   config.UDPIdleTimeout,
 		udpCheckInterval: config.UDPCheckInterval,
 		serviceProxies:   cmap.New[*tProxy](),
@@ -100,8 +102,19 @@ func New(config Config, alerter proxy.Alerter) (intercept.Interceptor, error) {
 		log.Infof("udpCheckInterval is less than 1s, using default value of %s", DefaultUdpCheckInterval.String())
 	}
 
+	if self.backend == "" {
+		self.backend = BackendIptables
+	}
+	if self.backend != BackendIptables && self.backend != BackendNftables {
+		return nil, errors.Errorf("invalid tproxy backend '%s', must be one of %s or %s", self.backend, BackendIptables, BackendNftables)
+	}
+	if self.diverter != "" && self.backend == BackendNftables {
+		return nil, errors.Errorf("tproxy backend %s can't be used with an external diverter", BackendNftables)
+	}
+
 	log.Infof("tproxy config: lanIf            =  [%s]", self.lanIf)
 	log.Infof("tproxy config: diverter         =  [%s]", self.diverter)
+	log.Infof("tproxy config: backend          =  [%s]", self.backend)
 	log.Infof("tproxy config: udpIdleTimeout   =  [%s]", self.udpIdleTimeout.String())
 	log.Infof("tproxy config: udpCheckInterval =  [%s]", self.udpCheckInterval.String())
 
@@ -124,6 +137,21 @@ func New(config Config, alerter proxy.Alerter) (intercept.Interceptor, error) {
 		return self, nil
 	}
 
+	if self.lanIf != "" {
+		if _, err := net.InterfaceByName(self.lanIf); err != nil {
+			return nil, fmt.Errorf("invalid lanIf '%s'", self.lanIf)
+		}
+	} else {
+		logrus.Infof("no lan interface specified with '-lanIf'. please ensure firewall accepts intercepted service addresses")
+	}
+
+	if self.backend == BackendNftables {
+		if self.nft, err = newNftables(self.lanIf); err != nil {
+			return nil, errors.Wrap(err, "tproxy: failed to initialize nftables")
+		}
+		return self, nil
+	}
+
 	ipt, err := iptables.New()
 	if err != nil {
 		return nil, errors.Wrap(err, "tproxy: failed to initialize iptables handle")
@@ -134,16 +162,10 @@ func New(config Config, alerter proxy.Alerter) (intercept.Interceptor, error) {
 	}
 
 	if self.lanIf != "" {
-		_, err := net.InterfaceByName(self.lanIf)
-		if err != nil {
-			return nil, fmt.Errorf("invalid lanIf '%s'", self.lanIf)
-		}
 		err = self.addIptablesChain(self.ipt, filterTable, "INPUT", dstChain)
 		if err != nil {
 			return nil, err
 		}
-	} else {
-		logrus.Infof("no lan interface specified with '-lanIf'. please ensure firewall accepts intercepted service addresses")
 	}
 
 	return self, err
@@ -160,11 +182,13 @@ func (a alwaysRemoveAddressTracker) RemoveAddress(string) bool {
 type interceptor struct {
 	lanIf            string
 	diverter         string // external tproxy configuration utility. use internal iptables implementation if not specified.
+	backend          string
 	udpIdleTimeout   time.Duration
 	udpCheckInterval time.Duration
 
 	serviceProxies   cmap.ConcurrentMap[string, *tProxy]
 	ipt              *iptables.IPTables
+	nft              *nftables
 	proxyInterceptor intercept.Interceptor
 }
 
@@ -212,6 +236,14 @@ func (self *interceptor) cleanupChains() {
 	if self.diverter != "" {
 		return
 	}
+	if self.nft != nil {
+		if self.serviceProxies.IsEmpty() {
+			if err := self.nft.stop(); err != nil {
+				pfxlog.Logger().WithError(err).Errorf("failed to delete nftables table '%s'", nftTable)
+			}
+		}
+		return
+	}
 	if self.serviceProxies.IsEmpty() {
 		deleteIptablesChain(self.ipt, mangleTable, "PREROUTING", dstChain)
 		if self.lanIf != "" {
@@ -303,6 +335,13 @@ type tProxy struct {
 	tracker     intercept.AddressTracker
 	resolver    dns.Resolver
 	interfaces  []string
+
+	// nftables state. while the initial intercept addresses are collected they're queued, so they can be added in
+	// a single transaction
+	nftLock     sync.Mutex
+	nftService  *nftService
+	nftQueueing bool
+	nftQueue    []*intercept.InterceptAddress
 }
 
 const (
@@ -521,6 +560,10 @@ func (self *tProxy) intercept(service *entities.Service, resolver dns.Resolver,
 		protocols = append(protocols, p.GetProtocol())
 	}
 
+	if self.interceptor.nft != nil {
+		return self.interceptWithNftables(service, resolver, ports, protocols)
+	}
+
 	err := intercept.GetInterceptAddresses(service, protocols, resolver, self)
 	if err != nil {
 		return err
@@ -529,6 +572,42 @@ func (self *tProxy) intercept(service *entities.Service, resolver dns.Resolver,
 	return nil
 }
 
+func (self *tProxy) interceptWithNftables(service *entities.Service, resolver dns.Resolver, ports []IPPortAddr, protocols []string) error {
+	nftService, err := self.interceptor.nft.addService(*service.Name, ports, service.InterceptV1Config.AllowedSourceAddresses)
+	if err != nil {
+		return err
+	}
+
+	self.nftLock.Lock()
+	self.nftService = nftService
+	self.nftQueueing = true
+	self.nftLock.Unlock()
+
+	err = intercept.GetInterceptAddresses(service, protocols, resolver, self)
+
+	self.nftLock.Lock()
+	defer self.nftLock.Unlock()
+
+	queue := self.nftQueue
+	self.nftQueue = nil
+	self.nftQueueing = false
+
+	if err == nil {
+		err = self.interceptor.nft.addAddresses(nftService, queue)
+	}
+
+	// a failed tproxy isn't kept, so it won't be stopped. remove the service's rules and sets now, so they're not left
+	// in the table
+	if err != nil {
+		if removeErr := self.interceptor.nft.removeService(nftService); removeErr != nil {
+			pfxlog.Logger().WithError(removeErr).WithField("service", *service.Name).Error("failed to remove nftables rules for service")
+		}
+		self.nftService = nil
+		return err
+	}
+	return nil
+}
+
 func (self *tProxy) addInterceptAddr(interceptAddr *intercept.InterceptAddress, service *entities.Service, port IPPortAddr, tracker intercept.AddressTracker) error {
 	ipNet := interceptAddr.IpNet()
 	if interceptAddr.RouteRequired() {
@@ -556,6 +635,15 @@ func (self *tProxy) addInterceptAddr(interceptAddr *intercept.InterceptAddress,
 				return err
 			}
 		}
+	} else if self.interceptor.nft != nil {
+		self.nftLock.Lock()
+		defer self.nftLock.Unlock()
+
+		if self.nftQueueing {
+			self.nftQueue = append(self.nftQueue, interceptAddr)
+			return nil
+		}
+		return self.interceptor.nft.addAddresses(self.nftService, []*intercept.InterceptAddress{interceptAddr})
 	} else {
 		baseSpec := []string{
 			"-m", "comment", "--comment", *service.Name,
@@ -681,7 +769,7 @@ func (self *tProxy) StopIntercepting(tracker intercept.AddressTracker) error {
 					}
 				}
 			}
-		} else {
+		} else if self.interceptor.ipt != nil {
 			log.Infof("Removing rule iptables -t %v -A %v %v", mangleTable, dstChain, addr.TproxySpec)
 			err := self.interceptor.ipt.Delete(mangleTable, dstChain, addr.TproxySpec...)
 			if err != nil {
@@ -710,6 +798,18 @@ func (self *tProxy) StopIntercepting(tracker intercept.AddressTracker) error {
 		}
 	}
 
+	if self.interceptor.nft != nil {
+		self.nftLock.Lock()
+		if self.nftService != nil {
+			if err := self.interceptor.nft.removeService(self.nftService); err != nil {
+				errorList = append(errorList, err)
+				log.WithError(err).Error("failed to remove nftables rules")
+			}
+			self.nftService = nil
+		}
+		self.nftLock.Unlock()
+	}
+
 	if len(errorList) == 0 {
 		return nil
 	}
@@ -0,0 +1,125 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package tun
+
+import (
+	"net"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/hanzozt/zt/v2/tunnel/intercept"
+	"github.com/hanzozt/zt/v2/tunnel/router"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+	"gvisor.dev/gvisor/pkg/tcpip/link/fdbased"
+	tunDevice "gvisor.dev/gvisor/pkg/tcpip/link/tun"
+)
+
+func New(config Config) (intercept.Interceptor, error) {
+	log := pfxlog.Logger()
+
+	deviceName := config.DeviceName
+	if deviceName == "" {
+		deviceName = DefaultDeviceName
+	}
+
+	mtu := config.MTU
+	if mtu == 0 {
+		mtu = DefaultMTU
+	}
+
+	fd, err := tunDevice.Open(deviceName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open tun device %s", deviceName)
+	}
+
+	if err = configureDevice(deviceName, mtu); err != nil {
+		_ = unix.Close(fd)
+		return nil, err
+	}
+
+	linkEndpoint, err := fdbased.New(&fdbased.Options{FDs: []int{fd}, MTU: mtu})
+	if err != nil {
+		_ = unix.Close(fd)
+		return nil, errors.Wrapf(err, "failed to create link endpoint for tun device %s", deviceName)
+	}
+
+	result, err := newInterceptor(linkEndpoint, config.UDPIdleTimeout)
+	if err != nil {
+		_ = unix.Close(fd)
+		return nil, err
+	}
+
+	result.addRoute = func(prefix *net.IPNet) error {
+		return router.AddRoute(prefix, deviceName)
+	}
+	result.removeRoute = func(prefix *net.IPNet) error {
+		return router.RemoveRoute(prefix, deviceName)
+	}
+	result.closeDevice = func() {
+		if err := unix.Close(fd); err != nil {
+			pfxlog.Logger().WithError(err).Errorf("failed to close tun device %s", deviceName)
+		}
+	}
+
+	// the device takes the first address of the dns range, which is never assigned to a hostname, so the dns
+	// resolver can be served on it. the rest of the range is routed to the device.
+	dnsNet := intercept.GetDnsInterceptIpRange()
+	deviceAddr := &net.IPNet{IP: dnsNet.IP, Mask: net.CIDRMask(len(dnsNet.Mask)*8, len(dnsNet.Mask)*8)}
+	if err = router.AddLocalAddress(deviceAddr, deviceName); err != nil {
+		result.Stop()
+		return nil, errors.Wrapf(err, "failed to add address %v to tun device %s", deviceAddr, deviceName)
+	}
+	if err = router.AddRoute(dnsNet, deviceName); err != nil {
+		result.Stop()
+		return nil, errors.Wrapf(err, "failed to route dns IP range %v to tun device %s", dnsNet, deviceName)
+	}
+
+	log.Infof("tun config: device      =  [%s]", deviceName)
+	log.Infof("tun config: mtu         =  [%d]", mtu)
+	log.Infof("tun config: address     =  [%s]", deviceAddr.IP)
+
+	return result, nil
+}
+
+// configureDevice sets the MTU of the device and brings it up
+func configureDevice(deviceName string, mtu uint32) error {
+	sock, err := unix.Socket(unix.AF_INET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return errors.Wrap(err, "failed to open socket for configuring tun device")
+	}
+	defer func() { _ = unix.Close(sock) }()
+
+	ifr, err := unix.NewIfreq(deviceName)
+	if err != nil {
+		return errors.Wrapf(err, "invalid tun device name %s", deviceName)
+	}
+
+	ifr.SetUint32(mtu)
+	if err = unix.IoctlIfreq(sock, unix.SIOCSIFMTU, ifr); err != nil {
+		return errors.Wrapf(err, "failed to set mtu of tun device %s", deviceName)
+	}
+
+	if err = unix.IoctlIfreq(sock, unix.SIOCGIFFLAGS, ifr); err != nil {
+		return errors.Wrapf(err, "failed to get flags of tun device %s", deviceName)
+	}
+	ifr.SetUint16(ifr.Uint16() | unix.IFF_UP)
+	if err = unix.IoctlIfreq(sock, unix.SIOCSIFFLAGS, ifr); err != nil {
+		return errors.Wrapf(err, "failed to bring up tun device %s", deviceName)
+	}
+
+	return nil
+}
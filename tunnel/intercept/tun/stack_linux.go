@@ -0,0 +1,366 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package tun
+
+import (
+	stdErr "errors"
+	"net"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/hanzozt/zt/v2/tunnel"
+	"github.com/hanzozt/zt/v2/tunnel/dns"
+	"github.com/hanzozt/zt/v2/tunnel/entities"
+	"github.com/hanzozt/zt/v2/tunnel/intercept"
+	"github.com/pkg/errors"
+	"gvisor.dev/gvisor/pkg/tcpip"
+	"gvisor.dev/gvisor/pkg/tcpip/adapters/gonet"
+	"gvisor.dev/gvisor/pkg/tcpip/header"
+	"gvisor.dev/gvisor/pkg/tcpip/network/ipv4"
+	"gvisor.dev/gvisor/pkg/tcpip/network/ipv6"
+	"gvisor.dev/gvisor/pkg/tcpip/stack"
+	"gvisor.dev/gvisor/pkg/tcpip/transport/icmp"
+	"gvisor.dev/gvisor/pkg/tcpip/transport/tcp"
+	"gvisor.dev/gvisor/pkg/tcpip/transport/udp"
+	"gvisor.dev/gvisor/pkg/waiter"
+)
+
+const (
+	nicId = 1
+
+	// tcpMaxInFlight is the maximum number of TCP connections which may be in the middle of their handshake
+	tcpMaxInFlight = 1024
+)
+
+type interceptor struct {
+	stack          *stack.Stack
+	udpIdleTimeout time.Duration
+	addRoute       func(*net.IPNet) error
+	removeRoute    func(*net.IPNet) error
+	closeDevice    func()
+
+	lock     sync.RWMutex
+	services map[string]*interceptedService
+}
+
+// newInterceptor creates an interceptor which terminates the TCP and UDP flows of packets read from the given link
+// endpoint in a userspace network stack
+func newInterceptor(linkEndpoint stack.LinkEndpoint, udpIdleTimeout time.Duration) (*interceptor, error) {
+	s := stack.New(stack.Options{
+		NetworkProtocols:   []stack.NetworkProtocolFactory{ipv4.NewProtocol, ipv6.NewProtocol},
+		TransportProtocols: []stack.TransportProtocolFactory{tcp.NewProtocol, udp.NewProtocol, icmp.NewProtocol4, icmp.NewProtocol6},
+	})
+
+	if err := s.CreateNIC(nicId, linkEndpoint); err != nil {
+		s.Close()
+		return nil, errors.Errorf("failed to create network stack nic: %v", err)
+	}
+
+	// packets are addressed to the intercepted addresses rather than an address of the stack, so the stack needs to
+	// accept packets for any address and reply from them
+	if err := s.SetPromiscuousMode(nicId, true); err != nil {
+		s.Close()
+		return nil, errors.Errorf("failed to enable promiscuous mode on network stack nic: %v", err)
+	}
+	if err := s.SetSpoofing(nicId, true); err != nil {
+		s.Close()
+		return nil, errors.Errorf("failed to enable spoofing on network stack nic: %v", err)
+	}
+
+	s.SetRouteTable([]tcpip.Route{
+		{Destination: header.IPv4EmptySubnet, NIC: nicId},
+		{Destination: header.IPv6EmptySubnet, NIC: nicId},
+	})
+
+	if udpIdleTimeout <= 0 {
+		udpIdleTimeout = DefaultUdpIdleTimeout
+	}
+
+	result := &interceptor{
+		stack:          s,
+		udpIdleTimeout: udpIdleTimeout,
+		addRoute:       func(*net.IPNet) error { return nil },
+		removeRoute:    func(*net.IPNet) error { return nil },
+		closeDevice:    func() {},
+		services:       map[string]*interceptedService{},
+	}
+
+	tcpForwarder := tcp.NewForwarder(s, 0, tcpMaxInFlight, result.handleTCP)
+	s.SetTransportProtocolHandler(tcp.ProtocolNumber, tcpForwarder.HandlePacket)
+
+	udpForwarder := udp.NewForwarder(s, result.handleUDP)
+	s.SetTransportProtocolHandler(udp.ProtocolNumber, udpForwarder.HandlePacket)
+
+	return result, nil
+}
+
+func (self *interceptor) Stop() {
+	pfxlog.Logger().Info("stopping tun interceptor")
+
+	self.lock.Lock()
+	self.services = map[string]*interceptedService{}
+	self.lock.Unlock()
+
+	// routes to the device are removed along with it
+	self.stack.Close()
+	self.stack.Wait()
+	self.closeDevice()
+}
+
+func (self *interceptor) Intercept(service *entities.Service, resolver dns.Resolver, tracker intercept.AddressTracker) error {
+	log := pfxlog.Logger().WithField("service", *service.Name)
+
+	if service.InterceptV1Config == nil {
+		log.Debug("service has no intercept config, not intercepting")
+		return nil
+	}
+
+	var protocols []string
+	for _, protocol := range service.InterceptV1Config.Protocols {
+		if protocol == "tcp" || protocol == "udp" {
+			protocols = append(protocols, protocol)
+		}
+	}
+
+	if len(protocols) == 0 {
+		return errors.Errorf("service %v has no supported protocols (tcp, udp). Service protocols: %+v", *service.Name, service.InterceptV1Config.Protocols)
+	}
+
+	svc := &interceptedService{
+		interceptor: self,
+		service:     service,
+		resolver:    resolver,
+		tracker:     tracker,
+	}
+
+	// register the service first, so addresses for wildcard domains which are resolved later are found
+	self.lock.Lock()
+	self.services[*service.Name] = svc
+	self.lock.Unlock()
+
+	if err := intercept.GetInterceptAddresses(service, protocols, resolver, svc); err != nil {
+		_ = self.StopIntercepting(*service.Name, tracker)
+		return err
+	}
+
+	// pre-fetch network session
+	service.FabricProvider.PrepForUse(*service.ID)
+
+	log.Infof("intercepting service, protocols: %v", protocols)
+	return nil
+}
+
+func (self *interceptor) StopIntercepting(serviceName string, tracker intercept.AddressTracker) error {
+	self.lock.Lock()
+	svc, found := self.services[serviceName]
+	delete(self.services, serviceName)
+	self.lock.Unlock()
+
+	if !found {
+		return nil
+	}
+
+	pfxlog.Logger().WithField("service", serviceName).Info("stopping tun interceptor for service")
+	return svc.removeRoutes(tracker)
+}
+
+// match returns the service which intercepts the given address. If several do, the one with the narrowest address is
+// used, so services for individual hosts take precedence over services for the networks containing them.
+func (self *interceptor) match(protocol string, ip net.IP, port uint16) *interceptedService {
+	self.lock.RLock()
+	defer self.lock.RUnlock()
+
+	var result *interceptedService
+	var resultAddr *intercept.InterceptAddress
+	for _, svc := range self.services {
+		for _, addr := range svc.getAddresses() {
+			if addr.Proto() == protocol && addr.Contains(ip, port) && (resultAddr == nil || isNarrower(addr, resultAddr)) {
+				result = svc
+				resultAddr = addr
+			}
+		}
+	}
+	return result
+}
+
+func isNarrower(addr, other *intercept.InterceptAddress) bool {
+	ones, _ := addr.IpNet().Mask.Size()
+	otherOnes, _ := other.IpNet().Mask.Size()
+	if ones != otherOnes {
+		return ones > otherOnes
+	}
+	return addr.HighPort()-addr.LowPort() < other.HighPort()-other.LowPort()
+}
+
+func (self *interceptor) handleTCP(request *tcp.ForwarderRequest) {
+	id := request.ID()
+	srcAddr := &net.TCPAddr{IP: id.RemoteAddress.AsSlice(), Port: int(id.RemotePort)}
+	dstAddr := &net.TCPAddr{IP: id.LocalAddress.AsSlice(), Port: int(id.LocalPort)}
+	log := pfxlog.Logger().WithField("src", srcAddr.String()).WithField("dst", dstAddr.String())
+
+	svc := self.match("tcp", dstAddr.IP, id.LocalPort)
+	if svc == nil {
+		log.Debug("no intercepted service matches tcp connection, resetting")
+		request.Complete(true)
+		return
+	}
+
+	var wq waiter.Queue
+	ep, err := request.CreateEndpoint(&wq)
+	if err != nil {
+		log.Errorf("failed to create tcp endpoint: %v", err)
+		request.Complete(true)
+		return
+	}
+	request.Complete(false)
+
+	log.WithField("service", *svc.service.Name).Info("received tcp connection")
+	svc.dial("tcp", gonet.NewTCPConn(&wq, ep), srcAddr, dstAddr, true)
+}
+
+func (self *interceptor) handleUDP(request *udp.ForwarderRequest) {
+	id := request.ID()
+	srcAddr := &net.UDPAddr{IP: id.RemoteAddress.AsSlice(), Port: int(id.RemotePort)}
+	dstAddr := &net.UDPAddr{IP: id.LocalAddress.AsSlice(), Port: int(id.LocalPort)}
+	log := pfxlog.Logger().WithField("src", srcAddr.String()).WithField("dst", dstAddr.String())
+
+	svc := self.match("udp", dstAddr.IP, id.LocalPort)
+	if svc == nil {
+		log.Debug("no intercepted service matches udp datagram, dropping")
+		return
+	}
+
+	var wq waiter.Queue
+	ep, err := request.CreateEndpoint(&wq)
+	if err != nil {
+		log.Errorf("failed to create udp endpoint: %v", err)
+		return
+	}
+
+	log.WithField("service", *svc.service.Name).Info("received udp flow")
+	conn := &udpConn{
+		UDPConn:     gonet.NewUDPConn(&wq, ep),
+		idleTimeout: self.udpIdleTimeout,
+	}
+	conn.lastWrite.Store(time.Now().UnixNano())
+	go svc.dial("udp", conn, srcAddr, dstAddr, false)
+}
+
+type interceptedService struct {
+	interceptor *interceptor
+	service     *entities.Service
+	resolver    dns.Resolver
+	tracker     intercept.AddressTracker
+
+	lock      sync.Mutex
+	addresses []*intercept.InterceptAddress
+	routes    []*net.IPNet
+}
+
+// Apply adds an intercept address for the service, routing it to the TUN device if required. Addresses for hostnames
+// matching a wildcard domain are added when the hostname is first resolved.
+func (self *interceptedService) Apply(addr *intercept.InterceptAddress) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.addresses = append(self.addresses, addr)
+
+	ipNet := addr.IpNet()
+	if !addr.RouteRequired() || slices.ContainsFunc(self.routes, func(route *net.IPNet) bool { return route.String() == ipNet.String() }) {
+		return
+	}
+
+	if err := self.interceptor.addRoute(ipNet); err != nil {
+		pfxlog.Logger().WithField("service", *self.service.Name).WithError(err).Errorf("failed to add route %v", ipNet)
+		return
+	}
+
+	self.routes = append(self.routes, ipNet)
+	if self.tracker != nil {
+		self.tracker.AddAddress(ipNet.String())
+	}
+}
+
+func (self *interceptedService) getAddresses() []*intercept.InterceptAddress {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return self.addresses
+}
+
+// removeRoutes removes the routes added for the service. If a tracker is given, routes are only removed once no other
+// service uses them
+func (self *interceptedService) removeRoutes(tracker intercept.AddressTracker) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	var errorList []error
+	for _, route := range self.routes {
+		if tracker == nil || tracker.RemoveAddress(route.String()) {
+			if err := self.interceptor.removeRoute(route); err != nil {
+				errorList = append(errorList, err)
+				pfxlog.Logger().WithField("service", *self.service.Name).WithError(err).Errorf("failed to remove route %v", route)
+			}
+		}
+	}
+	self.routes = nil
+
+	return stdErr.Join(errorList...)
+}
+
+func (self *interceptedService) dial(protocol string, conn net.Conn, srcAddr, dstAddr net.Addr, halfClose bool) {
+	dstIp, dstPort := tunnel.GetIpAndPort(dstAddr)
+	var dstHostname string
+	if self.resolver != nil {
+		dstHostname, _ = self.resolver.Lookup(net.ParseIP(dstIp))
+	}
+	sourceAddr := self.service.GetSourceAddr(srcAddr, dstAddr)
+	appInfo := tunnel.GetAppInfo(protocol, dstHostname, dstIp, dstPort, sourceAddr)
+	identity := self.service.GetDialIdentity(srcAddr, dstAddr)
+	tunnel.DialAndRun(self.service.FabricProvider, self.service, identity, conn, appInfo, halfClose)
+}
+
+// udpConn is a UDP flow terminated by the network stack. UDP has no connection teardown, so a flow is closed once no
+// datagrams have been sent or received for the idle timeout.
+type udpConn struct {
+	*gonet.UDPConn
+	idleTimeout time.Duration
+	lastWrite   atomic.Int64
+}
+
+func (self *udpConn) Read(b []byte) (int, error) {
+	for {
+		if err := self.SetReadDeadline(time.Now().Add(self.idleTimeout)); err != nil {
+			return 0, err
+		}
+
+		n, err := self.UDPConn.Read(b)
+
+		var netErr net.Error
+		if stdErr.As(err, &netErr) && netErr.Timeout() && time.Since(time.Unix(0, self.lastWrite.Load())) < self.idleTimeout {
+			continue
+		}
+		return n, err
+	}
+}
+
+func (self *udpConn) Write(b []byte) (int, error) {
+	self.lastWrite.Store(time.Now().UnixNano())
+	return self.UDPConn.Write(b)
+}
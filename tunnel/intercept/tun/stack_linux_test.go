@@ -0,0 +1,164 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package tun
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/hanzozt/edge-api/rest_model"
+	"github.com/hanzozt/zt/v2/tunnel"
+	"github.com/hanzozt/zt/v2/tunnel/entities"
+	"github.com/stretchr/testify/require"
+	"gvisor.dev/gvisor/pkg/tcpip"
+	"gvisor.dev/gvisor/pkg/tcpip/adapters/gonet"
+	"gvisor.dev/gvisor/pkg/tcpip/header"
+	"gvisor.dev/gvisor/pkg/tcpip/link/pipe"
+	"gvisor.dev/gvisor/pkg/tcpip/network/ipv4"
+	"gvisor.dev/gvisor/pkg/tcpip/stack"
+	"gvisor.dev/gvisor/pkg/tcpip/transport/tcp"
+	"gvisor.dev/gvisor/pkg/tcpip/transport/udp"
+)
+
+// testProvider answers each dial by writing the app info of the dial back to the client
+type testProvider struct{}
+
+func (self *testProvider) PrepForUse(string) {}
+
+func (self *testProvider) GetCurrentIdentity() (*rest_model.IdentityDetail, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (self *testProvider) GetCurrentIdentityWithBackoff() (*rest_model.IdentityDetail, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (self *testProvider) TunnelService(_ tunnel.Service, _ string, conn net.Conn, _ bool, appInfo []byte) error {
+	_, err := conn.Write(appInfo)
+	_ = conn.Close()
+	return err
+}
+
+func (self *testProvider) HostService(tunnel.HostingContext) (tunnel.HostControl, error) {
+	return nil, errors.New("not implemented")
+}
+
+func newTestService(name string, protocol string, addresses ...string) *entities.Service {
+	id := name + "-id"
+	service := &entities.Service{
+		FabricProvider: &testProvider{},
+		InterceptV1Config: &entities.InterceptV1Config{
+			Addresses:  addresses,
+			PortRanges: []*entities.PortRange{{Low: 80, High: 80}},
+			Protocols:  []string{protocol},
+		},
+	}
+	service.ID = &id
+	service.Name = &name
+	return service
+}
+
+// newClientStack creates a network stack which is connected to the interceptor in place of the TUN device
+func newClientStack(t *testing.T) (*stack.Stack, *interceptor) {
+	req := require.New(t)
+
+	clientEndpoint, interceptorEndpoint := pipe.New("", "", DefaultMTU)
+
+	i, err := newInterceptor(interceptorEndpoint, time.Second)
+	req.NoError(err)
+	t.Cleanup(i.Stop)
+
+	client := stack.New(stack.Options{
+		NetworkProtocols:   []stack.NetworkProtocolFactory{ipv4.NewProtocol},
+		TransportProtocols: []stack.TransportProtocolFactory{tcp.NewProtocol, udp.NewProtocol},
+	})
+	t.Cleanup(client.Close)
+
+	req.Nil(client.CreateNIC(1, clientEndpoint))
+	req.Nil(client.AddProtocolAddress(1, tcpip.ProtocolAddress{
+		Protocol:          ipv4.ProtocolNumber,
+		AddressWithPrefix: tcpip.AddrFrom4([4]byte{192, 168, 1, 10}).WithPrefix(),
+	}, stack.AddressProperties{}))
+	client.SetRouteTable([]tcpip.Route{{Destination: header.IPv4EmptySubnet, NIC: 1}})
+
+	return client, i
+}
+
+func readAppInfo(t *testing.T, conn net.Conn) map[string]string {
+	req := require.New(t)
+	req.NoError(conn.SetReadDeadline(time.Now().Add(5 * time.Second)))
+
+	buf := make([]byte, 4096)
+	n, err := conn.Read(buf)
+	req.NoError(err)
+
+	appInfo := map[string]string{}
+	req.NoError(json.Unmarshal(buf[:n], &appInfo))
+	return appInfo
+}
+
+func TestTunInterceptor(t *testing.T) {
+	client, i := newClientStack(t)
+
+	require.NoError(t, i.Intercept(newTestService("web", "tcp", "10.1.0.0/16"), nil, nil))
+	require.NoError(t, i.Intercept(newTestService("narrow", "tcp", "10.1.2.3"), nil, nil))
+	require.NoError(t, i.Intercept(newTestService("dns", "udp", "10.1.2.3"), nil, nil))
+
+	t.Run("tcp", func(t *testing.T) {
+		req := require.New(t)
+		conn, err := gonet.DialTCP(client, tcpip.FullAddress{NIC: 1, Addr: tcpip.AddrFrom4([4]byte{10, 1, 2, 3}), Port: 80}, ipv4.ProtocolNumber)
+		req.NoError(err)
+		defer func() { _ = conn.Close() }()
+
+		appInfo := readAppInfo(t, conn)
+		req.Equal("tcp", appInfo[tunnel.DestinationProtocolKey])
+		req.Equal("10.1.2.3", appInfo[tunnel.DestinationIpKey])
+		req.Equal("80", appInfo[tunnel.DestinationPortKey])
+
+		req.Equal("narrow", *i.match("tcp", net.ParseIP("10.1.2.3"), 80).service.Name)
+		req.Equal("web", *i.match("tcp", net.ParseIP("10.1.3.3"), 80).service.Name)
+	})
+
+	t.Run("udp", func(t *testing.T) {
+		req := require.New(t)
+		conn, err := gonet.DialUDP(client, nil, &tcpip.FullAddress{NIC: 1, Addr: tcpip.AddrFrom4([4]byte{10, 1, 2, 3}), Port: 80}, ipv4.ProtocolNumber)
+		req.NoError(err)
+		defer func() { _ = conn.Close() }()
+
+		_, err = conn.Write([]byte("query"))
+		req.NoError(err)
+
+		appInfo := readAppInfo(t, conn)
+		req.Equal("udp", appInfo[tunnel.DestinationProtocolKey])
+		req.Equal("10.1.2.3", appInfo[tunnel.DestinationIpKey])
+	})
+
+	t.Run("unmatched port is reset", func(t *testing.T) {
+		_, err := gonet.DialTCP(client, tcpip.FullAddress{NIC: 1, Addr: tcpip.AddrFrom4([4]byte{10, 1, 2, 3}), Port: 443}, ipv4.ProtocolNumber)
+		require.Error(t, err)
+	})
+
+	t.Run("stopped service", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(i.StopIntercepting("web", nil))
+		_, err := gonet.DialTCP(client, tcpip.FullAddress{NIC: 1, Addr: tcpip.AddrFrom4([4]byte{10, 1, 3, 3}), Port: 80}, ipv4.ProtocolNumber)
+		req.Error(err)
+	})
+}
@@ -0,0 +1,42 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+// Package tun implements an interceptor which captures traffic with a TUN device. Intercept addresses are routed to
+// the device, and the packets read from it are terminated by a userspace TCP/IP stack, so intercepted TCP and UDP
+// flows are handed to the tunnel without any firewall rules. ICMP echo requests to intercepted addresses are answered
+// by the stack, so intercepted addresses can be pinged.
+//
+// The device is assigned the first address of the DNS intercept IP range, so the DNS resolver can be served on the
+// TUN address.
+package tun
+
+import "time"
+
+const (
+	DefaultDeviceName     = "zt0"
+	DefaultMTU            = 1500
+	DefaultUdpIdleTimeout = 5 * time.Minute
+)
+
+type Config struct {
+	// DeviceName is the name of the TUN device. Defaults to DefaultDeviceName
+	DeviceName string
+	// MTU is the MTU of the TUN device. Defaults to DefaultMTU
+	MTU uint32
+	// UDPIdleTimeout is how long a UDP flow may go without traffic before it's closed. Defaults to
+	// DefaultUdpIdleTimeout
+	UDPIdleTimeout time.Duration
+}
@@ -64,6 +64,12 @@ type HostControl interface {
 	SendHealthEvent(pass bool) error
 }
 
+// CallerIdentityProvider is implemented by fabric providers which supply the role attributes and a controller signed
+// token for the identities dialing hosted services. Other providers only supply the id and name of the dialer.
+type CallerIdentityProvider interface {
+	ProvidesCallerIdentity() bool
+}
+
 type FabricProvider interface {
 	PrepForUse(serviceId string)
 	GetCurrentIdentity() (*rest_model.IdentityDetail, error)
@@ -82,6 +88,32 @@ func AppDataToMap(appData []byte) (map[string]interface{}, error) {
 	return result, nil
 }
 
+// DialerIdentity describes the identity which dialed a hosted service. It is taken from the circuit, rather than from
+// the app data supplied by the dialer, so it can be trusted. RoleAttributes and Token are only provided to router
+// embedded tunnelers.
+type DialerIdentity struct {
+	Id             string
+	Name           string
+	RoleAttributes []string
+	Token          string
+}
+
+// SetDialerIdentity stores the given dialer identity in the dial options, replacing anything the dialer may have put
+// under the same key in the app data
+func SetDialerIdentity(options map[string]interface{}, identity *DialerIdentity) {
+	if identity == nil {
+		delete(options, DialerIdentityKey)
+	} else {
+		options[DialerIdentityKey] = identity
+	}
+}
+
+// GetDialerIdentity returns the dialer identity stored in the dial options, or nil if the dialer isn't known
+func GetDialerIdentity(options map[string]interface{}) *DialerIdentity {
+	identity, _ := options[DialerIdentityKey].(*DialerIdentity)
+	return identity
+}
+
 func NewContextProvider(context zt.Context) FabricProvider {
 	return &contextProvider{
 		Context: context,
@@ -151,6 +183,15 @@ func (self *contextProvider) accept(listener edge.Listener, hostCtx HostingConte
 			continue
 		}
 
+		var dialerIdentity *DialerIdentity
+		if dialerId := conn.GetDialerIdentityId(); dialerId != "" {
+			dialerIdentity = &DialerIdentity{
+				Id:   dialerId,
+				Name: conn.GetDialerIdentityName(),
+			}
+		}
+		SetDialerIdentity(options, dialerIdentity)
+
 		externalConn, halfClose, err := hostCtx.Dial(options)
 		if err != nil {
 			logger.WithError(err).Error("dial failed")
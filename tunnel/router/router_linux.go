@@ -135,6 +135,94 @@ func nlAddrReq(localPrefix, peerPrefix *net.IPNet, ifName string, t netlink.Head
 	return err
 }
 
+// AddRoute adds a route for the prefix via the specified network interface.
+func AddRoute(prefix *net.IPNet, ifName string) error {
+	logrus.Debugf("adding route '%v' via interface %v", prefix.String(), ifName)
+	return nlRouteReq(prefix, ifName, unix.RTM_NEWROUTE, unix.NLM_F_CREATE|unix.NLM_F_EXCL, unix.RT_SCOPE_LINK)
+}
+
+func RemoveRoute(prefix *net.IPNet, ifName string) error {
+	logrus.Debugf("removing route '%v' via interface %v", prefix.String(), ifName)
+	return nlRouteReq(prefix, ifName, unix.RTM_DELROUTE, 0, unix.RT_SCOPE_NOWHERE)
+}
+
+func nlRouteReq(prefix *net.IPNet, ifName string, t netlink.HeaderType, flags netlink.HeaderFlags, scope uint8) error {
+	netIf, err := net.InterfaceByName(ifName)
+	if err != nil {
+		return fmt.Errorf("failed to find interface %s: %v", ifName, err)
+	}
+
+	var dst net.IP
+	var addrFamily uint8
+	if prefix.IP.To4() != nil {
+		dst = prefix.IP.To4()
+		addrFamily = unix.AF_INET
+	} else {
+		dst = prefix.IP.To16()
+		addrFamily = unix.AF_INET6
+	}
+	prefixLen, _ := prefix.Mask.Size()
+
+	c, err := netlink.Dial(unix.AF_UNSPEC, nil)
+	if err != nil {
+		return fmt.Errorf("error dialing netlink: %v", err)
+	}
+	defer closeNetlink(c)
+
+	rtmBytes := marshalRtMsg(&unix.RtMsg{
+		Family:   addrFamily,
+		Dst_len:  uint8(prefixLen),
+		Table:    unix.RT_TABLE_MAIN,
+		Protocol: unix.RTPROT_BOOT,
+		Scope:    scope,
+		Type:     unix.RTN_UNICAST,
+	})
+	attrBytes, err := netlink.MarshalAttributes([]netlink.Attribute{
+		{Type: unix.RTA_DST, Data: dst.Mask(prefix.Mask)},
+		{Type: unix.RTA_OIF, Data: nlenc.Uint32Bytes(uint32(netIf.Index))},
+	})
+	if err != nil {
+		return fmt.Errorf("failed marshalling routing attributes: %v", err)
+	}
+
+	req := netlink.Message{
+		Header: netlink.Header{
+			Type:  t,
+			Flags: unix.NLM_F_REQUEST | unix.NLM_F_ACK | flags,
+		},
+		Data: append(rtmBytes, attrBytes...),
+	}
+
+	_, err = c.Execute(req)
+	if err != nil {
+		var nlErr *netlink.OpError
+		if errors.As(err, &nlErr) {
+			if os.IsExist(nlErr.Err) {
+				return nil
+			}
+		}
+	}
+
+	return err
+}
+
+// marshalRtMsg packs a unix.RtMsg into a byte slice using host byte order.
+func marshalRtMsg(m *unix.RtMsg) []byte {
+	b := make([]byte, unix.SizeofRtMsg)
+
+	b[0] = m.Family
+	b[1] = m.Dst_len
+	b[2] = m.Src_len
+	b[3] = m.Tos
+	b[4] = m.Table
+	b[5] = m.Protocol
+	b[6] = m.Scope
+	b[7] = m.Type
+	nlenc.PutUint32(b[8:12], m.Flags)
+
+	return b
+}
+
 // marshalIfAddrmsg packs a unix.IfAddrmsg into a byte slice using host byte order.
 // The returned slice can be included in the payload of a netlink message.
 func marshalIfAddrmsg(m *unix.IfAddrmsg) []byte {
@@ -38,3 +38,11 @@ func AddPointToPointAddress(localIP net.IP, peerPrefix *net.IPNet, ifName string
 func RemovePointToPointAddress(localIP net.IP, peerPrefix *net.IPNet, ifName string) error {
 	return errors.New("RemovePointToPointAddress is not implemented on this operating system")
 }
+
+func AddRoute(prefix *net.IPNet, ifName string) error {
+	return errors.New("AddRoute is not implemented on this operating system")
+}
+
+func RemoveRoute(prefix *net.IPNet, ifName string) error {
+	return errors.New("RemoveRoute is not implemented on this operating system")
+}
@@ -30,6 +30,7 @@ type createEdgeRouterPolicyOptions struct {
 	edgeRouterRoles []string
 	identityRoles   []string
 	semantic        string
+	schedule        policyScheduleOptions
 }
 
 // NewCreateEdgeRouterPolicyCmd creates the 'edge controller create edge-router-policy' command
@@ -57,6 +58,7 @@ func NewCreateEdgeRouterPolicyCmd(out io.Writer, errOut io.Writer) *cobra.Comman
 	cmd.Flags().StringSliceVar(&options.edgeRouterRoles, "edge-router-roles", nil, "Edge router roles of the new edge router policy")
 	cmd.Flags().StringSliceVar(&options.identityRoles, "identity-roles", nil, "Identity roles of the new edge router policy")
 	cmd.Flags().StringVar(&options.semantic, "semantic", "AnyOf", "Semantic dictating how multiple attributes should be interpreted. Valid values: AnyOf, AllOf")
+	options.schedule.addFlags(cmd, false)
 	options.AddCommonFlags(cmd)
 
 	return cmd
@@ -81,6 +83,11 @@ func runCreateEdgeRouterPolicy(o *createEdgeRouterPolicyOptions) error {
 	if o.semantic != "" {
 		api.SetJSONValue(entityData, o.semantic, "semantic")
 	}
+	if o.schedule.changed(o.Cmd) {
+		if err = o.schedule.setSchedule(entityData); err != nil {
+			return err
+		}
+	}
 	o.SetTags(entityData)
 
 	result, err := CreateEntityOfType("edge-router-policies", entityData.String(), &o.Options)
@@ -32,6 +32,8 @@ type createServiceOptions struct {
 	roleAttributes     []string
 	configs            []string
 	encryption         encryptionVar
+	multipath          string
+	priority           string
 }
 
 // newCreateServiceCmd creates the 'edge controller create service local' command for the given entity type
@@ -59,6 +61,8 @@ func newCreateServiceCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	cmd.Flags().StringSliceVarP(&options.configs, "configs", "c", nil, "Configuration id or names to be associated with the new service")
 	cmd.Flags().StringVar(&options.terminatorStrategy, "terminator-strategy", "", "Specifies the terminator strategy for the service")
 	cmd.Flags().DurationVar(&options.maxIdleTime, "max-idle-time", 0, "Time after which idle circuit will be terminated. Defaults to 0, which indicates no limit on idle circuits")
+	cmd.Flags().StringVar(&options.multipath, "multipath", "", "Route circuits over two disjoint paths. Valid values are 'duplicate', which sends every payload over both paths, and 'stripe', which alternates payloads between them")
+	cmd.Flags().StringVar(&options.priority, "priority", "", "The priority class of the service's circuits on router links. Valid values are 'interactive', 'bulk' and 'background'")
 
 	if err := options.encryption.Set("ON"); err != nil {
 		panic(err)
@@ -86,6 +90,12 @@ func runCreateService(o *createServiceOptions) (err error) {
 	api.SetJSONValue(entityData, o.roleAttributes, "roleAttributes")
 	api.SetJSONValue(entityData, configs, "configs")
 	api.SetJSONValue(entityData, o.maxIdleTime.Milliseconds(), "maxIdleTimeMillis")
+	if o.multipath != "" {
+		api.SetJSONValue(entityData, o.multipath, "multipath")
+	}
+	if o.priority != "" {
+		api.SetJSONValue(entityData, o.priority, "priority")
+	}
 
 	o.SetTags(entityData)
 
@@ -34,6 +34,7 @@ type createServicePolicyOptions struct {
 	identityRoles     []string
 	postureCheckRoles []string
 	semantic          string
+	schedule          policyScheduleOptions
 }
 
 // newCreateServicePolicyCmd creates the 'edge controller create service-policy' command
@@ -62,6 +63,7 @@ func newCreateServicePolicyCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	cmd.Flags().StringSliceVar(&options.identityRoles, "identity-roles", nil, "Identity roles of the new service policy")
 	cmd.Flags().StringVar(&options.semantic, "semantic", "AnyOf", "Semantic dictating how multiple attributes should be interpreted. Valid values: AnyOf, AllOf")
 	cmd.Flags().StringSliceVarP(&options.postureCheckRoles, "posture-check-roles", "p", nil, "Posture check roles of the new service policy")
+	options.schedule.addFlags(cmd, false)
 	options.AddCommonFlags(cmd)
 
 	return cmd
@@ -98,6 +100,11 @@ func runCreateServicePolicy(o *createServicePolicyOptions) error {
 	if o.semantic != "" {
 		api.SetJSONValue(entityData, o.semantic, "semantic")
 	}
+	if o.schedule.changed(o.Cmd) {
+		if err = o.schedule.setSchedule(entityData); err != nil {
+			return err
+		}
+	}
 	o.SetTags(entityData)
 
 	result, err := CreateEntityOfType("service-policies", entityData.String(), &o.Options)
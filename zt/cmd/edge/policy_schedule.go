@@ -0,0 +1,91 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package edge
+
+import (
+	"time"
+
+	"github.com/Jeffail/gabs"
+	"github.com/hanzozt/zt/v2/zt/cmd/api"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// policyScheduleOptions holds the flags used to set the schedule of service policies and edge router policies
+type policyScheduleOptions struct {
+	timeZone  string
+	notBefore string
+	notAfter  string
+	windows   []string
+	clear     bool
+}
+
+var policyScheduleFlags = []string{"schedule-time-zone", "schedule-not-before", "schedule-not-after", "schedule-window"}
+
+func (o *policyScheduleOptions) addFlags(cmd *cobra.Command, forUpdate bool) {
+	cmd.Flags().StringVar(&o.timeZone, "schedule-time-zone", "", "IANA time zone the schedule windows are evaluated in, such as America/New_York. Defaults to UTC")
+	cmd.Flags().StringVar(&o.notBefore, "schedule-not-before", "", "RFC3339 time before which the policy grants no access")
+	cmd.Flags().StringVar(&o.notAfter, "schedule-not-after", "", "RFC3339 time after which the policy grants no access")
+	// windows contain commas, so they can't be given as a comma separated list
+	cmd.Flags().StringArrayVar(&o.windows, "schedule-window", nil, "Cron-like window (minute hour day-of-month month day-of-week) during which the policy grants access, such as '* 9-16 * * MON-FRI'. May be given multiple times")
+	if forUpdate {
+		cmd.Flags().BoolVar(&o.clear, "clear-schedule", false, "Remove the policy schedule")
+		cmd.MarkFlagsMutuallyExclusive(append([]string{"clear-schedule"}, policyScheduleFlags...)...)
+	}
+}
+
+// changed returns true if any schedule flags were given. On update, the given flags replace the whole schedule
+func (o *policyScheduleOptions) changed(cmd *cobra.Command) bool {
+	if o.clear {
+		return true
+	}
+	for _, flag := range policyScheduleFlags {
+		if cmd.Flags().Changed(flag) {
+			return true
+		}
+	}
+	return false
+}
+
+func (o *policyScheduleOptions) setSchedule(entityData *gabs.Container) error {
+	if o.clear {
+		api.SetJSONValue(entityData, nil, "schedule")
+		return nil
+	}
+
+	schedule := map[string]interface{}{}
+	if o.timeZone != "" {
+		schedule["timeZone"] = o.timeZone
+	}
+
+	for flag, value := range map[string]string{"notBefore": o.notBefore, "notAfter": o.notAfter} {
+		if value == "" {
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339, value); err != nil {
+			return errors.Wrapf(err, "invalid schedule %s time '%s', must be in RFC3339 format", flag, value)
+		}
+		schedule[flag] = value
+	}
+
+	if len(o.windows) > 0 {
+		schedule["windows"] = o.windows
+	}
+
+	api.SetJSONValue(entityData, schedule, "schedule")
+	return nil
+}
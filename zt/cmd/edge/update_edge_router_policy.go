@@ -33,6 +33,7 @@ type updateEdgeRouterPolicyOptions struct {
 	name            string
 	edgeRouterRoles []string
 	identityRoles   []string
+	schedule        policyScheduleOptions
 }
 
 func newUpdateEdgeRouterPolicyCmd(out io.Writer, errOut io.Writer) *cobra.Command {
@@ -59,6 +60,7 @@ func newUpdateEdgeRouterPolicyCmd(out io.Writer, errOut io.Writer) *cobra.Comman
 	cmd.Flags().StringVarP(&options.name, "name", "n", "", "Set the name of the edge router policy")
 	cmd.Flags().StringSliceVar(&options.edgeRouterRoles, "edge-router-roles", nil, "Edge router roles of the edge router policy")
 	cmd.Flags().StringSliceVar(&options.identityRoles, "identity-roles", nil, "Identity roles of the edge router policy")
+	options.schedule.addFlags(cmd, true)
 
 	options.AddCommonFlags(cmd)
 
@@ -99,6 +101,13 @@ func runUpdateEdgeRouterPolicy(o *updateEdgeRouterPolicyOptions) error {
 		change = true
 	}
 
+	if o.schedule.changed(o.Cmd) {
+		if err = o.schedule.setSchedule(entityData); err != nil {
+			return err
+		}
+		change = true
+	}
+
 	if o.TagsProvided() {
 		o.SetTags(entityData)
 		change = true
@@ -37,6 +37,8 @@ type updateServiceOptions struct {
 	roleAttributes     []string
 	encryption         encryptionVar
 	configs            []string
+	multipath          string
+	priority           string
 }
 
 func newUpdateServiceCmd(out io.Writer, errOut io.Writer) *cobra.Command {
@@ -69,6 +71,8 @@ func newUpdateServiceCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	}
 	cmd.Flags().VarP(&options.encryption, "encryption", "e", "Controls end-to-end encryption for the service")
 	cmd.Flags().StringSliceVarP(&options.configs, "configs", "c", nil, "Configuration id or names to be associated with the new service")
+	cmd.Flags().StringVar(&options.multipath, "multipath", "", "Route circuits over two disjoint paths. Valid values are 'duplicate', which sends every payload over both paths, and 'stripe', which alternates payloads between them. Set to an empty string to use a single path")
+	cmd.Flags().StringVar(&options.priority, "priority", "", "The priority class of the service's circuits on router links. Valid values are 'interactive', 'bulk' and 'background'. Set to an empty string to use the default, 'bulk'")
 
 	options.AddCommonFlags(cmd)
 
@@ -118,6 +122,15 @@ func runUpdateService(o *updateServiceOptions) error {
 		change = true
 	}
 
+	if o.Cmd.Flags().Changed("multipath") {
+		api.SetJSONValue(entityData, o.multipath, "multipath")
+		change = true
+	}
+	if o.Cmd.Flags().Changed("priority") {
+		api.SetJSONValue(entityData, o.priority, "priority")
+		change = true
+	}
+
 	if o.TagsProvided() {
 		o.SetTags(entityData)
 		change = true
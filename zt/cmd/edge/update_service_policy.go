@@ -34,6 +34,7 @@ type updateServicePolicyOptions struct {
 	serviceRoles      []string
 	identityRoles     []string
 	postureCheckRoles []string
+	schedule          policyScheduleOptions
 }
 
 func newUpdateServicePolicyCmd(out io.Writer, errOut io.Writer) *cobra.Command {
@@ -61,6 +62,7 @@ func newUpdateServicePolicyCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	cmd.Flags().StringSliceVar(&options.serviceRoles, "service-roles", nil, "Service roles of the service policy")
 	cmd.Flags().StringSliceVar(&options.identityRoles, "identity-roles", nil, "Identity roles of the service policy")
 	cmd.Flags().StringSliceVarP(&options.postureCheckRoles, "posture-check-roles", "p", nil, "Posture Check roles of the service policy")
+	options.schedule.addFlags(cmd, true)
 
 	options.AddCommonFlags(cmd)
 
@@ -111,6 +113,13 @@ func runUpdateServicePolicy(o *updateServicePolicyOptions) error {
 		change = true
 	}
 
+	if o.schedule.changed(o.Cmd) {
+		if err = o.schedule.setSchedule(entityData); err != nil {
+			return err
+		}
+		change = true
+	}
+
 	if o.TagsProvided() {
 		o.SetTags(entityData)
 		change = true
@@ -30,6 +30,8 @@ type createServiceOptions struct {
 	terminatorStrategy string
 	tags               map[string]string
 	maxIdleTime        time.Duration
+	multipath          string
+	priority           string
 }
 
 // newCreateServiceCmd creates the 'fabric create service' command for the given entity type
@@ -54,6 +56,8 @@ func newCreateServiceCmd(p common.OptionsProvider) *cobra.Command {
 	cmd.Flags().StringToStringVarP(&options.tags, "tags", "t", nil, "Add tags to service definition")
 	cmd.Flags().StringVar(&options.terminatorStrategy, "terminator-strategy", "", "Specifies the terminator strategy for the service")
 	cmd.Flags().DurationVar(&options.maxIdleTime, "max-idle-time", 0, "Time after which idle circuit will be terminated. Defaults to 0, which indicates no limit on idle circuits")
+	cmd.Flags().StringVar(&options.multipath, "multipath", "", "Route circuits over two disjoint paths. Valid values are 'duplicate', which sends every payload over both paths, and 'stripe', which alternates payloads between them")
+	cmd.Flags().StringVar(&options.priority, "priority", "", "The priority class for the service's circuits on router links. Valid values are 'interactive', 'bulk' and 'background'. Defaults to 'bulk'")
 
 	options.AddCommonFlags(cmd)
 
@@ -69,6 +73,12 @@ func (o *createServiceOptions) createService(_ *cobra.Command, args []string) (e
 		api.SetJSONValue(entityData, o.terminatorStrategy, "terminatorStrategy")
 	}
 	api.SetJSONValue(entityData, o.maxIdleTime.Milliseconds(), "maxIdleTimeMillis")
+	if o.multipath != "" {
+		api.SetJSONValue(entityData, o.multipath, "multipath")
+	}
+	if o.priority != "" {
+		api.SetJSONValue(entityData, o.priority, "priority")
+	}
 	api.SetJSONValue(entityData, o.tags, "tags")
 
 	result, err := createEntityOfType("services", entityData.String(), &o.Options)
@@ -153,10 +153,13 @@ func outputLinks(o *api.Options, results *link.ListLinksOK) error {
 	columnConfigs := []table.ColumnConfig{
 		{Number: 5, Align: text.AlignRight},
 		{Number: 6, Align: text.AlignRight},
+		{Number: 7, Align: text.AlignRight},
 		{Number: 8, Align: text.AlignRight},
+		{Number: 9, Align: text.AlignRight},
+		{Number: 11, Align: text.AlignRight},
 	}
 	t.SetColumnConfigs(columnConfigs)
-	t.AppendHeader(table.Row{"ID", "Dialer", "Acceptor", "Static Cost", "Src Latency", "Dst Latency", "State", "Status", "Full Cost", "Connections"})
+	t.AppendHeader(table.Row{"ID", "Dialer", "Acceptor", "Static Cost", "Src Latency", "Dst Latency", "Loss", "Jitter", "Avail Bandwidth", "State", "Status", "Full Cost", "Connections"})
 
 	for _, entity := range results.Payload.Data {
 		id := valOrDefault(entity.ID)
@@ -183,6 +186,9 @@ func outputLinks(o *api.Options, results *link.ListLinksOK) error {
 		t.AppendRow(table.Row{id, srcRouter, dstRouter, staticCost,
 			fmt.Sprintf("%.1fms", srcLatency),
 			fmt.Sprintf("%.1fms", dstLatency),
+			fmt.Sprintf("%.1f%% / %.1f%%", entity.SourceLoss, entity.DestLoss),
+			fmt.Sprintf("%.1fms / %.1fms", float64(entity.SourceJitter)/1_000_000, float64(entity.DestJitter)/1_000_000),
+			fmt.Sprintf("%s / %s", formatBandwidth(entity.SourceAvailableBandwidth), formatBandwidth(entity.DestAvailableBandwidth)),
 			state, status, cost, strings.Join(conns, "\n")})
 	}
 
@@ -191,6 +197,20 @@ func outputLinks(o *api.Options, results *link.ListLinksOK) error {
 	return nil
 }
 
+// formatBandwidth formats a bandwidth in bits per second, using "-" if no estimate is available
+func formatBandwidth(bitsPerSecond int64) string {
+	switch {
+	case bitsPerSecond <= 0:
+		return "-"
+	case bitsPerSecond >= 1_000_000_000:
+		return fmt.Sprintf("%.1fGbps", float64(bitsPerSecond)/1_000_000_000)
+	case bitsPerSecond >= 1_000_000:
+		return fmt.Sprintf("%.1fMbps", float64(bitsPerSecond)/1_000_000)
+	default:
+		return fmt.Sprintf("%.1fKbps", float64(bitsPerSecond)/1_000)
+	}
+}
+
 func runListTerminators(o *api.Options) error {
 	return WithFabricClient(o, func(client *fabricRestModel.ZitiFabric) error {
 		ctx, cancelF := o.GetContext()
@@ -207,7 +227,7 @@ func runListTerminators(o *api.Options) error {
 func outputTerminators(o *api.Options, result *terminator.ListTerminatorsOK) error {
 	t := table.NewWriter()
 	t.SetStyle(table.StyleRounded)
-	t.AppendHeader(table.Row{"ID", "Service", "Router", "Binding", "Address", "Instance", "Cost", "Precedence", "Dynamic Cost", "Host ID"})
+	t.AppendHeader(table.Row{"ID", "Service", "Router", "Binding", "Address", "Instance", "Cost", "Precedence", "Dynamic Cost", "Host ID", "Ejected Until"})
 
 	for _, entity := range result.Payload.Data {
 		id := valOrDefault(entity.ID)
@@ -220,8 +240,12 @@ func outputTerminators(o *api.Options, result *terminator.ListTerminatorsOK) err
 		precedence := valOrDefault(entity.Precedence)
 		dynamicCost := valOrDefault(entity.DynamicCost)
 		hostId := valOrDefault(entity.HostID)
+		ejectedUntil := ""
+		if entity.EjectedUntil != nil {
+			ejectedUntil = entity.EjectedUntil.String()
+		}
 
-		t.AppendRow(table.Row{id, serviceName, routerName, binding, address, instanceId, staticCost, precedence, dynamicCost, hostId})
+		t.AppendRow(table.Row{id, serviceName, routerName, binding, address, instanceId, staticCost, precedence, dynamicCost, hostId, ejectedUntil})
 	}
 
 	api.RenderTable(o, t, getPaging(result.Payload.Meta))
@@ -275,7 +299,7 @@ func runListRouters(o *api.Options) error {
 func outputRouters(o *api.Options, result *router.ListRoutersOK) error {
 	t := table.NewWriter()
 	t.SetStyle(table.StyleRounded)
-	t.AppendHeader(table.Row{"ID", "Name", "Online", "Cost", "No Traversal", "Disabled", "Version", "Listeners"})
+	t.AppendHeader(table.Row{"ID", "Name", "Online", "Cost", "No Traversal", "Disabled", "Drain", "Version", "Listeners"})
 
 	for _, entity := range result.Payload.Data {
 		var version string
@@ -287,6 +311,12 @@ func outputRouters(o *api.Options, result *router.ListRoutersOK) error {
 			addr := stringz.OrEmpty(listenerAddr.Address)
 			listeners = append(listeners, fmt.Sprintf("%v: %v", idx+1, addr))
 		}
+		var drain string
+		if valOrDefault(entity.Drained) {
+			drain = "drained"
+		} else if valOrDefault(entity.Draining) {
+			drain = "draining"
+		}
 		t.AppendRow(table.Row{
 			valOrDefault(entity.ID),
 			valOrDefault(entity.Name),
@@ -294,6 +324,7 @@ func outputRouters(o *api.Options, result *router.ListRoutersOK) error {
 			valOrDefault(entity.Cost),
 			valOrDefault(entity.NoTraversal),
 			valOrDefault(entity.Disabled),
+			drain,
 			version,
 			strings.Join(listeners, "\n")})
 	}
@@ -35,6 +35,8 @@ type updateRouterOptions struct {
 	cost        uint16
 	noTraversal bool
 	disabled    bool
+	drain       bool
+	config      string
 	tags        map[string]string
 }
 
@@ -62,6 +64,8 @@ func newUpdateRouterCmd(p common.OptionsProvider) *cobra.Command {
 	cmd.Flags().Uint16Var(&options.cost, "cost", 0, "Specifies the router cost. Default 0.")
 	cmd.Flags().BoolVar(&options.noTraversal, "no-traversal", false, "Disallow traversal for this edge router. Default to allowed(false).")
 	cmd.Flags().BoolVar(&options.disabled, "disabled", false, "Disabled routers can't connect to controllers")
+	cmd.Flags().BoolVar(&options.drain, "drain", false, "Draining routers are avoided for new circuits, so they can be restarted once empty. Use --drain=false to stop draining")
+	cmd.Flags().StringVar(&options.config, "config", "", "Sets the router.v1 config which manages part of the router's configuration. Use --config \"\" to remove it")
 	cmd.Flags().StringToStringVar(&options.tags, "tags", nil, "Custom management tags")
 
 	options.AddCommonFlags(cmd)
@@ -103,6 +107,24 @@ func runUpdateRouter(o *updateRouterOptions) error {
 		change = true
 	}
 
+	if o.Cmd.Flags().Changed("drain") {
+		api.SetJSONValue(entityData, o.drain, "draining")
+		change = true
+	}
+
+	if o.Cmd.Flags().Changed("config") {
+		if o.config == "" {
+			api.SetJSONValue(entityData, nil, "configId")
+		} else {
+			configId, err := api.MapNameToID(util.EdgeAPI, "configs", &o.Options, o.config)
+			if err != nil {
+				return err
+			}
+			api.SetJSONValue(entityData, configId, "configId")
+		}
+		change = true
+	}
+
 	if o.Cmd.Flags().Changed("tags") {
 		api.SetJSONValue(entityData, o.tags, "tags")
 		change = true
@@ -34,6 +34,8 @@ type updateServiceOptions struct {
 	name               string
 	terminatorStrategy string
 	maxIdleTime        time.Duration
+	multipath          string
+	priority           string
 	tags               map[string]string
 }
 
@@ -59,6 +61,8 @@ func newUpdateServiceCmd(p common.OptionsProvider) *cobra.Command {
 	cmd.Flags().StringVarP(&options.name, "name", "n", "", "Set the name of the service")
 	cmd.Flags().StringVar(&options.terminatorStrategy, "terminator-strategy", "", "Specifies the terminator strategy for the service")
 	cmd.Flags().DurationVar(&options.maxIdleTime, "max-idle-time", 0, "Time after which idle circuit will be terminated. Defaults to 0, which indicates no limit on idle circuits")
+	cmd.Flags().StringVar(&options.multipath, "multipath", "", "Route circuits over two disjoint paths. Valid values are 'duplicate', which sends every payload over both paths, and 'stripe', which alternates payloads between them. Set to an empty string to use a single path")
+	cmd.Flags().StringVar(&options.priority, "priority", "", "The priority class for the service's circuits on router links. Valid values are 'interactive', 'bulk' and 'background'")
 	cmd.Flags().StringToStringVar(&options.tags, "tags", nil, "Custom management tags")
 	options.AddCommonFlags(cmd)
 
@@ -89,6 +93,16 @@ func runUpdateService(o *updateServiceOptions) error {
 		change = true
 	}
 
+	if o.Cmd.Flags().Changed("multipath") {
+		api.SetJSONValue(entityData, o.multipath, "multipath")
+		change = true
+	}
+
+	if o.Cmd.Flags().Changed("priority") {
+		api.SetJSONValue(entityData, o.priority, "priority")
+		change = true
+	}
+
 	if o.Cmd.Flags().Changed("tags") {
 		api.SetJSONValue(entityData, o.tags, "tags")
 		change = true
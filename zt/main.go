@@ -29,6 +29,7 @@ import (
 	"github.com/hanzozt/transport/v2/wss"
 	"github.com/hanzozt/zt/v2/common/build"
 	"github.com/hanzozt/zt/v2/common/version"
+	"github.com/hanzozt/zt/v2/router/xlink_transport/quic"
 	"github.com/hanzozt/zt/v2/zt/cmd"
 	"github.com/sirupsen/logrus"
 )
@@ -45,6 +46,7 @@ func init() {
 	transport.AddAddressParser(ws.AddressParser{})
 	transport.AddAddressParser(wss.AddressParser{})
 	transport.AddAddressParser(udp.AddressParser{})
+	transport.AddAddressParser(quic.AddressParser{})
 
 	build.InitBuildInfo(version.GetCmdBuildInfo())
 }
@@ -73,6 +73,7 @@ func NewTunnelCmd(legacy bool) *cobra.Command {
 	root.PersistentFlags().Uint8Var(&maxControlConnections, "control-connections", 1, "sets the desired number of control connections")
 	root.AddCommand(NewHostCmd())
 	root.AddCommand(NewProxyCmd())
+	root.AddCommand(NewSocksCmd())
 	for _, cmdF := range hostSpecificCmds {
 		cmd := cmdF()
 		if cmd.Name() != "run" || legacy { // only include run in 'zt tunnel' tree
@@ -0,0 +1,55 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package tunnel
+
+import (
+	"github.com/hanzozt/zt/v2/tunnel/intercept/socks"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const socksAddressFlag = "address"
+
+func NewSocksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "socks",
+		Short:   "Run in 'socks' mode",
+		Long:    "The 'socks' intercept mode creates a single SOCKS5 and HTTP CONNECT proxy listener. Requested host:port destinations are matched against the intercept.v1 addresses of dialable services, so no root privileges or per-service ports are needed.",
+		Args:    cobra.ExactArgs(0),
+		RunE:    runSocks,
+		PostRun: rootPostRun,
+	}
+	cmd.Flags().String(socksAddressFlag, socks.DefaultAddress, "The address the proxy listens on")
+	return cmd
+}
+
+func runSocks(cmd *cobra.Command, _ []string) error {
+	// hostnames are resolved by the proxy, so the DNS server is only started if explicitly requested
+	if flag := cmd.Flag(resolverCfgFlag); !flag.Changed {
+		_ = flag.Value.Set("")
+	}
+
+	address, err := cmd.Flags().GetString(socksAddressFlag)
+	if err != nil {
+		return err
+	}
+
+	if interceptor, err = socks.New(socks.Config{Address: address}); err != nil {
+		return errors.Wrap(err, "failed to initialize socks interceptor")
+	}
+	return nil
+}
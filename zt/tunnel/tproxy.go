@@ -34,12 +34,13 @@ func NewTProxyCmd() *cobra.Command {
 	var runTProxyCmd = &cobra.Command{
 		Use:     "tproxy",
 		Short:   "Use the 'tproxy' interceptor",
-		Long:    "The 'tproxy' interceptor captures packets by using the TPROXY iptables target.",
+		Long:    "The 'tproxy' interceptor captures packets by using the TPROXY iptables or nftables target.",
 		RunE:    runTProxy,
 		PostRun: rootPostRun,
 	}
 	runTProxyCmd.PersistentFlags().String("lanIf", "", "if specified, INPUT rules for intercepted service addresses are assigned to this interface ")
 	runTProxyCmd.PersistentFlags().String("diverter", "", "if specified, use external tproxy configuration utility instead of internal iptables implementation")
+	runTProxyCmd.PersistentFlags().String("backend", tproxy.BackendIptables, "the firewall backend used to manage intercept rules, either iptables or nftables. nftables requires nft 0.9.4 and linux 5.6 or later")
 	return runTProxyCmd
 }
 
@@ -54,7 +55,12 @@ func runTProxy(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	interceptor, err = tproxy.New(tproxy.Config{LanIf: lanIf, Diverter: diverter}, proxy.DefaultAlerter{})
+	backend, err := cmd.Flags().GetString("backend")
+	if err != nil {
+		return err
+	}
+
+	interceptor, err = tproxy.New(tproxy.Config{LanIf: lanIf, Diverter: diverter, Backend: backend}, proxy.DefaultAlerter{})
 	if err != nil {
 		return fmt.Errorf("failed to initialize tproxy interceptor: %v", err)
 	}
@@ -0,0 +1,80 @@
+//go:build linux
+
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package tunnel
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/hanzozt/zt/v2/tunnel/intercept"
+	"github.com/hanzozt/zt/v2/tunnel/intercept/tun"
+	"github.com/spf13/cobra"
+)
+
+const (
+	tunDeviceFlag = "device"
+	tunMtuFlag    = "mtu"
+)
+
+func init() {
+	hostSpecificCmds = append(hostSpecificCmds, NewTunCmd)
+}
+
+func NewTunCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tun",
+		Short:   "Use the 'tun' interceptor",
+		Long:    "The 'tun' interceptor routes intercepted addresses to a TUN device and terminates the traffic with a userspace network stack, so no iptables rules are needed. Unless a resolver is given, DNS is served on the address of the TUN device, which is the first address of the DNS service IP range.",
+		Args:    cobra.ExactArgs(0),
+		RunE:    runTun,
+		PostRun: rootPostRun,
+	}
+	cmd.Flags().String(tunDeviceFlag, tun.DefaultDeviceName, "The name of the TUN device")
+	cmd.Flags().Uint32(tunMtuFlag, tun.DefaultMTU, "The MTU of the TUN device")
+	return cmd
+}
+
+func runTun(cmd *cobra.Command, _ []string) error {
+	deviceName, err := cmd.Flags().GetString(tunDeviceFlag)
+	if err != nil {
+		return err
+	}
+
+	mtu, err := cmd.Flags().GetUint32(tunMtuFlag)
+	if err != nil {
+		return err
+	}
+
+	// the TUN device address is taken from the DNS range, so it needs to be set before the device is created
+	dnsIpRange, _ := cmd.Flags().GetString(dnsSvcIpRangeFlag)
+	if err = intercept.SetDnsInterceptIpRange(dnsIpRange); err != nil {
+		return fmt.Errorf("invalid dns service IP range %s: %v", dnsIpRange, err)
+	}
+
+	if flag := cmd.Flag(resolverCfgFlag); !flag.Changed {
+		deviceIp := intercept.GetDnsInterceptIpRange().IP
+		_ = flag.Value.Set("udp://" + net.JoinHostPort(deviceIp.String(), "53"))
+	}
+
+	interceptor, err = tun.New(tun.Config{DeviceName: deviceName, MTU: mtu})
+	if err != nil {
+		return fmt.Errorf("failed to initialize tun interceptor: %v", err)
+	}
+	return nil
+}